@@ -0,0 +1,92 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// AffinityLabelID is the identifier of an affinity label.
+type AffinityLabelID string
+
+// AffinityLabelClient contains the methods to manage affinity labels. Affinity labels are a lighter-weight
+// alternative to affinity groups: VMs can only run on hosts that carry all the labels of the VM.
+//
+// See https://www.ovirt.org/documentation/virtual_machine_management_guide/#sect-Affinity_Labels for details.
+type AffinityLabelClient interface {
+	// CreateAffinityLabel creates a new affinity label. The name must be unique.
+	CreateAffinityLabel(name string, retries ...RetryStrategy) (AffinityLabel, error)
+	// GetAffinityLabel returns a single affinity label based on its ID.
+	GetAffinityLabel(id AffinityLabelID, retries ...RetryStrategy) (AffinityLabel, error)
+	// ListAffinityLabels returns all affinity labels on the oVirt Engine.
+	ListAffinityLabels(retries ...RetryStrategy) ([]AffinityLabel, error)
+	// RemoveAffinityLabel removes the affinity label with the specified ID. The label is removed from all VMs.
+	RemoveAffinityLabel(id AffinityLabelID, retries ...RetryStrategy) error
+
+	// AddAffinityLabelToVM adds the affinity label to the VM. An ENotFound error is returned if the label doesn't
+	// exist. Adding a label the VM already has does nothing.
+	AddAffinityLabelToVM(vmID VMID, labelID AffinityLabelID, retries ...RetryStrategy) error
+	// RemoveAffinityLabelFromVM removes the affinity label from the VM. Removing a label the VM doesn't have does
+	// nothing.
+	RemoveAffinityLabelFromVM(vmID VMID, labelID AffinityLabelID, retries ...RetryStrategy) error
+	// ListVMAffinityLabels returns the affinity labels of the VM.
+	ListVMAffinityLabels(vmID VMID, retries ...RetryStrategy) ([]AffinityLabel, error)
+}
+
+// AffinityLabelData contains the data access functions of an AffinityLabel.
+type AffinityLabelData interface {
+	// ID returns the identifier of the affinity label.
+	ID() AffinityLabelID
+	// Name returns the unique name of the affinity label.
+	Name() string
+}
+
+// AffinityLabel is a label that can be added to VMs and hosts to restrict which hosts the VMs can run on.
+type AffinityLabel interface {
+	AffinityLabelData
+
+	// Remove removes the current affinity label.
+	Remove(retries ...RetryStrategy) error
+}
+
+func convertSDKAffinityLabel(sdkObject *ovirtsdk.AffinityLabel, client Client) (*affinityLabel, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("affinity label", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("affinity label", "name")
+	}
+	return &affinityLabel{
+		client: client,
+		id:     AffinityLabelID(id),
+		name:   name,
+	}, nil
+}
+
+type affinityLabel struct {
+	client Client
+
+	id   AffinityLabelID
+	name string
+	// vmIDs holds the VMs the label is added to. It is only used by the mock.
+	vmIDs map[VMID]struct{}
+}
+
+func (a *affinityLabel) ID() AffinityLabelID {
+	return a.id
+}
+
+func (a *affinityLabel) Name() string {
+	return a.name
+}
+
+func (a *affinityLabel) Remove(retries ...RetryStrategy) error {
+	return a.client.RemoveAffinityLabel(a.id, retries...)
+}
+
+func validateAffinityLabelName(name string) error {
+	if err := ValidateResourceName(name); err != nil {
+		return wrap(err, EBadArgument, "invalid affinity label name")
+	}
+	return nil
+}
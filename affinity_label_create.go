@@ -0,0 +1,87 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateAffinityLabel(name string, retries ...RetryStrategy) (result AffinityLabel, err error) {
+	if err := validateAffinityLabelName(name); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	action := fmt.Sprintf("creating affinity label %s", name)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				AffinityLabelsService().
+				Add().
+				Label(ovirtsdk.NewAffinityLabelBuilder().Name(name).MustBuild()).
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			label, ok := response.Label()
+			if !ok {
+				return newFieldNotFound("affinity label creation response", "label")
+			}
+			result, err = convertSDKAffinityLabel(label, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert affinity label")
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) RemoveAffinityLabel(id AffinityLabelID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	action := fmt.Sprintf("removing affinity label %s", id)
+	return retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().AffinityLabelsService().LabelService(string(id)).Remove().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			return nil
+		})
+}
+
+func (m *mockClient) CreateAffinityLabel(name string, _ ...RetryStrategy) (AffinityLabel, error) {
+	if err := validateAffinityLabelName(name); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, label := range m.affinityLabels {
+		if label.name == name {
+			return nil, newError(EConflict, "an affinity label with the name %s already exists", name)
+		}
+	}
+	label := &affinityLabel{
+		client: m,
+		id:     AffinityLabelID(m.GenerateUUID()),
+		name:   name,
+		vmIDs:  map[VMID]struct{}{},
+	}
+	m.affinityLabels[label.id] = label
+	return label, nil
+}
+
+func (m *mockClient) RemoveAffinityLabel(id AffinityLabelID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.affinityLabels[id]; !ok {
+		return newError(ENotFound, "affinity label with ID %s not found", id)
+	}
+	delete(m.affinityLabels, id)
+	return nil
+}
@@ -0,0 +1,77 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetAffinityLabel(id AffinityLabelID, retries ...RetryStrategy) (result AffinityLabel, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("getting affinity label %s", id)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().AffinityLabelsService().LabelService(string(id)).Get().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			label, ok := response.Label()
+			if !ok {
+				return newError(ENotFound, "no affinity label returned when getting affinity label ID %s", id)
+			}
+			result, err = convertSDKAffinityLabel(label, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert affinity label %s", id)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) ListAffinityLabels(retries ...RetryStrategy) (result []AffinityLabel, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []AffinityLabel{}
+	err = retry(
+		"listing affinity labels",
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().AffinityLabelsService().List().Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Labels()
+			if !ok {
+				return nil
+			}
+			result = make([]AffinityLabel, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], err = convertSDKAffinityLabel(sdkObject, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert affinity label during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetAffinityLabel(id AffinityLabelID, _ ...RetryStrategy) (AffinityLabel, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if item, ok := m.affinityLabels[id]; ok {
+		return item, nil
+	}
+	return nil, newError(ENotFound, "affinity label with ID %s not found", id)
+}
+
+func (m *mockClient) ListAffinityLabels(_ ...RetryStrategy) ([]AffinityLabel, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := make([]AffinityLabel, 0, len(m.affinityLabels))
+	for _, item := range m.affinityLabels {
+		result = append(result, item)
+	}
+	return result, nil
+}
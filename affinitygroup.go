@@ -25,6 +25,15 @@ type AffinityGroupClient interface {
 	GetAffinityGroupByName(clusterID ClusterID, name string, retries ...RetryStrategy) (AffinityGroup, error)
 	// RemoveAffinityGroup removes the affinity group specified.
 	RemoveAffinityGroup(clusterID ClusterID, id AffinityGroupID, retries ...RetryStrategy) error
+	// EnsureAffinityGroup returns the affinity group with the specified name in the cluster, creating it if it
+	// doesn't exist yet. A concurrent creation by another caller is not an error, the group they created is
+	// returned. Existing groups are returned as they are, even if they don't match the params.
+	EnsureAffinityGroup(
+		clusterID ClusterID,
+		name string,
+		params CreateAffinityGroupOptionalParams,
+		retries ...RetryStrategy,
+	) (AffinityGroup, error)
 
 	AddVMToAffinityGroup(clusterID ClusterID, vmID VMID, agID AffinityGroupID, retries ...RetryStrategy) error
 	RemoveVMFromAffinityGroup(clusterID ClusterID, vmID VMID, agID AffinityGroupID, retries ...RetryStrategy) error
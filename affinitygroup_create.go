@@ -12,6 +12,9 @@ func (o *oVirtClient) CreateAffinityGroup(
 	params CreateAffinityGroupOptionalParams,
 	retries ...RetryStrategy,
 ) (result AffinityGroup, err error) {
+	if err := validateAffinityGroupName(name); err != nil {
+		return nil, err
+	}
 	if params == nil {
 		params = CreateAffinityGroupParams()
 	}
@@ -54,7 +57,7 @@ func (o *oVirtClient) CreateAffinityGroup(
 			)
 			response, err := addRequest.Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("creating affinity group in cluster %s", clusterID), err)
 			}
 			group, ok := response.Group()
 			if !ok {
@@ -73,6 +76,9 @@ func (m *mockClient) CreateAffinityGroup(
 	params CreateAffinityGroupOptionalParams,
 	_ ...RetryStrategy,
 ) (AffinityGroup, error) {
+	if err := validateAffinityGroupName(name); err != nil {
+		return nil, err
+	}
 	if params == nil {
 		params = CreateAffinityGroupParams()
 	}
@@ -111,8 +117,20 @@ func (m *mockClient) CreateAffinityGroup(
 	if _, ok := m.affinityGroups[ag.ClusterID()]; !ok {
 		return nil, newError(ENotFound, "Cluster %s not found.", ag.ClusterID())
 	}
+	for _, existingGroup := range m.affinityGroups[ag.ClusterID()] {
+		if existingGroup.name == name {
+			return nil, newError(EConflict, "an affinity group with the name %s already exists in cluster %s", name, clusterID)
+		}
+	}
 
 	m.affinityGroups[ag.ClusterID()][ag.id] = ag
 
 	return ag, nil
 }
+
+func validateAffinityGroupName(name string) error {
+	if err := ValidateResourceName(name); err != nil {
+		return wrap(err, EBadArgument, "invalid affinity group name")
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package ovirtclient
+
+func (o *oVirtClient) EnsureAffinityGroup(
+	clusterID ClusterID,
+	name string,
+	params CreateAffinityGroupOptionalParams,
+	retries ...RetryStrategy,
+) (AffinityGroup, error) {
+	return ensureAffinityGroup(o, clusterID, name, params, retries)
+}
+
+func (m *mockClient) EnsureAffinityGroup(
+	clusterID ClusterID,
+	name string,
+	params CreateAffinityGroupOptionalParams,
+	retries ...RetryStrategy,
+) (AffinityGroup, error) {
+	return ensureAffinityGroup(m, clusterID, name, params, retries)
+}
+
+func ensureAffinityGroup(
+	client Client,
+	clusterID ClusterID,
+	name string,
+	params CreateAffinityGroupOptionalParams,
+	retries []RetryStrategy,
+) (AffinityGroup, error) {
+	if err := validateAffinityGroupName(name); err != nil {
+		return nil, err
+	}
+	existingGroup, err := client.GetAffinityGroupByName(clusterID, name, retries...)
+	if err == nil {
+		return existingGroup, nil
+	}
+	if !HasErrorCode(err, ENotFound) {
+		return nil, err
+	}
+	result, err := client.CreateAffinityGroup(clusterID, name, params, abortOnConflict(retries)...)
+	if err == nil {
+		return result, nil
+	}
+	if !HasErrorCode(err, EConflict) {
+		return nil, err
+	}
+	// The group was created concurrently, fetch the one that won the race.
+	existingGroup, lookupErr := client.GetAffinityGroupByName(clusterID, name, retries...)
+	if lookupErr != nil {
+		return nil, wrap(
+			lookupErr,
+			EUnidentified,
+			"failed to fetch affinity group %s after its creation resulted in a conflict (%v)",
+			name,
+			err,
+		)
+	}
+	return existingGroup, nil
+}
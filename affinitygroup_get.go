@@ -13,7 +13,7 @@ func (o *oVirtClient) GetAffinityGroup(clusterID ClusterID, id AffinityGroupID,
 		func() error {
 			response, err := o.conn.SystemService().ClustersService().ClusterService(string(clusterID)).AffinityGroupsService().GroupService(string(id)).Get().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting affinity group %s", id), err)
 			}
 			sdkObject, ok := response.Group()
 			if !ok {
@@ -15,7 +15,7 @@ func (o *oVirtClient) GetAffinityGroupByName(clusterID ClusterID, name string, r
 		func() error {
 			response, err := o.conn.SystemService().ClustersService().ClusterService(string(clusterID)).AffinityGroupsService().List().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting affinity group %s", name), err)
 			}
 			sdkObject, ok := response.Groups()
 			if !ok {
@@ -0,0 +1,34 @@
+package ovirtclient
+
+import (
+	"context"
+)
+
+// allContentHeader is the HTTP header instructing the oVirt Engine to include attributes in the response that are
+// excluded by default.
+const allContentHeader = "All-Content"
+
+type allContentContextKey struct{}
+
+// WithAllContent returns a copy of ctx that makes the client send the All-Content header on VM reads (GetVM,
+// GetVMByName, ListVMs, SearchVMs and GetVMGuestInfo). Pass the returned context to Client.WithContext:
+//
+//	vm, err := client.WithContext(ovirtclient.WithAllContent(ctx)).GetVM(id)
+//
+// With the header set, the oVirt Engine includes attributes that are otherwise left out of the response to save
+// bandwidth. Without it, VM.SerialConsole and VM.SoundcardEnabled always report false.
+func WithAllContent(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, allContentContextKey{}, true)
+}
+
+// allContentRequested returns true if ctx has been created by WithAllContent.
+func allContentRequested(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	allContent, _ := ctx.Value(allContentContextKey{}).(bool)
+	return allContent
+}
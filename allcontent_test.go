@@ -0,0 +1,69 @@
+package ovirtclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestWithAllContent(t *testing.T) {
+	t.Parallel()
+
+	lock := &sync.Mutex{}
+	var headers []string
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/ovirt-engine/sso/oauth/token":
+			writer.Header().Set("Content-Type", "application/json")
+			_, _ = writer.Write([]byte(`{"access_token":"test"}`))
+		case "/ovirt-engine/api/vms":
+			lock.Lock()
+			headers = append(headers, request.Header.Get("All-Content"))
+			lock.Unlock()
+			writer.Header().Set("Content-Type", "application/xml")
+			_, _ = writer.Write([]byte(`<vms></vms>`))
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := ovirtclient.NewWithVerify(
+		srv.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"invalid-password-for-testing-purposes",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+		func(connection ovirtclient.Client) error {
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("failed to set up connection (%v)", err)
+	}
+
+	if _, err := client.ListVMs(); err != nil {
+		t.Fatalf("failed to list VMs (%v)", err)
+	}
+	if _, err := client.WithContext(ovirtclient.WithAllContent(context.Background())).ListVMs(); err != nil {
+		t.Fatalf("failed to list VMs with all content (%v)", err)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	if len(headers) != 2 {
+		t.Fatalf("incorrect number of requests (expected: 2, got: %d)", len(headers))
+	}
+	if headers[0] != "" {
+		t.Fatalf("All-Content header sent without WithAllContent (%s)", headers[0])
+	}
+	if headers[1] != "true" {
+		t.Fatalf("All-Content header not sent with WithAllContent (got: %q)", headers[1])
+	}
+}
@@ -0,0 +1,215 @@
+package ovirtclient
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// BatchItemStatus is the state of a single item in a batch operation, as reported by BatchProgress.
+type BatchItemStatus string
+
+const (
+	// BatchItemStatusPending means the item waits for a free worker.
+	BatchItemStatusPending BatchItemStatus = "pending"
+	// BatchItemStatusRunning means the operation on the item is in progress.
+	BatchItemStatusRunning BatchItemStatus = "running"
+	// BatchItemStatusDone means the operation on the item finished successfully.
+	BatchItemStatusDone BatchItemStatus = "done"
+	// BatchItemStatusFailed means the operation on the item failed. The error is also part of the MultiError the
+	// batch operation returns.
+	BatchItemStatusFailed BatchItemStatus = "failed"
+)
+
+// BatchItemStatusList is a list of BatchItemStatus values.
+type BatchItemStatusList []BatchItemStatus
+
+// BatchItemStatusValues returns all possible BatchItemStatus values.
+func BatchItemStatusValues() BatchItemStatusList {
+	return []BatchItemStatus{
+		BatchItemStatusPending,
+		BatchItemStatusRunning,
+		BatchItemStatusDone,
+		BatchItemStatusFailed,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l BatchItemStatusList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// Validate returns an error if the batch item status is not a valid value.
+func (s BatchItemStatus) Validate() error {
+	for _, status := range BatchItemStatusValues() {
+		if status == s {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid batch item status: %s must be one of: %s",
+		s,
+		strings.Join(BatchItemStatusValues().Strings(), ", "),
+	)
+}
+
+// BatchItemProgress is the state of a single item in a BatchProgress snapshot.
+type BatchItemProgress interface {
+	// ID returns the identifier of the item, for example the VM ID. It is the same key the MultiError of the batch
+	// operation uses.
+	ID() string
+	// Status returns the state of the item.
+	Status() BatchItemStatus
+	// Err returns the error of a failed item, or nil otherwise.
+	Err() error
+}
+
+// BatchProgress tracks the state of each item of the batch operations (StartVMs, StopVMs, AddTagToVMs,
+// ShutdownEnvironment). Create it with NewBatchProgress and pass it to the client with WithBatchProgress:
+//
+//	progress := ovirtclient.NewBatchProgress()
+//	go func() {
+//	    err := client.WithContext(ovirtclient.WithBatchProgress(ctx, progress)).StartVMs(vmIDs)
+//	    // ...
+//	}()
+//	for _, item := range progress.Snapshot() {
+//	    fmt.Printf("%s: %s\n", item.ID(), item.Status())
+//	}
+//
+// The workers only hold a short lock to record a status change, so polling Snapshot never slows the batch down.
+// A BatchProgress may be reused for several batches, items of a new batch are reset to pending.
+type BatchProgress interface {
+	// Snapshot returns a copy of the current state of all items in the order the batch operations registered them.
+	Snapshot() []BatchItemProgress
+
+	// update records the status of an item, adding it if it is new. It is unexported so that only the client can
+	// report progress.
+	update(id string, status BatchItemStatus, err error)
+}
+
+// NewBatchProgress creates an empty BatchProgress.
+func NewBatchProgress() BatchProgress {
+	return &batchProgress{
+		items: map[string]*batchItemProgress{},
+	}
+}
+
+type batchProgress struct {
+	lock  sync.Mutex
+	order []string
+	items map[string]*batchItemProgress
+}
+
+func (b *batchProgress) Snapshot() []BatchItemProgress {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	result := make([]BatchItemProgress, len(b.order))
+	for i, id := range b.order {
+		item := *b.items[id]
+		result[i] = &item
+	}
+	return result
+}
+
+func (b *batchProgress) update(id string, status BatchItemStatus, err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	item, ok := b.items[id]
+	if !ok {
+		item = &batchItemProgress{id: id}
+		b.items[id] = item
+		b.order = append(b.order, id)
+	}
+	item.status = status
+	item.err = err
+}
+
+type batchItemProgress struct {
+	id     string
+	status BatchItemStatus
+	err    error
+}
+
+func (b *batchItemProgress) ID() string {
+	return b.id
+}
+
+func (b *batchItemProgress) Status() BatchItemStatus {
+	return b.status
+}
+
+func (b *batchItemProgress) Err() error {
+	return b.err
+}
+
+type batchProgressContextKey struct{}
+
+// WithBatchProgress returns a copy of ctx that makes the batch operations of the client record the state of each
+// item in progress. Pass the returned context to Client.WithContext.
+func WithBatchProgress(ctx context.Context, progress BatchProgress) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, batchProgressContextKey{}, progress)
+}
+
+// batchProgressFromContext returns the progress set by WithBatchProgress, or nil if there is none.
+func batchProgressFromContext(ctx context.Context) BatchProgress {
+	if ctx == nil {
+		return nil
+	}
+	progress, _ := ctx.Value(batchProgressContextKey{}).(BatchProgress)
+	return progress
+}
+
+// registerBatchItems marks the items as pending in progress, if it is not nil.
+func registerBatchItems(progress BatchProgress, ids []string) {
+	if progress == nil {
+		return
+	}
+	for _, id := range ids {
+		progress.update(id, BatchItemStatusPending, nil)
+	}
+}
+
+// runTrackedBulkOperation runs fn for each item using runBulkOperation, records the state of the items in progress
+// if it is not nil and returns the errors of the failed items keyed by their ID for newMultiError.
+func runTrackedBulkOperation(progress BatchProgress, ids []string, fn func(i int) error) map[string]error {
+	registerBatchItems(progress, ids)
+	var lock sync.Mutex
+	itemErrors := map[string]error{}
+	runBulkOperation(len(ids), func(i int) {
+		if progress != nil {
+			progress.update(ids[i], BatchItemStatusRunning, nil)
+		}
+		err := fn(i)
+		if err != nil {
+			lock.Lock()
+			itemErrors[ids[i]] = err
+			lock.Unlock()
+		}
+		if progress == nil {
+			return
+		}
+		if err != nil {
+			progress.update(ids[i], BatchItemStatusFailed, err)
+		} else {
+			progress.update(ids[i], BatchItemStatusDone, nil)
+		}
+	})
+	return itemErrors
+}
+
+// vmIDStrings converts the VM IDs to the item IDs used by BatchProgress and MultiError.
+func vmIDStrings(vmIDs []VMID) []string {
+	result := make([]string, len(vmIDs))
+	for i, id := range vmIDs {
+		result[i] = string(id)
+	}
+	return result
+}
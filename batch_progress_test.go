@@ -0,0 +1,37 @@
+package ovirtclient_test
+
+import (
+	"context"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestBatchProgress(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	tag := assertCanCreateTag(t, helper, helper.GenerateTestResourceName(t), "")
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	missingVMID := ovirtclient.VMID("00000000-0000-0000-0000-000000000001")
+
+	progress := ovirtclient.NewBatchProgress()
+	trackedClient := client.WithContext(ovirtclient.WithBatchProgress(context.Background(), progress))
+	if err := trackedClient.AddTagToVMs(tag.ID(), []ovirtclient.VMID{vm.ID(), missingVMID}); err == nil {
+		t.Fatalf("Adding a tag to a nonexistent VM did not result in an error.")
+	}
+
+	snapshot := progress.Snapshot()
+	if len(snapshot) != 2 {
+		t.Fatalf("Incorrect number of items in the batch progress (expected: 2, got: %d)", len(snapshot))
+	}
+	if snapshot[0].ID() != string(vm.ID()) || snapshot[0].Status() != ovirtclient.BatchItemStatusDone {
+		t.Fatalf("Incorrect progress for VM %s (status: %s)", snapshot[0].ID(), snapshot[0].Status())
+	}
+	if snapshot[1].ID() != string(missingVMID) ||
+		snapshot[1].Status() != ovirtclient.BatchItemStatusFailed ||
+		snapshot[1].Err() == nil {
+		t.Fatalf("Incorrect progress for VM %s (status: %s)", snapshot[1].ID(), snapshot[1].Status())
+	}
+}
@@ -0,0 +1,29 @@
+package ovirtclient
+
+// validateNotBlankUUID rejects the blank UUID for objects that can never have it as their ID. Without this check the
+// engine answers with a not found error that doesn't hint at the mixed-up ID.
+func validateNotBlankUUID(objectName string, id string) error {
+	if id == BlankUUID {
+		return newError(
+			EBadArgument,
+			"the blank UUID %s is not a valid %s ID, the engine only uses it for the Blank template",
+			id,
+			objectName,
+		)
+	}
+	return nil
+}
+
+// wrapBlankTemplateNotFound explains a not found error for the default Blank template, which happens when the
+// template has been removed from the engine.
+func wrapBlankTemplateNotFound(id TemplateID, err error) error {
+	if err == nil || id != DefaultBlankTemplateID || !HasErrorCode(err, ENotFound) {
+		return err
+	}
+	return wrap(
+		err,
+		ENotFound,
+		"the default Blank template %s has been removed from the engine, use GetBlankTemplate to find a replacement",
+		id,
+	)
+}
@@ -0,0 +1,25 @@
+package ovirtclient
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetRemovedBlankTemplate(t *testing.T) {
+	m := NewMock().(*mockClient)
+	if _, err := m.GetTemplate(DefaultBlankTemplateID); err != nil {
+		t.Fatalf("Failed to get the default Blank template (%v)", err)
+	}
+
+	m.lock.Lock()
+	delete(m.templates, DefaultBlankTemplateID)
+	m.lock.Unlock()
+
+	_, err := m.GetTemplate(DefaultBlankTemplateID)
+	if !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Getting the removed Blank template did not result in an ENotFound error (%v)", err)
+	}
+	if !strings.Contains(err.Error(), "GetBlankTemplate") {
+		t.Fatalf("The error for the removed Blank template does not point to GetBlankTemplate (%v)", err)
+	}
+}
@@ -0,0 +1,46 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestBlankUUIDIsRejected(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	calls := map[string]func() error{
+		"GetVM": func() error {
+			_, err := client.GetVM(ovirtclient.VMID(ovirtclient.BlankUUID))
+			return err
+		},
+		"GetDisk": func() error {
+			_, err := client.GetDisk(ovirtclient.DiskID(ovirtclient.BlankUUID))
+			return err
+		},
+		"GetHost": func() error {
+			_, err := client.GetHost(ovirtclient.HostID(ovirtclient.BlankUUID))
+			return err
+		},
+		"GetCluster": func() error {
+			_, err := client.GetCluster(ovirtclient.ClusterID(ovirtclient.BlankUUID))
+			return err
+		},
+		"CreateVM": func() error {
+			_, err := client.CreateVM(
+				ovirtclient.ClusterID(ovirtclient.BlankUUID),
+				helper.GetBlankTemplateID(),
+				helper.GenerateTestResourceName(t),
+				nil,
+			)
+			return err
+		},
+	}
+	for name, call := range calls {
+		if err := call(); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+			t.Fatalf("Calling %s with the blank UUID did not result in an EBadArgument error (%v)", name, err)
+		}
+	}
+}
@@ -12,24 +12,41 @@ import (
 
 // Client is a simplified client for the oVirt API.
 //
+// A Client is safe for concurrent use by multiple goroutines, including subclients created with WithContext, which
+// share the underlying connection. Reconnect may be called while other requests are in progress: requests that have
+// already started complete on the previous connection, new requests use the new connection.
+//
+// The oVirt SDK obtains its SSO token lazily and without synchronization on the first request of a connection. The
+// client obtains the token on a new connection before sharing it by running the verification function passed to
+// NewWithVerify. If you pass a nil verification function, make sure the first request is not sent concurrently.
+//
 //goland:noinspection GoDeprecation
 type Client interface {
 	// GetURL returns the oVirt engine base URL.
 	GetURL() string
 	// Reconnect triggers the client to reauthenticate against the oVirt Engine.
 	Reconnect() (err error)
-	// WithContext creates a subclient with the specified context applied.
+	// WithContext creates a subclient with the specified context applied. Cancelling the context stops the retries
+	// and aborts the engine request in progress. The engine may already have received an aborted request, so a
+	// cancelled call that creates or changes a resource may still complete on the engine.
 	WithContext(ctx context.Context) Client
 	// GetContext returns the current context of the client. May be nil.
 	GetContext() context.Context
+	// Refresh discards the values the client caches about the oVirt Engine and reads them again, so long-running
+	// processes pick up an engine upgrade without restarting. Currently, this is the engine version feature checks
+	// compare against. The cache is shared with all subclients, and Reconnect clears it as well.
+	Refresh(retries ...RetryStrategy) error
 
 	AffinityGroupClient
+	AffinityLabelClient
 	DiskClient
 	DiskAttachmentClient
 	VMClient
 	NICClient
 	VNICProfileClient
 	NetworkClient
+	NetworkProviderClient
+	NetworkFilterClient
 	DatacenterClient
 	ClusterClient
 	StorageDomainClient
@@ -41,24 +58,46 @@ type Client interface {
 	FeatureClient
 	InstanceTypeClient
 	GraphicsConsoleClient
+	VMWatchdogClient
+	EventClient
+	StorageConnectionClient
+	SnapshotClient
+	EngineOptionClient
+	DiagnosticsClient
+	RoleClient
+	PermissionClient
 }
 
 // ClientWithLegacySupport is an extension of Client that also offers the ability to retrieve the underlying
 // SDK connection or a configured HTTP client.
 type ClientWithLegacySupport interface {
-	// GetSDKClient returns a configured oVirt SDK client for the use cases that are not covered by goVirt.
+	// GetSDKClient returns a configured oVirt SDK client for the use cases that are not covered by goVirt. The
+	// returned connection is replaced when the client reconnects, so it should not be stored for long.
 	GetSDKClient() *ovirtsdk4.Connection
 
+	// DoWithRetry runs op with the current SDK connection under the same retry and error handling as the
+	// built-in calls. Errors returned by the SDK are converted to EngineError values, so the default retry
+	// strategies abort on permanent failures such as ENotFound and retry transient ones. If ctx is not nil, the
+	// retries are bound to the context instead of the default timeouts.
+	//
+	// The connection passed to op may change between attempts if the client reconnects, so op should not store it.
+	DoWithRetry(ctx context.Context, op func(conn *ovirtsdk4.Connection) error, retries ...RetryStrategy) error
+
 	// GetHTTPClient returns a configured HTTP client for the oVirt engine. This can be used to send manual
 	// HTTP requests to the oVirt engine.
 	GetHTTPClient() http.Client
 
+	// TLSConfig returns a copy of the TLS configuration used to connect to the oVirt Engine. This is intended for
+	// diagnostics, e.g. logging the minimum TLS version, the cipher suites, or whether certificate verification is
+	// enabled. Changing the returned configuration has no effect on the client.
+	TLSConfig() *tls.Config
+
 	Client
 }
 
 type oVirtClient struct {
 	reconnectLock   *sync.Mutex
-	conn            *ovirtsdk4.Connection
+	conn            *sdkConnection
 	ctx             context.Context
 	httpClient      http.Client
 	logger          Logger
@@ -69,15 +108,16 @@ type oVirtClient struct {
 	extraSettings   ExtraSettings
 	nonSecureRandom *rand.Rand
 	verify          func(connection Client) error
+	engineVersion   *engineVersionCache
 }
 
 func (o *oVirtClient) WithContext(ctx context.Context) Client {
 	return &oVirtClient{
 		o.reconnectLock,
-		o.conn,
+		o.conn.withContext(ctx),
 		ctx,
 		o.httpClient,
-		o.logger.WithContext(ctx),
+		newContextLogger(o.logger, ctx),
 		o.url,
 		o.username,
 		o.password,
@@ -85,6 +125,7 @@ func (o *oVirtClient) WithContext(ctx context.Context) Client {
 		o.extraSettings,
 		o.nonSecureRandom,
 		o.verify,
+		o.engineVersion,
 	}
 }
 
@@ -108,29 +149,127 @@ func (o *oVirtClient) Reconnect() error {
 	if err != nil {
 		return wrap(err, EUnidentified, "failed to create underlying oVirt connection")
 	}
-	if o.conn == nil {
-		o.conn = conn
-	} else {
-		// Replace the structure under the pointer to make all instances update.
-		*o.conn = *conn
+	if err := installSDKTransport(conn, o.extraSettings); err != nil {
+		return err
 	}
 
 	if o.verify != nil {
-		if err := o.verify(o); err != nil {
+		// Verify the new connection before other goroutines can use it. This also ensures that the SDK has
+		// obtained its SSO token, which it doesn't do in a thread-safe manner.
+		candidate := *o
+		candidate.conn = newSDKConnection(conn)
+		if err := o.verify(&candidate); err != nil {
 			return err
 		}
 	}
+	// All subclients share the sdkConnection, so they all use the new connection from here on.
+	o.conn.set(conn)
+	// The engine may have been upgraded while the connection was down.
+	o.engineVersion.clear()
 	return nil
 }
 
 func (o *oVirtClient) GetSDKClient() *ovirtsdk4.Connection {
-	return o.conn
+	return o.conn.get()
+}
+
+func (o *oVirtClient) DoWithRetry(
+	ctx context.Context,
+	op func(conn *ovirtsdk4.Connection) error,
+	retries ...RetryStrategy,
+) error {
+	client := o
+	if ctx != nil {
+		client = o.WithContext(ctx).(*oVirtClient)
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(client))
+	return retry(
+		"running custom SDK operation",
+		o.logger,
+		retries,
+		func() error {
+			return wrapSDKError("running custom SDK operation", op(client.conn.get()))
+		},
+	)
 }
 
 func (o *oVirtClient) GetHTTPClient() http.Client {
 	return o.httpClient
 }
 
+func (o *oVirtClient) TLSConfig() *tls.Config {
+	return o.tlsConfig.Clone()
+}
+
 func (o *oVirtClient) GetURL() string {
 	return o.url
 }
+
+// sdkConnection holds the current SDK connection of a client and all its subclients and allows it to be replaced
+// safely while other goroutines are sending requests. A client created with WithContext gets its own sdkConnection
+// with the same underlying connection, which sends the requests bound to the context of the client.
+type sdkConnection struct {
+	shared *sharedSDKConnection
+	ctx    context.Context
+
+	// boundLock guards bound and boundFrom, the copy of the shared connection bound to ctx and the connection it
+	// was created from.
+	boundLock *sync.Mutex
+	bound     *ovirtsdk4.Connection
+	boundFrom *ovirtsdk4.Connection
+}
+
+type sharedSDKConnection struct {
+	lock sync.RWMutex
+	conn *ovirtsdk4.Connection
+}
+
+func newSDKConnection(conn *ovirtsdk4.Connection) *sdkConnection {
+	return &sdkConnection{
+		shared:    &sharedSDKConnection{conn: conn},
+		boundLock: &sync.Mutex{},
+	}
+}
+
+// withContext returns an sdkConnection sharing the connection with s that binds the requests to ctx.
+func (s *sdkConnection) withContext(ctx context.Context) *sdkConnection {
+	return &sdkConnection{
+		shared:    s.shared,
+		ctx:       ctx,
+		boundLock: &sync.Mutex{},
+	}
+}
+
+// SystemService returns the system service of the current connection. Services created from it remain bound to
+// that connection even if it is replaced in the meantime.
+func (s *sdkConnection) SystemService() *ovirtsdk4.SystemService {
+	return s.get().SystemService()
+}
+
+func (s *sdkConnection) get() *ovirtsdk4.Connection {
+	s.shared.lock.RLock()
+	conn := s.shared.conn
+	s.shared.lock.RUnlock()
+	if conn == nil || s.ctx == nil || (s.ctx.Done() == nil && sdkTimeoutFromContext(s.ctx) == 0) {
+		return conn
+	}
+	s.boundLock.Lock()
+	defer s.boundLock.Unlock()
+	if s.boundFrom != conn {
+		bound, err := bindSDKConnection(s.ctx, conn)
+		if err != nil {
+			// Reconnect has already checked that the transport can be replaced, so this doesn't happen. If it does,
+			// the requests are sent without the context, the retries still honor it.
+			return conn
+		}
+		s.bound = bound
+		s.boundFrom = conn
+	}
+	return s.bound
+}
+
+func (s *sdkConnection) set(conn *ovirtsdk4.Connection) {
+	s.shared.lock.Lock()
+	defer s.shared.lock.Unlock()
+	s.shared.conn = conn
+}
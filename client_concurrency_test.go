@@ -0,0 +1,112 @@
+package ovirtclient_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+// TestConcurrentVMAccess reads VMs from multiple goroutines. Run it with -race to detect unsynchronized access.
+func TestConcurrentVMAccess(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+
+	const goroutines = 10
+	const iterations = 20
+	errs := make(chan error, goroutines*iterations*2)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < iterations; j++ {
+				if _, err := client.GetVM(vm.ID()); err != nil {
+					errs <- err
+				}
+				if _, err := client.ListVMs(); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("Concurrent VM access failed (%v)", err)
+	}
+}
+
+// TestConcurrentReconnect reconnects while other goroutines are sending requests to a fake engine. Run it with -race
+// to detect unsynchronized access to the connection.
+func TestConcurrentReconnect(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/ovirt-engine/sso/oauth/token":
+			writer.Header().Set("Content-Type", "application/json")
+			_, _ = writer.Write([]byte(`{"access_token":"test"}`))
+		case "/ovirt-engine/api":
+			writer.Header().Set("Content-Type", "application/xml")
+			_, _ = writer.Write([]byte(`<api></api>`))
+		case "/ovirt-engine/api/vms":
+			writer.Header().Set("Content-Type", "application/xml")
+			_, _ = writer.Write([]byte(`<vms></vms>`))
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := ovirtclient.NewWithVerify(
+		srv.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"invalid-password-for-testing-purposes",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+		func(connection ovirtclient.Client) error {
+			return connection.Test()
+		},
+	)
+	if err != nil {
+		t.Fatalf("failed to set up connection (%v)", err)
+	}
+
+	const goroutines = 5
+	const iterations = 20
+	errs := make(chan error, goroutines*iterations+iterations)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < iterations; j++ {
+				if _, err := client.ListVMs(); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for j := 0; j < iterations; j++ {
+			if err := client.Reconnect(); err != nil {
+				errs <- err
+			}
+		}
+	}()
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("Request failed while reconnecting (%v)", err)
+	}
+}
@@ -0,0 +1,126 @@
+package ovirtclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestDoWithRetry(t *testing.T) {
+	t.Parallel()
+
+	lock := &sync.Mutex{}
+	failures := 2
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/ovirt-engine/sso/oauth/token":
+			writer.Header().Set("Content-Type", "application/json")
+			_, _ = writer.Write([]byte(`{"access_token":"test"}`))
+		case "/ovirt-engine/api/vms":
+			lock.Lock()
+			defer lock.Unlock()
+			if failures > 0 {
+				failures--
+				writer.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			writer.Header().Set("Content-Type", "application/xml")
+			_, _ = writer.Write([]byte(`<vms></vms>`))
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := ovirtclient.NewWithVerify(
+		srv.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"invalid-password-for-testing-purposes",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+		func(connection ovirtclient.Client) error {
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("failed to set up connection (%v)", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	calls := 0
+	err = client.DoWithRetry(ctx, func(conn *ovirtsdk4.Connection) error {
+		calls++
+		_, err := conn.SystemService().VmsService().List().Send()
+		return err
+	})
+	if err != nil {
+		t.Fatalf("the operation failed despite succeeding on the third attempt (%v)", err)
+	}
+	if calls != 3 {
+		t.Fatalf("incorrect number of attempts (expected: 3, got: %d)", calls)
+	}
+
+	calls = 0
+	err = client.DoWithRetry(ctx, func(conn *ovirtsdk4.Connection) error {
+		calls++
+		_, err := conn.SystemService().VmsService().VmService("nonexistent").Get().Send()
+		return err
+	})
+	if err == nil {
+		t.Fatalf("fetching a nonexistent VM did not result in an error")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("fetching a nonexistent VM did not result in an ENotFound error (%v)", err)
+	}
+	if calls != 1 {
+		t.Fatalf("a permanent error was retried (attempts: %d)", calls)
+	}
+}
+
+func TestTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/ovirt-engine/sso/oauth/token":
+			writer.Header().Set("Content-Type", "application/json")
+			_, _ = writer.Write([]byte(`{"access_token":"test"}`))
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := ovirtclient.NewWithVerify(
+		srv.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"invalid-password-for-testing-purposes",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+		func(connection ovirtclient.Client) error {
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("failed to set up connection (%v)", err)
+	}
+
+	tlsConfig := client.TLSConfig()
+	if !tlsConfig.InsecureSkipVerify {
+		t.Fatalf("the TLS config does not have certificate verification disabled despite the insecure setting")
+	}
+	tlsConfig.InsecureSkipVerify = false
+	if !client.TLSConfig().InsecureSkipVerify {
+		t.Fatalf("changing the returned TLS config changed the TLS config of the client")
+	}
+}
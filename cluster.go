@@ -16,6 +16,48 @@ type ClusterClient interface {
 	ListClusters(retries ...RetryStrategy) ([]Cluster, error)
 	// GetCluster returns a specific cluster based on the cluster ID. An error is returned if the cluster doesn't exist.
 	GetCluster(id ClusterID, retries ...RetryStrategy) (Cluster, error)
+	// ResolveClusterID returns the ID of the cluster identified by nameOrID, trying it as an ID first and as a cluster
+	// name second. ENotFound is returned if neither matches, EConflict if the name is ambiguous.
+	ResolveClusterID(nameOrID string, retries ...RetryStrategy) (ClusterID, error)
+	// GetClusterSummary aggregates the host count, memory, CPUs and running VMs over the hosts of the cluster. The
+	// summary is computed on every call from the host list and host statistics, nothing is cached.
+	GetClusterSummary(id ClusterID, retries ...RetryStrategy) (ClusterSummary, error)
+	// WaitForClusterCapacity waits until a host in the cluster can fit a VM with the required memory in bytes and
+	// number of virtual CPUs, as determined by HostSummary.CanFit on the cluster summary. Use it before creating or
+	// starting VMs while hosts are in maintenance, for example during rolling upgrades. The capacity is not
+	// reserved, another VM may take it before the call returns.
+	WaitForClusterCapacity(id ClusterID, requiredMemory uint64, requiredVCPUs uint, retries ...RetryStrategy) error
+	// PreviewVMPlacement estimates which host of the cluster a VM created with params would be started on, from the
+	// cluster summary, the placement policy in params and the VMs rules of the affinity groups in affinityGroupIDs
+	// the VM is going to join. Nothing is created or reserved and the engine scheduler has the final word, the
+	// preview is meant to catch capacity and affinity problems before creating or starting the VM. Host rules of
+	// affinity groups are not evaluated.
+	PreviewVMPlacement(
+		clusterID ClusterID,
+		params OptionalVMParameters,
+		affinityGroupIDs []AffinityGroupID,
+		retries ...RetryStrategy,
+	) (PlacementPreview, error)
+	// GetClusterMigrationPolicy returns the migration policy, migration bandwidth and scheduling thresholds of the
+	// cluster.
+	GetClusterMigrationPolicy(id ClusterID, retries ...RetryStrategy) (MigrationPolicy, error)
+	// SetClusterMigrationPolicy changes the migration and scheduling settings of the cluster set in params. It
+	// returns an EBadArgument error for values out of range and the engine fault if the engine rejects the
+	// combination.
+	SetClusterMigrationPolicy(
+		id ClusterID,
+		params MigrationPolicyParameters,
+		retries ...RetryStrategy,
+	) (MigrationPolicy, error)
+	// GetClusterSchedulingPolicy returns the scheduling policy assigned to the cluster and its properties.
+	GetClusterSchedulingPolicy(id ClusterID, retries ...RetryStrategy) (SchedulingPolicy, error)
+	// SetClusterSchedulingPolicy assigns a scheduling policy, its properties, or both to the cluster. An ENotFound
+	// error is returned if the selected policy doesn't exist.
+	SetClusterSchedulingPolicy(
+		id ClusterID,
+		params SchedulingPolicyParameters,
+		retries ...RetryStrategy,
+	) (SchedulingPolicy, error)
 }
 
 // ClusterID is an identifier for a cluster.
@@ -27,6 +69,23 @@ type Cluster interface {
 	ID() ClusterID
 	// Name returns the textual name of the cluster.
 	Name() string
+	// CompatibilityVersion returns the compatibility version of the cluster, or nil if the engine didn't report it.
+	CompatibilityVersion() CompatibilityVersion
+
+	// Summary aggregates the resource usage of the cluster. See ClusterClient.GetClusterSummary for details.
+	Summary(retries ...RetryStrategy) (ClusterSummary, error)
+	// MigrationPolicy returns the migration and scheduling settings of the cluster. See
+	// ClusterClient.GetClusterMigrationPolicy for details.
+	MigrationPolicy(retries ...RetryStrategy) (MigrationPolicy, error)
+	// SetMigrationPolicy changes the migration and scheduling settings of the cluster. See
+	// ClusterClient.SetClusterMigrationPolicy for details.
+	SetMigrationPolicy(params MigrationPolicyParameters, retries ...RetryStrategy) (MigrationPolicy, error)
+	// SchedulingPolicy returns the scheduling policy of the cluster. See ClusterClient.GetClusterSchedulingPolicy for
+	// details.
+	SchedulingPolicy(retries ...RetryStrategy) (SchedulingPolicy, error)
+	// SetSchedulingPolicy assigns a scheduling policy to the cluster. See ClusterClient.SetClusterSchedulingPolicy
+	// for details.
+	SetSchedulingPolicy(params SchedulingPolicyParameters, retries ...RetryStrategy) (SchedulingPolicy, error)
 }
 
 func convertSDKCluster(sdkCluster *ovirtsdk4.Cluster, client Client) (Cluster, error) {
@@ -39,18 +98,23 @@ func convertSDKCluster(sdkCluster *ovirtsdk4.Cluster, client Client) (Cluster, e
 	if !ok {
 		return nil, newError(EFieldMissing, "failed to fetch name for cluster %s", id)
 	}
-	return &cluster{
+	result := &cluster{
 		client: client,
 		id:     ClusterID(id),
 		name:   name,
-	}, nil
+	}
+	if version, ok := sdkCluster.Version(); ok {
+		result.version = convertSDKCompatibilityVersion(version)
+	}
+	return result, nil
 }
 
 type cluster struct {
 	client Client
 
-	id   ClusterID
-	name string
+	id      ClusterID
+	name    string
+	version *compatibilityVersion
 }
 
 func (c cluster) ID() ClusterID {
@@ -60,3 +124,36 @@ func (c cluster) ID() ClusterID {
 func (c cluster) Name() string {
 	return c.name
 }
+
+func (c cluster) CompatibilityVersion() CompatibilityVersion {
+	if c.version == nil {
+		return nil
+	}
+	return c.version
+}
+
+func (c cluster) Summary(retries ...RetryStrategy) (ClusterSummary, error) {
+	return c.client.GetClusterSummary(c.id, retries...)
+}
+
+func (c cluster) MigrationPolicy(retries ...RetryStrategy) (MigrationPolicy, error) {
+	return c.client.GetClusterMigrationPolicy(c.id, retries...)
+}
+
+func (c cluster) SetMigrationPolicy(
+	params MigrationPolicyParameters,
+	retries ...RetryStrategy,
+) (MigrationPolicy, error) {
+	return c.client.SetClusterMigrationPolicy(c.id, params, retries...)
+}
+
+func (c cluster) SchedulingPolicy(retries ...RetryStrategy) (SchedulingPolicy, error) {
+	return c.client.GetClusterSchedulingPolicy(c.id, retries...)
+}
+
+func (c cluster) SetSchedulingPolicy(
+	params SchedulingPolicyParameters,
+	retries ...RetryStrategy,
+) (SchedulingPolicy, error) {
+	return c.client.SetClusterSchedulingPolicy(c.id, params, retries...)
+}
@@ -0,0 +1,71 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) WaitForClusterCapacity(
+	id ClusterID,
+	requiredMemory uint64,
+	requiredVCPUs uint,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	return waitForClusterCapacity(o, o.logger, id, requiredMemory, requiredVCPUs, retries)
+}
+
+func (m *mockClient) WaitForClusterCapacity(
+	id ClusterID,
+	requiredMemory uint64,
+	requiredVCPUs uint,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	return waitForClusterCapacity(m, m.logger, id, requiredMemory, requiredVCPUs, retries)
+}
+
+// waitForClusterCapacity polls the cluster summary until one of the hosts has room for the requested resources.
+func waitForClusterCapacity(
+	client Client,
+	logger Logger,
+	id ClusterID,
+	requiredMemory uint64,
+	requiredVCPUs uint,
+	retries []RetryStrategy,
+) error {
+	if requiredVCPUs == 0 {
+		return newError(EBadArgument, "at least one virtual CPU must be required")
+	}
+	return retry(
+		fmt.Sprintf(
+			"waiting for a host in cluster %s to fit a VM with %s of memory and %d virtual CPUs",
+			id,
+			formatMemorySize(int64(requiredMemory)),
+			requiredVCPUs,
+		),
+		logger,
+		retries,
+		func() error {
+			summary, err := client.GetClusterSummary(id, retries...)
+			if err != nil {
+				return err
+			}
+			var maxFreeMemory uint64
+			for _, host := range summary.Hosts() {
+				if host.CanFit(requiredMemory, requiredVCPUs) {
+					return nil
+				}
+				if host.Status() == HostStatusUp && host.FreeMemory() > maxFreeMemory {
+					maxFreeMemory = host.FreeMemory()
+				}
+			}
+			return newError(
+				EPending,
+				"none of the %d hosts in cluster %s can fit the requested resources (most free memory on an up "+
+					"host: %s)",
+				len(summary.Hosts()),
+				id,
+				formatMemorySize(int64(maxFreeMemory)),
+			)
+		})
+}
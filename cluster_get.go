@@ -7,6 +7,9 @@ import (
 )
 
 func (o *oVirtClient) GetCluster(id ClusterID, retries ...RetryStrategy) (result Cluster, err error) {
+	if err := validateNotBlankUUID("cluster", string(id)); err != nil {
+		return nil, err
+	}
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting cluster %s", id),
@@ -15,7 +18,7 @@ func (o *oVirtClient) GetCluster(id ClusterID, retries ...RetryStrategy) (result
 		func() error {
 			response, err := o.conn.SystemService().ClustersService().ClusterService(string(id)).Get().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting cluster %s", id), err)
 			}
 			sdkObject, ok := response.Cluster()
 			if !ok {
@@ -40,6 +43,9 @@ func (o *oVirtClient) GetCluster(id ClusterID, retries ...RetryStrategy) (result
 }
 
 func (m *mockClient) GetCluster(id ClusterID, _ ...RetryStrategy) (Cluster, error) {
+	if err := validateNotBlankUUID("cluster", string(id)); err != nil {
+		return nil, err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.clusters[id]; ok {
@@ -0,0 +1,637 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// MigrationBandwidthMethod describes how the bandwidth available to live migrations is determined.
+type MigrationBandwidthMethod string
+
+const (
+	// MigrationBandwidthMethodAuto takes the bandwidth from the speed of the migration network.
+	MigrationBandwidthMethodAuto MigrationBandwidthMethod = "auto"
+	// MigrationBandwidthMethodHypervisorDefault uses the bandwidth configured in VDSM on the source host.
+	MigrationBandwidthMethodHypervisorDefault MigrationBandwidthMethod = "hypervisor_default"
+	// MigrationBandwidthMethodCustom uses the bandwidth set in CustomBandwidth.
+	MigrationBandwidthMethodCustom MigrationBandwidthMethod = "custom"
+)
+
+// MigrationBandwidthMethodList is a list of MigrationBandwidthMethod values.
+type MigrationBandwidthMethodList []MigrationBandwidthMethod
+
+// MigrationBandwidthMethodValues returns all possible MigrationBandwidthMethod values.
+func MigrationBandwidthMethodValues() MigrationBandwidthMethodList {
+	return []MigrationBandwidthMethod{
+		MigrationBandwidthMethodAuto,
+		MigrationBandwidthMethodHypervisorDefault,
+		MigrationBandwidthMethodCustom,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l MigrationBandwidthMethodList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// Validate returns an error if the bandwidth method is not a valid value.
+func (m MigrationBandwidthMethod) Validate() error {
+	for _, method := range MigrationBandwidthMethodValues() {
+		if method == m {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid migration bandwidth method: %s must be one of: %s",
+		m,
+		strings.Join(MigrationBandwidthMethodValues().Strings(), ", "),
+	)
+}
+
+// The custom scheduling policy properties the engine reads the cluster thresholds from.
+const (
+	schedulingPropertyMinFreeMemory         = "MinFreeMemoryForUnderUtilized"
+	schedulingPropertyHighCPUUtilization    = "HighUtilization"
+	schedulingPropertyCPUOverCommitDuration = "CpuOverCommitDurationMinutes"
+)
+
+// The ranges the engine accepts for the scheduling thresholds.
+const (
+	maxHighCPUUtilization           = 100
+	maxCPUOverCommitDurationMinutes = 99
+)
+
+// MigrationPolicy is the migration and load balancing configuration of a cluster, as returned by
+// GetClusterMigrationPolicy.
+type MigrationPolicy interface {
+	// ClusterID returns the ID of the cluster the configuration belongs to.
+	ClusterID() ClusterID
+	// PolicyID returns the ID of the migration policy of the cluster. It is empty if the cluster inherits the
+	// policy from the engine configuration.
+	PolicyID() MigrationPolicyID
+	// BandwidthMethod returns how the bandwidth of live migrations is determined.
+	BandwidthMethod() MigrationBandwidthMethod
+	// CustomBandwidth returns the bandwidth of live migrations in Mbps if BandwidthMethod is
+	// MigrationBandwidthMethodCustom, 0 otherwise.
+	CustomBandwidth() uint
+	// MinFreeMemoryMB returns the free memory in MB below which the scheduler considers a host overutilized, or nil
+	// if the scheduling policy of the cluster doesn't set it.
+	MinFreeMemoryMB() *uint
+	// HighCPUUtilization returns the CPU load in percent above which the scheduler considers a host overutilized, or
+	// nil if the scheduling policy of the cluster doesn't set it.
+	HighCPUUtilization() *uint
+	// CPUOverCommitDurationMinutes returns how long a host has to stay above HighCPUUtilization before the
+	// scheduler starts migrating VMs away from it, or nil if the scheduling policy of the cluster doesn't set it.
+	CPUOverCommitDurationMinutes() *uint
+}
+
+// MigrationPolicyParameters contains the changes SetClusterMigrationPolicy makes. Values that are nil are left
+// unchanged.
+type MigrationPolicyParameters interface {
+	// PolicyID returns the migration policy to use.
+	PolicyID() *MigrationPolicyID
+	// BandwidthMethod returns how the bandwidth of live migrations is determined.
+	BandwidthMethod() *MigrationBandwidthMethod
+	// CustomBandwidth returns the bandwidth of live migrations in Mbps. It can only be set together with
+	// MigrationBandwidthMethodCustom.
+	CustomBandwidth() *uint
+	// MinFreeMemoryMB returns the free memory threshold of the scheduler in MB.
+	MinFreeMemoryMB() *uint
+	// HighCPUUtilization returns the CPU load threshold of the scheduler in percent.
+	HighCPUUtilization() *uint
+	// CPUOverCommitDurationMinutes returns how long a host can stay above HighCPUUtilization.
+	CPUOverCommitDurationMinutes() *uint
+}
+
+// BuildableMigrationPolicyParameters is a buildable version of MigrationPolicyParameters.
+type BuildableMigrationPolicyParameters interface {
+	MigrationPolicyParameters
+
+	// WithPolicyID sets the migration policy to use.
+	WithPolicyID(id MigrationPolicyID) (BuildableMigrationPolicyParameters, error)
+	// MustWithPolicyID is identical to WithPolicyID, but panics instead of returning an error.
+	MustWithPolicyID(id MigrationPolicyID) BuildableMigrationPolicyParameters
+
+	// WithBandwidthMethod sets how the bandwidth of live migrations is determined.
+	WithBandwidthMethod(method MigrationBandwidthMethod) (BuildableMigrationPolicyParameters, error)
+	// MustWithBandwidthMethod is identical to WithBandwidthMethod, but panics instead of returning an error.
+	MustWithBandwidthMethod(method MigrationBandwidthMethod) BuildableMigrationPolicyParameters
+
+	// WithCustomBandwidth sets the bandwidth of live migrations in Mbps, between 1 and MaxMigrationBandwidth.
+	WithCustomBandwidth(mbps uint) (BuildableMigrationPolicyParameters, error)
+	// MustWithCustomBandwidth is identical to WithCustomBandwidth, but panics instead of returning an error.
+	MustWithCustomBandwidth(mbps uint) BuildableMigrationPolicyParameters
+
+	// WithMinFreeMemoryMB sets the free memory threshold of the scheduler in MB.
+	WithMinFreeMemoryMB(mb uint) (BuildableMigrationPolicyParameters, error)
+	// MustWithMinFreeMemoryMB is identical to WithMinFreeMemoryMB, but panics instead of returning an error.
+	MustWithMinFreeMemoryMB(mb uint) BuildableMigrationPolicyParameters
+
+	// WithHighCPUUtilization sets the CPU load threshold of the scheduler in percent, between 1 and 100.
+	WithHighCPUUtilization(percent uint) (BuildableMigrationPolicyParameters, error)
+	// MustWithHighCPUUtilization is identical to WithHighCPUUtilization, but panics instead of returning an error.
+	MustWithHighCPUUtilization(percent uint) BuildableMigrationPolicyParameters
+
+	// WithCPUOverCommitDurationMinutes sets how long a host can stay above HighCPUUtilization, between 1 and 99
+	// minutes.
+	WithCPUOverCommitDurationMinutes(minutes uint) (BuildableMigrationPolicyParameters, error)
+	// MustWithCPUOverCommitDurationMinutes is identical to WithCPUOverCommitDurationMinutes, but panics instead of
+	// returning an error.
+	MustWithCPUOverCommitDurationMinutes(minutes uint) BuildableMigrationPolicyParameters
+}
+
+// MigrationPolicyParams creates a new set of parameters for SetClusterMigrationPolicy.
+func MigrationPolicyParams() BuildableMigrationPolicyParameters {
+	return &migrationPolicyParams{}
+}
+
+type migrationPolicyParams struct {
+	policyID                     *MigrationPolicyID
+	bandwidthMethod              *MigrationBandwidthMethod
+	customBandwidth              *uint
+	minFreeMemoryMB              *uint
+	highCPUUtilization           *uint
+	cpuOverCommitDurationMinutes *uint
+}
+
+func (m *migrationPolicyParams) PolicyID() *MigrationPolicyID {
+	return m.policyID
+}
+
+func (m *migrationPolicyParams) WithPolicyID(id MigrationPolicyID) (BuildableMigrationPolicyParameters, error) {
+	if id == "" {
+		return m, newError(EBadArgument, "the migration policy ID cannot be empty")
+	}
+	m.policyID = &id
+	return m, nil
+}
+
+func (m *migrationPolicyParams) MustWithPolicyID(id MigrationPolicyID) BuildableMigrationPolicyParameters {
+	builder, err := m.WithPolicyID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *migrationPolicyParams) BandwidthMethod() *MigrationBandwidthMethod {
+	return m.bandwidthMethod
+}
+
+func (m *migrationPolicyParams) WithBandwidthMethod(
+	method MigrationBandwidthMethod,
+) (BuildableMigrationPolicyParameters, error) {
+	if err := method.Validate(); err != nil {
+		return m, err
+	}
+	m.bandwidthMethod = &method
+	return m, nil
+}
+
+func (m *migrationPolicyParams) MustWithBandwidthMethod(
+	method MigrationBandwidthMethod,
+) BuildableMigrationPolicyParameters {
+	builder, err := m.WithBandwidthMethod(method)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *migrationPolicyParams) CustomBandwidth() *uint {
+	return m.customBandwidth
+}
+
+func (m *migrationPolicyParams) WithCustomBandwidth(mbps uint) (BuildableMigrationPolicyParameters, error) {
+	if err := validateMigrationPolicyRange("custom migration bandwidth", mbps, MaxMigrationBandwidth); err != nil {
+		return m, err
+	}
+	m.customBandwidth = &mbps
+	return m, nil
+}
+
+func (m *migrationPolicyParams) MustWithCustomBandwidth(mbps uint) BuildableMigrationPolicyParameters {
+	builder, err := m.WithCustomBandwidth(mbps)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *migrationPolicyParams) MinFreeMemoryMB() *uint {
+	return m.minFreeMemoryMB
+}
+
+func (m *migrationPolicyParams) WithMinFreeMemoryMB(mb uint) (BuildableMigrationPolicyParameters, error) {
+	m.minFreeMemoryMB = &mb
+	return m, nil
+}
+
+func (m *migrationPolicyParams) MustWithMinFreeMemoryMB(mb uint) BuildableMigrationPolicyParameters {
+	builder, err := m.WithMinFreeMemoryMB(mb)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *migrationPolicyParams) HighCPUUtilization() *uint {
+	return m.highCPUUtilization
+}
+
+func (m *migrationPolicyParams) WithHighCPUUtilization(percent uint) (BuildableMigrationPolicyParameters, error) {
+	if err := validateMigrationPolicyRange("high CPU utilization", percent, maxHighCPUUtilization); err != nil {
+		return m, err
+	}
+	m.highCPUUtilization = &percent
+	return m, nil
+}
+
+func (m *migrationPolicyParams) MustWithHighCPUUtilization(percent uint) BuildableMigrationPolicyParameters {
+	builder, err := m.WithHighCPUUtilization(percent)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *migrationPolicyParams) CPUOverCommitDurationMinutes() *uint {
+	return m.cpuOverCommitDurationMinutes
+}
+
+func (m *migrationPolicyParams) WithCPUOverCommitDurationMinutes(
+	minutes uint,
+) (BuildableMigrationPolicyParameters, error) {
+	if err := validateMigrationPolicyRange(
+		"CPU overcommit duration",
+		minutes,
+		maxCPUOverCommitDurationMinutes,
+	); err != nil {
+		return m, err
+	}
+	m.cpuOverCommitDurationMinutes = &minutes
+	return m, nil
+}
+
+func (m *migrationPolicyParams) MustWithCPUOverCommitDurationMinutes(
+	minutes uint,
+) BuildableMigrationPolicyParameters {
+	builder, err := m.WithCPUOverCommitDurationMinutes(minutes)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func validateMigrationPolicyRange(name string, value uint, maxValue uint) error {
+	if value < 1 || value > maxValue {
+		return newError(EBadArgument, "the %s must be between 1 and %d (%d given)", name, maxValue, value)
+	}
+	return nil
+}
+
+// validateMigrationPolicyParameters repeats the checks of the builder for MigrationPolicyParameters implemented
+// outside this package and checks the combination of the bandwidth settings.
+func validateMigrationPolicyParameters(params MigrationPolicyParameters) error {
+	if id := params.PolicyID(); id != nil && *id == "" {
+		return newError(EBadArgument, "the migration policy ID cannot be empty")
+	}
+	method := params.BandwidthMethod()
+	if method != nil {
+		if err := method.Validate(); err != nil {
+			return err
+		}
+	}
+	customBandwidth := params.CustomBandwidth()
+	isCustom := method != nil && *method == MigrationBandwidthMethodCustom
+	switch {
+	case customBandwidth != nil && !isCustom:
+		return newError(
+			EBadArgument,
+			"a custom migration bandwidth can only be set with the %s bandwidth method",
+			MigrationBandwidthMethodCustom,
+		)
+	case isCustom && customBandwidth == nil:
+		return newError(
+			EBadArgument,
+			"the %s bandwidth method requires a custom migration bandwidth",
+			MigrationBandwidthMethodCustom,
+		)
+	case isCustom:
+		if err := validateMigrationPolicyRange(
+			"custom migration bandwidth",
+			*customBandwidth,
+			MaxMigrationBandwidth,
+		); err != nil {
+			return err
+		}
+	}
+	if percent := params.HighCPUUtilization(); percent != nil {
+		if err := validateMigrationPolicyRange("high CPU utilization", *percent, maxHighCPUUtilization); err != nil {
+			return err
+		}
+	}
+	if minutes := params.CPUOverCommitDurationMinutes(); minutes != nil {
+		if err := validateMigrationPolicyRange(
+			"CPU overcommit duration",
+			*minutes,
+			maxCPUOverCommitDurationMinutes,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type migrationPolicy struct {
+	clusterID                    ClusterID
+	policyID                     MigrationPolicyID
+	bandwidthMethod              MigrationBandwidthMethod
+	customBandwidth              uint
+	minFreeMemoryMB              *uint
+	highCPUUtilization           *uint
+	cpuOverCommitDurationMinutes *uint
+}
+
+func (m migrationPolicy) ClusterID() ClusterID {
+	return m.clusterID
+}
+
+func (m migrationPolicy) PolicyID() MigrationPolicyID {
+	return m.policyID
+}
+
+func (m migrationPolicy) BandwidthMethod() MigrationBandwidthMethod {
+	return m.bandwidthMethod
+}
+
+func (m migrationPolicy) CustomBandwidth() uint {
+	return m.customBandwidth
+}
+
+func (m migrationPolicy) MinFreeMemoryMB() *uint {
+	return m.minFreeMemoryMB
+}
+
+func (m migrationPolicy) HighCPUUtilization() *uint {
+	return m.highCPUUtilization
+}
+
+func (m migrationPolicy) CPUOverCommitDurationMinutes() *uint {
+	return m.cpuOverCommitDurationMinutes
+}
+
+// schedulingProperty returns the value of the named custom scheduling policy property. Missing or non-numeric
+// values are treated as not set.
+func schedulingProperty(properties *ovirtsdk.PropertySlice, name string) *uint {
+	if properties == nil {
+		return nil
+	}
+	for _, property := range properties.Slice() {
+		if propertyName, _ := property.Name(); propertyName != name {
+			continue
+		}
+		value, _ := property.Value()
+		parsed, err := strconv.ParseUint(strings.TrimSpace(value), 10, 32)
+		if err != nil {
+			return nil
+		}
+		result := uint(parsed)
+		return &result
+	}
+	return nil
+}
+
+func convertSDKMigrationPolicy(id ClusterID, object *ovirtsdk.Cluster) migrationPolicy {
+	result := migrationPolicy{
+		clusterID:       id,
+		bandwidthMethod: MigrationBandwidthMethodAuto,
+	}
+	if migration, ok := object.Migration(); ok {
+		if policy, ok := migration.Policy(); ok {
+			policyID, _ := policy.Id()
+			result.policyID = MigrationPolicyID(policyID)
+		}
+		if bandwidth, ok := migration.Bandwidth(); ok {
+			if method, ok := bandwidth.AssignmentMethod(); ok {
+				result.bandwidthMethod = MigrationBandwidthMethod(method)
+			}
+			customValue, ok := bandwidth.CustomValue()
+			if ok && result.bandwidthMethod == MigrationBandwidthMethodCustom {
+				result.customBandwidth = uint(customValue)
+			}
+		}
+	}
+	properties, _ := object.CustomSchedulingPolicyProperties()
+	result.minFreeMemoryMB = schedulingProperty(properties, schedulingPropertyMinFreeMemory)
+	result.highCPUUtilization = schedulingProperty(properties, schedulingPropertyHighCPUUtilization)
+	result.cpuOverCommitDurationMinutes = schedulingProperty(properties, schedulingPropertyCPUOverCommitDuration)
+	return result
+}
+
+// schedulingPropertyChanges returns the scheduling policy properties set in params.
+func schedulingPropertyChanges(params MigrationPolicyParameters) map[string]uint {
+	changes := map[string]uint{}
+	if value := params.MinFreeMemoryMB(); value != nil {
+		changes[schedulingPropertyMinFreeMemory] = *value
+	}
+	if value := params.HighCPUUtilization(); value != nil {
+		changes[schedulingPropertyHighCPUUtilization] = *value
+	}
+	if value := params.CPUOverCommitDurationMinutes(); value != nil {
+		changes[schedulingPropertyCPUOverCommitDuration] = *value
+	}
+	return changes
+}
+
+func hasSchedulingPropertyChange(changes map[string]uint, name string) bool {
+	_, ok := changes[name]
+	return ok
+}
+
+// buildSDKMigrationPolicyCluster creates the cluster update for params. The engine replaces the whole list of custom
+// scheduling policy properties on update, so the changes are merged into the current properties.
+func buildSDKMigrationPolicyCluster(
+	params MigrationPolicyParameters,
+	currentProperties *ovirtsdk.PropertySlice,
+) *ovirtsdk.Cluster {
+	builder := ovirtsdk.NewClusterBuilder()
+	if params.PolicyID() != nil || params.BandwidthMethod() != nil {
+		migrationBuilder := ovirtsdk.NewMigrationOptionsBuilder()
+		if id := params.PolicyID(); id != nil {
+			migrationBuilder.PolicyBuilder(ovirtsdk.NewMigrationPolicyBuilder().Id(string(*id)))
+		}
+		if method := params.BandwidthMethod(); method != nil {
+			bandwidthBuilder := ovirtsdk.NewMigrationBandwidthBuilder().
+				AssignmentMethod(ovirtsdk.MigrationBandwidthAssignmentMethod(*method))
+			if customBandwidth := params.CustomBandwidth(); customBandwidth != nil {
+				bandwidthBuilder.CustomValue(int64(*customBandwidth))
+			}
+			migrationBuilder.BandwidthBuilder(bandwidthBuilder)
+		}
+		builder.MigrationBuilder(migrationBuilder)
+	}
+	changes := schedulingPropertyChanges(params)
+	if len(changes) > 0 {
+		var properties []*ovirtsdk.Property
+		if currentProperties != nil {
+			for _, property := range currentProperties.Slice() {
+				if name, _ := property.Name(); !hasSchedulingPropertyChange(changes, name) {
+					properties = append(properties, property)
+				}
+			}
+		}
+		for name, value := range changes {
+			properties = append(
+				properties,
+				ovirtsdk.NewPropertyBuilder().Name(name).Value(strconv.FormatUint(uint64(value), 10)).MustBuild(),
+			)
+		}
+		builder.CustomSchedulingPolicyPropertiesOfAny(properties...)
+	}
+	return builder.MustBuild()
+}
+
+func (o *oVirtClient) GetClusterMigrationPolicy(
+	id ClusterID,
+	retries ...RetryStrategy,
+) (result MigrationPolicy, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("getting migration policy of cluster %s", id)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().ClustersService().ClusterService(string(id)).Get().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkCluster, ok := response.Cluster()
+			if !ok {
+				return newError(ENotFound, "no cluster returned when getting migration policy of cluster %s", id)
+			}
+			result = convertSDKMigrationPolicy(id, sdkCluster)
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) SetClusterMigrationPolicy(
+	id ClusterID,
+	params MigrationPolicyParameters,
+	retries ...RetryStrategy,
+) (result MigrationPolicy, err error) {
+	if params == nil {
+		params = MigrationPolicyParams()
+	}
+	if err := validateMigrationPolicyParameters(params); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	action := fmt.Sprintf("setting migration policy of cluster %s", id)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			clusterService := o.conn.SystemService().ClustersService().ClusterService(string(id))
+			getResponse, err := clusterService.Get().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkCluster, ok := getResponse.Cluster()
+			if !ok {
+				return newError(ENotFound, "no cluster returned when getting cluster %s", id)
+			}
+			currentProperties, _ := sdkCluster.CustomSchedulingPolicyProperties()
+			// Invalid combinations, for example a threshold the scheduling policy doesn't support, are rejected by
+			// the engine and returned as they are.
+			updateResponse, err := clusterService.
+				Update().
+				Cluster(buildSDKMigrationPolicyCluster(params, currentProperties)).
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkCluster, ok = updateResponse.Cluster()
+			if !ok {
+				return newError(ENotFound, "no cluster returned when setting migration policy of cluster %s", id)
+			}
+			result = convertSDKMigrationPolicy(id, sdkCluster)
+			return nil
+		})
+	return result, err
+}
+
+// mockMigrationPolicy returns the migration policy of the cluster. Clusters without a stored policy have the
+// defaults of a new cluster. The caller must hold the lock.
+func (m *mockClient) mockMigrationPolicy(id ClusterID) migrationPolicy {
+	if policy, ok := m.clusterMigrationPolicies[id]; ok {
+		return policy
+	}
+	return migrationPolicy{
+		clusterID:       id,
+		bandwidthMethod: MigrationBandwidthMethodAuto,
+	}
+}
+
+func (m *mockClient) GetClusterMigrationPolicy(id ClusterID, _ ...RetryStrategy) (MigrationPolicy, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.clusters[id]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", id)
+	}
+	return m.mockMigrationPolicy(id), nil
+}
+
+func (m *mockClient) SetClusterMigrationPolicy(
+	id ClusterID,
+	params MigrationPolicyParameters,
+	_ ...RetryStrategy,
+) (MigrationPolicy, error) {
+	if params == nil {
+		params = MigrationPolicyParams()
+	}
+	if err := validateMigrationPolicyParameters(params); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.clusters[id]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", id)
+	}
+	policy := m.mockMigrationPolicy(id)
+	if policyID := params.PolicyID(); policyID != nil {
+		policy.policyID = *policyID
+	}
+	if method := params.BandwidthMethod(); method != nil {
+		policy.bandwidthMethod = *method
+		policy.customBandwidth = 0
+		if customBandwidth := params.CustomBandwidth(); customBandwidth != nil {
+			policy.customBandwidth = *customBandwidth
+		}
+	}
+	if value := params.MinFreeMemoryMB(); value != nil {
+		threshold := *value
+		policy.minFreeMemoryMB = &threshold
+	}
+	if value := params.HighCPUUtilization(); value != nil {
+		threshold := *value
+		policy.highCPUUtilization = &threshold
+	}
+	if value := params.CPUOverCommitDurationMinutes(); value != nil {
+		threshold := *value
+		policy.cpuOverCommitDurationMinutes = &threshold
+	}
+	m.clusterMigrationPolicies[id] = policy
+	return policy, nil
+}
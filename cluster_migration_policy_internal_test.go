@@ -0,0 +1,35 @@
+package ovirtclient
+
+import (
+	"testing"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func TestBuildSDKMigrationPolicyClusterKeepsProperties(t *testing.T) {
+	current := &ovirtsdk.PropertySlice{}
+	current.SetSlice([]*ovirtsdk.Property{
+		ovirtsdk.NewPropertyBuilder().Name("LowUtilization").Value("20").MustBuild(),
+		ovirtsdk.NewPropertyBuilder().Name(schedulingPropertyHighCPUUtilization).Value("90").MustBuild(),
+	})
+	params := MigrationPolicyParams().MustWithHighCPUUtilization(75)
+
+	sdkCluster := buildSDKMigrationPolicyCluster(params, current)
+
+	if _, ok := sdkCluster.Migration(); ok {
+		t.Fatalf("The migration options were sent although only a threshold was changed.")
+	}
+	properties, ok := sdkCluster.CustomSchedulingPolicyProperties()
+	if !ok {
+		t.Fatalf("No scheduling policy properties sent.")
+	}
+	if value := schedulingProperty(properties, "LowUtilization"); value == nil || *value != 20 {
+		t.Fatalf("An unrelated scheduling policy property was not kept (got: %v)", value)
+	}
+	if value := schedulingProperty(properties, schedulingPropertyHighCPUUtilization); value == nil || *value != 75 {
+		t.Fatalf("The high CPU utilization threshold was not changed (got: %v)", value)
+	}
+	if len(properties.Slice()) != 2 {
+		t.Fatalf("Incorrect number of scheduling policy properties (expected: 2, got: %d)", len(properties.Slice()))
+	}
+}
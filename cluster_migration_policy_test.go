@@ -0,0 +1,90 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestClusterMigrationPolicy(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	original, err := client.GetClusterMigrationPolicy(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to get migration policy of cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	t.Cleanup(func() {
+		params := ovirtclient.MigrationPolicyParams().MustWithBandwidthMethod(original.BandwidthMethod())
+		if original.BandwidthMethod() == ovirtclient.MigrationBandwidthMethodCustom {
+			params = params.MustWithCustomBandwidth(original.CustomBandwidth())
+		}
+		if value := original.HighCPUUtilization(); value != nil {
+			params = params.MustWithHighCPUUtilization(*value)
+		}
+		if value := original.CPUOverCommitDurationMinutes(); value != nil {
+			params = params.MustWithCPUOverCommitDurationMinutes(*value)
+		}
+		if _, err := client.SetClusterMigrationPolicy(helper.GetClusterID(), params); err != nil {
+			t.Fatalf("Failed to restore migration policy of cluster %s (%v)", helper.GetClusterID(), err)
+		}
+	})
+
+	params := ovirtclient.MigrationPolicyParams().
+		MustWithBandwidthMethod(ovirtclient.MigrationBandwidthMethodCustom).
+		MustWithCustomBandwidth(500).
+		MustWithHighCPUUtilization(80).
+		MustWithCPUOverCommitDurationMinutes(5)
+	if _, err := client.SetClusterMigrationPolicy(helper.GetClusterID(), params); err != nil {
+		t.Fatalf("Failed to set migration policy of cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	policy, err := client.GetClusterMigrationPolicy(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to get migration policy of cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	if policy.BandwidthMethod() != ovirtclient.MigrationBandwidthMethodCustom || policy.CustomBandwidth() != 500 {
+		t.Fatalf(
+			"Incorrect migration bandwidth (expected: %s 500 Mbps, got: %s %d Mbps)",
+			ovirtclient.MigrationBandwidthMethodCustom,
+			policy.BandwidthMethod(),
+			policy.CustomBandwidth(),
+		)
+	}
+	if policy.HighCPUUtilization() == nil || *policy.HighCPUUtilization() != 80 {
+		t.Fatalf("Incorrect high CPU utilization threshold (expected: 80, got: %v)", policy.HighCPUUtilization())
+	}
+	if policy.CPUOverCommitDurationMinutes() == nil || *policy.CPUOverCommitDurationMinutes() != 5 {
+		t.Fatalf(
+			"Incorrect CPU overcommit duration (expected: 5, got: %v)",
+			policy.CPUOverCommitDurationMinutes(),
+		)
+	}
+}
+
+func TestMigrationPolicyParamsValidation(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	if _, err := ovirtclient.MigrationPolicyParams().WithHighCPUUtilization(101); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting a CPU utilization above 100%% did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := ovirtclient.MigrationPolicyParams().WithCPUOverCommitDurationMinutes(0); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting a CPU overcommit duration of 0 did not result in an EBadArgument error (%v)", err)
+	}
+	params := ovirtclient.MigrationPolicyParams().
+		MustWithBandwidthMethod(ovirtclient.MigrationBandwidthMethodAuto).
+		MustWithCustomBandwidth(500)
+	if _, err := client.SetClusterMigrationPolicy(helper.GetClusterID(), params); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting a custom bandwidth with the auto method did not result in an EBadArgument error (%v)", err)
+	}
+}
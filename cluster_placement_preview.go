@@ -0,0 +1,280 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sort"
+)
+
+// PlacementPreview is the result of PreviewVMPlacement, the host a new VM would likely be started on.
+type PlacementPreview interface {
+	// ClusterID returns the ID of the cluster the preview was computed for.
+	ClusterID() ClusterID
+	// HostID returns the host the VM would most likely be started on, or nil if no host can run it.
+	HostID() *HostID
+	// CandidateHostIDs returns all hosts that can run the VM without violating an enforcing affinity rule, the most
+	// likely host first.
+	CandidateHostIDs() []HostID
+	// Schedulable returns true if at least one host can run the VM.
+	Schedulable() bool
+	// Warnings returns the non-enforcing affinity rules the most likely host violates, or the reasons why no host
+	// can run the VM.
+	Warnings() []string
+}
+
+type placementPreview struct {
+	clusterID    ClusterID
+	candidateIDs []HostID
+	warnings     []string
+}
+
+func (p *placementPreview) ClusterID() ClusterID {
+	return p.clusterID
+}
+
+func (p *placementPreview) HostID() *HostID {
+	if len(p.candidateIDs) == 0 {
+		return nil
+	}
+	hostID := p.candidateIDs[0]
+	return &hostID
+}
+
+func (p *placementPreview) CandidateHostIDs() []HostID {
+	return p.candidateIDs
+}
+
+func (p *placementPreview) Schedulable() bool {
+	return len(p.candidateIDs) > 0
+}
+
+func (p *placementPreview) Warnings() []string {
+	return p.warnings
+}
+
+func (o *oVirtClient) PreviewVMPlacement(
+	clusterID ClusterID,
+	params OptionalVMParameters,
+	affinityGroupIDs []AffinityGroupID,
+	retries ...RetryStrategy,
+) (PlacementPreview, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	return previewVMPlacement(o, clusterID, params, affinityGroupIDs, retries)
+}
+
+func (m *mockClient) PreviewVMPlacement(
+	clusterID ClusterID,
+	params OptionalVMParameters,
+	affinityGroupIDs []AffinityGroupID,
+	retries ...RetryStrategy,
+) (PlacementPreview, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	return previewVMPlacement(m, clusterID, params, affinityGroupIDs, retries)
+}
+
+// placementRequirements returns the memory in bytes and the number of virtual CPUs of a VM created with params,
+// using the same defaults as CreateVM.
+func placementRequirements(params OptionalVMParameters) (uint64, uint) {
+	memory := uint64(1024 * 1024 * 1024)
+	vcpus := uint(1)
+	if params == nil {
+		return memory, vcpus
+	}
+	if params.Memory() != nil && *params.Memory() > 0 {
+		memory = uint64(*params.Memory())
+	}
+	if cpu := params.CPU(); cpu != nil && cpu.Topo() != nil {
+		topo := cpu.Topo()
+		vcpus = topo.Cores() * topo.Threads() * topo.Sockets()
+	}
+	return memory, vcpus
+}
+
+// placementAffinityGroup is an affinity group with an enabled VMs rule and the hosts its VMs are running on.
+type placementAffinityGroup struct {
+	group   AffinityGroup
+	hostIDs map[HostID]bool
+}
+
+// violatedBy returns true if starting the VM on hostID would violate the VMs rule of the group.
+func (p placementAffinityGroup) violatedBy(hostID HostID) bool {
+	if len(p.hostIDs) == 0 {
+		// None of the VMs in the group are running, any host satisfies the rule.
+		return false
+	}
+	if p.group.VMsRule().Affinity() == AffinityPositive {
+		return !p.hostIDs[hostID]
+	}
+	return p.hostIDs[hostID]
+}
+
+func (p placementAffinityGroup) describe() string {
+	kind := "negative"
+	if p.group.VMsRule().Affinity() == AffinityPositive {
+		kind = "positive"
+	}
+	return fmt.Sprintf("%s VM affinity of group %s (%s)", kind, p.group.Name(), p.group.ID())
+}
+
+func listPlacementAffinityGroups(
+	client Client,
+	clusterID ClusterID,
+	affinityGroupIDs []AffinityGroupID,
+	retries []RetryStrategy,
+) ([]placementAffinityGroup, error) {
+	var result []placementAffinityGroup
+	for _, id := range affinityGroupIDs {
+		group, err := client.GetAffinityGroup(clusterID, id, retries...)
+		if err != nil {
+			return nil, err
+		}
+		if rule := group.VMsRule(); rule == nil || !rule.Enabled() {
+			continue
+		}
+		result = append(result, placementAffinityGroup{group: group, hostIDs: map[HostID]bool{}})
+	}
+	if len(result) == 0 {
+		return result, nil
+	}
+	vms, err := client.ListVMs(retries...)
+	if err != nil {
+		return nil, err
+	}
+	vmHosts := map[VMID]HostID{}
+	for _, vm := range vms {
+		if hostID := vm.HostID(); hostID != nil {
+			vmHosts[vm.ID()] = *hostID
+		}
+	}
+	for _, group := range result {
+		for _, vmID := range group.group.VMIDs() {
+			if hostID, ok := vmHosts[vmID]; ok {
+				group.hostIDs[hostID] = true
+			}
+		}
+	}
+	return result, nil
+}
+
+// previewVMPlacement approximates the scheduler of the engine: hosts that are up, have room for the VM, are allowed
+// by the placement policy and don't violate an enforcing VM affinity rule are candidates. Among them, the host with
+// the fewest violated non-enforcing rules and the most free memory wins.
+func previewVMPlacement(
+	client Client,
+	clusterID ClusterID,
+	params OptionalVMParameters,
+	affinityGroupIDs []AffinityGroupID,
+	retries []RetryStrategy,
+) (PlacementPreview, error) {
+	memory, vcpus := placementRequirements(params)
+	if vcpus == 0 {
+		return nil, newError(EBadArgument, "the VM must have at least one virtual CPU")
+	}
+	summary, err := client.GetClusterSummary(clusterID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := listPlacementAffinityGroups(client, clusterID, affinityGroupIDs, retries)
+	if err != nil {
+		return nil, err
+	}
+	var allowedHostIDs map[HostID]bool
+	if params != nil && params.PlacementPolicy() != nil && len((*params.PlacementPolicy()).HostIDs()) > 0 {
+		allowedHostIDs = map[HostID]bool{}
+		for _, hostID := range (*params.PlacementPolicy()).HostIDs() {
+			allowedHostIDs[hostID] = true
+		}
+	}
+
+	type candidate struct {
+		host       HostSummary
+		violations []string
+	}
+	var candidates []candidate
+	withoutCapacity := 0
+	notAllowed := 0
+	enforcedViolations := map[string]int{}
+	for _, host := range summary.Hosts() {
+		if !host.CanFit(memory, vcpus) {
+			withoutCapacity++
+			continue
+		}
+		if allowedHostIDs != nil && !allowedHostIDs[host.HostID()] {
+			notAllowed++
+			continue
+		}
+		excluded := false
+		var violations []string
+		for _, group := range groups {
+			if !group.violatedBy(host.HostID()) {
+				continue
+			}
+			if group.group.VMsRule().Enforcing() {
+				enforcedViolations[group.describe()]++
+				excluded = true
+				break
+			}
+			violations = append(violations, group.describe())
+		}
+		if !excluded {
+			candidates = append(candidates, candidate{host: host, violations: violations})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if len(candidates[i].violations) != len(candidates[j].violations) {
+			return len(candidates[i].violations) < len(candidates[j].violations)
+		}
+		if candidates[i].host.FreeMemory() != candidates[j].host.FreeMemory() {
+			return candidates[i].host.FreeMemory() > candidates[j].host.FreeMemory()
+		}
+		return candidates[i].host.HostID() < candidates[j].host.HostID()
+	})
+
+	result := &placementPreview{
+		clusterID:    clusterID,
+		candidateIDs: make([]HostID, len(candidates)),
+		warnings:     []string{},
+	}
+	for i, c := range candidates {
+		result.candidateIDs[i] = c.host.HostID()
+	}
+	if len(candidates) > 0 {
+		for _, violation := range candidates[0].violations {
+			result.warnings = append(
+				result.warnings,
+				fmt.Sprintf("host %s violates the non-enforcing %s", candidates[0].host.HostID(), violation),
+			)
+		}
+		return result, nil
+	}
+
+	if len(summary.Hosts()) == 0 {
+		result.warnings = append(result.warnings, fmt.Sprintf("cluster %s has no hosts", clusterID))
+	}
+	if withoutCapacity > 0 {
+		result.warnings = append(result.warnings, fmt.Sprintf(
+			"%d hosts are not up or cannot fit %s of memory and %d virtual CPUs",
+			withoutCapacity,
+			formatMemorySize(int64(memory)),
+			vcpus,
+		))
+	}
+	if notAllowed > 0 {
+		result.warnings = append(
+			result.warnings,
+			fmt.Sprintf("%d hosts are not allowed by the placement policy", notAllowed),
+		)
+	}
+	descriptions := make([]string, 0, len(enforcedViolations))
+	for description := range enforcedViolations {
+		descriptions = append(descriptions, description)
+	}
+	sort.Strings(descriptions)
+	for _, description := range descriptions {
+		result.warnings = append(result.warnings, fmt.Sprintf(
+			"%d hosts would violate the enforcing %s",
+			enforcedViolations[description],
+			description,
+		))
+	}
+	return result, nil
+}
@@ -0,0 +1,78 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestPreviewVMPlacement(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	preview, err := client.PreviewVMPlacement(helper.GetClusterID(), nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to preview VM placement (%v)", err)
+	}
+	if !preview.Schedulable() || preview.HostID() == nil {
+		t.Fatalf("No host found for a default VM (warnings: %v)", preview.Warnings())
+	}
+
+	tooLarge := ovirtclient.NewCreateVMParams().MustWithMemory(1024 * 1024 * 1024 * 1024 * 1024)
+	preview, err = client.PreviewVMPlacement(helper.GetClusterID(), tooLarge, nil)
+	if err != nil {
+		t.Fatalf("Failed to preview VM placement (%v)", err)
+	}
+	if preview.Schedulable() || len(preview.Warnings()) == 0 {
+		t.Fatalf("A VM with 1 PiB of memory was placed on host %v.", preview.HostID())
+	}
+}
+
+func TestPreviewVMPlacementAffinity(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateBootableVM(t, helper)
+	negative := assertCanCreateAffinityGroup(
+		t,
+		helper,
+		ovirtclient.CreateAffinityGroupParams().MustWithVMsRuleParameters(true, ovirtclient.AffinityNegative, true),
+	)
+	positive := assertCanCreateAffinityGroup(
+		t,
+		helper,
+		ovirtclient.CreateAffinityGroupParams().MustWithVMsRuleParameters(true, ovirtclient.AffinityPositive, false),
+	)
+	assertCanAddVMToAffinityGroup(t, vm, negative)
+	assertCanAddVMToAffinityGroup(t, vm, positive)
+	assertCanStartVM(t, helper, vm)
+	vm = assertVMWillStart(t, vm)
+
+	preview, err := client.PreviewVMPlacement(helper.GetClusterID(), nil, []ovirtclient.AffinityGroupID{negative.ID()})
+	if err != nil {
+		t.Fatalf("Failed to preview VM placement (%v)", err)
+	}
+	for _, hostID := range preview.CandidateHostIDs() {
+		if hostID == *vm.HostID() {
+			t.Fatalf("Host %s is a candidate despite the enforcing negative affinity group.", hostID)
+		}
+	}
+	if !preview.Schedulable() && len(preview.Warnings()) == 0 {
+		t.Fatalf("The preview has no candidate host and no warning.")
+	}
+
+	preview, err = client.PreviewVMPlacement(helper.GetClusterID(), nil, []ovirtclient.AffinityGroupID{positive.ID()})
+	if err != nil {
+		t.Fatalf("Failed to preview VM placement (%v)", err)
+	}
+	if preview.HostID() == nil || *preview.HostID() != *vm.HostID() {
+		t.Fatalf(
+			"The positive affinity group did not place the VM on host %s (got: %v, warnings: %v)",
+			*vm.HostID(),
+			preview.HostID(),
+			preview.Warnings(),
+		)
+	}
+}
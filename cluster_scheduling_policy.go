@@ -0,0 +1,451 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sort"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// SchedulingPolicyID is the identifier of a scheduling policy.
+type SchedulingPolicyID string
+
+// SchedulingPolicyName is the name of a scheduling policy. The constants are the policies every engine comes with,
+// custom policies can be referenced by their name as well.
+type SchedulingPolicyName string
+
+const (
+	// SchedulingPolicyNameNone only places VMs on start and never balances the load between hosts.
+	SchedulingPolicyNameNone SchedulingPolicyName = "none"
+	// SchedulingPolicyNameEvenlyDistributed balances the CPU and memory load evenly between hosts.
+	SchedulingPolicyNameEvenlyDistributed SchedulingPolicyName = "evenly_distributed"
+	// SchedulingPolicyNamePowerSaving packs VMs on as few hosts as possible so idle hosts can be shut down.
+	SchedulingPolicyNamePowerSaving SchedulingPolicyName = "power_saving"
+	// SchedulingPolicyNameVMEvenlyDistributed balances the number of VMs between hosts.
+	SchedulingPolicyNameVMEvenlyDistributed SchedulingPolicyName = "vm_evenly_distributed"
+	// SchedulingPolicyNameClusterMaintenance prevents new VMs from being started, for example during upgrades.
+	SchedulingPolicyNameClusterMaintenance SchedulingPolicyName = "cluster_maintenance"
+)
+
+// SchedulingPolicy is the scheduling policy assigned to a cluster, as returned by GetClusterSchedulingPolicy.
+type SchedulingPolicy interface {
+	// ClusterID returns the ID of the cluster the policy is assigned to.
+	ClusterID() ClusterID
+	// PolicyID returns the ID of the scheduling policy.
+	PolicyID() SchedulingPolicyID
+	// Name returns the name of the scheduling policy.
+	Name() SchedulingPolicyName
+	// Properties returns the scheduling policy properties set on the cluster. These include the thresholds returned
+	// by GetClusterMigrationPolicy.
+	Properties() map[string]string
+}
+
+// SchedulingPolicyParameters contains the changes SetClusterSchedulingPolicy makes. The policy can be selected
+// either by ID or by name.
+type SchedulingPolicyParameters interface {
+	// PolicyID returns the ID of the scheduling policy to assign, or nil if the policy is selected by name or left
+	// unchanged.
+	PolicyID() *SchedulingPolicyID
+	// PolicyName returns the name of the scheduling policy to assign, or nil if the policy is selected by ID or left
+	// unchanged.
+	PolicyName() *SchedulingPolicyName
+	// Properties returns the scheduling policy properties to set on the cluster. If not nil, they replace all
+	// properties of the cluster.
+	Properties() map[string]string
+}
+
+// BuildableSchedulingPolicyParameters is a buildable version of SchedulingPolicyParameters.
+type BuildableSchedulingPolicyParameters interface {
+	SchedulingPolicyParameters
+
+	// WithPolicyID selects the scheduling policy to assign by its ID.
+	WithPolicyID(id SchedulingPolicyID) (BuildableSchedulingPolicyParameters, error)
+	// MustWithPolicyID is identical to WithPolicyID, but panics instead of returning an error.
+	MustWithPolicyID(id SchedulingPolicyID) BuildableSchedulingPolicyParameters
+
+	// WithPolicyName selects the scheduling policy to assign by its name.
+	WithPolicyName(name SchedulingPolicyName) (BuildableSchedulingPolicyParameters, error)
+	// MustWithPolicyName is identical to WithPolicyName, but panics instead of returning an error.
+	MustWithPolicyName(name SchedulingPolicyName) BuildableSchedulingPolicyParameters
+
+	// WithProperty adds a scheduling policy property to set on the cluster.
+	WithProperty(name string, value string) (BuildableSchedulingPolicyParameters, error)
+	// MustWithProperty is identical to WithProperty, but panics instead of returning an error.
+	MustWithProperty(name string, value string) BuildableSchedulingPolicyParameters
+}
+
+// SchedulingPolicyParams creates a new set of parameters for SetClusterSchedulingPolicy.
+func SchedulingPolicyParams() BuildableSchedulingPolicyParameters {
+	return &schedulingPolicyParams{}
+}
+
+type schedulingPolicyParams struct {
+	policyID   *SchedulingPolicyID
+	policyName *SchedulingPolicyName
+	properties map[string]string
+}
+
+func (s *schedulingPolicyParams) PolicyID() *SchedulingPolicyID {
+	return s.policyID
+}
+
+func (s *schedulingPolicyParams) WithPolicyID(id SchedulingPolicyID) (BuildableSchedulingPolicyParameters, error) {
+	if id == "" {
+		return s, newError(EBadArgument, "the scheduling policy ID cannot be empty")
+	}
+	if s.policyName != nil {
+		return s, newError(EBadArgument, "the scheduling policy is already selected by name")
+	}
+	s.policyID = &id
+	return s, nil
+}
+
+func (s *schedulingPolicyParams) MustWithPolicyID(id SchedulingPolicyID) BuildableSchedulingPolicyParameters {
+	builder, err := s.WithPolicyID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *schedulingPolicyParams) PolicyName() *SchedulingPolicyName {
+	return s.policyName
+}
+
+func (s *schedulingPolicyParams) WithPolicyName(
+	name SchedulingPolicyName,
+) (BuildableSchedulingPolicyParameters, error) {
+	if name == "" {
+		return s, newError(EBadArgument, "the scheduling policy name cannot be empty")
+	}
+	if s.policyID != nil {
+		return s, newError(EBadArgument, "the scheduling policy is already selected by ID")
+	}
+	s.policyName = &name
+	return s, nil
+}
+
+func (s *schedulingPolicyParams) MustWithPolicyName(name SchedulingPolicyName) BuildableSchedulingPolicyParameters {
+	builder, err := s.WithPolicyName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *schedulingPolicyParams) Properties() map[string]string {
+	return s.properties
+}
+
+func (s *schedulingPolicyParams) WithProperty(name string, value string) (BuildableSchedulingPolicyParameters, error) {
+	if name == "" {
+		return s, newError(EBadArgument, "the scheduling policy property name cannot be empty")
+	}
+	if s.properties == nil {
+		s.properties = map[string]string{}
+	}
+	s.properties[name] = value
+	return s, nil
+}
+
+func (s *schedulingPolicyParams) MustWithProperty(name string, value string) BuildableSchedulingPolicyParameters {
+	builder, err := s.WithProperty(name, value)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// validateSchedulingPolicyParameters repeats the checks of the builder for SchedulingPolicyParameters implemented
+// outside this package.
+func validateSchedulingPolicyParameters(params SchedulingPolicyParameters) error {
+	id := params.PolicyID()
+	name := params.PolicyName()
+	switch {
+	case id != nil && name != nil:
+		return newError(EBadArgument, "the scheduling policy can be selected either by ID or by name, not both")
+	case id != nil && *id == "":
+		return newError(EBadArgument, "the scheduling policy ID cannot be empty")
+	case name != nil && *name == "":
+		return newError(EBadArgument, "the scheduling policy name cannot be empty")
+	case id == nil && name == nil && params.Properties() == nil:
+		return newError(EBadArgument, "neither a scheduling policy nor properties are set")
+	}
+	for propertyName := range params.Properties() {
+		if propertyName == "" {
+			return newError(EBadArgument, "the scheduling policy property name cannot be empty")
+		}
+	}
+	return nil
+}
+
+// schedulingPolicyRef is the ID and name of a scheduling policy known to the engine.
+type schedulingPolicyRef struct {
+	id   SchedulingPolicyID
+	name SchedulingPolicyName
+}
+
+// matchSchedulingPolicy returns the policy the parameters select from the policies known to the engine, or an
+// ENotFound error if there is none.
+func matchSchedulingPolicy(
+	policies []schedulingPolicyRef,
+	params SchedulingPolicyParameters,
+) (schedulingPolicyRef, error) {
+	for _, policy := range policies {
+		if id := params.PolicyID(); id != nil && policy.id == *id {
+			return policy, nil
+		}
+		if name := params.PolicyName(); name != nil && policy.name == *name {
+			return policy, nil
+		}
+	}
+	if id := params.PolicyID(); id != nil {
+		return schedulingPolicyRef{}, newError(ENotFound, "no scheduling policy with ID %s found", *id)
+	}
+	return schedulingPolicyRef{}, newError(ENotFound, "no scheduling policy named %s found", *params.PolicyName())
+}
+
+type schedulingPolicy struct {
+	clusterID  ClusterID
+	policyID   SchedulingPolicyID
+	name       SchedulingPolicyName
+	properties map[string]string
+}
+
+func (s schedulingPolicy) ClusterID() ClusterID {
+	return s.clusterID
+}
+
+func (s schedulingPolicy) PolicyID() SchedulingPolicyID {
+	return s.policyID
+}
+
+func (s schedulingPolicy) Name() SchedulingPolicyName {
+	return s.name
+}
+
+func (s schedulingPolicy) Properties() map[string]string {
+	return s.properties
+}
+
+func convertSDKSchedulingPolicy(id ClusterID, object *ovirtsdk.Cluster) schedulingPolicy {
+	result := schedulingPolicy{
+		clusterID:  id,
+		properties: map[string]string{},
+	}
+	if policy, ok := object.SchedulingPolicy(); ok {
+		policyID, _ := policy.Id()
+		name, _ := policy.Name()
+		result.policyID = SchedulingPolicyID(policyID)
+		result.name = SchedulingPolicyName(name)
+	}
+	if properties, ok := object.CustomSchedulingPolicyProperties(); ok {
+		for _, property := range properties.Slice() {
+			name, _ := property.Name()
+			value, _ := property.Value()
+			result.properties[name] = value
+		}
+	}
+	return result
+}
+
+// buildSDKSchedulingPolicyCluster creates the cluster update assigning policyID, if not empty, and the properties
+// from params.
+func buildSDKSchedulingPolicyCluster(policyID SchedulingPolicyID, params SchedulingPolicyParameters) *ovirtsdk.Cluster {
+	builder := ovirtsdk.NewClusterBuilder()
+	if policyID != "" {
+		builder.SchedulingPolicyBuilder(ovirtsdk.NewSchedulingPolicyBuilder().Id(string(policyID)))
+	}
+	if params.Properties() != nil {
+		names := make([]string, 0, len(params.Properties()))
+		for name := range params.Properties() {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		properties := make([]*ovirtsdk.Property, len(names))
+		for i, name := range names {
+			properties[i] = ovirtsdk.NewPropertyBuilder().Name(name).Value(params.Properties()[name]).MustBuild()
+		}
+		builder.CustomSchedulingPolicyPropertiesOfAny(properties...)
+	}
+	return builder.MustBuild()
+}
+
+func (o *oVirtClient) GetClusterSchedulingPolicy(
+	id ClusterID,
+	retries ...RetryStrategy,
+) (result SchedulingPolicy, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("getting scheduling policy of cluster %s", id)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				ClustersService().
+				ClusterService(string(id)).
+				Get().
+				Follow("scheduling_policy").
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkCluster, ok := response.Cluster()
+			if !ok {
+				return newError(ENotFound, "no cluster returned when getting scheduling policy of cluster %s", id)
+			}
+			result = convertSDKSchedulingPolicy(id, sdkCluster)
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) listSchedulingPolicies(retries []RetryStrategy) (result []schedulingPolicyRef, err error) {
+	action := "listing scheduling policies"
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().SchedulingPoliciesService().List().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			result = []schedulingPolicyRef{}
+			sdkPolicies, ok := response.Policies()
+			if !ok {
+				return nil
+			}
+			for _, sdkPolicy := range sdkPolicies.Slice() {
+				id, ok := sdkPolicy.Id()
+				if !ok {
+					return newFieldNotFound("scheduling policy", "ID")
+				}
+				name, _ := sdkPolicy.Name()
+				result = append(result, schedulingPolicyRef{
+					id:   SchedulingPolicyID(id),
+					name: SchedulingPolicyName(name),
+				})
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) SetClusterSchedulingPolicy(
+	id ClusterID,
+	params SchedulingPolicyParameters,
+	retries ...RetryStrategy,
+) (SchedulingPolicy, error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "the scheduling policy parameters cannot be nil")
+	}
+	if err := validateSchedulingPolicyParameters(params); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	var policyID SchedulingPolicyID
+	if params.PolicyID() != nil || params.PolicyName() != nil {
+		policies, err := o.listSchedulingPolicies(retries)
+		if err != nil {
+			return nil, err
+		}
+		policy, err := matchSchedulingPolicy(policies, params)
+		if err != nil {
+			return nil, err
+		}
+		policyID = policy.id
+	}
+	action := fmt.Sprintf("setting scheduling policy of cluster %s", id)
+	err := retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			// Properties the new policy doesn't support are rejected by the engine and returned as they are.
+			_, err := o.conn.
+				SystemService().
+				ClustersService().
+				ClusterService(string(id)).
+				Update().
+				Cluster(buildSDKSchedulingPolicyCluster(policyID, params)).
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return o.GetClusterSchedulingPolicy(id, retries...)
+}
+
+// mockSchedulingPolicies are the scheduling policies the mock engine knows, with the IDs of the engine built-ins.
+var mockSchedulingPolicies = []schedulingPolicyRef{
+	{"b4ed2332-a7ac-4d5f-9596-99a439cb2812", SchedulingPolicyNameNone},
+	{"20d25257-b4bd-4589-92a6-c4c5c5d3fd1a", SchedulingPolicyNameEvenlyDistributed},
+	{"5a2b0939-7d46-4b73-a469-e9c2c7fc6a53", SchedulingPolicyNamePowerSaving},
+	{"8d5d7bec-68de-4a67-b53e-0ac54686d579", SchedulingPolicyNameVMEvenlyDistributed},
+	{"7677771e-5eab-422e-83fa-dc04080d21b7", SchedulingPolicyNameClusterMaintenance},
+}
+
+// mockSchedulingPolicy returns the scheduling policy of the cluster. Clusters without a stored policy use the none
+// policy. The caller must hold the lock.
+func (m *mockClient) mockSchedulingPolicy(id ClusterID) schedulingPolicy {
+	if policy, ok := m.clusterSchedulingPolicies[id]; ok {
+		return policy
+	}
+	return schedulingPolicy{
+		clusterID:  id,
+		policyID:   mockSchedulingPolicies[0].id,
+		name:       mockSchedulingPolicies[0].name,
+		properties: map[string]string{},
+	}
+}
+
+func (m *mockClient) GetClusterSchedulingPolicy(id ClusterID, _ ...RetryStrategy) (SchedulingPolicy, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.clusters[id]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", id)
+	}
+	return m.mockSchedulingPolicy(id), nil
+}
+
+func (m *mockClient) SetClusterSchedulingPolicy(
+	id ClusterID,
+	params SchedulingPolicyParameters,
+	_ ...RetryStrategy,
+) (SchedulingPolicy, error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "the scheduling policy parameters cannot be nil")
+	}
+	if err := validateSchedulingPolicyParameters(params); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.clusters[id]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", id)
+	}
+	policy := m.mockSchedulingPolicy(id)
+	if params.PolicyID() != nil || params.PolicyName() != nil {
+		ref, err := matchSchedulingPolicy(mockSchedulingPolicies, params)
+		if err != nil {
+			return nil, err
+		}
+		policy.policyID = ref.id
+		policy.name = ref.name
+	}
+	if properties := params.Properties(); properties != nil {
+		policy.properties = make(map[string]string, len(properties))
+		for name, value := range properties {
+			policy.properties[name] = value
+		}
+	}
+	m.clusterSchedulingPolicies[id] = policy
+	return policy, nil
+}
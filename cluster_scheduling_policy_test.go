@@ -0,0 +1,67 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestClusterSchedulingPolicy(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	original, err := client.GetClusterSchedulingPolicy(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to get scheduling policy of cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	t.Cleanup(func() {
+		params := ovirtclient.SchedulingPolicyParams().MustWithPolicyID(original.PolicyID())
+		for name, value := range original.Properties() {
+			params = params.MustWithProperty(name, value)
+		}
+		if _, err := client.SetClusterSchedulingPolicy(helper.GetClusterID(), params); err != nil {
+			t.Fatalf("Failed to restore scheduling policy of cluster %s (%v)", helper.GetClusterID(), err)
+		}
+	})
+
+	params := ovirtclient.SchedulingPolicyParams().
+		MustWithPolicyName(ovirtclient.SchedulingPolicyNamePowerSaving).
+		MustWithProperty("HighUtilization", "80")
+	if _, err := client.SetClusterSchedulingPolicy(helper.GetClusterID(), params); err != nil {
+		t.Fatalf("Failed to set scheduling policy of cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	policy, err := client.GetClusterSchedulingPolicy(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to get scheduling policy of cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	if policy.Name() != ovirtclient.SchedulingPolicyNamePowerSaving {
+		t.Fatalf(
+			"Incorrect scheduling policy (expected: %s, got: %s)",
+			ovirtclient.SchedulingPolicyNamePowerSaving,
+			policy.Name(),
+		)
+	}
+	if value := policy.Properties()["HighUtilization"]; value != "80" {
+		t.Fatalf("Incorrect HighUtilization property (expected: 80, got: %s)", value)
+	}
+}
+
+func TestSetClusterSchedulingPolicyNotFound(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	params := ovirtclient.SchedulingPolicyParams().MustWithPolicyName("this-policy-does-not-exist")
+	if _, err := client.SetClusterSchedulingPolicy(helper.GetClusterID(), params); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.ENotFound,
+	) {
+		t.Fatalf("Setting a non-existent scheduling policy did not result in an ENotFound error (%v)", err)
+	}
+	_, err := ovirtclient.SchedulingPolicyParams().
+		MustWithPolicyName(ovirtclient.SchedulingPolicyNameNone).
+		WithPolicyID("b4ed2332-a7ac-4d5f-9596-99a439cb2812")
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Selecting a scheduling policy by both name and ID did not result in an EBadArgument error (%v)", err)
+	}
+}
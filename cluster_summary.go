@@ -0,0 +1,277 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+// ClusterSummary is the resource usage of a cluster, aggregated over its hosts at the time of the query.
+type ClusterSummary interface {
+	// ClusterID returns the ID of the cluster the summary belongs to.
+	ClusterID() ClusterID
+	// HostCount returns the number of hosts in the cluster, regardless of their status.
+	HostCount() uint
+	// TotalMemory returns the physical memory of all hosts in the cluster in bytes.
+	TotalMemory() uint64
+	// UsedMemory returns the memory in use on the hosts of the cluster in bytes.
+	UsedMemory() uint64
+	// TotalCPUs returns the number of logical CPUs of all hosts in the cluster.
+	TotalCPUs() uint
+	// UsedVCPUs returns the number of virtual CPUs of the VMs running on the hosts of the cluster. This can be
+	// higher than TotalCPUs if the CPUs are overcommitted.
+	UsedVCPUs() uint
+	// RunningVMCount returns the number of VMs running on the hosts of the cluster.
+	RunningVMCount() uint
+	// Hosts returns the resource usage of each host in the cluster.
+	Hosts() []HostSummary
+}
+
+// HostSummary is the resource usage of a single host, as part of a ClusterSummary.
+type HostSummary interface {
+	// HostID returns the ID of the host.
+	HostID() HostID
+	// Status returns the status of the host.
+	Status() HostStatus
+	// TotalMemory returns the physical memory of the host in bytes.
+	TotalMemory() uint64
+	// UsedMemory returns the memory in use on the host in bytes.
+	UsedMemory() uint64
+	// FreeMemory returns the memory not in use on the host in bytes.
+	FreeMemory() uint64
+	// TotalCPUs returns the number of logical CPUs of the host.
+	TotalCPUs() uint
+	// UsedVCPUs returns the number of virtual CPUs of the VMs running on the host.
+	UsedVCPUs() uint
+	// CanFit returns true if the host is up and a VM with the specified memory in bytes and number of virtual CPUs
+	// can be started on it. Like the scheduler of the engine, the host needs enough free memory and at least as
+	// many logical CPUs as the VM has virtual CPUs; CPUs in use by other VMs don't count since CPUs can be
+	// overcommitted.
+	CanFit(memory uint64, vcpus uint) bool
+}
+
+type clusterSummary struct {
+	clusterID      ClusterID
+	hostCount      uint
+	totalMemory    uint64
+	usedMemory     uint64
+	totalCPUs      uint
+	usedVCPUs      uint
+	runningVMCount uint
+	hosts          []HostSummary
+}
+
+func (c clusterSummary) ClusterID() ClusterID {
+	return c.clusterID
+}
+
+func (c clusterSummary) HostCount() uint {
+	return c.hostCount
+}
+
+func (c clusterSummary) TotalMemory() uint64 {
+	return c.totalMemory
+}
+
+func (c clusterSummary) UsedMemory() uint64 {
+	return c.usedMemory
+}
+
+func (c clusterSummary) TotalCPUs() uint {
+	return c.totalCPUs
+}
+
+func (c clusterSummary) UsedVCPUs() uint {
+	return c.usedVCPUs
+}
+
+func (c clusterSummary) RunningVMCount() uint {
+	return c.runningVMCount
+}
+
+func (c clusterSummary) Hosts() []HostSummary {
+	return c.hosts
+}
+
+type hostSummary struct {
+	hostID      HostID
+	status      HostStatus
+	totalMemory uint64
+	usedMemory  uint64
+	totalCPUs   uint
+	usedVCPUs   uint
+}
+
+func (h *hostSummary) HostID() HostID {
+	return h.hostID
+}
+
+func (h *hostSummary) Status() HostStatus {
+	return h.status
+}
+
+func (h *hostSummary) TotalMemory() uint64 {
+	return h.totalMemory
+}
+
+func (h *hostSummary) UsedMemory() uint64 {
+	return h.usedMemory
+}
+
+func (h *hostSummary) FreeMemory() uint64 {
+	if h.usedMemory > h.totalMemory {
+		return 0
+	}
+	return h.totalMemory - h.usedMemory
+}
+
+func (h *hostSummary) TotalCPUs() uint {
+	return h.totalCPUs
+}
+
+func (h *hostSummary) UsedVCPUs() uint {
+	return h.usedVCPUs
+}
+
+func (h *hostSummary) CanFit(memory uint64, vcpus uint) bool {
+	return h.status == HostStatusUp && h.FreeMemory() >= memory && h.totalCPUs >= vcpus
+}
+
+// summarizeCluster aggregates the hosts belonging to the cluster and the VMs placed on them. VMs without a host or
+// on a host in a different cluster are ignored. usedMemory returns the memory in use on a single host.
+func summarizeCluster(
+	clusterID ClusterID,
+	hosts []Host,
+	vms []VM,
+	usedMemory func(host Host) (uint64, error),
+) (ClusterSummary, error) {
+	result := &clusterSummary{
+		clusterID: clusterID,
+		hosts:     []HostSummary{},
+	}
+	clusterHosts := map[HostID]*hostSummary{}
+	for _, host := range hosts {
+		if host.ClusterID() != clusterID {
+			continue
+		}
+		used, err := usedMemory(host)
+		if err != nil {
+			return nil, err
+		}
+		summary := &hostSummary{
+			hostID:      host.ID(),
+			status:      host.Status(),
+			totalMemory: host.Memory(),
+			usedMemory:  used,
+			totalCPUs:   host.CPUCount(),
+		}
+		clusterHosts[host.ID()] = summary
+		result.hosts = append(result.hosts, summary)
+		result.hostCount++
+		result.totalMemory += summary.totalMemory
+		result.totalCPUs += summary.totalCPUs
+		result.usedMemory += used
+	}
+	for _, vm := range vms {
+		hostID := vm.HostID()
+		if hostID == nil {
+			continue
+		}
+		summary, ok := clusterHosts[*hostID]
+		if !ok {
+			continue
+		}
+		result.runningVMCount++
+		topo := vm.CPU().Topo()
+		vcpus := topo.Sockets() * topo.Cores() * topo.Threads()
+		summary.usedVCPUs += vcpus
+		result.usedVCPUs += vcpus
+	}
+	return result, nil
+}
+
+func (o *oVirtClient) GetClusterSummary(id ClusterID, retries ...RetryStrategy) (ClusterSummary, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	// Fetch the cluster first so a nonexistent cluster results in an ENotFound error instead of an empty summary.
+	if _, err := o.GetCluster(id, retries...); err != nil {
+		return nil, err
+	}
+	hosts, err := o.ListHosts(retries...)
+	if err != nil {
+		return nil, err
+	}
+	vms, err := o.ListVMs(retries...)
+	if err != nil {
+		return nil, err
+	}
+	return summarizeCluster(id, hosts, vms, func(host Host) (uint64, error) {
+		return o.getHostUsedMemory(host.ID(), retries)
+	})
+}
+
+// getHostUsedMemory reads the memory.used statistic of the host. Hosts that are not up report no statistics, these
+// are counted as using no memory.
+func (o *oVirtClient) getHostUsedMemory(id HostID, retries []RetryStrategy) (result uint64, err error) {
+	action := fmt.Sprintf("fetching memory statistics of host %s", id)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(id)).
+				StatisticsService().
+				List().
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			result = 0
+			statistics, ok := response.Statistics()
+			if !ok {
+				return nil
+			}
+			for _, statistic := range statistics.Slice() {
+				if name, _ := statistic.Name(); name != "memory.used" {
+					continue
+				}
+				values, ok := statistic.Values()
+				if !ok || len(values.Slice()) == 0 {
+					return nil
+				}
+				datum, _ := values.Slice()[0].Datum()
+				if datum > 0 {
+					result = uint64(datum)
+				}
+				return nil
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetClusterSummary(id ClusterID, _ ...RetryStrategy) (ClusterSummary, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.clusters[id]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", id)
+	}
+	hosts := make([]Host, 0, len(m.hosts))
+	for _, host := range m.hosts {
+		hosts = append(hosts, host)
+	}
+	vms := make([]VM, 0, len(m.vms))
+	for _, vm := range m.vms {
+		vms = append(vms, vm)
+	}
+	// The mock has no host statistics, the memory in use is the memory of the VMs placed on the host.
+	return summarizeCluster(id, hosts, vms, func(host Host) (uint64, error) {
+		var used uint64
+		for _, vm := range m.vms {
+			if vm.hostID != nil && *vm.hostID == host.ID() && vm.memory > 0 {
+				used += uint64(vm.memory)
+			}
+		}
+		return used, nil
+	})
+}
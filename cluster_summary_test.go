@@ -0,0 +1,67 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestGetClusterSummary(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	summary, err := client.GetClusterSummary(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to get cluster summary (%v)", err)
+	}
+	if summary.ClusterID() != helper.GetClusterID() {
+		t.Fatalf("Incorrect cluster ID on summary (expected: %s, got: %s)", helper.GetClusterID(), summary.ClusterID())
+	}
+	if summary.HostCount() == 0 {
+		t.Fatalf("No hosts counted in the test cluster.")
+	}
+	if summary.TotalMemory() == 0 || summary.TotalCPUs() == 0 {
+		t.Fatalf(
+			"No capacity counted in the test cluster (memory: %d, CPUs: %d)",
+			summary.TotalMemory(),
+			summary.TotalCPUs(),
+		)
+	}
+
+	if _, err := client.GetClusterSummary("00000000-0000-0000-0000-000000000001"); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.ENotFound,
+	) {
+		t.Fatalf("Getting the summary of a nonexistent cluster did not result in an ENotFound error (%v)", err)
+	}
+}
+
+func TestWaitForClusterCapacity(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	summary, err := client.GetClusterSummary(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to get cluster summary (%v)", err)
+	}
+	if len(summary.Hosts()) != int(summary.HostCount()) {
+		t.Fatalf("Incorrect number of host summaries (expected: %d, got: %d)", summary.HostCount(), len(summary.Hosts()))
+	}
+
+	if err := client.WaitForClusterCapacity(helper.GetClusterID(), 1024*1024, 1); err != nil {
+		t.Fatalf("Failed to wait for capacity for a small VM (%v)", err)
+	}
+	err = client.WaitForClusterCapacity(
+		helper.GetClusterID(),
+		summary.TotalMemory()+1,
+		1,
+		ovirtclient.FixedWait(100*time.Millisecond),
+		ovirtclient.Timeout(time.Second),
+	)
+	if err == nil {
+		t.Fatalf("Waiting for more memory than the cluster has did not fail.")
+	}
+}
@@ -7,6 +7,11 @@ import (
 )
 
 func (o *oVirtClient) Get{{ .Object }}(id {{ .IDType }}, retries ...RetryStrategy) (result {{ .Object }}, err error) {
+{{- if ne .Object "Template" }}
+	if err := validateNotBlankUUID("{{ .Name }}", {{ if eq .IDType "string" }}id{{ else }}string(id){{ end }}); err != nil {
+		return nil, err
+	}
+{{- end }}
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting {{ .Name }} %s", id),
@@ -15,7 +20,7 @@ func (o *oVirtClient) Get{{ .Object }}(id {{ .IDType }}, retries ...RetryStrateg
 		func() error {
 			response, err := o.conn.SystemService().{{ .ID }}sService().{{ .SecondaryID }}Service({{ if eq .IDType "string" }}id{{ else }}string(id){{ end }}).Get().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting {{ .Name }} %s", id), err)
 			}
 			sdkObject, ok := response.{{ .SecondaryID }}()
 			if !ok {
@@ -36,14 +41,27 @@ func (o *oVirtClient) Get{{ .Object }}(id {{ .IDType }}, retries ...RetryStrateg
 			}
 			return nil
 		})
+{{- if eq .Object "Template" }}
+	return result, wrapBlankTemplateNotFound(id, err)
+{{- else }}
 	return
+{{- end }}
 }
 
 func (m *mockClient) Get{{ .Object }}(id {{ .IDType }}, _ ...RetryStrategy) ({{ .Object }}, error) {
+{{- if ne .Object "Template" }}
+	if err := validateNotBlankUUID("{{ .Name }}", {{ if eq .IDType "string" }}id{{ else }}string(id){{ end }}); err != nil {
+		return nil, err
+	}
+{{- end }}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.{{ .ID | toLower }}s[id]; ok {
 		return item, nil
 	}
+{{- if eq .Object "Template" }}
+	return nil, wrapBlankTemplateNotFound(id, newError(ENotFound, "{{ .Name }} with ID %s not found", id))
+{{- else }}
 	return nil, newError(ENotFound, "{{ .Name }} with ID %s not found", id)
+{{- end }}
 }
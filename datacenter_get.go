@@ -7,6 +7,9 @@ import (
 )
 
 func (o *oVirtClient) GetDatacenter(id DatacenterID, retries ...RetryStrategy) (result Datacenter, err error) {
+	if err := validateNotBlankUUID("datacenter", string(id)); err != nil {
+		return nil, err
+	}
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting datacenter %s", id),
@@ -15,7 +18,7 @@ func (o *oVirtClient) GetDatacenter(id DatacenterID, retries ...RetryStrategy) (
 		func() error {
 			response, err := o.conn.SystemService().DataCentersService().DataCenterService(string(id)).Get().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting datacenter %s", id), err)
 			}
 			sdkObject, ok := response.DataCenter()
 			if !ok {
@@ -40,6 +43,9 @@ func (o *oVirtClient) GetDatacenter(id DatacenterID, retries ...RetryStrategy) (
 }
 
 func (m *mockClient) GetDatacenter(id DatacenterID, _ ...RetryStrategy) (Datacenter, error) {
+	if err := validateNotBlankUUID("datacenter", string(id)); err != nil {
+		return nil, err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.dataCenters[id]; ok {
@@ -0,0 +1,303 @@
+package ovirtclient
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// DiagnosticsClient provides a troubleshooting aid that bundles several checks of the connection to the oVirt
+// Engine into one report.
+type DiagnosticsClient interface {
+	// Diagnostics measures the round-trip latency to the engine, and reports the negotiated TLS version and cipher
+	// suite, the engine version, and whether the credentials have administrative permissions. Each check is
+	// attempted once, without retries, so the report reflects the current state of the connection.
+	//
+	// A failing check doesn't abort the others, its error is recorded in the report instead. An error is only
+	// returned, along with the report, if all checks failed. If ctx is nil, the context of the client is used.
+	Diagnostics(ctx context.Context) (DiagnosticsReport, error)
+	// GetEngineCertificateInfo opens a separate TLS connection to the engine with the TLS configuration of the
+	// client and returns the details of the certificate the engine presents, for example to alert before it expires.
+	// The connection is made directly, not through the proxy of the HTTP client. An EConnection error is returned if
+	// the connection can't be established, including when the certificate isn't trusted. If ctx is nil, the context
+	// of the client is used.
+	GetEngineCertificateInfo(ctx context.Context) (CertificateInfo, error)
+}
+
+// DiagnosticsCheck identifies a single check in a DiagnosticsReport.
+type DiagnosticsCheck string
+
+const (
+	// DiagnosticsCheckLatency measures the round-trip time of an authenticated request to the API.
+	DiagnosticsCheckLatency DiagnosticsCheck = "latency"
+	// DiagnosticsCheckTLS determines the TLS version and cipher suite negotiated with the engine.
+	DiagnosticsCheckTLS DiagnosticsCheck = "tls"
+	// DiagnosticsCheckEngineVersion reads the version of the engine.
+	DiagnosticsCheckEngineVersion DiagnosticsCheck = "engine_version"
+	// DiagnosticsCheckCredentials determines if the credentials have administrative permissions.
+	DiagnosticsCheckCredentials DiagnosticsCheck = "credentials"
+)
+
+// DiagnosticsCheckList is a list of DiagnosticsCheck values.
+type DiagnosticsCheckList []DiagnosticsCheck
+
+// DiagnosticsCheckValues returns all possible DiagnosticsCheck values.
+func DiagnosticsCheckValues() DiagnosticsCheckList {
+	return []DiagnosticsCheck{
+		DiagnosticsCheckLatency,
+		DiagnosticsCheckTLS,
+		DiagnosticsCheckEngineVersion,
+		DiagnosticsCheckCredentials,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l DiagnosticsCheckList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// CredentialScope describes what the credentials of the client are allowed to access.
+type CredentialScope string
+
+const (
+	// CredentialScopeAdmin means the user has at least one administrative role and can use the full API.
+	CredentialScopeAdmin CredentialScope = "admin"
+	// CredentialScopeUser means the user only has user roles and can only see the objects it has permissions on.
+	CredentialScopeUser CredentialScope = "user"
+)
+
+// DiagnosticsReport is the result of a Diagnostics call. The values of failed checks are empty, use Error to find
+// out why.
+type DiagnosticsReport interface {
+	// Latency returns the round-trip time of an authenticated request to the API root.
+	Latency() time.Duration
+	// TLSVersion returns the negotiated TLS version, for example "TLS 1.3". An empty string is returned if the
+	// connection doesn't use TLS.
+	TLSVersion() string
+	// TLSCipherSuite returns the name of the negotiated cipher suite, for example "TLS_AES_128_GCM_SHA256".
+	TLSCipherSuite() string
+	// EngineVersion returns the version of the engine.
+	EngineVersion() EngineVersion
+	// CredentialScope returns if the credentials have administrative permissions.
+	CredentialScope() CredentialScope
+	// Error returns the error of the specified check, or nil if the check succeeded.
+	Error(check DiagnosticsCheck) error
+	// Errors returns the errors of all failed checks.
+	Errors() map[DiagnosticsCheck]error
+}
+
+type diagnosticsReport struct {
+	latency         time.Duration
+	tlsVersion      string
+	tlsCipherSuite  string
+	engineVersion   EngineVersion
+	credentialScope CredentialScope
+	errors          map[DiagnosticsCheck]error
+}
+
+func (d *diagnosticsReport) Latency() time.Duration {
+	return d.latency
+}
+
+func (d *diagnosticsReport) TLSVersion() string {
+	return d.tlsVersion
+}
+
+func (d *diagnosticsReport) TLSCipherSuite() string {
+	return d.tlsCipherSuite
+}
+
+func (d *diagnosticsReport) EngineVersion() EngineVersion {
+	return d.engineVersion
+}
+
+func (d *diagnosticsReport) CredentialScope() CredentialScope {
+	return d.credentialScope
+}
+
+func (d *diagnosticsReport) Error(check DiagnosticsCheck) error {
+	return d.errors[check]
+}
+
+func (d *diagnosticsReport) Errors() map[DiagnosticsCheck]error {
+	result := make(map[DiagnosticsCheck]error, len(d.errors))
+	for check, err := range d.errors {
+		result[check] = err
+	}
+	return result
+}
+
+// result returns the report and an error if all checks failed.
+func (d *diagnosticsReport) result() (DiagnosticsReport, error) {
+	if len(d.errors) < len(DiagnosticsCheckValues()) {
+		return d, nil
+	}
+	return d, wrap(d.errors[DiagnosticsCheckLatency], EConnection, "all diagnostic checks failed")
+}
+
+// tlsVersionNames contains the names of the TLS versions the Go library supports.
+var tlsVersionNames = map[uint16]string{
+	tls.VersionTLS10: "TLS 1.0",
+	tls.VersionTLS11: "TLS 1.1",
+	tls.VersionTLS12: "TLS 1.2",
+	tls.VersionTLS13: "TLS 1.3",
+}
+
+func tlsVersionName(version uint16) string {
+	if name, ok := tlsVersionNames[version]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%04X", version)
+}
+
+func (o *oVirtClient) Diagnostics(ctx context.Context) (DiagnosticsReport, error) {
+	if ctx == nil {
+		ctx = o.ctx
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	report := &diagnosticsReport{
+		errors: map[DiagnosticsCheck]error{},
+	}
+	o.diagnoseTLS(ctx, report)
+	o.diagnoseAPI(ctx, report)
+	return report.result()
+}
+
+// diagnoseTLS sends an unauthenticated request to the API with the HTTP client of the client to find out the TLS
+// parameters. The response status doesn't matter, the engine rejects the request without credentials.
+func (o *oVirtClient) diagnoseTLS(ctx context.Context, report *diagnosticsReport) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, o.url, nil)
+	if err != nil {
+		report.errors[DiagnosticsCheckTLS] = wrap(err, EBug, "failed to create request for %s", o.url)
+		return
+	}
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		report.errors[DiagnosticsCheckTLS] = wrap(err, EConnection, "failed to connect to %s", o.url)
+		return
+	}
+	_ = resp.Body.Close()
+	if resp.TLS == nil {
+		return
+	}
+	report.tlsVersion = tlsVersionName(resp.TLS.Version)
+	report.tlsCipherSuite = tls.CipherSuiteName(resp.TLS.CipherSuite)
+}
+
+// diagnoseAPI fetches the API root, which provides the latency, the engine version and the authenticated user.
+func (o *oVirtClient) diagnoseAPI(ctx context.Context, report *diagnosticsReport) {
+	fail := func(err error, checks ...DiagnosticsCheck) {
+		for _, check := range checks {
+			report.errors[check] = err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		fail(
+			wrap(err, ETimeout, "context ended before the API checks"),
+			DiagnosticsCheckLatency,
+			DiagnosticsCheckEngineVersion,
+			DiagnosticsCheckCredentials,
+		)
+		return
+	}
+	action := "fetching the API root"
+	start := time.Now()
+	response, err := o.conn.SystemService().Get().Send()
+	if err != nil {
+		fail(
+			wrapSDKError(action, err),
+			DiagnosticsCheckLatency,
+			DiagnosticsCheckEngineVersion,
+			DiagnosticsCheckCredentials,
+		)
+		return
+	}
+	report.latency = time.Since(start)
+	api, ok := response.Api()
+	if !ok {
+		fail(
+			newError(EFieldMissing, "no API object returned while %s", action),
+			DiagnosticsCheckEngineVersion,
+			DiagnosticsCheckCredentials,
+		)
+		return
+	}
+	if productInfo, ok := api.ProductInfo(); !ok {
+		fail(newFieldNotFound("API", "product info"), DiagnosticsCheckEngineVersion)
+	} else if version, ok := productInfo.Version(); !ok {
+		fail(newFieldNotFound("API product info", "version"), DiagnosticsCheckEngineVersion)
+	} else {
+		report.engineVersion = convertSDKVersion(version)
+	}
+	user, ok := api.AuthenticatedUser()
+	if !ok {
+		fail(newFieldNotFound("API", "authenticated user"), DiagnosticsCheckCredentials)
+		return
+	}
+	userID, ok := user.Id()
+	if !ok {
+		fail(newFieldNotFound("authenticated user", "ID"), DiagnosticsCheckCredentials)
+		return
+	}
+	report.credentialScope, err = o.diagnoseCredentialScope(ctx, userID)
+	if err != nil {
+		fail(err, DiagnosticsCheckCredentials)
+	}
+}
+
+// diagnoseCredentialScope checks the roles assigned to the user. Users without administrative roles may not read
+// their own permissions without the filter header, so an access denied error also means a user scope.
+func (o *oVirtClient) diagnoseCredentialScope(ctx context.Context, userID string) (CredentialScope, error) {
+	if err := ctx.Err(); err != nil {
+		return "", wrap(err, ETimeout, "context ended before the credentials check")
+	}
+	action := fmt.Sprintf("listing permissions of user %s", userID)
+	response, err := o.conn.SystemService().UsersService().UserService(userID).PermissionsService().List().
+		Follow("role").Send()
+	if err != nil {
+		err = wrapSDKError(action, err)
+		if HasErrorCode(err, EAccessDenied) {
+			return CredentialScopeUser, nil
+		}
+		return "", err
+	}
+	permissions, ok := response.Permissions()
+	if !ok {
+		return CredentialScopeUser, nil
+	}
+	for _, permission := range permissions.Slice() {
+		role, ok := permission.Role()
+		if !ok {
+			continue
+		}
+		if administrative, ok := role.Administrative(); ok && administrative {
+			return CredentialScopeAdmin, nil
+		}
+	}
+	return CredentialScopeUser, nil
+}
+
+func (m *mockClient) Diagnostics(ctx context.Context) (DiagnosticsReport, error) {
+	report := &diagnosticsReport{
+		errors: map[DiagnosticsCheck]error{},
+	}
+	if ctx != nil && ctx.Err() != nil {
+		for _, check := range DiagnosticsCheckValues() {
+			report.errors[check] = wrap(ctx.Err(), ETimeout, "context ended before the diagnostic checks")
+		}
+		return report.result()
+	}
+	report.tlsVersion = tlsVersionName(tls.VersionTLS13)
+	report.tlsCipherSuite = tls.CipherSuiteName(tls.TLS_AES_128_GCM_SHA256)
+	report.engineVersion = mockEngineVersion
+	report.credentialScope = CredentialScopeAdmin
+	return report.result()
+}
@@ -0,0 +1,133 @@
+package ovirtclient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/url"
+	"time"
+)
+
+// CertificateInfo describes the TLS certificate the engine presents, as returned by GetEngineCertificateInfo.
+type CertificateInfo interface {
+	// Subject returns the distinguished name of the certificate, for example "CN=engine.example.com,O=Example".
+	Subject() string
+	// Issuer returns the distinguished name of the certificate authority that signed the certificate.
+	Issuer() string
+	// DNSNames returns the host names the certificate is valid for.
+	DNSNames() []string
+	// NotBefore returns the time the certificate becomes valid.
+	NotBefore() time.Time
+	// NotAfter returns the time the certificate expires.
+	NotAfter() time.Time
+	// ExpiresIn returns the time left until the certificate expires. It is negative for an expired certificate.
+	ExpiresIn() time.Duration
+}
+
+type certificateInfo struct {
+	subject   string
+	issuer    string
+	dnsNames  []string
+	notBefore time.Time
+	notAfter  time.Time
+}
+
+func (c *certificateInfo) Subject() string {
+	return c.subject
+}
+
+func (c *certificateInfo) Issuer() string {
+	return c.issuer
+}
+
+func (c *certificateInfo) DNSNames() []string {
+	return c.dnsNames
+}
+
+func (c *certificateInfo) NotBefore() time.Time {
+	return c.notBefore
+}
+
+func (c *certificateInfo) NotAfter() time.Time {
+	return c.notAfter
+}
+
+func (c *certificateInfo) ExpiresIn() time.Duration {
+	return time.Until(c.notAfter)
+}
+
+func convertX509Certificate(cert *x509.Certificate) *certificateInfo {
+	return &certificateInfo{
+		subject:   cert.Subject.String(),
+		issuer:    cert.Issuer.String(),
+		dnsNames:  cert.DNSNames,
+		notBefore: cert.NotBefore,
+		notAfter:  cert.NotAfter,
+	}
+}
+
+// engineTLSAddress returns the host and port to dial for the TLS connection to engineURL.
+func engineTLSAddress(engineURL string) (string, error) {
+	parsedURL, err := url.Parse(engineURL)
+	if err != nil {
+		return "", wrap(err, EBadArgument, "failed to parse engine URL %s", engineURL)
+	}
+	if parsedURL.Scheme != "https" {
+		return "", newError(EBadArgument, "the engine URL %s does not use https, there is no certificate to read", engineURL)
+	}
+	port := parsedURL.Port()
+	if port == "" {
+		port = "443"
+	}
+	return net.JoinHostPort(parsedURL.Hostname(), port), nil
+}
+
+func (o *oVirtClient) GetEngineCertificateInfo(ctx context.Context) (CertificateInfo, error) {
+	if ctx == nil {
+		ctx = o.ctx
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	address, err := engineTLSAddress(o.url)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &tls.Dialer{
+		Config: o.tlsConfig.Clone(),
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, wrap(
+			err,
+			EConnection,
+			"failed to establish a TLS connection to %s to read the engine certificate, check the network "+
+				"connectivity and the configured CA certificates",
+			address,
+		)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	peerCertificates := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(peerCertificates) == 0 {
+		return nil, newError(EConnection, "the engine at %s did not present a certificate", address)
+	}
+	return convertX509Certificate(peerCertificates[0]), nil
+}
+
+func (m *mockClient) GetEngineCertificateInfo(ctx context.Context) (CertificateInfo, error) {
+	if ctx != nil && ctx.Err() != nil {
+		return nil, wrap(ctx.Err(), ETimeout, "context ended before reading the engine certificate")
+	}
+	// The mock engine pretends to have a certificate issued a day ago that is valid for a year.
+	notBefore := time.Now().Add(-24 * time.Hour)
+	return &certificateInfo{
+		subject:   "CN=mock-engine",
+		issuer:    "CN=mock-engine-ca",
+		dnsNames:  []string{"mock-engine"},
+		notBefore: notBefore,
+		notAfter:  notBefore.AddDate(1, 0, 0),
+	}, nil
+}
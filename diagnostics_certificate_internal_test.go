@@ -0,0 +1,55 @@
+package ovirtclient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetEngineCertificateInfo(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+	pool := x509.NewCertPool()
+	pool.AddCert(srv.Certificate())
+
+	client := &oVirtClient{
+		url:       srv.URL + "/ovirt-engine/api",
+		tlsConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12},
+	}
+	info, err := client.GetEngineCertificateInfo(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to read the engine certificate (%v)", err)
+	}
+	if !info.NotAfter().Equal(srv.Certificate().NotAfter) {
+		t.Fatalf(
+			"Incorrect certificate expiry (expected: %s, got: %s)",
+			srv.Certificate().NotAfter,
+			info.NotAfter(),
+		)
+	}
+	if info.Subject() != srv.Certificate().Subject.String() {
+		t.Fatalf("Incorrect certificate subject (got: %s)", info.Subject())
+	}
+
+	untrusted := &oVirtClient{
+		url:       srv.URL,
+		tlsConfig: &tls.Config{RootCAs: x509.NewCertPool(), MinVersion: tls.VersionTLS12},
+	}
+	if _, err := untrusted.GetEngineCertificateInfo(context.Background()); !HasErrorCode(err, EConnection) {
+		t.Fatalf("Reading an untrusted certificate did not result in an EConnection error (%v)", err)
+	}
+
+	plain := &oVirtClient{
+		url:       "http://localhost/ovirt-engine/api",
+		tlsConfig: &tls.Config{MinVersion: tls.VersionTLS12},
+	}
+	if _, err := plain.GetEngineCertificateInfo(context.Background()); !HasErrorCode(err, EBadArgument) {
+		t.Fatalf("Reading the certificate of a plain HTTP URL did not result in an EBadArgument error (%v)", err)
+	}
+}
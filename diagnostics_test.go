@@ -0,0 +1,56 @@
+package ovirtclient_test
+
+import (
+	"context"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestDiagnostics(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	report, err := client.Diagnostics(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to run diagnostics (%v)", err)
+	}
+	for check, err := range report.Errors() {
+		t.Fatalf("Diagnostic check %s failed (%v)", check, err)
+	}
+	if report.EngineVersion() == nil {
+		t.Fatalf("No engine version in the diagnostics report.")
+	}
+	if report.TLSVersion() == "" || report.TLSCipherSuite() == "" {
+		t.Fatalf("No TLS parameters in the diagnostics report.")
+	}
+	if report.CredentialScope() != ovirtclient.CredentialScopeAdmin {
+		t.Fatalf(
+			"Incorrect credential scope (expected: %s, got: %s)",
+			ovirtclient.CredentialScopeAdmin,
+			report.CredentialScope(),
+		)
+	}
+}
+
+func TestDiagnosticsCancelled(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report, err := client.Diagnostics(ctx)
+	if err == nil {
+		t.Fatalf("Running diagnostics with a cancelled context did not result in an error.")
+	}
+	if report == nil {
+		t.Fatalf("Running diagnostics with a cancelled context did not return a report.")
+	}
+	for _, check := range ovirtclient.DiagnosticsCheckValues() {
+		if report.Error(check) == nil {
+			t.Fatalf("Diagnostic check %s did not fail with a cancelled context.", check)
+		}
+	}
+}
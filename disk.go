@@ -228,6 +228,13 @@ type DiskClient interface {
 		retries ...RetryStrategy,
 	) (Disk, error)
 
+	// CreateLUNDisk creates a disk backed directly by a LUN on an iSCSI or Fibre Channel storage. Unlike image disks,
+	// LUN disks do not reside on a storage domain. Parameters can be created using CreateLUNDiskParams().
+	CreateLUNDisk(
+		params LUNDiskParameters,
+		retries ...RetryStrategy,
+	) (Disk, error)
+
 	// StartUpdateDisk sends the disk update request to the oVirt API and returns a DiskUpdate
 	// object, which can be used to wait for the update to complete. Use UpdateDiskParams to
 	// obtain a builder for the parameters structure.
@@ -249,12 +256,39 @@ type DiskClient interface {
 	ListDisks(retries ...RetryStrategy) ([]Disk, error)
 	// GetDisk fetches a disk with a specific ID from the oVirt Engine.
 	GetDisk(diskID DiskID, retries ...RetryStrategy) (Disk, error)
+	// GetDiskStorageDomains fetches the disk and then the storage domains it is present on. Nothing is cached, the
+	// disk and the storage domains are fetched on every call.
+	GetDiskStorageDomains(diskID DiskID, retries ...RetryStrategy) ([]StorageDomain, error)
+	// DiskExists returns true if a disk with the specified ID exists. Errors other than the disk not being found are
+	// returned.
+	DiskExists(diskID DiskID, retries ...RetryStrategy) (bool, error)
+	// ResolveDiskID returns the ID of the disk identified by aliasOrID, trying it as an ID first and as a disk alias
+	// second. ENotFound is returned if neither matches, EConflict if several disks share the alias.
+	ResolveDiskID(aliasOrID string, retries ...RetryStrategy) (DiskID, error)
 	// ListDisksByAlias fetches a disks with a specific name from the oVirt Engine.
 	ListDisksByAlias(alias string, retries ...RetryStrategy) ([]Disk, error)
+	// ImportDiskFromExternalProvider imports an image from an external image provider, such as OpenStack Glance,
+	// as a new disk with the specified alias on the storage domain. It waits until the disk is in the DiskStatusOK
+	// status. An ENotFound error is returned if the provider or the image doesn't exist.
+	ImportDiskFromExternalProvider(
+		providerID ImageProviderID,
+		imageID string,
+		storageDomainID StorageDomainID,
+		alias string,
+		retries ...RetryStrategy,
+	) (Disk, error)
 	// RemoveDisk removes a disk with a specific ID.
 	RemoveDisk(diskID DiskID, retries ...RetryStrategy) error
-	// WaitForDiskOK waits for a disk to be in OK status
+	// WaitForDiskOK waits for a disk to be in OK status. It is a shorthand for WaitForDiskStatus with DiskStatusOK.
 	WaitForDiskOK(diskID DiskID, retries ...RetryStrategy) (Disk, error)
+	// WaitForDiskStatus waits until the disk reaches the specified status. This is useful after creating or
+	// modifying disks outside this library. An ENotFound error is returned if the disk disappears while waiting, and
+	// an EUnexpectedDiskStatus error if the disk becomes illegal while waiting for a different status.
+	WaitForDiskStatus(diskID DiskID, status DiskStatus, retries ...RetryStrategy) (Disk, error)
+	// GetDiskBackupCapabilities reports whether the disk can be backed up incrementally and which checkpoint the next
+	// incremental backup would start from. Backup tools use this to choose between a full and an incremental backup
+	// per disk, and DiskBackupCapabilities.Reason explains why a full backup is needed.
+	GetDiskBackupCapabilities(diskID DiskID, retries ...RetryStrategy) (DiskBackupCapabilities, error)
 }
 
 // UpdateDiskParams creates a builder for the params for updating a disk.
@@ -269,6 +303,13 @@ type UpdateDiskParameters interface {
 	// ProvisionedSize returns the disk provisioned size to set.
 	// It can return nil to leave the provisioned size unchanged.
 	ProvisionedSize() *uint64
+	// Description returns the disk description to set. It can return nil to leave the description unchanged.
+	Description() *string
+	// Shareable returns the shareable flag to set. It can return nil to leave the flag unchanged. Changing the flag
+	// on a disk attached to a VM results in an EConflict error.
+	Shareable() *bool
+	// WipeAfterDelete returns the wipe-after-delete flag to set. It can return nil to leave the flag unchanged.
+	WipeAfterDelete() *bool
 }
 
 // BuildableUpdateDiskParameters is a buildable version of UpdateDiskParameters.
@@ -286,11 +327,30 @@ type BuildableUpdateDiskParameters interface {
 	WithProvisionedSize(size uint64) (BuildableUpdateDiskParameters, error)
 	// MustWithProvisionedSize is identical to WithProvisionedSize, but panics instead of returning an error.
 	MustWithProvisionedSize(size uint64) BuildableUpdateDiskParameters
+
+	// WithDescription changes the params structure to set the description to the specified value.
+	WithDescription(description string) (BuildableUpdateDiskParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableUpdateDiskParameters
+
+	// WithShareable changes the params structure to set whether the disk can be attached to multiple VMs.
+	WithShareable(shareable bool) (BuildableUpdateDiskParameters, error)
+	// MustWithShareable is identical to WithShareable, but panics instead of returning an error.
+	MustWithShareable(shareable bool) BuildableUpdateDiskParameters
+
+	// WithWipeAfterDelete changes the params structure to set whether the disk contents are wiped when the disk
+	// is removed.
+	WithWipeAfterDelete(wipeAfterDelete bool) (BuildableUpdateDiskParameters, error)
+	// MustWithWipeAfterDelete is identical to WithWipeAfterDelete, but panics instead of returning an error.
+	MustWithWipeAfterDelete(wipeAfterDelete bool) BuildableUpdateDiskParameters
 }
 
 type updateDiskParams struct {
 	alias           *string
 	provisionedSize *uint64
+	description     *string
+	shareable       *bool
+	wipeAfterDelete *bool
 }
 
 func (u *updateDiskParams) Alias() *string {
@@ -332,16 +392,81 @@ func (u *updateDiskParams) MustWithProvisionedSize(size uint64) BuildableUpdateD
 	return builder
 }
 
+func (u *updateDiskParams) Description() *string {
+	return u.description
+}
+
+func (u *updateDiskParams) WithDescription(description string) (BuildableUpdateDiskParameters, error) {
+	u.description = &description
+	return u, nil
+}
+
+func (u *updateDiskParams) MustWithDescription(description string) BuildableUpdateDiskParameters {
+	builder, err := u.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateDiskParams) Shareable() *bool {
+	return u.shareable
+}
+
+func (u *updateDiskParams) WithShareable(shareable bool) (BuildableUpdateDiskParameters, error) {
+	u.shareable = &shareable
+	return u, nil
+}
+
+func (u *updateDiskParams) MustWithShareable(shareable bool) BuildableUpdateDiskParameters {
+	builder, err := u.WithShareable(shareable)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateDiskParams) WipeAfterDelete() *bool {
+	return u.wipeAfterDelete
+}
+
+func (u *updateDiskParams) WithWipeAfterDelete(wipeAfterDelete bool) (BuildableUpdateDiskParameters, error) {
+	u.wipeAfterDelete = &wipeAfterDelete
+	return u, nil
+}
+
+func (u *updateDiskParams) MustWithWipeAfterDelete(wipeAfterDelete bool) BuildableUpdateDiskParameters {
+	builder, err := u.WithWipeAfterDelete(wipeAfterDelete)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 // CreateDiskOptionalParameters is a structure that serves to hold the optional parameters for DiskClient.CreateDisk.
 type CreateDiskOptionalParameters interface {
 	// Alias is a secondary name for the disk.
 	Alias() string
 
-	// Sparse indicates that the disk should be sparse-provisioned.If it returns nil, the default will be used.
+	// Sparse indicates that the disk should be sparse-provisioned. If it returns nil, the default will be used.
+	//
+	// Sparse raw disks are not supported on block storage domains (iSCSI, FCP). Creating one results in an
+	// EBadArgument error before the request is sent to the engine. Use ImageFormatCow for thin provisioning on
+	// block storage instead.
 	Sparse() *bool
 
+	// Shareable indicates that the disk can be attached to multiple VMs at the same time, for example for clustered
+	// file systems. Shareable disks must use the raw format. If it returns nil, the default (not shareable) will be
+	// used.
+	Shareable() *bool
+
 	// InitialSize is the initially reserved disk space when creating the disk.
 	InitialSize() *uint64
+
+	// ContentType is the kind of content the disk holds, for example an ISO image uploaded to a data storage domain.
+	// Disks other than DiskContentTypeData must use the raw format. If it returns nil, the default (data) will be
+	// used.
+	ContentType() *DiskContentType
 }
 
 // BuildableCreateDiskParameters is a buildable version of CreateDiskOptionalParameters.
@@ -358,10 +483,20 @@ type BuildableCreateDiskParameters interface {
 	// MustWithSparse is the same as WithSparse, but panics instead of returning an error.
 	MustWithSparse(sparse bool) BuildableCreateDiskParameters
 
+	// WithShareable sets whether the disk can be attached to multiple VMs.
+	WithShareable(shareable bool) (BuildableCreateDiskParameters, error)
+	// MustWithShareable is the same as WithShareable, but panics instead of returning an error.
+	MustWithShareable(shareable bool) BuildableCreateDiskParameters
+
 	// WithInitialSize sets initial size for the disk.
 	WithInitialSize(size uint64) (BuildableCreateDiskParameters, error)
 	// MustWithInitialSize is the same as WithInitialSize, but panics instead of returning an error.
 	MustWithInitialSize(size uint64) BuildableCreateDiskParameters
+
+	// WithContentType sets the kind of content the disk holds.
+	WithContentType(contentType DiskContentType) (BuildableCreateDiskParameters, error)
+	// MustWithContentType is the same as WithContentType, but panics instead of returning an error.
+	MustWithContentType(contentType DiskContentType) BuildableCreateDiskParameters
 }
 
 // CreateDiskParams creates a buildable set of CreateDiskOptionalParameters for use with
@@ -373,7 +508,9 @@ func CreateDiskParams() BuildableCreateDiskParameters {
 type createDiskParams struct {
 	alias       string
 	sparse      *bool
+	shareable   *bool
 	initialSize *uint64
+	contentType *DiskContentType
 }
 
 func (c *createDiskParams) Alias() string {
@@ -410,6 +547,23 @@ func (c *createDiskParams) MustWithSparse(sparse bool) BuildableCreateDiskParame
 	return builder
 }
 
+func (c *createDiskParams) Shareable() *bool {
+	return c.shareable
+}
+
+func (c *createDiskParams) WithShareable(shareable bool) (BuildableCreateDiskParameters, error) {
+	c.shareable = &shareable
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithShareable(shareable bool) BuildableCreateDiskParameters {
+	builder, err := c.WithShareable(shareable)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 func (c *createDiskParams) InitialSize() *uint64 {
 	return c.initialSize
 }
@@ -427,6 +581,207 @@ func (c *createDiskParams) MustWithInitialSize(size uint64) BuildableCreateDiskP
 	return builder
 }
 
+func (c *createDiskParams) ContentType() *DiskContentType {
+	return c.contentType
+}
+
+func (c *createDiskParams) WithContentType(contentType DiskContentType) (BuildableCreateDiskParameters, error) {
+	if err := contentType.Validate(); err != nil {
+		return nil, err
+	}
+	c.contentType = &contentType
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithContentType(contentType DiskContentType) BuildableCreateDiskParameters {
+	builder, err := c.WithContentType(contentType)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// LUNDiskParameters holds the parameters for DiskClient.CreateLUNDisk.
+type LUNDiskParameters interface {
+	// Alias is the name of the disk.
+	Alias() string
+	// StorageType is the type of storage the LUN is accessed over.
+	StorageType() LUNStorageType
+	// LUNID is the identifier of the LUN on the storage.
+	LUNID() string
+	// Target is the iSCSI target name (IQN) the LUN is exposed on. Required for iSCSI LUNs.
+	Target() string
+	// Address is the address of the iSCSI portal. Required for iSCSI LUNs.
+	Address() string
+	// Port is the port of the iSCSI portal. Defaults to 3260.
+	Port() uint16
+}
+
+// BuildableLUNDiskParameters is a buildable version of LUNDiskParameters.
+type BuildableLUNDiskParameters interface {
+	LUNDiskParameters
+
+	// WithAlias sets the name of the disk.
+	WithAlias(alias string) (BuildableLUNDiskParameters, error)
+	// MustWithAlias is identical to WithAlias, but panics instead of returning an error.
+	MustWithAlias(alias string) BuildableLUNDiskParameters
+
+	// WithStorageType sets the type of storage the LUN is accessed over.
+	WithStorageType(storageType LUNStorageType) (BuildableLUNDiskParameters, error)
+	// MustWithStorageType is identical to WithStorageType, but panics instead of returning an error.
+	MustWithStorageType(storageType LUNStorageType) BuildableLUNDiskParameters
+
+	// WithLUNID sets the identifier of the LUN on the storage.
+	WithLUNID(lunID string) (BuildableLUNDiskParameters, error)
+	// MustWithLUNID is identical to WithLUNID, but panics instead of returning an error.
+	MustWithLUNID(lunID string) BuildableLUNDiskParameters
+
+	// WithTarget sets the iSCSI target name.
+	WithTarget(target string) (BuildableLUNDiskParameters, error)
+	// MustWithTarget is identical to WithTarget, but panics instead of returning an error.
+	MustWithTarget(target string) BuildableLUNDiskParameters
+
+	// WithAddress sets the address of the iSCSI portal.
+	WithAddress(address string) (BuildableLUNDiskParameters, error)
+	// MustWithAddress is identical to WithAddress, but panics instead of returning an error.
+	MustWithAddress(address string) BuildableLUNDiskParameters
+
+	// WithPort sets the port of the iSCSI portal.
+	WithPort(port uint16) (BuildableLUNDiskParameters, error)
+	// MustWithPort is identical to WithPort, but panics instead of returning an error.
+	MustWithPort(port uint16) BuildableLUNDiskParameters
+}
+
+// CreateLUNDiskParams creates a buildable set of LUNDiskParameters for use with Client.CreateLUNDisk.
+func CreateLUNDiskParams() BuildableLUNDiskParameters {
+	return &lunDiskParams{
+		port: 3260,
+	}
+}
+
+type lunDiskParams struct {
+	alias       string
+	storageType LUNStorageType
+	lunID       string
+	target      string
+	address     string
+	port        uint16
+}
+
+func (l *lunDiskParams) Alias() string {
+	return l.alias
+}
+
+func (l *lunDiskParams) StorageType() LUNStorageType {
+	return l.storageType
+}
+
+func (l *lunDiskParams) LUNID() string {
+	return l.lunID
+}
+
+func (l *lunDiskParams) Target() string {
+	return l.target
+}
+
+func (l *lunDiskParams) Address() string {
+	return l.address
+}
+
+func (l *lunDiskParams) Port() uint16 {
+	return l.port
+}
+
+func (l *lunDiskParams) WithAlias(alias string) (BuildableLUNDiskParameters, error) {
+	if err := ValidateResourceName(alias); err != nil {
+		return nil, wrap(err, EBadArgument, "invalid disk alias")
+	}
+	l.alias = alias
+	return l, nil
+}
+
+func (l *lunDiskParams) MustWithAlias(alias string) BuildableLUNDiskParameters {
+	builder, err := l.WithAlias(alias)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (l *lunDiskParams) WithStorageType(storageType LUNStorageType) (BuildableLUNDiskParameters, error) {
+	if err := storageType.Validate(); err != nil {
+		return nil, err
+	}
+	l.storageType = storageType
+	return l, nil
+}
+
+func (l *lunDiskParams) MustWithStorageType(storageType LUNStorageType) BuildableLUNDiskParameters {
+	builder, err := l.WithStorageType(storageType)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (l *lunDiskParams) WithLUNID(lunID string) (BuildableLUNDiskParameters, error) {
+	if lunID == "" {
+		return nil, newError(EBadArgument, "the LUN ID must not be empty")
+	}
+	l.lunID = lunID
+	return l, nil
+}
+
+func (l *lunDiskParams) MustWithLUNID(lunID string) BuildableLUNDiskParameters {
+	builder, err := l.WithLUNID(lunID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (l *lunDiskParams) WithTarget(target string) (BuildableLUNDiskParameters, error) {
+	l.target = target
+	return l, nil
+}
+
+func (l *lunDiskParams) MustWithTarget(target string) BuildableLUNDiskParameters {
+	builder, err := l.WithTarget(target)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (l *lunDiskParams) WithAddress(address string) (BuildableLUNDiskParameters, error) {
+	l.address = address
+	return l, nil
+}
+
+func (l *lunDiskParams) MustWithAddress(address string) BuildableLUNDiskParameters {
+	builder, err := l.WithAddress(address)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (l *lunDiskParams) WithPort(port uint16) (BuildableLUNDiskParameters, error) {
+	if port == 0 {
+		return nil, newError(EBadArgument, "the iSCSI portal port must not be 0")
+	}
+	l.port = port
+	return l, nil
+}
+
+func (l *lunDiskParams) MustWithPort(port uint16) BuildableLUNDiskParameters {
+	builder, err := l.WithPort(port)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 // DiskCreation is a process object that lets you query the status of the disk creation.
 type DiskCreation interface {
 	// Disk returns the disk that has been created, even if it is not yet ready.
@@ -498,12 +853,25 @@ type DiskData interface {
 	Format() ImageFormat
 	// StorageDomainIDs returns a list of storage domains this disk is present on. This will typically be a single
 	// disk, but may have multiple disk when the disk has been copied over to other storage domains. The disk is always
-	// present on at least one disk, so this list will never be empty.
+	// present on at least one disk, so this list will never be empty, except for LUN disks, which are not stored on a
+	// storage domain.
 	StorageDomainIDs() []StorageDomainID
 	// Status returns the status the disk is in.
 	Status() DiskStatus
 	// Sparse indicates sparse provisioning on the disk.
 	Sparse() bool
+	// StorageType returns the type of storage backing this disk.
+	StorageType() DiskStorageType
+	// Shareable indicates that the disk can be attached to multiple VMs at the same time.
+	Shareable() bool
+	// Description returns the user-provided description of the disk.
+	Description() string
+	// WipeAfterDelete indicates that the disk contents are overwritten when the disk is removed.
+	WipeAfterDelete() bool
+	// ContentType returns the kind of content the disk holds.
+	ContentType() DiskContentType
+	// Backup returns whether the disk takes part in incremental backups.
+	Backup() DiskBackup
 }
 
 // Disk is a disk in oVirt.
@@ -574,10 +942,32 @@ const (
 	// DiskStatusLocked represents a disk status where no operations can be performed on the disk.
 	DiskStatusLocked DiskStatus = "locked"
 	// DiskStatusIllegal indicates that the disk cannot be accessed by the virtual machine, and the user needs
-	// to take action to resolve the issue.
+	// to take action to resolve the issue. Disks usually end up in this status after a failed snapshot operation.
+	// An illegal disk doesn't recover on its own: WaitForDiskStatus fails immediately with an EUnexpectedDiskStatus
+	// error and attaching the disk fails with an EConflict error.
 	DiskStatusIllegal DiskStatus = "illegal"
 )
 
+// diskIllegalHint explains how an illegal disk can be recovered, to be appended to error messages.
+const diskIllegalHint = "the disk needs manual intervention, check the engine events for a failed snapshot " +
+	"operation and remove the broken snapshot or restore the disk from a backup"
+
+// checkDiskNotIllegal returns an EConflict error if the disk is illegal, since the engine would fail the operation
+// with an unspecific fault.
+func checkDiskNotIllegal(disk Disk, operation string) error {
+	if disk.Status() != DiskStatusIllegal {
+		return nil
+	}
+	return newError(
+		EConflict,
+		"cannot %s disk %s because it is in the %s status, %s",
+		operation,
+		disk.ID(),
+		DiskStatusIllegal,
+		diskIllegalHint,
+	)
+}
+
 // DiskStatusList is a list of DiskStatus values.
 type DiskStatusList []DiskStatus
 
@@ -599,6 +989,102 @@ func (l DiskStatusList) Strings() []string {
 	return result
 }
 
+// Validate returns an error if the disk status doesn't have a valid value.
+func (s DiskStatus) Validate() error {
+	for _, status := range DiskStatusValues() {
+		if status == s {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid disk status: %s must be one of: %s",
+		s,
+		strings.Join(DiskStatusValues().Strings(), ", "),
+	)
+}
+
+// DiskStorageType describes the type of storage backing a disk.
+type DiskStorageType string
+
+const (
+	// DiskStorageTypeImage is a disk stored as an image on a storage domain.
+	DiskStorageTypeImage DiskStorageType = "image"
+	// DiskStorageTypeLUN is a disk directly backed by a LUN on an iSCSI or Fibre Channel storage.
+	DiskStorageTypeLUN DiskStorageType = "lun"
+	// DiskStorageTypeCinder is a disk stored on an OpenStack Cinder volume.
+	DiskStorageTypeCinder DiskStorageType = "cinder"
+	// DiskStorageTypeManagedBlockStorage is a disk stored on a managed block storage domain.
+	DiskStorageTypeManagedBlockStorage DiskStorageType = "managed_block_storage"
+)
+
+// DiskStorageTypeList is a list of DiskStorageType values.
+type DiskStorageTypeList []DiskStorageType
+
+// DiskStorageTypeValues returns all possible values for DiskStorageType.
+func DiskStorageTypeValues() DiskStorageTypeList {
+	return []DiskStorageType{
+		DiskStorageTypeImage,
+		DiskStorageTypeLUN,
+		DiskStorageTypeCinder,
+		DiskStorageTypeManagedBlockStorage,
+	}
+}
+
+// Strings returns a list of strings.
+func (l DiskStorageTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, storageType := range l {
+		result[i] = string(storageType)
+	}
+	return result
+}
+
+// LUNStorageType is the protocol a LUN disk is accessed over.
+type LUNStorageType string
+
+const (
+	// LUNStorageTypeISCSI is a LUN accessed over iSCSI.
+	LUNStorageTypeISCSI LUNStorageType = "iscsi"
+	// LUNStorageTypeFCP is a LUN accessed over Fibre Channel.
+	LUNStorageTypeFCP LUNStorageType = "fcp"
+)
+
+// Validate returns an error if the LUN storage type doesn't have a valid value.
+func (l LUNStorageType) Validate() error {
+	for _, storageType := range LUNStorageTypeValues() {
+		if storageType == l {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid LUN storage type: %s must be one of: %s",
+		l,
+		strings.Join(LUNStorageTypeValues().Strings(), ", "),
+	)
+}
+
+// LUNStorageTypeList is a list of LUNStorageType values.
+type LUNStorageTypeList []LUNStorageType
+
+// LUNStorageTypeValues returns all possible values for LUNStorageType.
+func LUNStorageTypeValues() LUNStorageTypeList {
+	return []LUNStorageType{
+		LUNStorageTypeISCSI,
+		LUNStorageTypeFCP,
+	}
+}
+
+// Strings returns a list of strings.
+func (l LUNStorageTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, storageType := range l {
+		result[i] = string(storageType)
+	}
+	return result
+}
+
 // UploadImageProgress is a tracker for the upload progress happening in the background.
 type UploadImageProgress interface {
 	// Disk returns the disk created as part of the upload process once the upload is complete. Before the upload
@@ -616,6 +1102,108 @@ type UploadImageProgress interface {
 	Done() <-chan struct{}
 }
 
+// DiskContentType describes what a disk holds. The engine uses it to decide where the disk can be used, for example
+// only ISO disks can be inserted into the CD-ROM drive of a VM.
+type DiskContentType string
+
+const (
+	// DiskContentTypeData is a regular disk holding VM data. This is the default.
+	DiskContentTypeData DiskContentType = "data"
+	// DiskContentTypeISO is an ISO image stored on a data storage domain.
+	DiskContentTypeISO DiskContentType = "iso"
+	// DiskContentTypeOVFStore is a disk the engine stores the OVF configuration of the VMs and templates on the
+	// storage domain in.
+	DiskContentTypeOVFStore DiskContentType = "ovf_store"
+	// DiskContentTypeMemoryDump holds the memory of a VM saved in a snapshot or hibernation.
+	DiskContentTypeMemoryDump DiskContentType = "memory_dump_volume"
+	// DiskContentTypeMemoryMetadata holds the device state belonging to a DiskContentTypeMemoryDump disk.
+	DiskContentTypeMemoryMetadata DiskContentType = "memory_metadata_volume"
+)
+
+// Validate returns an error if the content type doesn't have a valid value.
+func (c DiskContentType) Validate() error {
+	for _, contentType := range DiskContentTypeValues() {
+		if contentType == c {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid disk content type: %s must be one of: %s",
+		c,
+		strings.Join(DiskContentTypeValues().Strings(), ", "),
+	)
+}
+
+// DiskContentTypeList is a list of DiskContentType values.
+type DiskContentTypeList []DiskContentType
+
+// DiskContentTypeValues returns all possible DiskContentType values.
+func DiskContentTypeValues() DiskContentTypeList {
+	return []DiskContentType{
+		DiskContentTypeData,
+		DiskContentTypeISO,
+		DiskContentTypeOVFStore,
+		DiskContentTypeMemoryDump,
+		DiskContentTypeMemoryMetadata,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l DiskContentTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, contentType := range l {
+		result[i] = string(contentType)
+	}
+	return result
+}
+
+// DiskBackup describes whether the engine tracks the changed blocks of a disk for incremental backups.
+type DiskBackup string
+
+const (
+	// DiskBackupNone means the disk can only be backed up in full. This is the default.
+	DiskBackupNone DiskBackup = "none"
+	// DiskBackupIncremental means the engine tracks the blocks changed since the last checkpoint, so the disk can be
+	// backed up incrementally. This requires the disk to use the ImageFormatCow format.
+	DiskBackupIncremental DiskBackup = "incremental"
+)
+
+// Validate returns an error if the disk backup setting doesn't have a valid value.
+func (b DiskBackup) Validate() error {
+	for _, backup := range DiskBackupValues() {
+		if backup == b {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid disk backup setting: %s must be one of: %s",
+		b,
+		strings.Join(DiskBackupValues().Strings(), ", "),
+	)
+}
+
+// DiskBackupList is a list of DiskBackup values.
+type DiskBackupList []DiskBackup
+
+// DiskBackupValues returns all possible DiskBackup values.
+func DiskBackupValues() DiskBackupList {
+	return []DiskBackup{
+		DiskBackupNone,
+		DiskBackupIncremental,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l DiskBackupList) Strings() []string {
+	result := make([]string, len(l))
+	for i, backup := range l {
+		result[i] = string(backup)
+	}
+	return result
+}
+
 // ImageFormat is a constant for representing the format that images can be in. This is relevant
 // for both image uploads and image downloads, as the oVirt engine has the capability of converting
 // between these formats.
@@ -674,6 +1262,17 @@ func convertSDKDisk(sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
 	if !ok {
 		return nil, newError(EFieldMissing, "disk does not contain an ID")
 	}
+	alias, ok := sdkDisk.Alias()
+	if !ok {
+		return nil, newError(EFieldMissing, "disk %s does not contain an alias", id)
+	}
+	storageType := DiskStorageTypeImage
+	if sdkStorageType, ok := sdkDisk.StorageType(); ok {
+		storageType = DiskStorageType(sdkStorageType)
+	}
+	if storageType == DiskStorageTypeLUN {
+		return convertSDKLUNDisk(sdkDisk, DiskID(id), alias, client), nil
+	}
 	var storageDomainIDs []StorageDomainID
 	if sdkStorageDomain, ok := sdkDisk.StorageDomain(); ok {
 		storageDomainID, _ := sdkStorageDomain.Id()
@@ -688,10 +1287,6 @@ func convertSDKDisk(sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
 	if len(storageDomainIDs) == 0 {
 		return nil, newError(EFieldMissing, "failed to find a valid storage domain for disk %s", id)
 	}
-	alias, ok := sdkDisk.Alias()
-	if !ok {
-		return nil, newError(EFieldMissing, "disk %s does not contain an alias", id)
-	}
 	provisionedSize, ok := sdkDisk.ProvisionedSize()
 	if !ok {
 		return nil, newError(EFieldMissing, "disk %s does not contain a provisioned size", id)
@@ -712,6 +1307,17 @@ func convertSDKDisk(sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
 	if !ok {
 		return nil, newError(EFieldMissing, "disk %s has no sparse field", id)
 	}
+	shareable, _ := sdkDisk.Shareable()
+	description, _ := sdkDisk.Description()
+	wipeAfterDelete, _ := sdkDisk.WipeAfterDelete()
+	contentType := DiskContentTypeData
+	if sdkContentType, ok := sdkDisk.ContentType(); ok {
+		contentType = DiskContentType(sdkContentType)
+	}
+	backup := DiskBackupNone
+	if sdkBackup, ok := sdkDisk.Backup(); ok {
+		backup = DiskBackup(sdkBackup)
+	}
 	return &disk{
 		client: client,
 
@@ -723,9 +1329,53 @@ func convertSDKDisk(sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
 		storageDomainIDs: storageDomainIDs,
 		status:           DiskStatus(status),
 		sparse:           sparse,
+		storageType:      storageType,
+		shareable:        shareable,
+		description:      description,
+		wipeAfterDelete:  wipeAfterDelete,
+		contentType:      contentType,
+		backup:           backup,
 	}, nil
 }
 
+// convertSDKLUNDisk converts a LUN disk. LUN disks are not stored on a storage domain and the engine does not report
+// the image-related fields for them, so the size is taken from the logical unit instead.
+func convertSDKLUNDisk(sdkDisk *ovirtsdk4.Disk, id DiskID, alias string, client Client) Disk {
+	shareable, _ := sdkDisk.Shareable()
+	description, _ := sdkDisk.Description()
+	wipeAfterDelete, _ := sdkDisk.WipeAfterDelete()
+	status := DiskStatusOK
+	if sdkStatus, ok := sdkDisk.Status(); ok {
+		status = DiskStatus(sdkStatus)
+	}
+	var size uint64
+	if lunStorage, ok := sdkDisk.LunStorage(); ok {
+		if logicalUnits, ok := lunStorage.LogicalUnits(); ok {
+			for _, logicalUnit := range logicalUnits.Slice() {
+				if lunSize, ok := logicalUnit.Size(); ok {
+					size += uint64(lunSize)
+				}
+			}
+		}
+	}
+	return &disk{
+		client: client,
+
+		id:              id,
+		alias:           alias,
+		provisionedSize: size,
+		totalSize:       size,
+		format:          ImageFormatRaw,
+		status:          status,
+		storageType:     DiskStorageTypeLUN,
+		shareable:       shareable,
+		description:     description,
+		wipeAfterDelete: wipeAfterDelete,
+		contentType:     DiskContentTypeData,
+		backup:          DiskBackupNone,
+	}
+}
+
 type disk struct {
 	client Client
 
@@ -737,6 +1387,12 @@ type disk struct {
 	status           DiskStatus
 	totalSize        uint64
 	sparse           bool
+	storageType      DiskStorageType
+	shareable        bool
+	description      string
+	wipeAfterDelete  bool
+	contentType      DiskContentType
+	backup           DiskBackup
 }
 
 func (d *disk) WaitForOK(retries ...RetryStrategy) (Disk, error) {
@@ -748,15 +1404,7 @@ func (d *disk) StorageDomainIDs() []StorageDomainID {
 }
 
 func (d *disk) StorageDomains(retries ...RetryStrategy) ([]StorageDomain, error) {
-	storageDomains := make([]StorageDomain, len(d.storageDomainIDs))
-	for i, id := range d.storageDomainIDs {
-		storageDomain, err := d.client.GetStorageDomain(id, retries...)
-		if err != nil {
-			return nil, err
-		}
-		storageDomains[i] = storageDomain
-	}
-	return storageDomains, nil
+	return followStorageDomainLinks(d.client, "disk", string(d.id), d.storageDomainIDs, retries)
 }
 
 func (d *disk) Update(params UpdateDiskParameters, retries ...RetryStrategy) (Disk, error) {
@@ -767,10 +1415,34 @@ func (d *disk) StartUpdate(params UpdateDiskParameters, retries ...RetryStrategy
 	return d.client.StartUpdateDisk(d.id, params, retries...)
 }
 
+func (d *disk) StorageType() DiskStorageType {
+	return d.storageType
+}
+
+func (d *disk) Shareable() bool {
+	return d.shareable
+}
+
 func (d *disk) Sparse() bool {
 	return d.sparse
 }
 
+func (d *disk) Description() string {
+	return d.description
+}
+
+func (d *disk) WipeAfterDelete() bool {
+	return d.wipeAfterDelete
+}
+
+func (d *disk) ContentType() DiskContentType {
+	return d.contentType
+}
+
+func (d *disk) Backup() DiskBackup {
+	return d.backup
+}
+
 func (d *disk) AttachToVM(
 	vmID VMID,
 	diskInterface DiskInterface,
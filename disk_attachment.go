@@ -23,8 +23,20 @@ type DiskAttachmentClient interface {
 	GetDiskAttachment(vmID VMID, id DiskAttachmentID, retries ...RetryStrategy) (DiskAttachment, error)
 	// ListDiskAttachments lists all disk attachments for a virtual machine.
 	ListDiskAttachments(vmID VMID, retries ...RetryStrategy) ([]DiskAttachment, error)
+	// GetDiskVMs returns the IDs of the VMs the disk is attached to. This is usually at most one VM, shareable disks
+	// can be attached to several. An empty list means the disk is floating and can be removed or moved safely.
+	GetDiskVMs(diskID DiskID, retries ...RetryStrategy) ([]VMID, error)
 	// RemoveDiskAttachment removes the disk attachment in question.
 	RemoveDiskAttachment(vmID VMID, diskAttachmentID DiskAttachmentID, retries ...RetryStrategy) error
+	// RemoveDiskFromVM removes the disk attachment and, if deleteDisk is true, also deletes the disk and waits until
+	// it is gone. A disk that is also attached to other VMs results in an EConflict error and is left untouched. An
+	// ENotFound error is returned if the attachment doesn't exist.
+	RemoveDiskFromVM(
+		vmID VMID,
+		diskAttachmentID DiskAttachmentID,
+		deleteDisk bool,
+		retries ...RetryStrategy,
+	) error
 }
 
 // DiskInterface describes the means by which a disk will appear to the VM.
@@ -85,6 +97,17 @@ func (d DiskInterface) Validate() error {
 	)
 }
 
+// ParseDiskInterface converts a user-supplied string, for example from a configuration file, into a DiskInterface.
+// Surrounding whitespace and the case of the letters are ignored. An EBadArgument error is returned for unknown
+// interfaces.
+func ParseDiskInterface(value string) (DiskInterface, error) {
+	diskInterface := DiskInterface(strings.ToLower(strings.TrimSpace(value)))
+	if err := diskInterface.Validate(); err != nil {
+		return "", err
+	}
+	return diskInterface, nil
+}
+
 // CreateDiskAttachmentOptionalParams are the optional parameters for creating a disk attachment.
 type CreateDiskAttachmentOptionalParams interface {
 	// Bootable defines whether the disk is bootable.
@@ -92,6 +115,23 @@ type CreateDiskAttachmentOptionalParams interface {
 
 	// Active defines whether the disk is active in the virtual machine it’s attached to.
 	Active() *bool
+
+	// AllowMultipleBootable disables the check that prevents attaching a bootable disk to a VM that already has a
+	// bootable disk attached.
+	AllowMultipleBootable() bool
+
+	// ReadOnly defines whether the disk is attached in read-only mode.
+	ReadOnly() *bool
+
+	// PassDiscard defines whether discard (TRIM) requests from the guest are passed to the underlying storage.
+	PassDiscard() *bool
+
+	// UsesSCSIReservation defines whether the guest may issue SCSI reservation commands on the disk.
+	UsesSCSIReservation() *bool
+
+	// LogicalName is the device name the disk should get inside the guest, for example /dev/vdb. If it returns nil,
+	// the name is chosen by the guest.
+	LogicalName() *string
 }
 
 // BuildableCreateDiskAttachmentParams is a buildable version of CreateDiskAttachmentOptionalParams.
@@ -106,6 +146,39 @@ type BuildableCreateDiskAttachmentParams interface {
 	WithActive(active bool) (BuildableCreateDiskAttachmentParams, error)
 	// MustWithActive is the same as WithActive, but panics instead of returning an error.
 	MustWithActive(active bool) BuildableCreateDiskAttachmentParams
+
+	// WithAllowMultipleBootable allows attaching a bootable disk even if the VM already has a bootable disk. By
+	// default, this results in an EConflict error since the engine would accept the attachment and leave the VM
+	// with an ambiguous boot configuration.
+	WithAllowMultipleBootable(allowMultipleBootable bool) (BuildableCreateDiskAttachmentParams, error)
+	// MustWithAllowMultipleBootable is the same as WithAllowMultipleBootable, but panics instead of returning an
+	// error.
+	MustWithAllowMultipleBootable(allowMultipleBootable bool) BuildableCreateDiskAttachmentParams
+
+	// WithReadOnly sets whether the VM can only read from the disk. Defaults to false.
+	WithReadOnly(readOnly bool) (BuildableCreateDiskAttachmentParams, error)
+	// MustWithReadOnly is the same as WithReadOnly, but panics instead of returning an error.
+	MustWithReadOnly(readOnly bool) BuildableCreateDiskAttachmentParams
+
+	// WithPassDiscard sets whether the guest's discard requests reach the storage, so the space freed in the guest
+	// can be reclaimed on thin-provisioned storage.
+	WithPassDiscard(passDiscard bool) (BuildableCreateDiskAttachmentParams, error)
+	// MustWithPassDiscard is the same as WithPassDiscard, but panics instead of returning an error.
+	MustWithPassDiscard(passDiscard bool) BuildableCreateDiskAttachmentParams
+
+	// WithUsesSCSIReservation sets whether the guest may use SCSI reservations, as clustered workloads sharing the
+	// disk do. SCSI reservations are only available with DiskInterfaceVirtIOSCSI, creating an attachment with any
+	// other interface and this flag enabled results in an EBadArgument error.
+	WithUsesSCSIReservation(usesSCSIReservation bool) (BuildableCreateDiskAttachmentParams, error)
+	// MustWithUsesSCSIReservation is the same as WithUsesSCSIReservation, but panics instead of returning an error.
+	MustWithUsesSCSIReservation(usesSCSIReservation bool) BuildableCreateDiskAttachmentParams
+
+	// WithLogicalName sets the device name of the disk inside the guest. This makes the device naming deterministic
+	// when several disks are attached. Attaching a disk with a logical name that is already used by another
+	// attachment on the same VM results in an EConflict error.
+	WithLogicalName(logicalName string) (BuildableCreateDiskAttachmentParams, error)
+	// MustWithLogicalName is the same as WithLogicalName, but panics instead of returning an error.
+	MustWithLogicalName(logicalName string) BuildableCreateDiskAttachmentParams
 }
 
 // CreateDiskAttachmentParams creates a buildable set of parameters for creating a disk attachment.
@@ -114,8 +187,13 @@ func CreateDiskAttachmentParams() BuildableCreateDiskAttachmentParams {
 }
 
 type createDiskAttachmentParams struct {
-	bootable *bool
-	active   *bool
+	bootable              *bool
+	active                *bool
+	allowMultipleBootable bool
+	readOnly              *bool
+	passDiscard           *bool
+	usesSCSIReservation   *bool
+	logicalName           *string
 }
 
 func (c createDiskAttachmentParams) Bootable() *bool {
@@ -152,6 +230,102 @@ func (c createDiskAttachmentParams) MustWithActive(active bool) BuildableCreateD
 	return builder
 }
 
+func (c createDiskAttachmentParams) AllowMultipleBootable() bool {
+	return c.allowMultipleBootable
+}
+
+func (c createDiskAttachmentParams) WithAllowMultipleBootable(
+	allowMultipleBootable bool,
+) (BuildableCreateDiskAttachmentParams, error) {
+	c.allowMultipleBootable = allowMultipleBootable
+	return c, nil
+}
+
+func (c createDiskAttachmentParams) MustWithAllowMultipleBootable(
+	allowMultipleBootable bool,
+) BuildableCreateDiskAttachmentParams {
+	builder, err := c.WithAllowMultipleBootable(allowMultipleBootable)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c createDiskAttachmentParams) ReadOnly() *bool {
+	return c.readOnly
+}
+
+func (c createDiskAttachmentParams) WithReadOnly(readOnly bool) (BuildableCreateDiskAttachmentParams, error) {
+	c.readOnly = &readOnly
+	return c, nil
+}
+
+func (c createDiskAttachmentParams) MustWithReadOnly(readOnly bool) BuildableCreateDiskAttachmentParams {
+	builder, err := c.WithReadOnly(readOnly)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c createDiskAttachmentParams) PassDiscard() *bool {
+	return c.passDiscard
+}
+
+func (c createDiskAttachmentParams) WithPassDiscard(passDiscard bool) (BuildableCreateDiskAttachmentParams, error) {
+	c.passDiscard = &passDiscard
+	return c, nil
+}
+
+func (c createDiskAttachmentParams) MustWithPassDiscard(passDiscard bool) BuildableCreateDiskAttachmentParams {
+	builder, err := c.WithPassDiscard(passDiscard)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c createDiskAttachmentParams) UsesSCSIReservation() *bool {
+	return c.usesSCSIReservation
+}
+
+func (c createDiskAttachmentParams) WithUsesSCSIReservation(
+	usesSCSIReservation bool,
+) (BuildableCreateDiskAttachmentParams, error) {
+	c.usesSCSIReservation = &usesSCSIReservation
+	return c, nil
+}
+
+func (c createDiskAttachmentParams) MustWithUsesSCSIReservation(
+	usesSCSIReservation bool,
+) BuildableCreateDiskAttachmentParams {
+	builder, err := c.WithUsesSCSIReservation(usesSCSIReservation)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c createDiskAttachmentParams) LogicalName() *string {
+	return c.logicalName
+}
+
+func (c createDiskAttachmentParams) WithLogicalName(logicalName string) (BuildableCreateDiskAttachmentParams, error) {
+	if strings.TrimSpace(logicalName) == "" || strings.ContainsAny(logicalName, " \t\n") {
+		return c, newError(EBadArgument, "invalid logical name for disk attachment: %q", logicalName)
+	}
+	c.logicalName = &logicalName
+	return c, nil
+}
+
+func (c createDiskAttachmentParams) MustWithLogicalName(logicalName string) BuildableCreateDiskAttachmentParams {
+	builder, err := c.WithLogicalName(logicalName)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 // DiskAttachment links together a Disk and a VM.
 type DiskAttachment interface {
 	// ID returns the identifier of the attachment.
@@ -166,6 +340,15 @@ type DiskAttachment interface {
 	Bootable() bool
 	// Active defines whether the disk is active in the virtual machine it’s attached to.
 	Active() bool
+	// ReadOnly defines whether the disk is attached in read-only mode.
+	ReadOnly() bool
+	// PassDiscard defines whether discard requests from the guest are passed to the storage.
+	PassDiscard() bool
+	// UsesSCSIReservation defines whether the guest may use SCSI reservations on the disk.
+	UsesSCSIReservation() bool
+	// LogicalName returns the device name of the disk inside the guest. It is empty if the name was not set when
+	// attaching and the engine does not report it.
+	LogicalName() string
 
 	// VM fetches the virtual machine this attachment belongs to.
 	VM(retries ...RetryStrategy) (VM, error)
@@ -174,17 +357,24 @@ type DiskAttachment interface {
 
 	// Remove removes the current disk attachment.
 	Remove(retries ...RetryStrategy) error
+	// RemoveWithDisk removes the current disk attachment and deletes the disk. See
+	// DiskAttachmentClient.RemoveDiskFromVM for details.
+	RemoveWithDisk(retries ...RetryStrategy) error
 }
 
 type diskAttachment struct {
 	client Client
 
-	id            DiskAttachmentID
-	vmid          VMID
-	diskID        DiskID
-	diskInterface DiskInterface
-	active        bool
-	bootable      bool
+	id                  DiskAttachmentID
+	vmid                VMID
+	diskID              DiskID
+	diskInterface       DiskInterface
+	active              bool
+	bootable            bool
+	readOnly            bool
+	passDiscard         bool
+	usesSCSIReservation bool
+	logicalName         string
 }
 
 func (d *diskAttachment) DiskInterface() DiskInterface {
@@ -195,6 +385,10 @@ func (d *diskAttachment) Remove(retries ...RetryStrategy) error {
 	return d.client.RemoveDiskAttachment(d.vmid, d.id, retries...)
 }
 
+func (d *diskAttachment) RemoveWithDisk(retries ...RetryStrategy) error {
+	return d.client.RemoveDiskFromVM(d.vmid, d.id, true, retries...)
+}
+
 func (d *diskAttachment) ID() DiskAttachmentID {
 	return d.id
 }
@@ -215,6 +409,22 @@ func (d *diskAttachment) Active() bool {
 	return d.active
 }
 
+func (d *diskAttachment) ReadOnly() bool {
+	return d.readOnly
+}
+
+func (d *diskAttachment) PassDiscard() bool {
+	return d.passDiscard
+}
+
+func (d *diskAttachment) UsesSCSIReservation() bool {
+	return d.usesSCSIReservation
+}
+
+func (d *diskAttachment) LogicalName() string {
+	return d.logicalName
+}
+
 func (d *diskAttachment) VM(retries ...RetryStrategy) (VM, error) {
 	return d.client.GetVM(d.vmid, retries...)
 }
@@ -256,14 +466,22 @@ func convertSDKDiskAttachment(object *ovirtsdk4.DiskAttachment, o *oVirtClient)
 	if !ok {
 		return nil, newFieldNotFound("active on disk attachment", "active")
 	}
+	readOnly, _ := object.ReadOnly()
+	passDiscard, _ := object.PassDiscard()
+	usesSCSIReservation, _ := object.UsesScsiReservation()
+	logicalName, _ := object.LogicalName()
 	return &diskAttachment{
 		client: o,
 
-		id:            DiskAttachmentID(id),
-		vmid:          VMID(vmID),
-		diskID:        DiskID(diskID),
-		diskInterface: DiskInterface(diskInterface),
-		bootable:      bootable,
-		active:        active,
+		id:                  DiskAttachmentID(id),
+		vmid:                VMID(vmID),
+		diskID:              DiskID(diskID),
+		diskInterface:       DiskInterface(diskInterface),
+		bootable:            bootable,
+		active:              active,
+		readOnly:            readOnly,
+		passDiscard:         passDiscard,
+		usesSCSIReservation: usesSCSIReservation,
+		logicalName:         logicalName,
 	}, nil
 }
@@ -17,6 +17,25 @@ func (o *oVirtClient) CreateDiskAttachment(
 	if err := diskInterface.Validate(); err != nil {
 		return nil, wrap(err, EBadArgument, "failed to create disk attachment")
 	}
+	if err := validateSCSIReservation(diskInterface, params); err != nil {
+		return nil, err
+	}
+	disk, err := o.GetDisk(diskID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDiskNotIllegal(disk, "attach"); err != nil {
+		return nil, err
+	}
+	if requiresBootableCheck(params) || (params != nil && params.LogicalName() != nil) {
+		existingAttachments, err := o.ListDiskAttachments(vmID, retries...)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkExistingDiskAttachments(vmID, params, existingAttachments); err != nil {
+			return nil, err
+		}
+	}
 	err = retry(
 		fmt.Sprintf("attaching disk %s to vm %s", diskID, vmID),
 		o.logger,
@@ -33,6 +52,18 @@ func (o *oVirtClient) CreateDiskAttachment(
 				if bootable := params.Bootable(); bootable != nil {
 					attachmentBuilder.Bootable(*params.Bootable())
 				}
+				if readOnly := params.ReadOnly(); readOnly != nil {
+					attachmentBuilder.ReadOnly(*readOnly)
+				}
+				if passDiscard := params.PassDiscard(); passDiscard != nil {
+					attachmentBuilder.PassDiscard(*passDiscard)
+				}
+				if usesSCSIReservation := params.UsesSCSIReservation(); usesSCSIReservation != nil {
+					attachmentBuilder.UsesScsiReservation(*usesSCSIReservation)
+				}
+				if logicalName := params.LogicalName(); logicalName != nil {
+					attachmentBuilder.LogicalName(*logicalName)
+				}
 			}
 			attachment := attachmentBuilder.MustBuild()
 
@@ -77,6 +108,9 @@ func (m *mockClient) CreateDiskAttachment(
 	if err := diskInterface.Validate(); err != nil {
 		return nil, wrap(err, EBadArgument, "failed to create disk attachment")
 	}
+	if err := validateSCSIReservation(diskInterface, params); err != nil {
+		return nil, err
+	}
 
 	vm, ok := m.vms[vmID]
 	if !ok {
@@ -87,6 +121,9 @@ func (m *mockClient) CreateDiskAttachment(
 	if !ok {
 		return nil, newError(ENotFound, "disk with ID %s not found", diskID)
 	}
+	if err := checkDiskNotIllegal(disk, "attach"); err != nil {
+		return nil, err
+	}
 
 	attachment := &diskAttachment{
 		client:        m,
@@ -102,25 +139,130 @@ func (m *mockClient) CreateDiskAttachment(
 		if active := params.Active(); active != nil {
 			attachment.active = *active
 		}
+		if readOnly := params.ReadOnly(); readOnly != nil {
+			attachment.readOnly = *readOnly
+		}
+		if passDiscard := params.PassDiscard(); passDiscard != nil {
+			attachment.passDiscard = *passDiscard
+		}
+		if usesSCSIReservation := params.UsesSCSIReservation(); usesSCSIReservation != nil {
+			attachment.usesSCSIReservation = *usesSCSIReservation
+		}
+		if logicalName := params.LogicalName(); logicalName != nil {
+			attachment.logicalName = *logicalName
+		}
 	}
 	for _, diskAttachment := range m.vmDiskAttachmentsByVM[vm.ID()] {
 		if diskAttachment.DiskID() == diskID {
 			return nil, newError(EConflict, "disk %s is already attached to VM %s", diskID, vmID)
 		}
 	}
+	existingAttachments := make([]DiskAttachment, 0, len(m.vmDiskAttachmentsByVM[vm.ID()]))
+	for _, diskAttachment := range m.vmDiskAttachmentsByVM[vm.ID()] {
+		existingAttachments = append(existingAttachments, diskAttachment)
+	}
+	if err := checkExistingDiskAttachments(vmID, params, existingAttachments); err != nil {
+		return nil, err
+	}
 
-	if diskAttachment, ok := m.vmDiskAttachmentsByDisk[disk.ID()]; ok {
-		return nil, newError(
-			EConflict,
-			"cannot attach disk %s to VM %s, already attached to VM %s",
-			diskID,
-			vmID,
-			diskAttachment.VMID(),
-		)
+	if !disk.shareable {
+		for _, diskAttachment := range m.vmDiskAttachmentsByDisk[disk.ID()] {
+			return nil, newError(
+				EConflict,
+				"cannot attach disk %s to VM %s, already attached to VM %s and the disk is not shareable",
+				diskID,
+				vmID,
+				diskAttachment.VMID(),
+			)
+		}
 	}
 
-	m.vmDiskAttachmentsByDisk[disk.ID()] = attachment
+	m.addDiskAttachmentByDisk(attachment)
 	m.vmDiskAttachmentsByVM[vm.ID()][attachment.ID()] = attachment
 
 	return attachment, nil
 }
+
+// addDiskAttachmentByDisk adds the attachment to the per-disk index. Shareable disks may have multiple attachments.
+func (m *mockClient) addDiskAttachmentByDisk(attachment *diskAttachment) {
+	if _, ok := m.vmDiskAttachmentsByDisk[attachment.diskID]; !ok {
+		m.vmDiskAttachmentsByDisk[attachment.diskID] = map[DiskAttachmentID]*diskAttachment{}
+	}
+	m.vmDiskAttachmentsByDisk[attachment.diskID][attachment.id] = attachment
+}
+
+// requiresBootableCheck returns true if the attachment to be created is bootable and the caller has not explicitly
+// allowed multiple bootable disks.
+func requiresBootableCheck(params CreateDiskAttachmentOptionalParams) bool {
+	if params == nil {
+		return false
+	}
+	bootable := params.Bootable()
+	return bootable != nil && *bootable && !params.AllowMultipleBootable()
+}
+
+// checkExistingDiskAttachments runs the checks of the new attachment against the attachments already on the VM.
+func checkExistingDiskAttachments(
+	vmID VMID,
+	params CreateDiskAttachmentOptionalParams,
+	existingAttachments []DiskAttachment,
+) error {
+	if requiresBootableCheck(params) {
+		if err := checkNoBootableDiskAttachment(vmID, existingAttachments); err != nil {
+			return err
+		}
+	}
+	if params == nil || params.LogicalName() == nil {
+		return nil
+	}
+	return checkLogicalNameUnique(vmID, *params.LogicalName(), existingAttachments)
+}
+
+// checkLogicalNameUnique makes sure no other attachment on the VM uses the same logical name. The engine doesn't
+// reject duplicates, which leaves the device naming in the guest up to chance.
+func checkLogicalNameUnique(vmID VMID, logicalName string, existingAttachments []DiskAttachment) error {
+	for _, attachment := range existingAttachments {
+		if attachment.LogicalName() == logicalName {
+			return newError(
+				EConflict,
+				"VM %s already has disk %s attached with the logical name %s",
+				vmID,
+				attachment.DiskID(),
+				logicalName,
+			)
+		}
+	}
+	return nil
+}
+
+func checkNoBootableDiskAttachment(vmID VMID, existingAttachments []DiskAttachment) error {
+	for _, attachment := range existingAttachments {
+		if attachment.Bootable() {
+			return newError(
+				EConflict,
+				"VM %s already has a bootable disk attached (%s), refusing to attach a second bootable disk",
+				vmID,
+				attachment.DiskID(),
+			)
+		}
+	}
+	return nil
+}
+
+// validateSCSIReservation checks that SCSI reservations are only requested on virtio-scsi attachments. The engine
+// rejects other interfaces with a message that doesn't point at the interface as the cause.
+func validateSCSIReservation(diskInterface DiskInterface, params CreateDiskAttachmentOptionalParams) error {
+	if params == nil {
+		return nil
+	}
+	usesSCSIReservation := params.UsesSCSIReservation()
+	if usesSCSIReservation == nil || !*usesSCSIReservation || diskInterface == DiskInterfaceVirtIOSCSI {
+		return nil
+	}
+	return newError(
+		EBadArgument,
+		"SCSI reservations require the %s disk interface (%s given)",
+		DiskInterfaceVirtIOSCSI,
+		diskInterface,
+	)
+}
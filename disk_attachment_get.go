@@ -24,7 +24,7 @@ func (o *oVirtClient) GetDiskAttachment(
 				Get().
 				Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting disk attachment %s on VM %s", id, vmid), err)
 			}
 			sdkObject, ok := response.Attachment()
 			if !ok {
@@ -0,0 +1,56 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sort"
+)
+
+func (o *oVirtClient) GetDiskVMs(diskID DiskID, retries ...RetryStrategy) (result []VMID, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("listing VMs using disk %s", diskID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().DisksService().DiskService(string(diskID)).Get().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkDisk, ok := response.Disk()
+			if !ok {
+				return newError(ENotFound, "no disk returned when getting disk ID %s", diskID)
+			}
+			result = []VMID{}
+			// The disk references the VMs it is attached to, so no search over all attachments is needed.
+			sdkVMs, ok := sdkDisk.Vms()
+			if !ok {
+				return nil
+			}
+			for _, sdkVM := range sdkVMs.Slice() {
+				id, ok := sdkVM.Id()
+				if !ok {
+					return newFieldNotFound("VM referenced by disk", "ID")
+				}
+				result = append(result, VMID(id))
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetDiskVMs(diskID DiskID, _ ...RetryStrategy) ([]VMID, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.disks[diskID]; !ok {
+		return nil, newError(ENotFound, "disk with ID %s not found", diskID)
+	}
+	result := []VMID{}
+	for _, attachment := range m.vmDiskAttachmentsByDisk[diskID] {
+		result = append(result, attachment.vmid)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+	return result, nil
+}
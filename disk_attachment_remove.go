@@ -24,6 +24,87 @@ func (o *oVirtClient) RemoveDiskAttachment(vmID VMID, diskAttachmentID DiskAttac
 	)
 }
 
+func (o *oVirtClient) RemoveDiskFromVM(
+	vmID VMID,
+	diskAttachmentID DiskAttachmentID,
+	deleteDisk bool,
+	retries ...RetryStrategy,
+) error {
+	if !deleteDisk {
+		return o.RemoveDiskAttachment(vmID, diskAttachmentID, retries...)
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	attachment, err := o.GetDiskAttachment(vmID, diskAttachmentID, retries...)
+	if err != nil {
+		return err
+	}
+	diskID := attachment.DiskID()
+	vmIDs, err := o.GetDiskVMs(diskID, retries...)
+	if err != nil {
+		return err
+	}
+	if err := checkDiskOnlyAttachedTo(diskID, vmID, vmIDs); err != nil {
+		return err
+	}
+	action := fmt.Sprintf("removing disk attachment %s and disk %s on VM %s", diskAttachmentID, diskID, vmID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				DiskAttachmentsService().
+				AttachmentService(string(diskAttachmentID)).
+				Remove().
+				DetachOnly(false).
+				Send()
+			err = wrapSDKError(action, err)
+			if err != nil && HasErrorCode(err, ENotFound) {
+				// A previous attempt went through, but the response was lost.
+				return nil
+			}
+			return err
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return retry(
+		fmt.Sprintf("waiting for disk %s to be removed", diskID),
+		o.logger,
+		retries,
+		func() error {
+			exists, err := o.DiskExists(diskID, retries...)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return newError(EPending, "disk %s still exists", diskID)
+			}
+			return nil
+		},
+	)
+}
+
+// checkDiskOnlyAttachedTo returns an EConflict error if the disk is attached to other VMs than vmID, since removing
+// it would pull it from under them.
+func checkDiskOnlyAttachedTo(diskID DiskID, vmID VMID, vmIDs []VMID) error {
+	for _, otherVMID := range vmIDs {
+		if otherVMID != vmID {
+			return newError(
+				EConflict,
+				"disk %s is also attached to VM %s, refusing to remove it",
+				diskID,
+				otherVMID,
+			)
+		}
+	}
+	return nil
+}
+
 func (m *mockClient) RemoveDiskAttachment(vmID VMID, diskAttachmentID DiskAttachmentID, _ ...RetryStrategy) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
@@ -38,8 +119,57 @@ func (m *mockClient) RemoveDiskAttachment(vmID VMID, diskAttachmentID DiskAttach
 		return newError(ENotFound, "Disk attachment %s not found on VM %s", diskAttachmentID, vmID)
 	}
 
-	delete(m.vmDiskAttachmentsByDisk, diskAttachment.DiskID())
+	m.removeDiskAttachmentByDisk(diskAttachment)
 	delete(m.vmDiskAttachmentsByVM[vmID], diskAttachmentID)
 
 	return nil
 }
+
+// removeDiskAttachmentByDisk removes the attachment from the per-disk index. The disk entry is removed when its last
+// attachment is gone.
+func (m *mockClient) removeDiskAttachmentByDisk(attachment *diskAttachment) {
+	delete(m.vmDiskAttachmentsByDisk[attachment.diskID], attachment.id)
+	if len(m.vmDiskAttachmentsByDisk[attachment.diskID]) == 0 {
+		delete(m.vmDiskAttachmentsByDisk, attachment.diskID)
+	}
+}
+
+func (m *mockClient) RemoveDiskFromVM(
+	vmID VMID,
+	diskAttachmentID DiskAttachmentID,
+	deleteDisk bool,
+	retries ...RetryStrategy,
+) error {
+	if !deleteDisk {
+		return m.RemoveDiskAttachment(vmID, diskAttachmentID, retries...)
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	attachment, ok := m.vmDiskAttachmentsByVM[vmID][diskAttachmentID]
+	if !ok {
+		return newError(ENotFound, "Disk attachment %s not found on VM %s", diskAttachmentID, vmID)
+	}
+	var vmIDs []VMID
+	for _, diskAttachment := range m.vmDiskAttachmentsByDisk[attachment.diskID] {
+		vmIDs = append(vmIDs, diskAttachment.vmid)
+	}
+	if err := checkDiskOnlyAttachedTo(attachment.diskID, vmID, vmIDs); err != nil {
+		return err
+	}
+	if vm := m.vms[vmID]; vm.status != VMStatusDown {
+		return newError(
+			EConflict,
+			"cannot remove disk %s from VM %s, which is \"%s\" not \"%s\"",
+			attachment.diskID,
+			vmID,
+			vm.status,
+			VMStatusDown,
+		)
+	}
+
+	m.removeDiskAttachmentByDisk(attachment)
+	delete(m.vmDiskAttachmentsByVM[vmID], diskAttachmentID)
+	delete(m.disks, attachment.diskID)
+	return nil
+}
@@ -55,6 +55,270 @@ func TestDiskAttachmentCannotBeAttachedToSecondVM(t *testing.T) {
 	assertCannotAttachDisk(t, vm2, disk, ovirtclient.EConflict)
 }
 
+func TestDiskAttachmentSecondBootableDisk(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("disk_attachment_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	disk1 := assertCanCreateDisk(t, helper)
+	disk2 := assertCanCreateDisk(t, helper)
+	bootableParams := ovirtclient.CreateDiskAttachmentParams().MustWithBootable(true)
+	_ = assertCanAttachDiskWithParams(t, vm, disk1, bootableParams)
+
+	_, err := vm.AttachDisk(disk2.ID(), ovirtclient.DiskInterfaceVirtIO, bootableParams)
+	if err == nil {
+		t.Fatalf("Attaching a second bootable disk did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Attaching a second bootable disk did not result in an EConflict error (%v)", err)
+	}
+	assertDiskAttachmentCount(t, vm, 1)
+}
+
+func TestDiskAttachmentReadOnly(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	attachment := assertCanAttachDiskWithParams(
+		t,
+		vm,
+		disk,
+		ovirtclient.CreateDiskAttachmentParams().MustWithReadOnly(true),
+	)
+	if !attachment.ReadOnly() {
+		t.Fatalf("Disk attachment is not read-only after creation.")
+	}
+	attachment, err := helper.GetClient().GetDiskAttachment(vm.ID(), attachment.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch disk attachment (%v)", err)
+	}
+	if !attachment.ReadOnly() {
+		t.Fatalf("Disk attachment is not read-only after fetching it.")
+	}
+}
+
+func TestDiskAttachmentSCSIReservation(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	params := ovirtclient.CreateDiskAttachmentParams().MustWithUsesSCSIReservation(true)
+	if _, err := vm.AttachDisk(disk.ID(), ovirtclient.DiskInterfaceVirtIO, params); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Requesting SCSI reservations on a virtio disk did not result in an EBadArgument error (%v)", err)
+	}
+	attachment, err := vm.AttachDisk(disk.ID(), ovirtclient.DiskInterfaceVirtIOSCSI, params)
+	if err != nil {
+		t.Fatalf("Failed to create disk attachment with SCSI reservations (%v)", err)
+	}
+	if !attachment.UsesSCSIReservation() {
+		t.Fatalf("Disk attachment does not use SCSI reservations after creation.")
+	}
+}
+
+func TestDiskAttachmentLogicalName(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk1 := assertCanCreateDisk(t, helper)
+	disk2 := assertCanCreateDisk(t, helper)
+	params := ovirtclient.CreateDiskAttachmentParams().MustWithLogicalName("/dev/vdb")
+	attachment := assertCanAttachDiskWithParams(t, vm, disk1, params)
+	if attachment.LogicalName() != "/dev/vdb" {
+		t.Fatalf("Incorrect logical name after creation (expected: /dev/vdb, got: %s)", attachment.LogicalName())
+	}
+
+	if _, err := vm.AttachDisk(disk2.ID(), ovirtclient.DiskInterfaceVirtIO, params); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EConflict,
+	) {
+		t.Fatalf("Attaching a second disk with the same logical name did not result in an EConflict error (%v)", err)
+	}
+	assertDiskAttachmentCount(t, vm, 1)
+
+	if _, err := ovirtclient.CreateDiskAttachmentParams().WithLogicalName(" "); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting an empty logical name did not result in an EBadArgument error (%v)", err)
+	}
+}
+
+func TestShareableDiskCanBeAttachedToSecondVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm1 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	vm2 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDiskWithParameters(
+		t,
+		helper,
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.CreateDiskParams().MustWithShareable(true),
+	)
+	if !disk.Shareable() {
+		t.Fatalf("Disk is not shareable after creation.")
+	}
+	_ = assertCanAttachDisk(t, vm1, disk)
+	attachment := assertCanAttachDisk(t, vm2, disk)
+	assertCanDetachDisk(t, attachment)
+	assertDiskAttachmentCount(t, vm1, 1)
+}
+
+func TestDiskAttachmentRemoveWithDisk(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	attachment := assertCanAttachDisk(t, vm, disk)
+
+	if err := attachment.RemoveWithDisk(); err != nil {
+		t.Fatalf("Failed to remove disk attachment with disk (%v)", err)
+	}
+	assertDiskAttachmentCount(t, vm, 0)
+	exists, err := helper.GetClient().DiskExists(disk.ID())
+	if err != nil {
+		t.Fatalf("Failed to check if disk %s exists (%v)", disk.ID(), err)
+	}
+	if exists {
+		t.Fatalf("Disk %s still exists after removing it with its attachment.", disk.ID())
+	}
+}
+
+func TestDiskAttachmentRemoveWithSharedDisk(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm1 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	vm2 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDiskWithParameters(
+		t,
+		helper,
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.CreateDiskParams().MustWithShareable(true),
+	)
+	attachment := assertCanAttachDisk(t, vm1, disk)
+	_ = assertCanAttachDisk(t, vm2, disk)
+
+	if err := attachment.RemoveWithDisk(); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Removing a disk attached to another VM did not result in an EConflict error (%v)", err)
+	}
+	assertDiskAttachmentCount(t, vm1, 1)
+}
+
+func TestGetDiskVMs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm1 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	vm2 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDiskWithParameters(
+		t,
+		helper,
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.CreateDiskParams().MustWithShareable(true),
+	)
+
+	vmIDs, err := client.GetDiskVMs(disk.ID())
+	if err != nil {
+		t.Fatalf("Failed to list VMs of disk %s (%v)", disk.ID(), err)
+	}
+	if len(vmIDs) != 0 {
+		t.Fatalf("Floating disk is reported as attached to %d VMs.", len(vmIDs))
+	}
+
+	_ = assertCanAttachDisk(t, vm1, disk)
+	_ = assertCanAttachDisk(t, vm2, disk)
+	vmIDs, err = client.GetDiskVMs(disk.ID())
+	if err != nil {
+		t.Fatalf("Failed to list VMs of disk %s (%v)", disk.ID(), err)
+	}
+	if len(vmIDs) != 2 {
+		t.Fatalf("Incorrect number of VMs using the shared disk (expected: 2, got: %d)", len(vmIDs))
+	}
+	for _, vm := range []ovirtclient.VM{vm1, vm2} {
+		found := false
+		for _, vmID := range vmIDs {
+			if vmID == vm.ID() {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("VM %s is missing from the VMs using disk %s.", vm.ID(), disk.ID())
+		}
+	}
+}
+
+func TestParseDiskInterface(t *testing.T) {
+	t.Parallel()
+	validValues := map[string]ovirtclient.DiskInterface{
+		"virtio":      ovirtclient.DiskInterfaceVirtIO,
+		"virtio_scsi": ovirtclient.DiskInterfaceVirtIOSCSI,
+		" IDE ":       ovirtclient.DiskInterfaceIDE,
+		"SATA":        ovirtclient.DiskInterfaceSATA,
+		"spapr_vscsi": ovirtclient.DiskInterfacesPAPRvSCSI,
+	}
+	for value, expected := range validValues {
+		diskInterface, err := ovirtclient.ParseDiskInterface(value)
+		if err != nil {
+			t.Fatalf("Failed to parse disk interface %q (%v)", value, err)
+		}
+		if diskInterface != expected {
+			t.Fatalf("Incorrect disk interface parsed from %q (expected: %s, got: %s)", value, expected, diskInterface)
+		}
+	}
+	for _, value := range []string{"", "virtio-scsi", "scsi", "virt io"} {
+		if _, err := ovirtclient.ParseDiskInterface(value); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+			t.Fatalf("Parsing the invalid disk interface %q did not result in an EBadArgument error (%v)", value, err)
+		}
+	}
+}
+
+func TestDiskAttachmentInvalidInterface(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	if _, err := vm.AttachDisk(disk.ID(), "virtio-blk", nil); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Attaching a disk with an invalid interface did not result in an EBadArgument error (%v)", err)
+	}
+}
+
+func TestShareableDiskRequiresRawFormat(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	_, err := helper.GetClient().CreateDisk(
+		helper.GetStorageDomainID(),
+		ovirtclient.ImageFormatCow,
+		1048576,
+		ovirtclient.CreateDiskParams().MustWithShareable(true),
+	)
+	if err == nil {
+		t.Fatalf("Creating a shareable disk in the cow format did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a shareable disk in the cow format did not result in an EBadArgument error (%v)", err)
+	}
+}
+
 func assertCanCreateDisk(t *testing.T, helper ovirtclient.TestHelper) ovirtclient.Disk {
 	return assertCanCreateDiskWithParameters(t, helper, ovirtclient.ImageFormatRaw, nil)
 }
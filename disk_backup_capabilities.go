@@ -0,0 +1,244 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// CheckpointID is the identifier of a VM checkpoint. A checkpoint marks the point an incremental backup starts from.
+type CheckpointID string
+
+// DiskBackupMode is the kind of backup that can be run for a disk.
+type DiskBackupMode string
+
+const (
+	// DiskBackupModeFull copies the whole disk.
+	DiskBackupModeFull DiskBackupMode = "full"
+	// DiskBackupModeIncremental copies only the blocks changed since the current checkpoint of the disk.
+	DiskBackupModeIncremental DiskBackupMode = "incremental"
+)
+
+// DiskBackupModeList is a list of DiskBackupMode values.
+type DiskBackupModeList []DiskBackupMode
+
+// DiskBackupModeValues returns all possible DiskBackupMode values.
+func DiskBackupModeValues() DiskBackupModeList {
+	return []DiskBackupMode{
+		DiskBackupModeFull,
+		DiskBackupModeIncremental,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l DiskBackupModeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, mode := range l {
+		result[i] = string(mode)
+	}
+	return result
+}
+
+// DiskBackupCapabilities describes which kind of backup can be run for a disk.
+type DiskBackupCapabilities interface {
+	// DiskID returns the ID of the disk the capabilities belong to.
+	DiskID() DiskID
+	// SupportsIncremental returns true if the engine and the disk allow incremental backups. This requires the
+	// ImageFormatCow format and DiskBackupIncremental to be enabled on the disk.
+	SupportsIncremental() bool
+	// CurrentCheckpointID returns the checkpoint the next incremental backup would start from, or nil if the disk
+	// is not part of a checkpoint yet.
+	CurrentCheckpointID() *CheckpointID
+	// Mode returns DiskBackupModeIncremental if the disk supports incremental backups and has a current checkpoint,
+	// DiskBackupModeFull otherwise.
+	Mode() DiskBackupMode
+	// Reason returns why an incremental backup is not possible. It is empty if Mode returns
+	// DiskBackupModeIncremental.
+	Reason() string
+}
+
+type diskBackupCapabilities struct {
+	diskID              DiskID
+	supportsIncremental bool
+	currentCheckpointID *CheckpointID
+	reason              string
+}
+
+func (d diskBackupCapabilities) DiskID() DiskID {
+	return d.diskID
+}
+
+func (d diskBackupCapabilities) SupportsIncremental() bool {
+	return d.supportsIncremental
+}
+
+func (d diskBackupCapabilities) CurrentCheckpointID() *CheckpointID {
+	return d.currentCheckpointID
+}
+
+func (d diskBackupCapabilities) Mode() DiskBackupMode {
+	if d.supportsIncremental && d.currentCheckpointID != nil {
+		return DiskBackupModeIncremental
+	}
+	return DiskBackupModeFull
+}
+
+func (d diskBackupCapabilities) Reason() string {
+	return d.reason
+}
+
+// incrementalBackupUnsupportedReason returns why the disk can't be backed up incrementally regardless of its
+// checkpoints, or an empty string if it can.
+func incrementalBackupUnsupportedReason(capabilities EngineCapabilities, disk Disk) string {
+	switch {
+	case !capabilities.SupportsIncrementalBackup():
+		return fmt.Sprintf("engine version %s does not support incremental backups", capabilities.Version())
+	case disk.StorageType() != DiskStorageTypeImage:
+		return fmt.Sprintf("incremental backups are only possible for image disks (storage type: %s)", disk.StorageType())
+	case disk.Format() != ImageFormatCow:
+		return fmt.Sprintf("incremental backups require the %s (qcow2) format (format: %s)", ImageFormatCow, disk.Format())
+	case disk.Backup() != DiskBackupIncremental:
+		return fmt.Sprintf("incremental backup is not enabled on the disk (backup: %s)", disk.Backup())
+	default:
+		return ""
+	}
+}
+
+// newDiskBackupCapabilities determines the backup capabilities of the disk. The checkpoint lookup is only called if
+// the disk supports incremental backups.
+func newDiskBackupCapabilities(
+	capabilities EngineCapabilities,
+	disk Disk,
+	currentCheckpoint func() (*CheckpointID, error),
+) (DiskBackupCapabilities, error) {
+	result := diskBackupCapabilities{
+		diskID: disk.ID(),
+		reason: incrementalBackupUnsupportedReason(capabilities, disk),
+	}
+	if result.reason != "" {
+		return result, nil
+	}
+	result.supportsIncremental = true
+	checkpointID, err := currentCheckpoint()
+	if err != nil {
+		return nil, err
+	}
+	result.currentCheckpointID = checkpointID
+	if checkpointID == nil {
+		result.reason = "the disk is not part of a checkpoint yet, a full backup is needed to create one"
+	}
+	return result, nil
+}
+
+func (o *oVirtClient) GetDiskBackupCapabilities(
+	diskID DiskID,
+	retries ...RetryStrategy,
+) (DiskBackupCapabilities, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	capabilities, err := o.GetEngineCapabilities(retries...)
+	if err != nil {
+		return nil, err
+	}
+	disk, err := o.GetDisk(diskID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return newDiskBackupCapabilities(capabilities, disk, func() (*CheckpointID, error) {
+		return o.getCurrentDiskCheckpoint(diskID, retries)
+	})
+}
+
+// getCurrentDiskCheckpoint returns the newest valid checkpoint of the VMs the disk is attached to that includes the
+// disk. Floating disks have no checkpoints since checkpoints belong to VMs.
+func (o *oVirtClient) getCurrentDiskCheckpoint(diskID DiskID, retries []RetryStrategy) (*CheckpointID, error) {
+	vmIDs, err := o.GetDiskVMs(diskID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	var result *CheckpointID
+	var resultCreated time.Time
+	for _, vmID := range vmIDs {
+		action := fmt.Sprintf("listing checkpoints of VM %s", vmID)
+		err := retry(
+			action,
+			o.logger,
+			retries,
+			func() error {
+				response, err := o.conn.
+					SystemService().
+					VmsService().
+					VmService(string(vmID)).
+					CheckpointsService().
+					List().
+					Follow("disks").
+					Send()
+				if err != nil {
+					return wrapSDKError(action, err)
+				}
+				sdkCheckpoints, ok := response.Checkpoints()
+				if !ok {
+					return nil
+				}
+				for _, sdkCheckpoint := range sdkCheckpoints.Slice() {
+					if state, ok := sdkCheckpoint.State(); ok && state != ovirtsdk.CHECKPOINTSTATE_CREATED {
+						continue
+					}
+					if !sdkCheckpointHasDisk(sdkCheckpoint, diskID) {
+						continue
+					}
+					id, ok := sdkCheckpoint.Id()
+					if !ok {
+						return newFieldNotFound("checkpoint", "ID")
+					}
+					created, _ := sdkCheckpoint.CreationDate()
+					if result == nil || created.After(resultCreated) {
+						checkpointID := CheckpointID(id)
+						result = &checkpointID
+						resultCreated = created
+					}
+				}
+				return nil
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func sdkCheckpointHasDisk(sdkCheckpoint *ovirtsdk.Checkpoint, diskID DiskID) bool {
+	sdkDisks, ok := sdkCheckpoint.Disks()
+	if !ok {
+		return false
+	}
+	for _, sdkDisk := range sdkDisks.Slice() {
+		if id, ok := sdkDisk.Id(); ok && DiskID(id) == diskID {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *mockClient) GetDiskBackupCapabilities(
+	diskID DiskID,
+	retries ...RetryStrategy,
+) (DiskBackupCapabilities, error) {
+	capabilities, err := m.GetEngineCapabilities(retries...)
+	if err != nil {
+		return nil, err
+	}
+	disk, err := m.GetDisk(diskID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return newDiskBackupCapabilities(capabilities, disk, func() (*CheckpointID, error) {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		checkpointID, ok := m.diskCheckpoints[diskID]
+		if !ok {
+			return nil, nil
+		}
+		return &checkpointID, nil
+	})
+}
@@ -0,0 +1,85 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestGetDiskBackupCapabilities(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	var storageDomainID StorageDomainID
+	for id := range m.storageDomains {
+		storageDomainID = id
+		break
+	}
+	rawDisk, err := m.CreateDisk(storageDomainID, ImageFormatRaw, 1024*1024, nil)
+	if err != nil {
+		t.Fatalf("Failed to create raw disk (%v)", err)
+	}
+	cowDisk, err := m.CreateDisk(storageDomainID, ImageFormatCow, 1024*1024, nil)
+	if err != nil {
+		t.Fatalf("Failed to create cow disk (%v)", err)
+	}
+
+	capabilities, err := m.GetDiskBackupCapabilities(rawDisk.ID())
+	if err != nil {
+		t.Fatalf("Failed to get backup capabilities of raw disk (%v)", err)
+	}
+	if capabilities.SupportsIncremental() || capabilities.Mode() != DiskBackupModeFull || capabilities.Reason() == "" {
+		t.Fatalf("A raw disk was reported as supporting incremental backups.")
+	}
+
+	capabilities, err = m.GetDiskBackupCapabilities(cowDisk.ID())
+	if err != nil {
+		t.Fatalf("Failed to get backup capabilities of cow disk (%v)", err)
+	}
+	if capabilities.SupportsIncremental() {
+		t.Fatalf("A disk without incremental backup enabled was reported as supporting incremental backups.")
+	}
+
+	m.lock.Lock()
+	m.disks[cowDisk.ID()].backup = DiskBackupIncremental
+	m.lock.Unlock()
+	capabilities, err = m.GetDiskBackupCapabilities(cowDisk.ID())
+	if err != nil {
+		t.Fatalf("Failed to get backup capabilities of cow disk (%v)", err)
+	}
+	if !capabilities.SupportsIncremental() {
+		t.Fatalf("A cow disk with incremental backup enabled was not reported as supporting incremental backups.")
+	}
+	if capabilities.Mode() != DiskBackupModeFull || capabilities.Reason() == "" {
+		t.Fatalf("A disk without a checkpoint did not require a full backup.")
+	}
+
+	m.lock.Lock()
+	m.diskCheckpoints[cowDisk.ID()] = CheckpointID(m.GenerateUUID())
+	m.lock.Unlock()
+	capabilities, err = m.GetDiskBackupCapabilities(cowDisk.ID())
+	if err != nil {
+		t.Fatalf("Failed to get backup capabilities of cow disk (%v)", err)
+	}
+	if capabilities.Mode() != DiskBackupModeIncremental {
+		t.Fatalf("Incorrect backup mode (expected: %s, got: %s, reason: %s)",
+			DiskBackupModeIncremental, capabilities.Mode(), capabilities.Reason())
+	}
+	if capabilities.CurrentCheckpointID() == nil || capabilities.Reason() != "" {
+		t.Fatalf("The current checkpoint was not reported for an incremental backup.")
+	}
+}
+
+func TestIncrementalBackupRequiresEngineSupport(t *testing.T) {
+	t.Parallel()
+	d := &disk{
+		id:          "test",
+		format:      ImageFormatCow,
+		storageType: DiskStorageTypeImage,
+		backup:      DiskBackupIncremental,
+	}
+	if reason := incrementalBackupUnsupportedReason(newEngineCapabilities(mockEngineVersion), d); reason != "" {
+		t.Fatalf("A supported disk was rejected (%s)", reason)
+	}
+	oldEngine := newEngineCapabilities(engineVersion{4, 3, 0, 0})
+	if reason := incrementalBackupUnsupportedReason(oldEngine, d); reason == "" {
+		t.Fatalf("Incremental backups were reported as possible on an engine without support.")
+	}
+}
@@ -0,0 +1,23 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestDiskContentTypeRequiresDataStorageDomain(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+
+	isoDomain := generateTestStorageDomain()
+	isoDomain.function = StorageDomainFunctionISO
+	m.storageDomains[isoDomain.ID()] = isoDomain
+
+	if _, err := m.CreateDisk(
+		isoDomain.ID(),
+		ImageFormatRaw,
+		MinDiskSizeOVirt,
+		CreateDiskParams().MustWithContentType(DiskContentTypeISO),
+	); !HasErrorCode(err, EBadArgument) {
+		t.Fatalf("Creating an ISO disk on a legacy ISO storage domain did not result in an EBadArgument error (%v)", err)
+	}
+}
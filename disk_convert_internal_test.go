@@ -0,0 +1,36 @@
+package ovirtclient
+
+import (
+	"testing"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func TestConvertSDKLUNDisk(t *testing.T) {
+	t.Parallel()
+	sdkDisk := ovirtsdk4.NewDiskBuilder().
+		Id("test").
+		Alias("test").
+		StorageType(ovirtsdk4.DISKSTORAGETYPE_LUN).
+		LunStorage(
+			ovirtsdk4.NewHostStorageBuilder().
+				Type(ovirtsdk4.STORAGETYPE_ISCSI).
+				LogicalUnitsOfAny(ovirtsdk4.NewLogicalUnitBuilder().Id("lun").Size(1048576).MustBuild()).
+				MustBuild(),
+		).
+		MustBuild()
+
+	disk, err := convertSDKDisk(sdkDisk, nil)
+	if err != nil {
+		t.Fatalf("Failed to convert LUN disk without storage domain and image fields (%v)", err)
+	}
+	if disk.StorageType() != DiskStorageTypeLUN {
+		t.Fatalf("Incorrect storage type (expected: %s, got: %s)", DiskStorageTypeLUN, disk.StorageType())
+	}
+	if disk.ProvisionedSize() != 1048576 {
+		t.Fatalf("Incorrect provisioned size (expected: %d, got: %d)", 1048576, disk.ProvisionedSize())
+	}
+	if disk.Status() != DiskStatusOK {
+		t.Fatalf("Incorrect status (expected: %s, got: %s)", DiskStatusOK, disk.Status())
+	}
+}
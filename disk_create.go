@@ -16,9 +16,23 @@ func (o *oVirtClient) StartCreateDisk(
 ) (DiskCreation, error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
 
-	if err := validateDiskCreationParameters(format, size); err != nil {
+	if err := validateDiskCreationParameters(format, size, params); err != nil {
 		return nil, err
 	}
+	if params != nil && (params.Sparse() != nil || params.ContentType() != nil) {
+		storageDomain, err := o.GetStorageDomain(storageDomainID, retries...)
+		if err != nil {
+			return nil, err
+		}
+		if params.Sparse() != nil {
+			if err := validateDiskAllocation(storageDomain, format, *params.Sparse()); err != nil {
+				return nil, err
+			}
+		}
+		if err := validateDiskContentType(storageDomain, params); err != nil {
+			return nil, err
+		}
+	}
 
 	var result *diskWait
 	processName := "creating disk"
@@ -63,13 +77,92 @@ func (o *oVirtClient) StartCreateDisk(
 	return result, nil
 }
 
-func validateDiskCreationParameters(format ImageFormat, size uint64) error {
+func validateDiskCreationParameters(format ImageFormat, size uint64, params CreateDiskOptionalParameters) error {
 	if err := format.Validate(); err != nil {
 		return err
 	}
+	if params != nil && params.Alias() != "" {
+		if err := ValidateResourceName(params.Alias()); err != nil {
+			return wrap(err, EBadArgument, "invalid disk alias")
+		}
+	}
+	if params != nil {
+		if shareable := params.Shareable(); shareable != nil && *shareable && format != ImageFormatRaw {
+			return newError(
+				EBadArgument,
+				"shareable disks must use the %s format, %s given",
+				ImageFormatRaw,
+				format,
+			)
+		}
+		if contentType := params.ContentType(); contentType != nil {
+			if err := contentType.Validate(); err != nil {
+				return err
+			}
+			if *contentType != DiskContentTypeData && format != ImageFormatRaw {
+				return newError(
+					EBadArgument,
+					"disks with the %s content type must use the %s format, %s given",
+					*contentType,
+					ImageFormatRaw,
+					format,
+				)
+			}
+		}
+	}
 	return validateDiskSize(size)
 }
 
+// validateDiskContentType checks if a disk with the content type in params can be created on the storage domain.
+// Since oVirt 4.4 ISO images are stored as disks on data storage domains, the legacy ISO and export domains don't
+// accept new disks of any content type.
+func validateDiskContentType(storageDomain StorageDomain, params CreateDiskOptionalParameters) error {
+	if params == nil || params.ContentType() == nil {
+		return nil
+	}
+	if storageDomain.Function() != StorageDomainFunctionData {
+		return newError(
+			EBadArgument,
+			"disks with the %s content type can only be created on %s storage domains, storage domain %s is "+
+				"a %s storage domain",
+			*params.ContentType(),
+			StorageDomainFunctionData,
+			storageDomain.ID(),
+			storageDomain.Function(),
+		)
+	}
+	return nil
+}
+
+// validateDiskAllocation checks if the storage domain supports the combination of image format and allocation
+// policy. The engine supports the following combinations:
+//
+//	storage domain | raw, preallocated | raw, sparse | cow, preallocated | cow, sparse
+//	---------------+-------------------+-------------+-------------------+------------
+//	file           | yes               | yes         | yes               | yes
+//	block          | yes               | no          | yes               | yes
+//
+// Raw images on block storage domains are stored on a logical volume of the full size, so they cannot be sparse.
+// Thin provisioning on block storage requires the cow format, which the engine extends as the disk fills up.
+func validateDiskAllocation(storageDomain StorageDomain, format ImageFormat, sparse bool) error {
+	if format != ImageFormatRaw || !sparse {
+		return nil
+	}
+	for _, blockType := range BlockStorageDomainTypeValues() {
+		if storageDomain.StorageType() == blockType {
+			return newError(
+				EBadArgument,
+				"raw disks cannot be sparse on storage domain %s of type %s, use the %s format for thin "+
+					"provisioning on block storage or disable sparse provisioning",
+				storageDomain.ID(),
+				storageDomain.StorageType(),
+				ImageFormatCow,
+			)
+		}
+	}
+	return nil
+}
+
 func validateDiskSize(size uint64) error {
 	if size < MinDiskSizeOVirt {
 		return newError(EBadArgument, "Disk size must be at least %d bytes (1 MB)", MinDiskSizeOVirt)
@@ -84,7 +177,7 @@ func (o *oVirtClient) createDisk(
 	correlationID string,
 	params CreateDiskOptionalParameters,
 ) (*ovirtsdk4.DisksServiceAddResponse, error) {
-	disk, err := o.buildDiskObjectForCreation(storageDomainID, size, format, params)
+	disk, err := buildDiskObjectForCreation(storageDomainID, size, format, params)
 	if err != nil {
 		return nil, wrap(
 			err,
@@ -101,7 +194,7 @@ func (o *oVirtClient) createDisk(
 		Send()
 }
 
-func (o *oVirtClient) buildDiskObjectForCreation(
+func buildDiskObjectForCreation(
 	storageDomainID StorageDomainID,
 	size uint64,
 	format ImageFormat,
@@ -124,12 +217,18 @@ func (o *oVirtClient) buildDiskObjectForCreation(
 		if sparse := params.Sparse(); sparse != nil {
 			diskBuilder.Sparse(*sparse)
 		}
+		if shareable := params.Shareable(); shareable != nil {
+			diskBuilder.Shareable(*shareable)
+		}
 		if alias := params.Alias(); alias != "" {
 			diskBuilder.Alias(alias)
 		}
 		if initialSize := params.InitialSize(); initialSize != nil {
 			diskBuilder.InitialSize(int64(*initialSize))
 		}
+		if contentType := params.ContentType(); contentType != nil {
+			diskBuilder.ContentType(ovirtsdk4.DiskContentType(*contentType))
+		}
 	}
 	return diskBuilder.Build()
 }
@@ -0,0 +1,61 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDiskAllocationMatrix(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		storageType StorageDomainType
+		format      ImageFormat
+		sparse      bool
+		valid       bool
+	}
+	var testCases []testCase
+	for _, storageType := range FileStorageDomainTypeValues() {
+		testCases = append(
+			testCases,
+			testCase{storageType, ImageFormatRaw, false, true},
+			testCase{storageType, ImageFormatRaw, true, true},
+			testCase{storageType, ImageFormatCow, false, true},
+			testCase{storageType, ImageFormatCow, true, true},
+		)
+	}
+	for _, storageType := range BlockStorageDomainTypeValues() {
+		testCases = append(
+			testCases,
+			testCase{storageType, ImageFormatRaw, false, true},
+			testCase{storageType, ImageFormatRaw, true, false},
+			testCase{storageType, ImageFormatCow, false, true},
+			testCase{storageType, ImageFormatCow, true, true},
+		)
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("%s-%s-sparse-%t", tc.storageType, tc.format, tc.sparse), func(t *testing.T) {
+			t.Parallel()
+			m := NewMock().(*mockClient)
+			storageDomain := generateTestStorageDomain()
+			storageDomain.storageType = tc.storageType
+			m.storageDomains[storageDomain.ID()] = storageDomain
+
+			_, err := m.CreateDisk(
+				storageDomain.ID(),
+				tc.format,
+				uint64(1024*1024),
+				CreateDiskParams().MustWithSparse(tc.sparse),
+			)
+			switch {
+			case tc.valid && err != nil:
+				t.Fatalf("Failed to create disk with a valid allocation (%v)", err)
+			case !tc.valid && err == nil:
+				t.Fatalf("Creating a disk with an invalid allocation did not result in an error.")
+			case !tc.valid && !HasErrorCode(err, EBadArgument):
+				t.Fatalf("Creating a disk with an invalid allocation did not result in an EBadArgument error (%v)", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateLUNDisk(
+	params LUNDiskParameters,
+	retries ...RetryStrategy,
+) (result Disk, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+
+	if err := validateLUNDiskParameters(params); err != nil {
+		return nil, err
+	}
+	sdkDisk, err := buildLUNDiskObjectForCreation(params)
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to construct LUN disk object")
+	}
+
+	err = retry(
+		fmt.Sprintf("creating LUN disk %s", params.Alias()),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().DisksService().Add().Disk(sdkDisk).Send()
+			if err != nil {
+				return err
+			}
+			disk, ok := response.Disk()
+			if !ok {
+				return newError(EFieldMissing, "missing disk object from LUN disk add response")
+			}
+			result, err = convertSDKDisk(disk, o)
+			if err != nil {
+				return wrap(err, EUnidentified, "failed to convert SDK disk object")
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func validateLUNDiskParameters(params LUNDiskParameters) error {
+	if params == nil {
+		return newError(EBadArgument, "LUN disk parameters must be provided")
+	}
+	if err := ValidateResourceName(params.Alias()); err != nil {
+		return wrap(err, EBadArgument, "invalid disk alias")
+	}
+	if err := params.StorageType().Validate(); err != nil {
+		return err
+	}
+	if params.LUNID() == "" {
+		return newError(EBadArgument, "the LUN ID must be provided for LUN disks")
+	}
+	if params.StorageType() == LUNStorageTypeISCSI {
+		if params.Target() == "" {
+			return newError(EBadArgument, "the target must be provided for iSCSI LUN %s", params.LUNID())
+		}
+		if params.Address() == "" {
+			return newError(EBadArgument, "the portal address must be provided for iSCSI LUN %s", params.LUNID())
+		}
+		if params.Port() == 0 {
+			return newError(EBadArgument, "the portal port must be provided for iSCSI LUN %s", params.LUNID())
+		}
+	}
+	return nil
+}
+
+func buildLUNDiskObjectForCreation(params LUNDiskParameters) (*ovirtsdk4.Disk, error) {
+	logicalUnit := ovirtsdk4.NewLogicalUnitBuilder().Id(params.LUNID())
+	if params.StorageType() == LUNStorageTypeISCSI {
+		logicalUnit.
+			Target(params.Target()).
+			Address(params.Address()).
+			Port(int64(params.Port()))
+	}
+	return ovirtsdk4.NewDiskBuilder().
+		Alias(params.Alias()).
+		StorageType(ovirtsdk4.DISKSTORAGETYPE_LUN).
+		LunStorage(
+			ovirtsdk4.NewHostStorageBuilder().
+				Type(ovirtsdk4.StorageType(params.StorageType())).
+				LogicalUnitsOfAny(logicalUnit.MustBuild()).
+				MustBuild(),
+		).
+		Build()
+}
@@ -0,0 +1,33 @@
+package ovirtclient
+
+import (
+	"sync"
+)
+
+func (m *mockClient) CreateLUNDisk(
+	params LUNDiskParameters,
+	_ ...RetryStrategy,
+) (Disk, error) {
+	if err := validateLUNDiskParameters(params); err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	disk := &diskWithData{
+		disk: disk{
+			client:      m,
+			id:          DiskID(m.GenerateUUID()),
+			alias:       params.Alias(),
+			format:      ImageFormatRaw,
+			status:      DiskStatusOK,
+			storageType: DiskStorageTypeLUN,
+			contentType: DiskContentTypeData,
+			backup:      DiskBackupNone,
+		},
+		lock: &sync.Mutex{},
+	}
+	m.disks[disk.ID()] = disk
+	return disk, nil
+}
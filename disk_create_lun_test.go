@@ -0,0 +1,90 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestCreateLUNDiskMissingParameters(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	testcases := map[string]ovirtclient.LUNDiskParameters{
+		"no storage type": ovirtclient.CreateLUNDiskParams().
+			MustWithAlias(helper.GenerateTestResourceName(t)).
+			MustWithLUNID("36001405abcdef"),
+		"no LUN ID": ovirtclient.CreateLUNDiskParams().
+			MustWithAlias(helper.GenerateTestResourceName(t)).
+			MustWithStorageType(ovirtclient.LUNStorageTypeFCP),
+		"iSCSI without target": ovirtclient.CreateLUNDiskParams().
+			MustWithAlias(helper.GenerateTestResourceName(t)).
+			MustWithStorageType(ovirtclient.LUNStorageTypeISCSI).
+			MustWithLUNID("36001405abcdef").
+			MustWithAddress("192.0.2.1"),
+		"iSCSI without address": ovirtclient.CreateLUNDiskParams().
+			MustWithAlias(helper.GenerateTestResourceName(t)).
+			MustWithStorageType(ovirtclient.LUNStorageTypeISCSI).
+			MustWithLUNID("36001405abcdef").
+			MustWithTarget("iqn.2003-01.org.example:target"),
+		"no alias": ovirtclient.CreateLUNDiskParams().
+			MustWithStorageType(ovirtclient.LUNStorageTypeFCP).
+			MustWithLUNID("36001405abcdef"),
+	}
+
+	for name, params := range testcases {
+		params := params
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			_, err := helper.GetClient().CreateLUNDisk(params)
+			if err == nil {
+				t.Fatalf("Creating a LUN disk with missing parameters did not result in an error.")
+			}
+			if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+				t.Fatalf("Creating a LUN disk with missing parameters did not result in an EBadArgument error (%v)", err)
+			}
+		})
+	}
+}
+
+// TestCreateLUNDisk runs against the mock only since the live test environment has no LUNs to attach.
+func TestCreateLUNDisk(t *testing.T) {
+	t.Parallel()
+	helper, err := ovirtclient.NewMockTestHelper(ovirtclientlog.NewTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create mock test helper (%v)", err)
+	}
+	client := helper.GetClient()
+
+	alias := helper.GenerateTestResourceName(t)
+	disk, err := client.CreateLUNDisk(
+		ovirtclient.CreateLUNDiskParams().
+			MustWithAlias(alias).
+			MustWithStorageType(ovirtclient.LUNStorageTypeISCSI).
+			MustWithLUNID("36001405abcdef").
+			MustWithTarget("iqn.2003-01.org.example:target").
+			MustWithAddress("192.0.2.1"),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create LUN disk (%v)", err)
+	}
+	t.Cleanup(func() {
+		// Removing the VM also removes the attached disk.
+		if err := disk.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to remove LUN disk %s (%v)", disk.ID(), err)
+		}
+	})
+	if disk.StorageType() != ovirtclient.DiskStorageTypeLUN {
+		t.Fatalf("Incorrect storage type on LUN disk (expected: %s, got: %s)", ovirtclient.DiskStorageTypeLUN, disk.StorageType())
+	}
+	if disk.Alias() != alias {
+		t.Fatalf("Incorrect alias on LUN disk (expected: %s, got: %s)", alias, disk.Alias())
+	}
+	if len(disk.StorageDomainIDs()) != 0 {
+		t.Fatalf("LUN disk reports storage domains: %v", disk.StorageDomainIDs())
+	}
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	assertCanAttachDisk(t, vm, disk)
+}
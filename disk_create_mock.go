@@ -35,13 +35,22 @@ func (m *mockClient) createDisk(
 	size uint64,
 	params CreateDiskOptionalParameters,
 ) (*diskWithData, error) {
-	if err := validateDiskCreationParameters(format, size); err != nil {
+	if err := validateDiskCreationParameters(format, size, params); err != nil {
 		return nil, err
 	}
 
-	if _, ok := m.storageDomains[storageDomainID]; !ok {
+	storageDomain, ok := m.storageDomains[storageDomainID]
+	if !ok {
 		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
 	}
+	if params != nil && params.Sparse() != nil {
+		if err := validateDiskAllocation(storageDomain, format, *params.Sparse()); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateDiskContentType(storageDomain, params); err != nil {
+		return nil, err
+	}
 
 	disk := &diskWithData{
 		disk: disk{
@@ -52,6 +61,9 @@ func (m *mockClient) createDisk(
 			totalSize:        size,
 			storageDomainIDs: []StorageDomainID{storageDomainID},
 			status:           DiskStatusLocked,
+			storageType:      DiskStorageTypeImage,
+			contentType:      DiskContentTypeData,
+			backup:           DiskBackupNone,
 		},
 		lock: &sync.Mutex{},
 		data: nil,
@@ -64,6 +76,12 @@ func (m *mockClient) createDisk(
 		if sparse := params.Sparse(); sparse != nil {
 			disk.disk.sparse = *sparse
 		}
+		if shareable := params.Shareable(); shareable != nil {
+			disk.disk.shareable = *shareable
+		}
+		if contentType := params.ContentType(); contentType != nil {
+			disk.disk.contentType = *contentType
+		}
 	}
 
 	m.disks[disk.id] = disk
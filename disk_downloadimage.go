@@ -20,14 +20,25 @@ func (o *oVirtClient) StartImageDownload(diskID DiskID, format ImageFormat, retr
 }
 
 func (o *oVirtClient) StartDownloadDisk(diskID DiskID, format ImageFormat, retries ...RetryStrategy) (ImageDownload, error) {
-	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	retries = defaultRetries(o.withPollInterval(retries, pollTransfer), defaultLongTimeouts(o))
 
 	o.logger.Infof("Starting disk %s image download...", diskID)
 	disk, err := o.GetDisk(diskID)
 	if err != nil {
 		return nil, wrap(err, EUnidentified, "failed to fetch disk for image download")
 	}
+	return o.startDownload(disk, "", fmt.Sprintf("downloading image from disk %s", diskID), format, retries), nil
+}
 
+// startDownload creates the download and starts polling the transfer in the background. If snapshotImageID is set,
+// the image of the disk in that snapshot is downloaded.
+func (o *oVirtClient) startDownload(
+	disk Disk,
+	snapshotImageID string,
+	description string,
+	format ImageFormat,
+	retries []RetryStrategy,
+) *imageDownload {
 	realCtx, cancel := context.WithCancel(context.Background())
 
 	dl := &imageDownload{
@@ -48,9 +59,16 @@ func (o *oVirtClient) StartDownloadDisk(diskID DiskID, format ImageFormat, retri
 		logger:     o.logger,
 		retries:    retries,
 		format:     format,
+		reporter:   progressReporterFromContext(o.ctx),
+		progressLog: newTransferProgressLogger(
+			o.logger,
+			description,
+			disk.TotalSize(),
+		),
+		snapshotImageID: snapshotImageID,
 	}
 	go dl.poll()
-	return dl, nil
+	return dl
 }
 
 // Deprecated: use DownloadDisk instead.
@@ -88,18 +106,24 @@ type imageDownload struct {
 	lastError error
 	ctx       context.Context
 	cancel    context.CancelFunc
-	conn      *ovirtsdk4.Connection
+	conn      *sdkConnection
 	done      chan struct{}
 
-	reader     io.ReadCloser
-	httpClient http.Client
-	createReq  *ovirtsdk4.ImageTransfersServiceAddRequest
-	transfer   imageTransfer
-	cli        *oVirtClient
-	logger     Logger
-	retries    []RetryStrategy
-	format     ImageFormat
-	disk       Disk
+	reader      io.ReadCloser
+	httpClient  http.Client
+	createReq   *ovirtsdk4.ImageTransfersServiceAddRequest
+	transfer    imageTransfer
+	cli         *oVirtClient
+	logger      Logger
+	retries     []RetryStrategy
+	format      ImageFormat
+	disk        Disk
+	reporter    ProgressReporter
+	progressLog *transferProgressLogger
+
+	// snapshotImageID is the image ID of the disk in the snapshot being downloaded, or empty when downloading the
+	// current image of the disk.
+	snapshotImageID string
 }
 
 // poll polls the oVirt API for the status of the transfer and initializes the HTTP request to
@@ -112,6 +136,7 @@ func (i *imageDownload) poll() {
 		i.cli,
 		i.logger,
 		i.disk.ID(),
+		i.snapshotImageID,
 		"",
 		i.retries,
 		ovirtsdk4.IMAGETRANSFERDIRECTION_DOWNLOAD,
@@ -169,6 +194,10 @@ func (i *imageDownload) attemptTransferImage(transferURL string) (*http.Response
 			transferURL,
 		)
 	}
+	if err := checkRateLimitedResponse(httpResponse); err != nil {
+		_ = httpResponse.Body.Close()
+		return nil, err
+	}
 	if err := i.transfer.checkStatusCode(httpResponse.StatusCode); err != nil {
 		_ = httpResponse.Body.Close()
 		return nil, wrap(
@@ -244,6 +273,8 @@ func (i *imageDownload) Read(p []byte) (n int, err error) {
 	i.lock.Lock()
 	defer i.lock.Unlock()
 	i.bytesRead += uint64(n)
+	reportBytes(i.reporter, "downloading disk image", i.bytesRead, i.size)
+	i.progressLog.update(i.bytesRead)
 
 	if i.bytesRead == i.size {
 		go func() {
@@ -312,6 +343,7 @@ func (m *mockClient) StartDownloadDisk(diskID DiskID, format ImageFormat, _ ...R
 		lastError: nil,
 		lock:      &sync.Mutex{},
 		reader:    bytes.NewReader(disk.data),
+		reporter:  progressReporterFromContext(m.ctx),
 	}
 	go dl.prepare()
 
@@ -349,6 +381,7 @@ type mockImageDownload struct {
 	lastError error
 	lock      *sync.Mutex
 	reader    io.Reader
+	reporter  ProgressReporter
 }
 
 func (m *mockImageDownload) Err() error {
@@ -373,6 +406,7 @@ func (m *mockImageDownload) Read(p []byte) (n int, err error) {
 		m.lastError = err
 	}
 	m.bytesRead += uint64(n)
+	reportBytes(m.reporter, "downloading disk image", m.bytesRead, m.size)
 
 	if m.bytesRead == m.size {
 		go func() {
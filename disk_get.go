@@ -7,6 +7,9 @@ import (
 )
 
 func (o *oVirtClient) GetDisk(id DiskID, retries ...RetryStrategy) (result Disk, err error) {
+	if err := validateNotBlankUUID("disk", string(id)); err != nil {
+		return nil, err
+	}
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting disk %s", id),
@@ -15,7 +18,7 @@ func (o *oVirtClient) GetDisk(id DiskID, retries ...RetryStrategy) (result Disk,
 		func() error {
 			response, err := o.conn.SystemService().DisksService().DiskService(string(id)).Get().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting disk %s", id), err)
 			}
 			sdkObject, ok := response.Disk()
 			if !ok {
@@ -40,6 +43,9 @@ func (o *oVirtClient) GetDisk(id DiskID, retries ...RetryStrategy) (result Disk,
 }
 
 func (m *mockClient) GetDisk(id DiskID, _ ...RetryStrategy) (Disk, error) {
+	if err := validateNotBlankUUID("disk", string(id)); err != nil {
+		return nil, err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.disks[id]; ok {
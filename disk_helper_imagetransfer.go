@@ -42,6 +42,7 @@ func newImageTransfer(
 	cli *oVirtClient,
 	logger Logger,
 	diskID DiskID,
+	snapshotImageID string,
 	correlationID string,
 	retries []RetryStrategy,
 	direction ovirtsdk4.ImageTransferDirection,
@@ -55,6 +56,7 @@ func newImageTransfer(
 	return &imageTransferImpl{
 		retries:         retries,
 		diskID:          diskID,
+		snapshotImageID: snapshotImageID,
 		cli:             cli,
 		logger:          logger,
 		correlationID:   correlationID,
@@ -93,6 +95,9 @@ type imageTransferImpl struct {
 	retries []RetryStrategy
 	// diskID is the ID of the disk used for this transfer.
 	diskID DiskID
+	// snapshotImageID is the image ID of the disk in a snapshot. If set, the image of the snapshot is transferred
+	// instead of the current image of the disk.
+	snapshotImageID string
 	// cli is the calling client library.
 	cli *oVirtClient
 	// logger is the go-ovirt-client-log logger
@@ -100,7 +105,7 @@ type imageTransferImpl struct {
 	// correlationID is a unique ID that can be used to track jobs in the oVirt Engine.
 	correlationID string
 	// conn is the underlying SDK connection.
-	conn *ovirtsdk4.Connection
+	conn *sdkConnection
 	// httpClient is the configured HTTP client for calling the engine.
 	httpClient http.Client
 	// direction indicates the direction of transfer.
@@ -119,6 +124,8 @@ type imageTransferImpl struct {
 	transferService *ovirtsdk4.ImageTransferService
 	// transferURL is the URL that is found for the transfer. It is set after findTransferURL is called.
 	transferURL string
+	// phase is the last phase of the transfer seen by this helper, used to log phase transitions.
+	phase ovirtsdk4.ImageTransferPhase
 }
 
 // checkStatusCode takes a HTTP status code from the ImageIO endpoint and verifies it.
@@ -185,17 +192,70 @@ func (i *imageTransferImpl) finalize(err error) error {
 		i.abortTransfer()
 		return err
 	}
-	steps := []func() error{
-		i.finalizeTransfer,
+	if err := finalizeImageTransfer(
+		i.logger,
+		i.retries,
+		i.diskID,
+		i.attemptFinalizeTransfer,
+		i.finalizeAccepted,
 		i.waitForTransferFinalize,
 		i.waitForTransferOk,
+	); err != nil {
+		i.abortTransfer()
+		return err
 	}
-	for _, step := range steps {
+	return nil
+}
+
+// finalizeImageTransfer calls finalize with the retry strategies, then runs the wait steps. The engine sometimes
+// returns a transient error to a finalize request it has already accepted, and retrying the request then fails
+// because the transfer is no longer in the transferring phase. Before each repeated attempt, and after the final
+// failed one, finalizeAccepted is consulted; if the engine accepted the request the wait steps run as usual and a
+// disk reaching the OK status means the transfer succeeded despite the error.
+func finalizeImageTransfer(
+	logger Logger,
+	retries []RetryStrategy,
+	diskID DiskID,
+	finalize func() error,
+	finalizeAccepted func() (bool, error),
+	waitSteps ...func() error,
+) error {
+	attempted := false
+	finalizeErr := retry(
+		fmt.Sprintf("finalizing image for disk %s", diskID),
+		logger,
+		retries,
+		func() error {
+			if attempted {
+				if accepted, err := finalizeAccepted(); err == nil && accepted {
+					return nil
+				}
+			}
+			attempted = true
+			return finalize()
+		},
+	)
+	if finalizeErr != nil {
+		if accepted, err := finalizeAccepted(); err != nil || !accepted {
+			return finalizeErr
+		}
+	}
+	for _, step := range waitSteps {
 		if err := step(); err != nil {
-			i.abortTransfer()
+			if finalizeErr != nil {
+				return finalizeErr
+			}
 			return err
 		}
 	}
+	if finalizeErr != nil {
+		logger.Warningf(
+			"Finalizing the image transfer for disk %s returned an error, but the disk is OK, treating the transfer "+
+				"as successful. (%v)",
+			diskID,
+			finalizeErr,
+		)
+	}
 	return nil
 }
 
@@ -225,10 +285,13 @@ func (i *imageTransferImpl) buildImageTransferRequest() (
 	*ovirtsdk4.ImageTransfersService,
 ) {
 	imageTransfersService := i.conn.SystemService().ImageTransfersService()
-	image := ovirtsdk4.NewImageBuilder().Id(string(i.diskID)).MustBuild()
-	transfer := ovirtsdk4.
-		NewImageTransferBuilder().
-		Image(image).
+	transferBuilder := ovirtsdk4.NewImageTransferBuilder()
+	if i.snapshotImageID != "" {
+		transferBuilder.Snapshot(ovirtsdk4.NewDiskSnapshotBuilder().Id(i.snapshotImageID).MustBuild())
+	} else {
+		transferBuilder.Image(ovirtsdk4.NewImageBuilder().Id(string(i.diskID)).MustBuild())
+	}
+	transfer := transferBuilder.
 		Direction(i.direction).
 		Format(i.format).
 		MustBuild()
@@ -276,9 +339,27 @@ func (i *imageTransferImpl) attemptCreateImageTransfer() error {
 		)
 	}
 	i.transferService = imageTransfersService.ImageTransferService(transferID)
+	if phase, ok := i.transfer.Phase(); ok {
+		i.logPhase(phase)
+	}
 	return nil
 }
 
+// logPhase writes a debug message if the transfer has entered a different phase since the last check. The engine
+// moves transfers through initializing, transferring and finalizing, so a transfer that stays in one phase for long
+// is easy to spot in the log.
+func (i *imageTransferImpl) logPhase(phase ovirtsdk4.ImageTransferPhase) {
+	if phase == i.phase {
+		return
+	}
+	if i.phase == "" {
+		i.logger.Debugf("Image transfer for disk %s is in phase %s.", i.diskID, phase)
+	} else {
+		i.logger.Debugf("Image transfer for disk %s moved from phase %s to %s.", i.diskID, i.phase, phase)
+	}
+	i.phase = phase
+}
+
 // waitForImageTransferReady repeatedly calls checkImageTransferReady until it returns successfully or the retries are
 // exhausted.
 //
@@ -316,6 +397,7 @@ func (i *imageTransferImpl) checkImageTransferReady() error {
 			"fetching image transfer did not contain a phase",
 		)
 	}
+	i.logPhase(phase)
 	switch phase {
 	case ovirtsdk4.IMAGETRANSFERPHASE_INITIALIZING:
 		return newError(
@@ -335,18 +417,6 @@ func (i *imageTransferImpl) checkImageTransferReady() error {
 	}
 }
 
-// finalizeTransfer attempts to finalize an image transfer. It is part of the finalize function. After this function
-// finalize still waits for the disk to be OK. This function calls attemptFinalizeTransfer repeatedly until it succeeds
-// or the retries are exhausted.
-func (i *imageTransferImpl) finalizeTransfer() error {
-	return retry(
-		fmt.Sprintf("finalizing image for disk %s", i.diskID),
-		i.logger,
-		i.retries,
-		i.attemptFinalizeTransfer,
-	)
-}
-
 // attemptFinalizeTransfer calls the oVirt Engine API a single time attempting to finalize a transfer.
 func (i *imageTransferImpl) attemptFinalizeTransfer() error {
 	finalizeRequest := i.transferService.Finalize()
@@ -355,6 +425,35 @@ func (i *imageTransferImpl) attemptFinalizeTransfer() error {
 	return err
 }
 
+// finalizeAccepted fetches the transfer once and returns true if the engine is finalizing it or has finished it
+// successfully.
+func (i *imageTransferImpl) finalizeAccepted() (bool, error) {
+	var notFoundError *ovirtsdk4.NotFoundError
+	transferResponse, err := i.transferService.Get().Send()
+	if err != nil {
+		if errors.As(err, &notFoundError) {
+			// oVirt <4.4.7 removes the transfer once it is finished, see checkImageTransferPhase.
+			return true, nil
+		}
+		return false, err
+	}
+	transfer, ok := transferResponse.ImageTransfer()
+	if !ok {
+		return true, nil
+	}
+	phase, ok := transfer.Phase()
+	if !ok {
+		return false, newFieldNotFound("image transfer", "phase")
+	}
+	i.logPhase(phase)
+	switch phase {
+	case ovirtsdk4.IMAGETRANSFERPHASE_FINALIZING_SUCCESS, ovirtsdk4.IMAGETRANSFERPHASE_FINISHED_SUCCESS:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
 // waitForTransferFinalize waits for a transfer to reach a final state.
 func (i *imageTransferImpl) waitForTransferFinalize() error {
 	return retry(
@@ -415,6 +514,9 @@ func (i *imageTransferImpl) checkImageTransferPhase(
 		// Image transfer has disappeared, see comment above.
 		return nil
 	}
+	if phase, ok := transfer.Phase(); ok {
+		i.logPhase(phase)
+	}
 	if transfer.MustPhase() == waitForPhase {
 		return nil
 	}
@@ -503,6 +605,9 @@ func (i *imageTransferImpl) optionsRequest(parsedTransferURL *url.URL) error {
 	defer func() {
 		_ = res.Body.Close()
 	}()
+	if err := checkRateLimitedResponse(res); err != nil {
+		return err
+	}
 	statusCode := res.StatusCode
 	switch {
 	case statusCode < 199:
@@ -533,6 +638,8 @@ func (i *imageTransferImpl) optionsRequest(parsedTransferURL *url.URL) error {
 // abortTransfer cancels an image transfer with the oVirt Engine API. It calls the abort repeatedly until it succeeds or
 // the retries are exhausted.
 func (i *imageTransferImpl) abortTransfer() {
+	// The transfer is over at this point, so we can switch to retries that still work after a cancellation.
+	i.retries = retriesForCleanup(i.cli, i.retries)
 	if i.transfer != nil {
 		errorHappened := false
 		if err := retry(
@@ -0,0 +1,103 @@
+package ovirtclient
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFinalizeImageTransferRecoversFromFlakyFinalize(t *testing.T) {
+	t.Parallel()
+	logger := NewCollectingLogger()
+	retries := []RetryStrategy{AutoRetry(), FixedWait(time.Millisecond), MaxTries(3)}
+	finalizeCalls := 0
+	accepted := false
+	waitCalls := 0
+
+	err := finalizeImageTransfer(
+		logger,
+		retries,
+		"disk-1",
+		func() error {
+			finalizeCalls++
+			// The engine accepts the request, but the response is lost.
+			accepted = true
+			return newError(EConnection, "connection reset by peer")
+		},
+		func() (bool, error) {
+			return accepted, nil
+		},
+		func() error {
+			waitCalls++
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("Finalizing failed although the engine accepted the request (%v)", err)
+	}
+	if finalizeCalls != 1 {
+		t.Fatalf("Finalize was sent %d times instead of once.", finalizeCalls)
+	}
+	if waitCalls != 1 {
+		t.Fatalf("The wait step was called %d times instead of once.", waitCalls)
+	}
+}
+
+func TestFinalizeImageTransferAcceptsOKDiskAfterFinalizeError(t *testing.T) {
+	t.Parallel()
+	logger := NewCollectingLogger()
+	retries := []RetryStrategy{AutoRetry(), FixedWait(time.Millisecond), MaxTries(3)}
+
+	err := finalizeImageTransfer(
+		logger,
+		retries,
+		"disk-1",
+		func() error {
+			return newError(EBadArgument, "the transfer is not in the transferring phase")
+		},
+		func() (bool, error) {
+			return true, nil
+		},
+		func() error {
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("Finalizing failed although the disk became OK (%v)", err)
+	}
+	if len(logger.EntriesAtLevel(LogLevelWarning)) != 1 {
+		t.Fatalf("No warning was logged about the ignored finalize error.")
+	}
+}
+
+func TestFinalizeImageTransferFailsIfFinalizeWasNotAccepted(t *testing.T) {
+	t.Parallel()
+	retries := []RetryStrategy{AutoRetry(), FixedWait(time.Millisecond), MaxTries(3)}
+	finalizeCalls := 0
+	waitCalls := 0
+
+	err := finalizeImageTransfer(
+		NewCollectingLogger(),
+		retries,
+		"disk-1",
+		func() error {
+			finalizeCalls++
+			return newError(EConnection, "connection reset by peer")
+		},
+		func() (bool, error) {
+			return false, nil
+		},
+		func() error {
+			waitCalls++
+			return nil
+		},
+	)
+	if err == nil {
+		t.Fatalf("Finalizing succeeded although the engine never accepted the request.")
+	}
+	if finalizeCalls < 2 {
+		t.Fatalf("Finalize was not retried after a transient error.")
+	}
+	if waitCalls != 0 {
+		t.Fatalf("The wait steps ran although finalize was not accepted.")
+	}
+}
@@ -0,0 +1,31 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestIllegalDisk(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	storageDomain := generateTestStorageDomain()
+	m.storageDomains[storageDomain.ID()] = storageDomain
+	vmID := VMID("test-vm")
+	m.vms[vmID] = &vm{client: m, id: vmID, name: "test", status: VMStatusDown}
+
+	disk, err := m.CreateDisk(storageDomain.ID(), ImageFormatRaw, uint64(1024*1024), nil)
+	if err != nil {
+		t.Fatalf("Failed to create disk (%v)", err)
+	}
+	// This is where a failed snapshot operation leaves the disk.
+	m.disks[disk.ID()].status = DiskStatusIllegal
+
+	if _, err := m.WaitForDiskStatus(disk.ID(), DiskStatusOK); !HasErrorCode(err, EUnexpectedDiskStatus) {
+		t.Fatalf("Waiting for an illegal disk to become OK did not result in an EUnexpectedDiskStatus error (%v)", err)
+	}
+	if _, err := m.CreateDiskAttachment(vmID, disk.ID(), DiskInterfaceVirtIO, nil); !HasErrorCode(err, EConflict) {
+		t.Fatalf("Attaching an illegal disk did not result in an EConflict error (%v)", err)
+	}
+	if _, err := m.WaitForDiskStatus(disk.ID(), DiskStatusIllegal); err != nil {
+		t.Fatalf("Failed to wait for the illegal status on an illegal disk (%v)", err)
+	}
+}
@@ -0,0 +1,151 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// ImageProviderID is the identifier of an external image provider, such as an OpenStack Glance repository.
+type ImageProviderID string
+
+func (o *oVirtClient) ImportDiskFromExternalProvider(
+	providerID ImageProviderID,
+	imageID string,
+	storageDomainID StorageDomainID,
+	alias string,
+	retries ...RetryStrategy,
+) (Disk, error) {
+	if alias == "" {
+		return nil, newError(EBadArgument, "an alias is required for the imported disk")
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	if err := o.validateActiveStorageDomain(storageDomainID, retries); err != nil {
+		return nil, err
+	}
+	if err := o.validateImageProvider(providerID, retries); err != nil {
+		return nil, err
+	}
+	// The import action doesn't return the disk, so it is found by its alias afterwards. Disks that already have the
+	// same alias are excluded.
+	existingDisks, err := o.ListDisksByAlias(alias, retries...)
+	if err != nil {
+		return nil, err
+	}
+	existingDiskIDs := make(map[DiskID]struct{}, len(existingDisks))
+	for _, disk := range existingDisks {
+		existingDiskIDs[disk.ID()] = struct{}{}
+	}
+
+	correlationID := fmt.Sprintf("disk_import_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf("importing image %s from provider %s to storage domain %s", imageID, providerID, storageDomainID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				OpenstackImageProvidersService().
+				ProviderService(string(providerID)).
+				ImagesService().
+				ImageService(imageID).
+				Import().
+				Disk(ovirtsdk.NewDiskBuilder().Alias(alias).MustBuild()).
+				StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(string(storageDomainID)).MustBuild()).
+				ImportAsTemplate(false).
+				Query("correlation_id", correlationID).
+				Send()
+			return wrapSDKError(action, err)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.waitForJobFinished(correlationID, retries); err != nil {
+		return nil, err
+	}
+
+	disks, err := o.ListDisksByAlias(alias, retries...)
+	if err != nil {
+		return nil, err
+	}
+	for _, disk := range disks {
+		if _, ok := existingDiskIDs[disk.ID()]; ok {
+			continue
+		}
+		for _, diskStorageDomainID := range disk.StorageDomainIDs() {
+			if diskStorageDomainID == storageDomainID {
+				return o.WaitForDiskOK(disk.ID(), retries...)
+			}
+		}
+	}
+	return nil, newError(
+		ENotFound,
+		"the import of image %s finished, but no disk with alias %s was found on storage domain %s",
+		imageID,
+		alias,
+		storageDomainID,
+	)
+}
+
+func (o *oVirtClient) validateImageProvider(providerID ImageProviderID, retries []RetryStrategy) error {
+	action := fmt.Sprintf("getting image provider %s", providerID)
+	return retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				OpenstackImageProvidersService().
+				ProviderService(string(providerID)).
+				Get().
+				Send()
+			return wrapSDKError(action, err)
+		},
+	)
+}
+
+// mockImageProvider is an external image provider in the mock. The images are keyed by their ID on the provider.
+type mockImageProvider struct {
+	id     ImageProviderID
+	images map[string]*mockExternalImage
+}
+
+type mockExternalImage struct {
+	format ImageFormat
+	size   uint64
+}
+
+func (m *mockClient) ImportDiskFromExternalProvider(
+	providerID ImageProviderID,
+	imageID string,
+	storageDomainID StorageDomainID,
+	alias string,
+	_ ...RetryStrategy,
+) (Disk, error) {
+	if alias == "" {
+		return nil, newError(EBadArgument, "an alias is required for the imported disk")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if err := m.validateActiveStorageDomain(storageDomainID); err != nil {
+		return nil, err
+	}
+	provider, ok := m.imageProviders[providerID]
+	if !ok {
+		return nil, newError(ENotFound, "image provider with ID %s not found", providerID)
+	}
+	image, ok := provider.images[imageID]
+	if !ok {
+		return nil, newError(ENotFound, "image with ID %s not found on provider %s", imageID, providerID)
+	}
+	disk, err := m.createDisk(storageDomainID, image.format, image.size, CreateDiskParams().MustWithAlias(alias))
+	if err != nil {
+		return nil, err
+	}
+	disk.status = DiskStatusOK
+	return disk, nil
+}
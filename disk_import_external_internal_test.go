@@ -0,0 +1,60 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestImportDiskFromExternalProvider(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	provider := &mockImageProvider{
+		id: ImageProviderID(m.GenerateUUID()),
+		images: map[string]*mockExternalImage{
+			"fedora": {format: ImageFormatCow, size: 1024 * 1024},
+		},
+	}
+	m.imageProviders[provider.id] = provider
+	var storageDomainID StorageDomainID
+	for id, storageDomain := range m.storageDomains {
+		if storageDomain.Status() == StorageDomainStatusActive {
+			storageDomainID = id
+			break
+		}
+	}
+
+	disk, err := m.ImportDiskFromExternalProvider(provider.id, "fedora", storageDomainID, "imported-fedora")
+	if err != nil {
+		t.Fatalf("Failed to import disk from external provider (%v)", err)
+	}
+	if disk.Alias() != "imported-fedora" {
+		t.Fatalf("Incorrect alias on imported disk (expected: %s, got: %s)", "imported-fedora", disk.Alias())
+	}
+	if disk.Status() != DiskStatusOK {
+		t.Fatalf("Imported disk is not in status %s (got: %s)", DiskStatusOK, disk.Status())
+	}
+
+	if _, err := m.ImportDiskFromExternalProvider(
+		provider.id,
+		"nonexistent",
+		storageDomainID,
+		"imported",
+	); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Importing a nonexistent image did not result in an ENotFound error (%v)", err)
+	}
+	if _, err := m.ImportDiskFromExternalProvider(
+		ImageProviderID(m.GenerateUUID()),
+		"fedora",
+		storageDomainID,
+		"imported",
+	); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Importing from a nonexistent provider did not result in an ENotFound error (%v)", err)
+	}
+	if _, err := m.ImportDiskFromExternalProvider(
+		provider.id,
+		"fedora",
+		StorageDomainID(m.GenerateUUID()),
+		"imported",
+	); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Importing to a nonexistent storage domain did not result in an ENotFound error (%v)", err)
+	}
+}
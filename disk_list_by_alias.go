@@ -7,13 +7,16 @@ import (
 func (o *oVirtClient) ListDisksByAlias(alias string, retries ...RetryStrategy) (result []Disk, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	result = []Disk{}
+	query, err := SearchField("name").Eq(alias).Build()
+	if err != nil {
+		return nil, err
+	}
 	err = retry(
 		fmt.Sprintf("listing disk by alias %s", alias),
 		o.logger,
 		retries,
 		func() error {
-			searchString := fmt.Sprintf("name=%s", alias)
-			response, e := o.conn.SystemService().DisksService().List().Search(searchString).Send()
+			response, e := o.conn.SystemService().DisksService().List().Search(query).Send()
 			if e != nil {
 				return e
 			}
@@ -34,6 +37,9 @@ func (o *oVirtClient) ListDisksByAlias(alias string, retries ...RetryStrategy) (
 }
 
 func (m *mockClient) ListDisksByAlias(alias string, _ ...RetryStrategy) ([]Disk, error) {
+	if _, err := SearchField("name").Eq(alias).Build(); err != nil {
+		return nil, err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	result := make([]Disk, 0)
@@ -42,6 +42,12 @@ func (d *diskWithData) WithAlias(alias *string) *diskWithData {
 			status:           d.status,
 			totalSize:        d.totalSize,
 			sparse:           d.sparse,
+			storageType:      d.storageType,
+			shareable:        d.shareable,
+			description:      d.description,
+			wipeAfterDelete:  d.wipeAfterDelete,
+			contentType:      d.contentType,
+			backup:           d.backup,
 		},
 		d.lock,
 		d.data,
@@ -66,6 +72,12 @@ func (d *diskWithData) withProvisionedSize(ps uint64) (*diskWithData, error) {
 			status:           d.status,
 			totalSize:        ps,
 			sparse:           d.sparse,
+			storageType:      d.storageType,
+			shareable:        d.shareable,
+			description:      d.description,
+			wipeAfterDelete:  d.wipeAfterDelete,
+			contentType:      d.contentType,
+			backup:           d.backup,
 		},
 		d.lock,
 		d.data,
@@ -73,6 +85,21 @@ func (d *diskWithData) withProvisionedSize(ps uint64) (*diskWithData, error) {
 }
 
 // clone is an internal function that makes a copy of the disk object with a new UUID.
+// withUpdatedMetadata returns a copy of the disk with the description and flags from the update parameters applied.
+func (d *diskWithData) withUpdatedMetadata(params UpdateDiskParameters) *diskWithData {
+	result := &diskWithData{d.disk, d.lock, d.data}
+	if description := params.Description(); description != nil {
+		result.description = *description
+	}
+	if shareable := params.Shareable(); shareable != nil {
+		result.shareable = *shareable
+	}
+	if wipeAfterDelete := params.WipeAfterDelete(); wipeAfterDelete != nil {
+		result.wipeAfterDelete = *wipeAfterDelete
+	}
+	return result
+}
+
 func (d *diskWithData) clone(sparse *bool) *diskWithData {
 	if sparse == nil {
 		sparse = &d.sparse
@@ -88,6 +115,12 @@ func (d *diskWithData) clone(sparse *bool) *diskWithData {
 			d.status,
 			d.totalSize,
 			*sparse,
+			d.storageType,
+			d.shareable,
+			d.description,
+			d.wipeAfterDelete,
+			d.contentType,
+			d.backup,
 		},
 		&sync.Mutex{},
 		d.data,
@@ -95,6 +95,51 @@ func TestDiskCreationAndUpdate(t *testing.T) {
 	checkDiskAfterCreation(updatedDisk, t, "changed_disk_name")
 }
 
+func TestDiskCreationWithContentType(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	disk, err := client.CreateDisk(
+		helper.GetStorageDomainID(),
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.MinDiskSizeOVirt,
+		ovirtclient.CreateDiskParams().
+			MustWithAlias(fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5))).
+			MustWithContentType(ovirtclient.DiskContentTypeISO),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create ISO disk (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := disk.Remove(); err != nil {
+			t.Fatalf("Failed to remove ISO disk after test (%v)", err)
+		}
+	})
+	if disk.ContentType() != ovirtclient.DiskContentTypeISO {
+		t.Fatalf(
+			"Incorrect content type on disk (expected: %s, got: %s)",
+			ovirtclient.DiskContentTypeISO,
+			disk.ContentType(),
+		)
+	}
+
+	if _, err := client.CreateDisk(
+		helper.GetStorageDomainID(),
+		ovirtclient.ImageFormatCow,
+		ovirtclient.MinDiskSizeOVirt,
+		ovirtclient.CreateDiskParams().MustWithContentType(ovirtclient.DiskContentTypeISO),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a cow ISO disk did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := ovirtclient.CreateDiskParams().WithContentType("floppy"); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting an invalid content type did not result in an EBadArgument error (%v)", err)
+	}
+}
+
 func checkDiskAfterCreation(disk ovirtclient.Disk, t *testing.T, name string) {
 	if disk.ProvisionedSize() < 512 {
 		t.Fatalf("Incorrect provisioned disk size after creation: %d", disk.ProvisionedSize())
@@ -0,0 +1,71 @@
+package ovirtclient
+
+import (
+	"time"
+)
+
+// transferLogInterval is the minimum time between two progress log messages of an image transfer.
+const transferLogInterval = 10 * time.Second
+
+// transferProgressLogger writes the number of transferred bytes and the estimated remaining time of an image transfer
+// to the debug log. Messages are rate limited to one per transferLogInterval, except for the final one, so large
+// transfers don't flood the log.
+type transferProgressLogger struct {
+	logger     Logger
+	action     string
+	totalBytes uint64
+	now        func() time.Time
+	start      time.Time
+	lastLog    time.Time
+}
+
+func newTransferProgressLogger(logger Logger, action string, totalBytes uint64) *transferProgressLogger {
+	return &transferProgressLogger{
+		logger:     logger,
+		action:     action,
+		totalBytes: totalBytes,
+		now:        time.Now,
+	}
+}
+
+// update records the number of bytes transferred so far and logs the progress if the interval has passed or the
+// transfer is complete.
+func (t *transferProgressLogger) update(transferredBytes uint64) {
+	now := t.now()
+	if t.start.IsZero() {
+		t.start = now
+		t.lastLog = now
+	}
+	complete := t.totalBytes > 0 && transferredBytes >= t.totalBytes
+	if !complete && now.Sub(t.lastLog) < transferLogInterval {
+		return
+	}
+	t.lastLog = now
+	elapsed := now.Sub(t.start)
+	if complete {
+		t.logger.Debugf(
+			"Completed %s, transferred %d bytes in %s.",
+			t.action,
+			transferredBytes,
+			elapsed.Round(time.Second),
+		)
+		return
+	}
+	t.logger.Debugf(
+		"Still %s, transferred %d of %d bytes (%.1f%%), estimated time remaining: %s.",
+		t.action,
+		transferredBytes,
+		t.totalBytes,
+		100*float64(transferredBytes)/float64(t.totalBytes),
+		estimateRemainingTime(elapsed, transferredBytes, t.totalBytes),
+	)
+}
+
+// estimateRemainingTime extrapolates the remaining time from the average transfer rate so far.
+func estimateRemainingTime(elapsed time.Duration, transferredBytes uint64, totalBytes uint64) string {
+	if transferredBytes == 0 || totalBytes <= transferredBytes {
+		return "unknown"
+	}
+	remaining := time.Duration(float64(elapsed) * float64(totalBytes-transferredBytes) / float64(transferredBytes))
+	return remaining.Round(time.Second).String()
+}
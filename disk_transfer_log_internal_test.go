@@ -0,0 +1,61 @@
+package ovirtclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+)
+
+func TestTransferProgressLogger(t *testing.T) {
+	logger := &recordingLogger{}
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	progressLog := newTransferProgressLogger(logger, "uploading image", 1000)
+	progressLog.now = func() time.Time {
+		return now
+	}
+
+	progressLog.update(0)
+	now = now.Add(time.Second)
+	progressLog.update(100)
+	if len(logger.debug) != 0 {
+		t.Fatalf("Progress logged before the log interval passed: %v", logger.debug)
+	}
+
+	now = now.Add(transferLogInterval)
+	progressLog.update(250)
+	if len(logger.debug) != 1 {
+		t.Fatalf("Incorrect number of progress messages after the log interval (expected: 1, got: %d)", len(logger.debug))
+	}
+	// 250 bytes in 11 seconds leaves 750 bytes for another 33 seconds.
+	if !strings.Contains(logger.debug[0], "250 of 1000 bytes") || !strings.Contains(logger.debug[0], "33s") {
+		t.Fatalf("Unexpected progress message: %s", logger.debug[0])
+	}
+
+	progressLog.update(1000)
+	if len(logger.debug) != 2 || !strings.HasPrefix(logger.debug[1], "Completed uploading image") {
+		t.Fatalf("The transfer completion was not logged immediately: %v", logger.debug)
+	}
+}
+
+// recordingLogger keeps the debug messages for inspection and discards everything else.
+type recordingLogger struct {
+	debug []string
+}
+
+func (r *recordingLogger) WithContext(_ context.Context) ovirtclientlog.Logger {
+	return r
+}
+
+func (r *recordingLogger) Debugf(format string, args ...interface{}) {
+	r.debug = append(r.debug, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingLogger) Infof(_ string, _ ...interface{}) {}
+
+func (r *recordingLogger) Warningf(_ string, _ ...interface{}) {}
+
+func (r *recordingLogger) Errorf(_ string, _ ...interface{}) {}
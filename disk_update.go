@@ -25,6 +25,20 @@ func (o *oVirtClient) StartUpdateDisk(id DiskID, params UpdateDiskParameters, re
 ) {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
 
+	if params.Shareable() != nil {
+		currentDisk, err := o.GetDisk(id, retries...)
+		if err != nil {
+			return nil, err
+		}
+		vmIDs, err := o.GetDiskVMs(id, retries...)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateDiskUpdate(currentDisk, vmIDs, params); err != nil {
+			return nil, err
+		}
+	}
+
 	sdkDisk := ovirtsdk.NewDiskBuilder().Id(string(id))
 	if alias := params.Alias(); alias != nil {
 		sdkDisk.Alias(*alias)
@@ -32,6 +46,15 @@ func (o *oVirtClient) StartUpdateDisk(id DiskID, params UpdateDiskParameters, re
 	if provisionedSize := params.ProvisionedSize(); provisionedSize != nil {
 		sdkDisk.ProvisionedSize(int64(*provisionedSize))
 	}
+	if description := params.Description(); description != nil {
+		sdkDisk.Description(*description)
+	}
+	if shareable := params.Shareable(); shareable != nil {
+		sdkDisk.Shareable(*shareable)
+	}
+	if wipeAfterDelete := params.WipeAfterDelete(); wipeAfterDelete != nil {
+		sdkDisk.WipeAfterDelete(*wipeAfterDelete)
+	}
 	correlationID := fmt.Sprintf("disk_update_%s", generateRandomID(5, o.nonSecureRandom))
 
 	var disk Disk
@@ -77,10 +100,37 @@ func (o *oVirtClient) StartUpdateDisk(id DiskID, params UpdateDiskParameters, re
 	}, nil
 }
 
+// validateDiskUpdate checks the update parameters against the current state of the disk. The engine refuses to
+// change the shareable flag while the disk is attached to a VM, and only raw disks can be shared.
+func validateDiskUpdate(disk Disk, vmIDs []VMID, params UpdateDiskParameters) error {
+	shareable := params.Shareable()
+	if shareable == nil || *shareable == disk.Shareable() {
+		return nil
+	}
+	if *shareable && disk.Format() != ImageFormatRaw {
+		return newError(
+			EBadArgument,
+			"shareable disks must use the %s format, disk %s uses %s",
+			ImageFormatRaw,
+			disk.ID(),
+			disk.Format(),
+		)
+	}
+	if len(vmIDs) != 0 {
+		return newError(
+			EConflict,
+			"cannot change the shareable flag of disk %s while it is attached to VMs %v, detach it first",
+			disk.ID(),
+			vmIDs,
+		)
+	}
+	return nil
+}
+
 func (m *mockClient) UpdateDisk(id DiskID, params UpdateDiskParameters, retries ...RetryStrategy) (Disk, error) {
 	progress, err := m.StartUpdateDisk(id, params, retries...)
 	if err != nil {
-		return progress.Disk(), err
+		return nil, err
 	}
 	return progress.Wait(retries...)
 }
@@ -97,6 +147,13 @@ func (m *mockClient) StartUpdateDisk(id DiskID, params UpdateDiskParameters, _ .
 	if !ok {
 		return nil, newError(ENotFound, "disk with ID %s not found", id)
 	}
+	vmIDs := []VMID{}
+	for _, attachment := range m.vmDiskAttachmentsByDisk[id] {
+		vmIDs = append(vmIDs, attachment.vmid)
+	}
+	if err := validateDiskUpdate(disk, vmIDs, params); err != nil {
+		return nil, err
+	}
 	if err := disk.Lock(); err != nil {
 		return nil, err
 	}
@@ -109,6 +166,7 @@ func (m *mockClient) StartUpdateDisk(id DiskID, params UpdateDiskParameters, _ .
 			return nil, err
 		}
 	}
+	disk = disk.withUpdatedMetadata(params)
 	update := &mockDiskUpdate{
 		client: m,
 		disk:   disk,
@@ -34,3 +34,48 @@ func TestExtendDisk(t *testing.T) {
 	}
 	t.Logf("New disk size is OK.")
 }
+
+func TestUpdateDiskMetadata(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	disk := assertCanCreateDisk(t, helper)
+
+	alias := helper.GenerateTestResourceName(t)
+	updatedDisk, err := disk.Update(
+		ovirtclient.UpdateDiskParams().
+			MustWithAlias(alias).
+			MustWithDescription("Updated description").
+			MustWithShareable(true).
+			MustWithWipeAfterDelete(true),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update disk %s (%v)", disk.ID(), err)
+	}
+	if updatedDisk.Alias() != alias {
+		t.Fatalf("Incorrect alias after update (expected: %s, got: %s)", alias, updatedDisk.Alias())
+	}
+	if updatedDisk.Description() != "Updated description" {
+		t.Fatalf("Incorrect description after update (got: %s)", updatedDisk.Description())
+	}
+	if !updatedDisk.Shareable() {
+		t.Fatalf("Disk is not shareable after update.")
+	}
+	if !updatedDisk.WipeAfterDelete() {
+		t.Fatalf("Wipe after delete is not set after update.")
+	}
+}
+
+func TestUpdateDiskShareableWhileAttached(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+
+	_, err := disk.Update(ovirtclient.UpdateDiskParams().MustWithShareable(true))
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Changing the shareable flag of an attached disk did not result in an EConflict error (%v)", err)
+	}
+}
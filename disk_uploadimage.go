@@ -42,7 +42,7 @@ func (o *oVirtClient) UploadToNewDisk(
 	reader io.ReadSeekCloser,
 	retries ...RetryStrategy,
 ) (UploadImageResult, error) {
-	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	retries = defaultRetries(o.withPollInterval(retries, pollTransfer), defaultLongTimeouts(o))
 	progress, err := o.StartUploadToNewDisk(storageDomainID, format, size, params, reader, retries...)
 	if err != nil {
 		return nil, err
@@ -80,7 +80,7 @@ func (o *oVirtClient) UploadToDisk(
 	reader io.ReadSeekCloser,
 	retries ...RetryStrategy,
 ) error {
-	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	retries = defaultRetries(o.withPollInterval(retries, pollTransfer), defaultLongTimeouts(o))
 	progress, err := o.StartUploadToDisk(diskID, size, reader, retries...)
 	if err != nil {
 		return err
@@ -95,7 +95,7 @@ func (o *oVirtClient) StartUploadToDisk(
 	reader io.ReadSeekCloser,
 	retries ...RetryStrategy,
 ) (UploadImageProgress, error) {
-	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	retries = defaultRetries(o.withPollInterval(retries, pollTransfer), defaultWriteTimeouts(o))
 	o.logger.Infof("Starting disk image upload...")
 	disk, err := o.GetDisk(diskID, retries...)
 	if err != nil {
@@ -116,7 +116,7 @@ func (o *oVirtClient) StartUploadToDisk(
 			disk.ProvisionedSize(),
 		)
 	}
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := newUploadContext(o)
 	progress := &uploadToDiskProgress{
 		client:        o,
 		lock:          &sync.Mutex{},
@@ -130,11 +130,23 @@ func (o *oVirtClient) StartUploadToDisk(
 		qcowSize:      qcowSize,
 		reader:        reader,
 		retries:       retries,
+		reporter:      progressReporterFromContext(o.ctx),
+		progressLog:   newTransferProgressLogger(o.logger, fmt.Sprintf("uploading image to disk %s", diskID), size),
 	}
 	go progress.Do()
 	return progress, nil
 }
 
+// newUploadContext creates the context for the HTTP transfer of an upload. It is cancelled along with the context of
+// the client, which aborts the upload even in the middle of the transfer.
+func newUploadContext(o *oVirtClient) (context.Context, context.CancelFunc) {
+	parent := o.ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	return context.WithCancel(parent)
+}
+
 type uploadToDiskProgress struct {
 	client           *oVirtClient
 	lock             *sync.Mutex
@@ -150,6 +162,8 @@ type uploadToDiskProgress struct {
 	err              error
 	format           ImageFormat
 	qcowSize         uint64
+	reporter         ProgressReporter
+	progressLog      *transferProgressLogger
 }
 
 func (u *uploadToDiskProgress) Close() error {
@@ -180,6 +194,7 @@ func (u *uploadToDiskProgress) transfer() error {
 		u.client,
 		u.client.logger,
 		u.disk.ID(),
+		"",
 		u.correlationID,
 		u.retries,
 		ovirtsdk4.IMAGETRANSFERDIRECTION_UPLOAD,
@@ -228,7 +243,7 @@ func (u *uploadToDiskProgress) putRequest(transferURL string, transfer imageTran
 	u.transferredBytes = 0
 	u.lock.Unlock()
 
-	putRequest, err := http.NewRequest(http.MethodPut, transferURL, u)
+	putRequest, err := http.NewRequestWithContext(u.ctx, http.MethodPut, transferURL, u)
 	if err != nil {
 		return wrap(err, EUnidentified, "failed to create HTTP request")
 	}
@@ -243,6 +258,10 @@ func (u *uploadToDiskProgress) putRequest(transferURL string, transfer imageTran
 			"failed to upload image",
 		)
 	}
+	if err := checkRateLimitedResponse(response); err != nil {
+		_ = response.Body.Close()
+		return err
+	}
 	if err := transfer.checkStatusCode(response.StatusCode); err != nil {
 		_ = response.Body.Close()
 		return err
@@ -291,6 +310,8 @@ func (u *uploadToDiskProgress) Read(p []byte) (n int, err error) {
 	}
 	n, err = u.reader.Read(p)
 	u.transferredBytes += uint64(n)
+	reportBytes(u.reporter, "uploading disk image", u.transferredBytes, u.totalBytes)
+	u.progressLog.update(u.transferredBytes)
 	return
 }
 
@@ -302,7 +323,7 @@ func (o *oVirtClient) StartUploadToNewDisk(
 	reader io.ReadSeekCloser,
 	retries ...RetryStrategy,
 ) (UploadImageProgress, error) {
-	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	retries = defaultRetries(o.withPollInterval(retries, pollTransfer), defaultLongTimeouts(o))
 
 	o.logger.Infof("Starting disk image upload...")
 
@@ -317,7 +338,7 @@ func (o *oVirtClient) StartUploadToNewDisk(
 		return nil, err
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := newUploadContext(o)
 
 	diskCreateParams := CreateDiskParams().
 		MustWithAlias(params.Alias()).
@@ -325,6 +346,11 @@ func (o *oVirtClient) StartUploadToNewDisk(
 	if params.Sparse() != nil {
 		diskCreateParams.MustWithSparse(*params.Sparse())
 	}
+	if params.ContentType() != nil {
+		if _, err := diskCreateParams.WithContentType(*params.ContentType()); err != nil {
+			return nil, err
+		}
+	}
 
 	progress := &uploadToNewDiskProgress{
 		uploadToDiskProgress: uploadToDiskProgress{
@@ -340,6 +366,8 @@ func (o *oVirtClient) StartUploadToNewDisk(
 			qcowSize:      qcowSize,
 			reader:        reader,
 			retries:       retries,
+			reporter:      progressReporterFromContext(o.ctx),
+			progressLog:   newTransferProgressLogger(o.logger, "uploading image to new disk", size),
 		},
 
 		storageDomainID: storageDomainID,
@@ -393,7 +421,7 @@ func (u *uploadToNewDiskProgress) Do() {
 
 	if err != nil {
 		u.client.logger.Infof("Image upload to new disk failed, removing created disk (%v)", err)
-		if err := disk.Remove(u.retries...); err != nil && !HasErrorCode(err, ENotFound) {
+		if err := disk.Remove(retriesForCleanup(u.client, u.retries)...); err != nil && !HasErrorCode(err, ENotFound) {
 			u.client.logger.Warningf(
 				"Failed to remove newly created disk %s after failed image upload, please remove manually. (%v)",
 				disk.ID(),
@@ -476,12 +504,14 @@ func (m *mockClient) StartUploadToDisk(
 	}
 
 	progress := &mockImageUploadProgress{
-		err:    nil,
-		disk:   disk,
-		client: m,
-		reader: reader,
-		size:   size,
-		done:   make(chan struct{}),
+		err:      nil,
+		disk:     disk,
+		client:   m,
+		reader:   reader,
+		size:     size,
+		done:     make(chan struct{}),
+		reporter: progressReporterFromContext(m.ctx),
+		ctx:      m.ctx,
 	}
 
 	// Lock the disk to simulate the upload being initialized.
@@ -545,12 +575,15 @@ func (m *mockClient) StartUploadToNewDisk(
 	disk.Unlock()
 
 	progress := &mockImageUploadProgress{
-		err:    nil,
-		disk:   disk,
-		client: m,
-		reader: reader,
-		size:   size,
-		done:   make(chan struct{}),
+		err:             nil,
+		disk:            disk,
+		client:          m,
+		reader:          reader,
+		size:            size,
+		done:            make(chan struct{}),
+		reporter:        progressReporterFromContext(m.ctx),
+		ctx:             m.ctx,
+		removeOnFailure: true,
 	}
 
 	// Lock the disk to simulate the upload being initialized.
@@ -590,6 +623,11 @@ type mockImageUploadProgress struct {
 	size          uint64
 	uploadedBytes uint64
 	done          chan struct{}
+	reporter      ProgressReporter
+	// ctx is the context of the client. If it is cancelled, the upload fails.
+	ctx context.Context
+	// removeOnFailure indicates that the disk was created for the upload and should be removed if it fails.
+	removeOnFailure bool
 }
 
 func (m *mockImageUploadProgress) Disk() Disk {
@@ -619,6 +657,11 @@ func (m *mockImageUploadProgress) Done() <-chan struct{} {
 func (m *mockImageUploadProgress) do() {
 	defer func() {
 		m.disk.Unlock()
+		if m.err != nil && m.removeOnFailure {
+			m.client.lock.Lock()
+			delete(m.client.disks, m.disk.id)
+			m.client.lock.Unlock()
+		}
 		close(m.done)
 	}()
 
@@ -627,9 +670,32 @@ func (m *mockImageUploadProgress) do() {
 		m.err = fmt.Errorf("failed to seek to start of image file (%w)", err)
 		return
 	}
-	m.disk.data, err = io.ReadAll(m.reader)
-	m.err = err
+	data, err := m.read()
 	if err != nil {
-		m.uploadedBytes = m.size
+		m.err = err
+		return
+	}
+	m.disk.data = data
+	m.uploadedBytes = m.size
+	reportBytes(m.reporter, "uploading disk image", m.size, m.size)
+}
+
+// read reads the image in chunks and checks the context between them, so cancelling the context interrupts the
+// upload like it would with the engine.
+func (m *mockImageUploadProgress) read() ([]byte, error) {
+	var data []byte
+	buf := make([]byte, 64*1024)
+	for {
+		if m.ctx != nil && m.ctx.Err() != nil {
+			return nil, wrap(m.ctx.Err(), ETimeout, "image upload to disk %s cancelled", m.disk.id)
+		}
+		n, err := m.reader.Read(buf)
+		data = append(data, buf[:n]...)
+		if err == io.EOF {
+			return data, nil
+		}
+		if err != nil {
+			return nil, err
+		}
 	}
 }
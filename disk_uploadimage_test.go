@@ -1,9 +1,12 @@
 package ovirtclient_test
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
 	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
 )
 
@@ -97,3 +100,54 @@ func TestImageUploadToExistingDisk(t *testing.T) {
 
 	assertCanUploadDiskImage(t, helper, disk)
 }
+
+// cancellingReader cancels the context on the first read to simulate a cancellation in the middle of an upload.
+type cancellingReader struct {
+	*bytes.Reader
+	cancel context.CancelFunc
+}
+
+func (c *cancellingReader) Read(p []byte) (int, error) {
+	c.cancel()
+	return c.Reader.Read(p)
+}
+
+func (c *cancellingReader) Close() error {
+	return nil
+}
+
+// TestImageUploadCancelledRemovesDisk runs against the mock only since the cancellation point can't be controlled
+// on a live engine.
+func TestImageUploadCancelledRemovesDisk(t *testing.T) {
+	t.Parallel()
+	helper, err := ovirtclient.NewMockTestHelper(ovirtclientlog.NewTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create mock test helper (%v)", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := helper.GetClient().WithContext(ctx)
+
+	imageName := helper.GenerateTestResourceName(t)
+	size := uint64(1024 * 1024)
+	_, err = client.UploadToNewDisk(
+		helper.GetStorageDomainID(),
+		ovirtclient.ImageFormatRaw,
+		size,
+		ovirtclient.CreateDiskParams().MustWithSparse(true).MustWithAlias(imageName),
+		&cancellingReader{bytes.NewReader(make([]byte, size)), cancel},
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ETimeout) {
+		t.Fatalf("Cancelling an upload did not result in an ETimeout error (%v)", err)
+	}
+
+	disks, err := helper.GetClient().ListDisks()
+	if err != nil {
+		t.Fatalf("Failed to list disks (%v)", err)
+	}
+	for _, disk := range disks {
+		if disk.Alias() == imageName {
+			t.Fatalf("The disk %s created for the cancelled upload was not removed.", disk.ID())
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+)
+
+func (o *oVirtClient) WaitForDiskOK(diskID DiskID, retries ...RetryStrategy) (Disk, error) {
+	return o.WaitForDiskStatus(diskID, DiskStatusOK, retries...)
+}
+
+func (o *oVirtClient) WaitForDiskStatus(
+	diskID DiskID,
+	status DiskStatus,
+	retries ...RetryStrategy,
+) (disk Disk, err error) {
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(o.withPollInterval(retries, pollDiskStatus), defaultLongTimeouts(o))
+	action := fmt.Sprintf("waiting for disk %s status %s", diskID, status)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			disk, err = o.GetDisk(diskID)
+			if err != nil {
+				return err
+			}
+			reportStatus(o.ctx, action, string(disk.Status()))
+			return checkDiskStatus(disk, status)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return disk, nil
+}
+
+// checkDiskStatus returns an EPending error if the disk is not yet in the desired status. An illegal disk doesn't
+// recover on its own, so waiting for any other status results in an EUnexpectedDiskStatus error instead.
+func checkDiskStatus(disk Disk, status DiskStatus) error {
+	switch {
+	case disk.Status() == status:
+		return nil
+	case disk.Status() == DiskStatusIllegal:
+		return newError(
+			EUnexpectedDiskStatus,
+			"disk status is %s, not %s, %s",
+			disk.Status(),
+			status,
+			diskIllegalHint,
+		)
+	default:
+		return newError(EPending, "disk status is %s, not %s", disk.Status(), status)
+	}
+}
+
+// WaitForDiskOK waits for a disk to be in the OK status, then additionally queries the job that was in progress with
+// the correlation ID. This is necessary because the disk returns OK status before the job has actually finished,
+// resulting in a "disk locked" error on subsequent operations.
+func (m *mockClient) WaitForDiskOK(diskID DiskID, _ ...RetryStrategy) (Disk, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	disk, ok := m.disks[diskID]
+
+	if !ok {
+		return nil, newError(ENotFound, "Disk with ID %s not found", diskID)
+	}
+	if disk.status == DiskStatusIllegal {
+		return nil, checkDiskStatus(disk, DiskStatusOK)
+	}
+	time.Sleep(2 * time.Second)
+	disk.status = DiskStatusOK
+	reportStatus(m.ctx, fmt.Sprintf("waiting for disk %s status %s", diskID, DiskStatusOK), string(disk.status))
+
+	return disk, nil
+}
+
+func (m *mockClient) WaitForDiskStatus(
+	diskID DiskID,
+	status DiskStatus,
+	retries ...RetryStrategy,
+) (disk Disk, err error) {
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+	// The mock has no background jobs, locked disks only become OK when they are waited for.
+	if status == DiskStatusOK {
+		return m.WaitForDiskOK(diskID, retries...)
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	action := fmt.Sprintf("waiting for disk %s status %s", diskID, status)
+	err = retry(
+		action,
+		m.logger,
+		retries,
+		func() error {
+			disk, err = m.GetDisk(diskID)
+			if err != nil {
+				return err
+			}
+			reportStatus(m.ctx, action, string(disk.Status()))
+			return checkDiskStatus(disk, status)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return disk, nil
+}
@@ -0,0 +1,38 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestWaitForDiskStatus(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	disk := assertCanCreateDisk(t, helper)
+	if _, err := client.WaitForDiskStatus(disk.ID(), "invalid"); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Waiting for an invalid disk status did not result in an EBadArgument error (%v)", err)
+	}
+	waitedDisk, err := client.WaitForDiskStatus(disk.ID(), ovirtclient.DiskStatusOK)
+	if err != nil {
+		t.Fatalf("Failed to wait for disk %s to become OK (%v)", disk.ID(), err)
+	}
+	if waitedDisk.Status() != ovirtclient.DiskStatusOK {
+		t.Fatalf("Incorrect disk status after waiting (expected: %s, got: %s)", ovirtclient.DiskStatusOK, waitedDisk.Status())
+	}
+
+	if err := disk.Remove(); err != nil {
+		t.Fatalf("Failed to remove disk %s (%v)", disk.ID(), err)
+	}
+	if _, err := client.WaitForDiskStatus(disk.ID(), ovirtclient.DiskStatusOK); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.ENotFound,
+	) {
+		t.Fatalf("Waiting for a removed disk did not result in an ENotFound error (%v)", err)
+	}
+}
@@ -0,0 +1,121 @@
+package ovirtclient
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// engineCAPath is the PKI resource of the oVirt Engine serving the engine CA certificate without authentication.
+const engineCAPath = "/ovirt-engine/services/pki-resource?resource=ca-certificate&format=X509-PEM-CA"
+
+// maxEngineCASize limits how much of the response FetchEngineCA reads, a CA certificate is a few kilobytes.
+const maxEngineCASize = 1024 * 1024
+
+// FetchEngineCA downloads the PEM-encoded CA certificate of the oVirt Engine at engineURL, for example
+// https://engine.example.com/ovirt-engine/api. This allows bootstrapping trust on the first connection instead of
+// disabling certificate verification: pass the result to CACertsFromMemory of the TLS provider for New.
+//
+// The download itself is only as trustworthy as the tls provider passed. Use WithCertFingerprint to pin the engine
+// certificate if it was obtained out of band. Passing TLS().Insecure() accepts any server, so the returned CA should
+// then be verified by other means, such as comparing its fingerprint with the one on the engine.
+//
+// An ENotAnOVirtEngine error is returned if the server doesn't return a PEM-encoded certificate.
+func FetchEngineCA(ctx context.Context, engineURL string, tls TLSProvider) ([]byte, error) {
+	if err := validateURL(engineURL); err != nil {
+		return nil, wrap(err, EBadArgument, "invalid URL: %s", engineURL)
+	}
+	caURL, err := getEngineCAURL(engineURL)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := tls.CreateTLSConfig()
+	if err != nil {
+		return nil, wrap(err, ETLSError, "failed to create TLS configuration")
+	}
+	httpClient := http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+			Proxy:           http.ProxyFromEnvironment,
+		},
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, caURL, nil)
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to create request for %s", caURL)
+	}
+	req.Header.Set("User-Agent", DefaultUserAgent())
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, wrap(err, EConnection, "failed to fetch the engine CA certificate from %s", caURL)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, newError(
+			ENotAnOVirtEngine,
+			"fetching the engine CA certificate from %s returned status code %d",
+			caURL,
+			resp.StatusCode,
+		)
+	}
+	caCert, err := io.ReadAll(io.LimitReader(resp.Body, maxEngineCASize))
+	if err != nil {
+		return nil, wrap(err, EConnection, "failed to read the engine CA certificate from %s", caURL)
+	}
+	if err := validateEngineCA(caCert); err != nil {
+		return nil, wrap(err, ENotAnOVirtEngine, "invalid engine CA certificate returned from %s", caURL)
+	}
+	return caCert, nil
+}
+
+// getEngineCAURL returns the URL of the CA certificate for an engine or API URL. Engines behind a reverse proxy may
+// be served under a prefix, so everything before /ovirt-engine is kept.
+func getEngineCAURL(engineURL string) (string, error) {
+	u, err := url.Parse(engineURL)
+	if err != nil {
+		return "", wrap(err, EBadArgument, "failed to parse URL: %s", engineURL)
+	}
+	prefix := u.Path
+	if i := strings.Index(prefix, "/ovirt-engine"); i >= 0 {
+		prefix = prefix[:i]
+	} else {
+		prefix = strings.TrimSuffix(prefix, "/")
+	}
+	return fmt.Sprintf("%s://%s%s%s", u.Scheme, u.Host, prefix, engineCAPath), nil
+}
+
+// validateEngineCA checks that data contains only PEM-encoded certificates, and at least one.
+func validateEngineCA(data []byte) error {
+	rest := data
+	count := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return newError(EBadArgument, "unexpected PEM block of type %s", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return wrap(err, EBadArgument, "failed to parse certificate #%d", count)
+		}
+		count++
+	}
+	if count == 0 {
+		return newError(EBadArgument, "no PEM-encoded certificate found")
+	}
+	if len(strings.TrimSpace(string(rest))) > 0 {
+		return newError(EBadArgument, "unexpected data after the PEM-encoded certificates")
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package ovirtclient_test
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestFetchEngineCA(t *testing.T) {
+	t.Parallel()
+	var caCert []byte
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != "/ovirt-engine/services/pki-resource" ||
+			request.URL.Query().Get("resource") != "ca-certificate" {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = writer.Write(caCert)
+	}))
+	t.Cleanup(srv.Close)
+	// The test server uses a self-signed certificate, so it is its own CA.
+	caCert = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	fetched, err := ovirtclient.FetchEngineCA(
+		context.Background(),
+		srv.URL+"/ovirt-engine/api",
+		ovirtclient.TLS().Insecure(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to fetch the engine CA (%v)", err)
+	}
+	if string(fetched) != string(caCert) {
+		t.Fatalf("Incorrect engine CA returned.")
+	}
+
+	// The fetched CA must be usable to verify the engine on the next connection.
+	if _, err := ovirtclient.FetchEngineCA(
+		context.Background(),
+		srv.URL+"/ovirt-engine/",
+		ovirtclient.TLS().CACertsFromMemory(fetched),
+	); err != nil {
+		t.Fatalf("Failed to fetch the engine CA with the fetched CA trusted (%v)", err)
+	}
+
+	if _, err := ovirtclient.FetchEngineCA(
+		context.Background(),
+		srv.URL+"/ovirt-engine/api",
+		ovirtclient.TLS().CACertsFromSystem(),
+	); err == nil {
+		t.Fatalf("Fetching the engine CA from an untrusted server did not result in an error.")
+	}
+
+	caCert = []byte("<html>Not an engine</html>")
+	if _, err := ovirtclient.FetchEngineCA(
+		context.Background(),
+		srv.URL+"/ovirt-engine/api",
+		ovirtclient.TLS().Insecure(),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.ENotAnOVirtEngine) {
+		t.Fatalf("Fetching an invalid engine CA did not result in an ENotAnOVirtEngine error (%v)", err)
+	}
+}
@@ -0,0 +1,131 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// EngineVersion is the version of the oVirt Engine, for example 4.5.4.0.
+type EngineVersion interface {
+	// Major returns the major version, for example 4.
+	Major() int64
+	// Minor returns the minor version, for example 5.
+	Minor() int64
+	// Build returns the build number.
+	Build() int64
+	// Revision returns the revision number.
+	Revision() int64
+	// String returns the version in the major.minor.build.revision format.
+	String() string
+}
+
+// EngineCapabilities describes the features the oVirt Engine supports.
+type EngineCapabilities interface {
+	// Version returns the engine version the capabilities were determined from.
+	Version() EngineVersion
+	// Supports returns true if the engine supports the feature. Unknown features are reported as unsupported.
+	Supports(feature Feature) bool
+
+	// SupportsAutoPinning returns true if the engine supports FeatureAutoPinning.
+	SupportsAutoPinning() bool
+	// SupportsPlacementPolicy returns true if the engine supports FeaturePlacementPolicy.
+	SupportsPlacementPolicy() bool
+	// SupportsMemoryHotUnplug returns true if the engine supports FeatureMemoryHotUnplug.
+	SupportsMemoryHotUnplug() bool
+	// SupportsIncrementalBackup returns true if the engine supports FeatureIncrementalBackup.
+	SupportsIncrementalBackup() bool
+	// SupportsQ35SecureBoot returns true if the engine supports FeatureQ35SecureBoot.
+	SupportsQ35SecureBoot() bool
+}
+
+type engineVersion struct {
+	major    int64
+	minor    int64
+	build    int64
+	revision int64
+}
+
+func (e engineVersion) Major() int64 {
+	return e.major
+}
+
+func (e engineVersion) Minor() int64 {
+	return e.minor
+}
+
+func (e engineVersion) Build() int64 {
+	return e.build
+}
+
+func (e engineVersion) Revision() int64 {
+	return e.revision
+}
+
+func (e engineVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d", e.major, e.minor, e.build, e.revision)
+}
+
+// compare returns a negative number if e is older than other, 0 if they are the same, and a positive number if e is
+// newer.
+func (e engineVersion) compare(other engineVersion) int64 {
+	if result := e.major - other.major; result != 0 {
+		return result
+	}
+	if result := e.minor - other.minor; result != 0 {
+		return result
+	}
+	if result := e.build - other.build; result != 0 {
+		return result
+	}
+	return e.revision - other.revision
+}
+
+func convertSDKVersion(version *ovirtsdk.Version) engineVersion {
+	result := engineVersion{}
+	result.major, _ = version.Major()
+	result.minor, _ = version.Minor()
+	result.build, _ = version.Build_()
+	result.revision, _ = version.Revision()
+	return result
+}
+
+func newEngineCapabilities(version engineVersion) *engineCapabilities {
+	return &engineCapabilities{version: version}
+}
+
+type engineCapabilities struct {
+	version engineVersion
+}
+
+func (e *engineCapabilities) Version() EngineVersion {
+	return e.version
+}
+
+func (e *engineCapabilities) Supports(feature Feature) bool {
+	minimumVersion, ok := featureMinimumVersions[feature]
+	if !ok {
+		return false
+	}
+	return e.version.compare(minimumVersion) >= 0
+}
+
+func (e *engineCapabilities) SupportsAutoPinning() bool {
+	return e.Supports(FeatureAutoPinning)
+}
+
+func (e *engineCapabilities) SupportsPlacementPolicy() bool {
+	return e.Supports(FeaturePlacementPolicy)
+}
+
+func (e *engineCapabilities) SupportsMemoryHotUnplug() bool {
+	return e.Supports(FeatureMemoryHotUnplug)
+}
+
+func (e *engineCapabilities) SupportsIncrementalBackup() bool {
+	return e.Supports(FeatureIncrementalBackup)
+}
+
+func (e *engineCapabilities) SupportsQ35SecureBoot() bool {
+	return e.Supports(FeatureQ35SecureBoot)
+}
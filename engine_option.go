@@ -0,0 +1,183 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// EngineOptionVersionGeneral is the version of engine option values that don't depend on the cluster compatibility
+// version.
+const EngineOptionVersionGeneral = "general"
+
+// EngineOptionClient provides read access to the configuration values of the oVirt Engine, as set with the
+// engine-config tool. The engine only exposes the options marked as public, and has no call to list them.
+type EngineOptionClient interface {
+	// GetEngineOption returns the values of the named engine option, for example "MaxNumOfVmCpus". An ENotFound
+	// error is returned if the option doesn't exist or is not public. Reading options requires oVirt 4.3 or newer.
+	GetEngineOption(name string, retries ...RetryStrategy) (EngineOption, error)
+}
+
+// EngineOption is a configuration value of the oVirt Engine. Many options have a separate value for each cluster
+// compatibility version.
+type EngineOption interface {
+	// Name returns the name of the option.
+	Name() string
+	// Values returns the values of the option for all versions.
+	Values() []EngineOptionValue
+	// ValueForVersion returns the value of the option for a cluster compatibility version, such as "4.6". If the
+	// option has no value for the version, the EngineOptionVersionGeneral value is returned, if present.
+	ValueForVersion(version string) (string, bool)
+}
+
+// EngineOptionValue is the value of an engine option for a single version.
+type EngineOptionValue interface {
+	// Version returns the cluster compatibility version the value applies to, or EngineOptionVersionGeneral.
+	Version() string
+	// Value returns the value as stored by the engine.
+	Value() string
+}
+
+func convertSDKEngineOption(object *ovirtsdk.SystemOption) (*engineOption, error) {
+	name, ok := object.Name()
+	if !ok {
+		return nil, newFieldNotFound("engine option", "name")
+	}
+	result := &engineOption{
+		name: name,
+	}
+	if values, ok := object.Values(); ok {
+		for _, sdkValue := range values.Slice() {
+			version, ok := sdkValue.Version()
+			if !ok {
+				return nil, newFieldNotFound(fmt.Sprintf("value of engine option %s", name), "version")
+			}
+			value, _ := sdkValue.Value()
+			result.values = append(result.values, engineOptionValue{version: version, value: value})
+		}
+	}
+	return result, nil
+}
+
+type engineOption struct {
+	name   string
+	values []engineOptionValue
+}
+
+func (e engineOption) Name() string {
+	return e.name
+}
+
+func (e engineOption) Values() []EngineOptionValue {
+	result := make([]EngineOptionValue, len(e.values))
+	for i, value := range e.values {
+		result[i] = value
+	}
+	return result
+}
+
+func (e engineOption) ValueForVersion(version string) (string, bool) {
+	var general *engineOptionValue
+	for i, value := range e.values {
+		if value.version == version {
+			return value.value, true
+		}
+		if value.version == EngineOptionVersionGeneral {
+			general = &e.values[i]
+		}
+	}
+	if general != nil {
+		return general.value, true
+	}
+	return "", false
+}
+
+type engineOptionValue struct {
+	version string
+	value   string
+}
+
+func (e engineOptionValue) Version() string {
+	return e.version
+}
+
+func (e engineOptionValue) Value() string {
+	return e.value
+}
+
+func (o *oVirtClient) GetEngineOption(name string, retries ...RetryStrategy) (result EngineOption, err error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the engine option name cannot be empty")
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("getting engine option %s", name)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().OptionsService().OptionService(name).Get().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkOption, ok := response.Option()
+			if !ok {
+				return newError(ENotFound, "no engine option returned when getting option %s", name)
+			}
+			result, err = convertSDKEngineOption(sdkOption)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert engine option %s", name)
+			}
+			return nil
+		})
+	return result, err
+}
+
+// mockEngineOptions are the options the mock returns, with values resembling the defaults of a recent engine.
+var mockEngineOptions = map[string]engineOption{
+	"MaxNumOfVmCpus": {
+		name: "MaxNumOfVmCpus",
+		values: []engineOptionValue{
+			{version: "4.2", value: "384"},
+			{version: "4.3", value: "384"},
+			{version: "4.4", value: "512"},
+			{version: "4.5", value: "512"},
+			{version: "4.6", value: "512"},
+			{version: "4.7", value: "512"},
+		},
+	},
+	"MaxNumOfVmSockets": {
+		name: "MaxNumOfVmSockets",
+		values: []engineOptionValue{
+			{version: EngineOptionVersionGeneral, value: "16"},
+		},
+	},
+	migrationPoliciesEngineOption: {
+		name: migrationPoliciesEngineOption,
+		values: []engineOptionValue{
+			{version: "4.6", value: mockMigrationPolicies},
+			{version: "4.7", value: mockMigrationPolicies},
+		},
+	},
+}
+
+// mockMigrationPolicies is the MigrationPolicies engine option value of the mock, listing the shipped policies.
+var mockMigrationPolicies = fmt.Sprintf(
+	`[{"id":"%s","name":"Minimal downtime"},`+
+		`{"id":"%s","name":"Suspend workload if needed"},`+
+		`{"id":"%s","name":"Post-copy migration"}]`,
+	MigrationPolicyMinimalDowntime,
+	MigrationPolicySuspendWorkload,
+	MigrationPolicyPostCopy,
+)
+
+func (m *mockClient) GetEngineOption(name string, _ ...RetryStrategy) (EngineOption, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the engine option name cannot be empty")
+	}
+	option, ok := mockEngineOptions[name]
+	if !ok {
+		return nil, newError(ENotFound, "engine option %s not found", name)
+	}
+	return option, nil
+}
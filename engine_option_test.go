@@ -0,0 +1,43 @@
+package ovirtclient_test
+
+import (
+	"strconv"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestGetEngineOption(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	option, err := client.GetEngineOption("MaxNumOfVmCpus")
+	if err != nil {
+		t.Fatalf("Failed to get engine option (%v)", err)
+	}
+	if option.Name() != "MaxNumOfVmCpus" {
+		t.Fatalf("Incorrect option name (expected: MaxNumOfVmCpus, got: %s)", option.Name())
+	}
+	values := option.Values()
+	if len(values) == 0 {
+		t.Fatalf("The engine option has no values.")
+	}
+	value, ok := option.ValueForVersion(values[0].Version())
+	if !ok {
+		t.Fatalf("No value returned for version %s.", values[0].Version())
+	}
+	if _, err := strconv.Atoi(value); err != nil {
+		t.Fatalf("The maximum number of VM CPUs is not a number: %s", value)
+	}
+}
+
+func TestGetNonexistentEngineOption(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	_, err := helper.GetClient().GetEngineOption("NonexistentEngineOption")
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Getting a nonexistent engine option did not result in an ENotFound error (%v)", err)
+	}
+}
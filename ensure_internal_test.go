@@ -0,0 +1,102 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+// racingClient simulates another caller creating the same object between the lookup and the creation of the Ensure
+// functions.
+type racingClient struct {
+	Client
+}
+
+func (r *racingClient) CreateTag(name string, params CreateTagParams, retries ...RetryStrategy) (Tag, error) {
+	if _, err := r.Client.CreateTag(name, params, retries...); err != nil {
+		return nil, err
+	}
+	return r.Client.CreateTag(name, params, retries...)
+}
+
+func (r *racingClient) CreateAffinityGroup(
+	clusterID ClusterID,
+	name string,
+	params CreateAffinityGroupOptionalParams,
+	retries ...RetryStrategy,
+) (AffinityGroup, error) {
+	if _, err := r.Client.CreateAffinityGroup(clusterID, name, params, retries...); err != nil {
+		return nil, err
+	}
+	return r.Client.CreateAffinityGroup(clusterID, name, params, retries...)
+}
+
+func TestEnsureTag(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+
+	createdTag, err := m.EnsureTag("test", nil)
+	if err != nil {
+		t.Fatalf("Failed to ensure a new tag (%v)", err)
+	}
+	existingTag, err := m.EnsureTag("test", nil)
+	if err != nil {
+		t.Fatalf("Failed to ensure an existing tag (%v)", err)
+	}
+	if existingTag.ID() != createdTag.ID() {
+		t.Fatalf("Ensuring an existing tag returned a different tag (%s instead of %s)", existingTag.ID(), createdTag.ID())
+	}
+
+	racedTag, err := ensureTag(&racingClient{m}, "raced", nil, nil)
+	if err != nil {
+		t.Fatalf("Ensuring a concurrently created tag failed (%v)", err)
+	}
+	tags, err := m.ListTags()
+	if err != nil {
+		t.Fatalf("Failed to list tags (%v)", err)
+	}
+	found := 0
+	for _, tag := range tags {
+		if tag.Name() == "raced" {
+			found++
+			if tag.ID() != racedTag.ID() {
+				t.Fatalf("Ensuring a concurrently created tag did not return the concurrently created tag.")
+			}
+		}
+	}
+	if found != 1 {
+		t.Fatalf("Incorrect number of tags after a concurrent creation (expected: 1, got: %d)", found)
+	}
+}
+
+func TestEnsureAffinityGroup(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	cluster := generateTestCluster()
+	m.clusters[cluster.ID()] = cluster
+	m.affinityGroups[cluster.ID()] = map[AffinityGroupID]*affinityGroup{}
+
+	createdGroup, err := m.EnsureAffinityGroup(cluster.ID(), "test", nil)
+	if err != nil {
+		t.Fatalf("Failed to ensure a new affinity group (%v)", err)
+	}
+	existingGroup, err := m.EnsureAffinityGroup(cluster.ID(), "test", nil)
+	if err != nil {
+		t.Fatalf("Failed to ensure an existing affinity group (%v)", err)
+	}
+	if existingGroup.ID() != createdGroup.ID() {
+		t.Fatalf("Ensuring an existing affinity group returned a different group.")
+	}
+
+	racedGroup, err := ensureAffinityGroup(&racingClient{m}, cluster.ID(), "raced", nil, nil)
+	if err != nil {
+		t.Fatalf("Ensuring a concurrently created affinity group failed (%v)", err)
+	}
+	if len(m.affinityGroups[cluster.ID()]) != 2 {
+		t.Fatalf(
+			"Incorrect number of affinity groups after a concurrent creation (expected: 2, got: %d)",
+			len(m.affinityGroups[cluster.ID()]),
+		)
+	}
+	if m.affinityGroups[cluster.ID()][racedGroup.ID()] == nil {
+		t.Fatalf("Ensuring a concurrently created affinity group did not return the concurrently created group.")
+	}
+}
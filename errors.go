@@ -3,6 +3,10 @@ package ovirtclient
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	ovirtsdk "github.com/ovirt/go-ovirt"
@@ -45,6 +49,9 @@ const EPending ErrorCode = "pending"
 // EUnexpectedDiskStatus indicates that a disk was in a status that was not expected in this state.
 const EUnexpectedDiskStatus ErrorCode = "unexpected_disk_status"
 
+// EUnexpectedVMStatus indicates that a VM was in a status that was not expected in this state.
+const EUnexpectedVMStatus ErrorCode = "unexpected_vm_status"
+
 // ETimeout signals that the client library has timed out waiting for an action to be completed.
 const ETimeout ErrorCode = "timeout"
 
@@ -97,6 +104,22 @@ const EInvalidGrant ErrorCode = "invalid_grant"
 // ECannotRunVM indicates an error with the VM configuration which prevents it from being run.
 const ECannotRunVM ErrorCode = "cannot_run_vm"
 
+// ERateLimited indicates that the oVirt Engine, or a gateway in front of it, rejected the request because too many
+// requests were sent. The request is retried, honoring the wait time the server asked for if there is one.
+const ERateLimited ErrorCode = "rate_limited"
+
+// EHostInstallFailed indicates that installing or reinstalling a host failed. The engine events contain the details.
+const EHostInstallFailed ErrorCode = "host_install_failed"
+
+// EQuotaExceeded indicates that the engine blocked the operation because it would exceed a quota. The error can be
+// retrieved as a QuotaExceededError with errors.As for the details of the limit.
+const EQuotaExceeded ErrorCode = "quota_exceeded"
+
+// EUncertainOutcome indicates that a request creating or changing a resource was aborted before the engine
+// responded, for example because it exceeded the timeout set with WithSDKTimeout. The engine may still carry it out,
+// so it is not retried automatically. Check the state of the resource before trying again.
+const EUncertainOutcome ErrorCode = "uncertain_outcome"
+
 // CanRecover returns true if there is a way to automatically recoverFailure from this error. For the actual recovery an
 // appropriate recovery strategy must be passed to the retry function.
 func (e ErrorCode) CanRecover() bool {
@@ -135,8 +158,16 @@ func (e ErrorCode) CanAutoRetry() bool {
 		return false
 	case EUnexpectedDiskStatus:
 		return false
+	case EUnexpectedVMStatus:
+		return false
 	case ECannotRunVM:
 		return false
+	case EHostInstallFailed:
+		return false
+	case EQuotaExceeded:
+		return false
+	case EUncertainOutcome:
+		return false
 	default:
 		return true
 	}
@@ -231,6 +262,57 @@ func (e *engineError) CanAutoRetry() bool {
 	return e.code.CanAutoRetry()
 }
 
+// MultiError is returned by bulk operations that carry on after the failure of individual items. It contains the
+// error of each failed item, keyed by the identifier of the item.
+type MultiError interface {
+	error
+
+	// Errors returns the errors of the failed items, keyed by the item identifier.
+	Errors() map[string]error
+}
+
+type multiError struct {
+	action string
+	total  int
+	errors map[string]error
+}
+
+func (m *multiError) Errors() map[string]error {
+	return m.errors
+}
+
+func (m *multiError) Error() string {
+	ids := make([]string, 0, len(m.errors))
+	for id := range m.errors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	messages := make([]string, len(ids))
+	for i, id := range ids {
+		messages[i] = fmt.Sprintf("%s: %v", id, m.errors[id])
+	}
+	return fmt.Sprintf(
+		"%s failed for %d of %d items (%s)",
+		m.action,
+		len(m.errors),
+		m.total,
+		strings.Join(messages, "; "),
+	)
+}
+
+// newMultiError returns a MultiError for the failed items of a bulk operation over total items, or nil if no item
+// failed.
+func newMultiError(action string, total int, itemErrors map[string]error) error {
+	if len(itemErrors) == 0 {
+		return nil
+	}
+	return &multiError{
+		action: action,
+		total:  total,
+		errors: itemErrors,
+	}
+}
+
 func newFieldNotFound(object string, field string) error {
 	return newError(EFieldMissing, "no %s field found on %s object", field, object)
 }
@@ -266,11 +348,82 @@ func wrap(err error, code ErrorCode, format string, args ...interface{}) EngineE
 	}
 }
 
+var sdkHTTPStatusCodeRegexp = regexp.MustCompile(`HTTP response code is "(\d+)"`)
+
+// wrapSDKError converts an error returned from the oVirt SDK into an EngineError with an appropriate error code. Errors
+// that can be identified from their message are handled by realIdentify, the rest are mapped based on the HTTP status
+// code of the response. If neither is possible, the error is returned unchanged so the retry logic can deal with it.
+func wrapSDKError(op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var engineErr EngineError
+	if errors.As(err, &engineErr) {
+		return err
+	}
+	if identifiedErr := realIdentify(err); identifiedErr != nil {
+		return wrap(identifiedErr, identifiedErr.Code(), "failed %s", op)
+	}
+	statusCode, ok := sdkErrorHTTPStatusCode(err)
+	if !ok {
+		return err
+	}
+	switch {
+	case statusCode == http.StatusBadRequest:
+		return wrap(err, EBadArgument, "bad request while %s", op)
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return wrap(err, EAccessDenied, "access denied while %s", op)
+	case statusCode == http.StatusNotFound:
+		return wrap(err, ENotFound, "resource not found while %s", op)
+	case statusCode == http.StatusConflict:
+		return wrap(err, EConflict, "conflict while %s", op)
+	case statusCode == http.StatusTooManyRequests:
+		return wrap(err, ERateLimited, "rate limited while %s", op)
+	case statusCode >= http.StatusInternalServerError:
+		return wrap(err, EConnection, "server error (HTTP %d) while %s", statusCode, op)
+	case statusCode >= http.StatusBadRequest:
+		return wrap(err, EPermanentHTTPError, "HTTP %d error while %s", statusCode, op)
+	default:
+		return err
+	}
+}
+
+// sdkErrorHTTPStatusCode extracts the HTTP status code from an SDK error. The SDK only provides typed errors for
+// authentication and not found errors and for responses it can't parse, such as the HTML page of a proxy. All other
+// status codes are only available in the error message.
+func sdkErrorHTTPStatusCode(err error) (int, bool) {
+	var authErr *ovirtsdk.AuthError
+	if errors.As(err, &authErr) {
+		return authErr.Code, true
+	}
+	var notFoundErr *ovirtsdk.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return notFoundErr.Code, true
+	}
+	var parseErr *ovirtsdk.ResponseParseError
+	if errors.As(err, &parseErr) && parseErr.Code != 0 {
+		return parseErr.Code, true
+	}
+	match := sdkHTTPStatusCodeRegexp.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0, false
+	}
+	statusCode, convErr := strconv.Atoi(match[1])
+	if convErr != nil {
+		return 0, false
+	}
+	return statusCode, true
+}
+
 //nolint:funlen
 func realIdentify(err error) EngineError {
 	var authErr *ovirtsdk.AuthError
 	var notFoundErr *ovirtsdk.NotFoundError
+	statusCode, _ := sdkErrorHTTPStatusCode(err)
 	switch {
+	case statusCode == http.StatusTooManyRequests:
+		// Identified here and not only in wrapSDKError so raw SDK errors are retried by DefaultRetryPolicy too.
+		return wrap(err, ERateLimited, "rate limited by the engine or a proxy in front of it")
 	case strings.Contains(err.Error(), "Cannot run VM without at least one bootable disk."):
 		return wrap(
 			err,
@@ -319,6 +472,8 @@ func realIdentify(err error) EngineError {
 		return wrap(err, ERelatedOperationInProgress, "a related operation is in progress")
 	case strings.Contains(err.Error(), "Disk configuration") && strings.Contains(err.Error(), " is incompatible with the storage domain type."):
 		return wrap(err, EBadArgument, "disk configuration is incompatible with the storage domain type")
+	case quotaExceededRegexp.MatchString(err.Error()):
+		return parseQuotaExceeded(err)
 	case strings.Contains(err.Error(), "409 Conflict"):
 		return wrap(err, EConflict, "conflicting operations")
 	case errors.As(err, &authErr):
@@ -0,0 +1,82 @@
+package ovirtclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func TestWrapSDKError(t *testing.T) {
+	testcases := map[int]ErrorCode{
+		http.StatusBadRequest:          EBadArgument,
+		http.StatusUnauthorized:        EAccessDenied,
+		http.StatusForbidden:           EAccessDenied,
+		http.StatusNotFound:            ENotFound,
+		http.StatusConflict:            EConflict,
+		http.StatusTooManyRequests:     ERateLimited,
+		http.StatusMethodNotAllowed:    EPermanentHTTPError,
+		http.StatusInternalServerError: EConnection,
+		http.StatusBadGateway:          EConnection,
+		http.StatusServiceUnavailable:  EConnection,
+	}
+
+	for statusCode, expectedCode := range testcases {
+		statusCode := statusCode
+		expectedCode := expectedCode
+		t.Run(fmt.Sprintf("%d", statusCode), func(t *testing.T) {
+			sdkErr := ovirtsdk.BuildError(
+				&http.Response{
+					StatusCode: statusCode,
+					Status:     http.StatusText(statusCode),
+				},
+				nil,
+			)
+			err := wrapSDKError("getting test resource", sdkErr)
+			var e EngineError
+			if !errors.As(err, &e) {
+				t.Fatalf("Failed to convert returned error to EngineError (%v)", err)
+			}
+			if e.Code() != expectedCode {
+				t.Fatalf("Unexpected error code: %s, instead of: %s (%v)", e.Code(), expectedCode, err)
+			}
+			if !errors.Is(err, sdkErr) {
+				t.Fatalf("The returned error does not wrap the original SDK error (%v)", err)
+			}
+		})
+	}
+}
+
+func TestRealIdentifyRateLimited(t *testing.T) {
+	sdkErr := ovirtsdk.BuildError(
+		&http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Status:     http.StatusText(http.StatusTooManyRequests),
+		},
+		nil,
+	)
+	if e := realIdentify(sdkErr); e == nil || e.Code() != ERateLimited {
+		t.Fatalf("A raw SDK 429 error was not identified as ERateLimited (%v)", e)
+	}
+	if !DefaultRetryPolicy(sdkErr, 1) {
+		t.Fatalf("The default retry policy does not retry a raw SDK 429 error.")
+	}
+}
+
+func TestWrapSDKErrorPassthrough(t *testing.T) {
+	if err := wrapSDKError("getting test resource", nil); err != nil {
+		t.Fatalf("wrapSDKError returned an error for a nil input (%v)", err)
+	}
+
+	unknownErr := errors.New("connection reset by peer")
+	if err := wrapSDKError("getting test resource", unknownErr); err != unknownErr {
+		t.Fatalf("wrapSDKError modified an unidentifiable error (%v)", err)
+	}
+
+	engineErr := newError(EVMLocked, "test")
+	if err := wrapSDKError("getting test resource", engineErr); err != engineErr {
+		t.Fatalf("wrapSDKError modified an EngineError (%v)", err)
+	}
+}
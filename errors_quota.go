@@ -0,0 +1,153 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// QuotaResource is the kind of resource a quota limits.
+type QuotaResource string
+
+const (
+	// QuotaResourceVCPU is the number of virtual CPUs of the VMs in a cluster.
+	QuotaResourceVCPU QuotaResource = "vcpu"
+	// QuotaResourceMemory is the memory of the VMs in a cluster.
+	QuotaResourceMemory QuotaResource = "memory"
+	// QuotaResourceStorage is the disk space on a storage domain.
+	QuotaResourceStorage QuotaResource = "storage"
+	// QuotaResourceUnknown is returned if the engine message doesn't tell which limit was hit.
+	QuotaResourceUnknown QuotaResource = "unknown"
+)
+
+// QuotaResourceList is a list of QuotaResource values.
+type QuotaResourceList []QuotaResource
+
+// QuotaResourceValues returns all possible QuotaResource values.
+func QuotaResourceValues() QuotaResourceList {
+	return []QuotaResource{
+		QuotaResourceVCPU,
+		QuotaResourceMemory,
+		QuotaResourceStorage,
+		QuotaResourceUnknown,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l QuotaResourceList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// QuotaExceededError is an EQuotaExceeded error with the details the engine reported about the limit that was hit.
+// Use errors.As to retrieve it from an error returned by the client:
+//
+//	var quotaErr ovirtclient.QuotaExceededError
+//	if errors.As(err, &quotaErr) {
+//	    fmt.Printf("quota %s exceeded for %s\n", quotaErr.QuotaName(), quotaErr.Resource())
+//	}
+type QuotaExceededError interface {
+	EngineError
+
+	// QuotaName returns the name of the quota that was exceeded.
+	QuotaName() string
+	// Resource returns the kind of resource that ran out.
+	Resource() QuotaResource
+	// Utilization returns the current use of the quota as reported by the engine, usually as a percentage of the
+	// limit. It is empty if the engine didn't report it.
+	Utilization() string
+	// Requested returns the amount the blocked operation requested as reported by the engine. It is empty if the
+	// engine didn't report it.
+	Requested() string
+}
+
+type quotaExceededError struct {
+	engineError
+
+	quotaName   string
+	resource    QuotaResource
+	utilization string
+	requested   string
+}
+
+func (q *quotaExceededError) QuotaName() string {
+	return q.quotaName
+}
+
+func (q *quotaExceededError) Resource() QuotaResource {
+	return q.resource
+}
+
+func (q *quotaExceededError) Utilization() string {
+	return q.utilization
+}
+
+func (q *quotaExceededError) Requested() string {
+	return q.requested
+}
+
+// The engine blocks operations exceeding a quota in enforcing mode with messages such as:
+//
+//	Cluster-Quota Default limit exceeded and operation was blocked. Utilization: vcpu:100%, Requested: vcpu:4 -
+//	Please select a different quota or contact your administrator to extend the quota.
+//	Storage-Quota Default limit exceeded and operation was blocked. Utilization(used/requested): 95%/10% -
+//	Please select a different quota or contact your administrator to extend the quota.
+var (
+	quotaExceededRegexp     = regexp.MustCompile(`(Cluster|Storage)-Quota (.+?) limit exceeded`)
+	quotaClusterUsageRegexp = regexp.MustCompile(`Utilization: (.+?), Requested: (.+?) - `)
+	quotaStorageUsageRegexp = regexp.MustCompile(`Utilization\(used/requested\): (.+?)/(.+?) - `)
+	quotaClusterResources   = []struct {
+		keyword  string
+		resource QuotaResource
+	}{
+		{"cpu", QuotaResourceVCPU},
+		{"mem", QuotaResourceMemory},
+	}
+)
+
+// parseQuotaExceeded returns a QuotaExceededError if the error message is a quota violation reported by the
+// engine, nil otherwise.
+func parseQuotaExceeded(err error) EngineError {
+	message := err.Error()
+	match := quotaExceededRegexp.FindStringSubmatch(message)
+	if match == nil {
+		return nil
+	}
+	result := &quotaExceededError{
+		engineError: engineError{
+			code:  EQuotaExceeded,
+			cause: err,
+		},
+		quotaName: match[2],
+		resource:  QuotaResourceUnknown,
+	}
+	if match[1] == "Storage" {
+		result.resource = QuotaResourceStorage
+		if usage := quotaStorageUsageRegexp.FindStringSubmatch(message); usage != nil {
+			result.utilization, result.requested = usage[1], usage[2]
+		}
+	} else if usage := quotaClusterUsageRegexp.FindStringSubmatch(message); usage != nil {
+		result.utilization, result.requested = usage[1], usage[2]
+		result.resource = quotaClusterResource(result.requested)
+	}
+	result.message = fmt.Sprintf("quota %s exceeded for %s (%v)", result.quotaName, result.resource, err)
+	return result
+}
+
+// quotaClusterResource finds the resource of a cluster quota violation. The engine may list both the vCPU and the
+// memory usage, the resource mentioned first is returned.
+func quotaClusterResource(requested string) QuotaResource {
+	requested = strings.ToLower(requested)
+	result := QuotaResourceUnknown
+	position := len(requested)
+	for _, candidate := range quotaClusterResources {
+		if i := strings.Index(requested, candidate.keyword); i >= 0 && i < position {
+			result = candidate.resource
+			position = i
+		}
+	}
+	return result
+}
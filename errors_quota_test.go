@@ -0,0 +1,116 @@
+package ovirtclient_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestQuotaExceededError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		detail      string
+		quotaName   string
+		resource    ovirtclient.QuotaResource
+		utilization string
+		requested   string
+	}{
+		{
+			"storage",
+			"Cannot add Virtual Disk. Storage-Quota Default limit exceeded and operation was blocked. " +
+				"Utilization(used/requested): 95%/10% - Please select a different quota or contact your " +
+				"administrator to extend the quota.",
+			"Default",
+			ovirtclient.QuotaResourceStorage,
+			"95%",
+			"10%",
+		},
+		{
+			"vcpu",
+			"Cannot add VM. Cluster-Quota team quota limit exceeded and operation was blocked. " +
+				"Utilization: vcpu:100%, Requested: vcpu:4 - Please select a different quota or contact your " +
+				"administrator to extend the quota.",
+			"team quota",
+			ovirtclient.QuotaResourceVCPU,
+			"vcpu:100%",
+			"vcpu:4",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			srv := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				switch request.URL.Path {
+				case "/ovirt-engine/sso/oauth/token":
+					writer.Header().Set("Content-Type", "application/json")
+					_, _ = writer.Write([]byte(`{"access_token":"test"}`))
+				case "/ovirt-engine/api/disks":
+					writer.Header().Set("Content-Type", "application/xml")
+					writer.WriteHeader(http.StatusConflict)
+					_, _ = fmt.Fprintf(
+						writer,
+						"<fault><reason>Operation Failed</reason><detail>[%s]</detail></fault>",
+						testCase.detail,
+					)
+				default:
+					writer.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			client, err := ovirtclient.NewWithVerify(
+				srv.URL+"/ovirt-engine/api",
+				"admin@internal",
+				"invalid-password-for-testing-purposes",
+				ovirtclient.TLS().Insecure(),
+				ovirtclientlog.NewTestLogger(t),
+				nil,
+				func(connection ovirtclient.Client) error {
+					return nil
+				},
+			)
+			if err != nil {
+				t.Fatalf("failed to set up connection (%v)", err)
+			}
+
+			_, err = client.CreateDisk(
+				"00000000-0000-0000-0000-000000000001",
+				ovirtclient.ImageFormatRaw,
+				ovirtclient.MinDiskSizeOVirt,
+				nil,
+			)
+			if !ovirtclient.HasErrorCode(err, ovirtclient.EQuotaExceeded) {
+				t.Fatalf("the quota fault did not result in an EQuotaExceeded error (%v)", err)
+			}
+			var quotaErr ovirtclient.QuotaExceededError
+			if !errors.As(err, &quotaErr) {
+				t.Fatalf("the error is not a QuotaExceededError (%v)", err)
+			}
+			if quotaErr.QuotaName() != testCase.quotaName {
+				t.Fatalf("incorrect quota name (expected: %s, got: %s)", testCase.quotaName, quotaErr.QuotaName())
+			}
+			if quotaErr.Resource() != testCase.resource {
+				t.Fatalf("incorrect resource (expected: %s, got: %s)", testCase.resource, quotaErr.Resource())
+			}
+			if quotaErr.Utilization() != testCase.utilization {
+				t.Fatalf(
+					"incorrect utilization (expected: %s, got: %s)",
+					testCase.utilization,
+					quotaErr.Utilization(),
+				)
+			}
+			if quotaErr.Requested() != testCase.requested {
+				t.Fatalf("incorrect requested value (expected: %s, got: %s)", testCase.requested, quotaErr.Requested())
+			}
+		})
+	}
+}
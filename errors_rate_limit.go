@@ -0,0 +1,78 @@
+package ovirtclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRetryAfter caps the wait time a server can request in a Retry-After header so a misbehaving gateway can't stall
+// a retry loop indefinitely.
+const maxRetryAfter = 5 * time.Minute
+
+// rateLimitedError is an ERateLimited error that also carries the wait time the server requested before the next
+// attempt.
+type rateLimitedError struct {
+	engineError
+
+	retryAfter time.Duration
+}
+
+// RetryAfter returns how long the server asked the client to wait before retrying.
+func (r *rateLimitedError) RetryAfter() time.Duration {
+	return r.retryAfter
+}
+
+// checkRateLimitedResponse returns an ERateLimited error if the HTTP response has the status code 429. The wait time
+// from the Retry-After header is attached to the error so the retry loop can honor it.
+func checkRateLimitedResponse(response *http.Response) error {
+	if response.StatusCode != http.StatusTooManyRequests {
+		return nil
+	}
+	retryAfter, ok := parseRetryAfter(response.Header.Get("Retry-After"), time.Now())
+	if !ok {
+		return newError(ERateLimited, "the server responded with too many requests (429)")
+	}
+	return &rateLimitedError{
+		engineError: engineError{
+			message: fmt.Sprintf("the server responded with too many requests (429), retry after %s", retryAfter),
+			code:    ERateLimited,
+		},
+		retryAfter: retryAfter,
+	}
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	var retryAfter time.Duration
+	if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+		retryAfter = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		retryAfter = date.Sub(now)
+	} else {
+		return 0, false
+	}
+	if retryAfter < 0 {
+		retryAfter = 0
+	}
+	if retryAfter > maxRetryAfter {
+		retryAfter = maxRetryAfter
+	}
+	return retryAfter, true
+}
+
+// getRetryAfter returns the wait time requested by the server if the error carries one.
+func getRetryAfter(err error) (time.Duration, bool) {
+	var rateLimitedErr *rateLimitedError
+	if errors.As(err, &rateLimitedErr) {
+		return rateLimitedErr.retryAfter, true
+	}
+	return 0, false
+}
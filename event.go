@@ -0,0 +1,257 @@
+package ovirtclient
+
+import (
+	"strings"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// EventID is the identifier of an event in the oVirt Engine audit log.
+type EventID string
+
+// EventClient contains the methods to query the oVirt Engine audit log.
+type EventClient interface {
+	// ListEvents returns the events matching the filter, newest first unless the filter requests the oldest first. The
+	// filter may be nil to list all events.
+	ListEvents(filter EventFilter, retries ...RetryStrategy) ([]Event, error)
+	// GetOperationEvents returns the events the engine logged for the API calls made with the correlation ID, oldest
+	// first. This shows what happened to an operation that failed asynchronously, for example a job that the engine
+	// accepted but could not complete. An empty correlation ID results in an EBadArgument error.
+	GetOperationEvents(correlationID string, retries ...RetryStrategy) ([]Event, error)
+	// ListAlerts returns the events of alert severity that have not been dismissed, newest first.
+	ListAlerts(retries ...RetryStrategy) ([]Alert, error)
+	// DismissAlert removes the alert from the engine, which clears it from the alerts tab of the web interface. An
+	// ENotFound error is returned if there is no alert with the ID, including for events of lower severity.
+	DismissAlert(id EventID, retries ...RetryStrategy) error
+}
+
+// Event is a single entry of the oVirt Engine audit log.
+type Event interface {
+	// ID returns the identifier of the event.
+	ID() EventID
+	// Code returns the numeric type of the event, for example 30 for a VM that has been powered off.
+	Code() int64
+	// Severity returns how important the event is.
+	Severity() EventSeverity
+	// Description returns the human-readable message of the event.
+	Description() string
+	// Time returns when the event happened.
+	Time() time.Time
+	// CorrelationID returns the correlation ID of the API call that caused the event, or an empty string if the
+	// event has none.
+	CorrelationID() string
+}
+
+// EventSeverity is the severity of an event. The severities are ordered from EventSeverityNormal to
+// EventSeverityAlert.
+type EventSeverity string
+
+const (
+	// EventSeverityNormal is an informational event.
+	EventSeverityNormal EventSeverity = "normal"
+	// EventSeverityWarning indicates a problem that doesn't need immediate attention.
+	EventSeverityWarning EventSeverity = "warning"
+	// EventSeverityError indicates a failed operation.
+	EventSeverityError EventSeverity = "error"
+	// EventSeverityAlert indicates a problem that needs immediate attention, for example a host that went down.
+	EventSeverityAlert EventSeverity = "alert"
+)
+
+// EventSeverityList is a list of EventSeverity values.
+type EventSeverityList []EventSeverity
+
+// EventSeverityValues returns all possible EventSeverity values, from the least to the most severe.
+func EventSeverityValues() EventSeverityList {
+	return []EventSeverity{
+		EventSeverityNormal,
+		EventSeverityWarning,
+		EventSeverityError,
+		EventSeverityAlert,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l EventSeverityList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// Validate returns an error if the event severity is not valid.
+func (e EventSeverity) Validate() error {
+	for _, value := range EventSeverityValues() {
+		if value == e {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid event severity: %s, must be one of: %s",
+		e,
+		strings.Join(EventSeverityValues().Strings(), ", "),
+	)
+}
+
+// AtLeast returns all severities that are at least as severe as the current one.
+func (e EventSeverity) AtLeast() EventSeverityList {
+	values := EventSeverityValues()
+	for i, value := range values {
+		if value == e {
+			return values[i:]
+		}
+	}
+	return nil
+}
+
+// EventFilter restricts the events returned by ListEvents.
+type EventFilter interface {
+	// Since returns the time after which the events must have happened, or nil to not limit it.
+	Since() *time.Time
+	// Until returns the time before which the events must have happened, or nil to not limit it.
+	Until() *time.Time
+	// MinSeverity returns the least severe event to return, or nil to return events of all severities.
+	MinSeverity() *EventSeverity
+	// OldestFirst returns true if the events should be returned in chronological order instead of newest first.
+	OldestFirst() bool
+}
+
+// BuildableEventFilter is a buildable version of EventFilter.
+type BuildableEventFilter interface {
+	EventFilter
+
+	// WithSince only returns events that happened after the specified time.
+	WithSince(since time.Time) BuildableEventFilter
+	// WithUntil only returns events that happened before the specified time.
+	WithUntil(until time.Time) BuildableEventFilter
+	// WithMinSeverity only returns events that are at least as severe as the specified severity. For example,
+	// EventSeverityError returns errors and alerts.
+	WithMinSeverity(severity EventSeverity) (BuildableEventFilter, error)
+	// MustWithMinSeverity is identical to WithMinSeverity, but panics instead of returning an error.
+	MustWithMinSeverity(severity EventSeverity) BuildableEventFilter
+	// WithOldestFirst returns the events in chronological order instead of newest first.
+	WithOldestFirst(oldestFirst bool) BuildableEventFilter
+}
+
+// NewEventFilter creates a new filter for ListEvents that matches all events.
+func NewEventFilter() BuildableEventFilter {
+	return &eventFilter{}
+}
+
+type eventFilter struct {
+	since       *time.Time
+	until       *time.Time
+	minSeverity *EventSeverity
+	oldestFirst bool
+}
+
+func (e *eventFilter) Since() *time.Time {
+	return e.since
+}
+
+func (e *eventFilter) Until() *time.Time {
+	return e.until
+}
+
+func (e *eventFilter) MinSeverity() *EventSeverity {
+	return e.minSeverity
+}
+
+func (e *eventFilter) OldestFirst() bool {
+	return e.oldestFirst
+}
+
+func (e *eventFilter) WithSince(since time.Time) BuildableEventFilter {
+	e.since = &since
+	return e
+}
+
+func (e *eventFilter) WithUntil(until time.Time) BuildableEventFilter {
+	e.until = &until
+	return e
+}
+
+func (e *eventFilter) WithMinSeverity(severity EventSeverity) (BuildableEventFilter, error) {
+	if err := severity.Validate(); err != nil {
+		return nil, err
+	}
+	e.minSeverity = &severity
+	return e, nil
+}
+
+func (e *eventFilter) MustWithMinSeverity(severity EventSeverity) BuildableEventFilter {
+	builder, err := e.WithMinSeverity(severity)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *eventFilter) WithOldestFirst(oldestFirst bool) BuildableEventFilter {
+	e.oldestFirst = oldestFirst
+	return e
+}
+
+type event struct {
+	id            EventID
+	code          int64
+	severity      EventSeverity
+	description   string
+	time          time.Time
+	correlationID string
+}
+
+func (e *event) ID() EventID {
+	return e.id
+}
+
+func (e *event) Code() int64 {
+	return e.code
+}
+
+func (e *event) Severity() EventSeverity {
+	return e.severity
+}
+
+func (e *event) Description() string {
+	return e.description
+}
+
+func (e *event) Time() time.Time {
+	return e.time
+}
+
+func (e *event) CorrelationID() string {
+	return e.correlationID
+}
+
+func convertSDKEvent(sdkObject *ovirtsdk.Event) (Event, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("event", "id")
+	}
+	code, ok := sdkObject.Code()
+	if !ok {
+		return nil, newFieldNotFound("event", "code")
+	}
+	severity, ok := sdkObject.Severity()
+	if !ok {
+		return nil, newFieldNotFound("event", "severity")
+	}
+	eventTime, ok := sdkObject.Time()
+	if !ok {
+		return nil, newFieldNotFound("event", "time")
+	}
+	description, _ := sdkObject.Description()
+	correlationID, _ := sdkObject.CorrelationId()
+	return &event{
+		id:            EventID(id),
+		code:          code,
+		severity:      EventSeverity(severity),
+		description:   description,
+		time:          eventTime,
+		correlationID: correlationID,
+	}, nil
+}
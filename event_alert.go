@@ -0,0 +1,106 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+// Alert is an event of alert severity that stays on the alerts tab of the oVirt Engine until it is dismissed, for
+// example a host that became non-responsive.
+type Alert interface {
+	Event
+
+	// Dismiss removes the alert from the engine. See EventClient.DismissAlert.
+	Dismiss(retries ...RetryStrategy) error
+}
+
+type alert struct {
+	Event
+
+	client Client
+}
+
+func (a *alert) Dismiss(retries ...RetryStrategy) error {
+	return a.client.DismissAlert(a.ID(), retries...)
+}
+
+func (o *oVirtClient) ListAlerts(retries ...RetryStrategy) ([]Alert, error) {
+	return listAlerts(o, retries)
+}
+
+func (m *mockClient) ListAlerts(retries ...RetryStrategy) ([]Alert, error) {
+	return listAlerts(m, retries)
+}
+
+func listAlerts(client Client, retries []RetryStrategy) ([]Alert, error) {
+	events, err := client.ListEvents(NewEventFilter().MustWithMinSeverity(EventSeverityAlert), retries...)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Alert, len(events))
+	for i, e := range events {
+		result[i] = &alert{Event: e, client: client}
+	}
+	return result, nil
+}
+
+func (o *oVirtClient) DismissAlert(id EventID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	action := fmt.Sprintf("dismissing alert %s", id)
+	service := o.conn.SystemService().EventsService().EventService(string(id))
+	fetchAction := fmt.Sprintf("fetching alert %s", id)
+	var e Event
+	if err := retry(
+		fetchAction,
+		o.logger,
+		retries,
+		func() error {
+			response, err := service.Get().Send()
+			if err != nil {
+				return wrapSDKError(fetchAction, err)
+			}
+			sdkEvent, ok := response.Event()
+			if !ok {
+				return newError(ENotFound, "alert with ID %s not found", id)
+			}
+			e, err = convertSDKEvent(sdkEvent)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert event %s", id)
+			}
+			return nil
+		}); err != nil {
+		return err
+	}
+	// Only events of alert severity are alerts, refuse to remove other audit log entries by accident.
+	if e.Severity() != EventSeverityAlert {
+		return newError(ENotFound, "event %s is not an alert (severity: %s)", id, e.Severity())
+	}
+	return retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			if _, err := service.Remove().Send(); err != nil {
+				err = wrapSDKError(action, err)
+				if HasErrorCode(err, ENotFound) {
+					// A previous attempt removed the alert, but the response was lost.
+					return nil
+				}
+				return err
+			}
+			return nil
+		})
+}
+
+func (m *mockClient) DismissAlert(id EventID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	e, ok := m.events[id]
+	if !ok {
+		return newError(ENotFound, "alert with ID %s not found", id)
+	}
+	if e.severity != EventSeverityAlert {
+		return newError(ENotFound, "event %s is not an alert (severity: %s)", id, e.severity)
+	}
+	delete(m.events, id)
+	return nil
+}
@@ -0,0 +1,93 @@
+package ovirtclient
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestListAndDismissAlerts(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	hostAlert := m.addEvent(9000, EventSeverityAlert, "host is not responding", "")
+	vmError := m.addEvent(119, EventSeverityError, "VM is down with error", "")
+
+	alerts, err := m.ListAlerts()
+	if err != nil {
+		t.Fatalf("Failed to list alerts (%v)", err)
+	}
+	if len(alerts) != 1 || alerts[0].ID() != hostAlert.id {
+		t.Fatalf("Incorrect alerts returned (expected: %s, got: %d alerts)", hostAlert.id, len(alerts))
+	}
+	if alerts[0].Description() != hostAlert.description || alerts[0].Severity() != EventSeverityAlert {
+		t.Fatalf("Incorrect alert description or severity (%s, %s)", alerts[0].Description(), alerts[0].Severity())
+	}
+
+	if err := m.DismissAlert(vmError.id); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Dismissing an error event did not result in an ENotFound error (%v)", err)
+	}
+	if err := m.DismissAlert("00000000-0000-0000-0000-000000000001"); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Dismissing a nonexistent alert did not result in an ENotFound error (%v)", err)
+	}
+
+	if err := alerts[0].Dismiss(); err != nil {
+		t.Fatalf("Failed to dismiss alert %s (%v)", alerts[0].ID(), err)
+	}
+	alerts, err = m.ListAlerts()
+	if err != nil {
+		t.Fatalf("Failed to list alerts (%v)", err)
+	}
+	if len(alerts) != 0 {
+		t.Fatalf("%d alerts left after dismissing the only alert", len(alerts))
+	}
+	if _, ok := m.events[vmError.id]; !ok {
+		t.Fatalf("Dismissing an alert removed an unrelated event.")
+	}
+}
+
+func TestDismissAlertRetriesOnlyTheRemoval(t *testing.T) {
+	t.Parallel()
+	var lock sync.Mutex
+	gets := 0
+	removals := 0
+	client := newFakeEngineClient(t, func(writer http.ResponseWriter, request *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if request.URL.Path != "/ovirt-engine/api/events/1" {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch request.Method {
+		case http.MethodGet:
+			gets++
+			writeFakeEngineXML(
+				writer,
+				`<event id="1"><code>9000</code><severity>alert</severity>`+
+					`<time>2021-01-01T00:00:00.000Z</time></event>`,
+			)
+		case http.MethodDelete:
+			removals++
+			// The first removal succeeds on the engine, but the response is lost on the way.
+			if removals == 1 {
+				writer.WriteHeader(http.StatusServiceUnavailable)
+			} else {
+				writer.WriteHeader(http.StatusNotFound)
+			}
+		default:
+			writer.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	if err := client.DismissAlert("1", MaxTries(3), FixedWait(time.Millisecond)); err != nil {
+		t.Fatalf("Dismissing an alert removed by an earlier attempt failed (%v)", err)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if gets != 1 {
+		t.Fatalf("The alert was fetched %d times instead of once.", gets)
+	}
+	if removals != 2 {
+		t.Fatalf("Incorrect number of removal attempts (expected: 2, got: %d)", removals)
+	}
+}
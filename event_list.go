@@ -0,0 +1,145 @@
+package ovirtclient
+
+import (
+	"sort"
+	"time"
+)
+
+// eventSearchTimeFormat is the date format the oVirt Engine accepts for the time field in event searches.
+const eventSearchTimeFormat = "01/02/2006 15:04:05"
+
+// buildEventSearchQuery translates the filter to the engine search syntax. It returns an empty string if the filter
+// matches all events. The times are sent in UTC, so the engine must run in UTC for the search to be exact; ListEvents
+// filters the results again locally to compensate.
+func buildEventSearchQuery(filter EventFilter) (string, error) {
+	var conditions []SearchQuery
+	if since := filter.Since(); since != nil {
+		conditions = append(conditions, SearchField("time").GreaterThan(since.UTC().Format(eventSearchTimeFormat)))
+	}
+	if until := filter.Until(); until != nil {
+		conditions = append(conditions, SearchField("time").LessThan(until.UTC().Format(eventSearchTimeFormat)))
+	}
+	if minSeverity := filter.MinSeverity(); minSeverity != nil {
+		var severityConditions []SearchQuery
+		for _, severity := range minSeverity.AtLeast() {
+			severityConditions = append(severityConditions, SearchField("severity").Eq(string(severity)))
+		}
+		if len(severityConditions) == 0 {
+			return "", newError(EBadArgument, "invalid minimum event severity: %s", *minSeverity)
+		}
+		conditions = append(conditions, combineSearchQueries("OR", severityConditions))
+	}
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return combineSearchQueries("AND", conditions).Build()
+}
+
+// eventMatchesFilter returns true if the event is within the time range and severity floor of the filter.
+func eventMatchesFilter(e Event, filter EventFilter) bool {
+	if since := filter.Since(); since != nil && !e.Time().After(*since) {
+		return false
+	}
+	if until := filter.Until(); until != nil && !e.Time().Before(*until) {
+		return false
+	}
+	if minSeverity := filter.MinSeverity(); minSeverity != nil {
+		for _, severity := range minSeverity.AtLeast() {
+			if e.Severity() == severity {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// filterAndSortEvents removes the events not matching the filter and sorts the rest by time, newest first unless the
+// filter requests otherwise.
+func filterAndSortEvents(events []Event, filter EventFilter) []Event {
+	result := make([]Event, 0, len(events))
+	for _, e := range events {
+		if eventMatchesFilter(e, filter) {
+			result = append(result, e)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if filter.OldestFirst() {
+			return result[i].Time().Before(result[j].Time())
+		}
+		return result[i].Time().After(result[j].Time())
+	})
+	return result
+}
+
+func (o *oVirtClient) ListEvents(filter EventFilter, retries ...RetryStrategy) (result []Event, err error) {
+	if filter == nil {
+		filter = NewEventFilter()
+	}
+	query, err := buildEventSearchQuery(filter)
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := "listing events"
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			request := o.conn.SystemService().EventsService().List()
+			if query != "" {
+				request.Search(query)
+			}
+			response, err := request.Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkObjects, ok := response.Events()
+			if !ok {
+				return nil
+			}
+			result = make([]Event, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], err = convertSDKEvent(sdkObject)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert event during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return filterAndSortEvents(result, filter), nil
+}
+
+func (m *mockClient) ListEvents(filter EventFilter, _ ...RetryStrategy) ([]Event, error) {
+	if filter == nil {
+		filter = NewEventFilter()
+	}
+	if _, err := buildEventSearchQuery(filter); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	events := make([]Event, 0, len(m.events))
+	for _, e := range m.events {
+		events = append(events, e)
+	}
+	return filterAndSortEvents(events, filter), nil
+}
+
+// addEvent records an event in the mock audit log. The caller must hold the lock.
+func (m *mockClient) addEvent(code int64, severity EventSeverity, description string, correlationID string) *event {
+	e := &event{
+		id:            EventID(m.GenerateUUID()),
+		code:          code,
+		severity:      severity,
+		description:   description,
+		time:          time.Now(),
+		correlationID: correlationID,
+	}
+	m.events[e.id] = e
+	return e
+}
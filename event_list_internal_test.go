@@ -0,0 +1,86 @@
+package ovirtclient
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEventSearchQuery(t *testing.T) {
+	t.Parallel()
+	since := time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC)
+	until := since.Add(time.Hour)
+	query, err := buildEventSearchQuery(
+		NewEventFilter().WithSince(since).WithUntil(until).MustWithMinSeverity(EventSeverityError),
+	)
+	if err != nil {
+		t.Fatalf("Failed to build event search query (%v)", err)
+	}
+	expected := `(time > "03/04/2022 05:06:07") AND (time < "03/04/2022 06:06:07") AND ` +
+		`((severity = "error") OR (severity = "alert"))`
+	if query != expected {
+		t.Fatalf("Incorrect event search query (expected: %s, got: %s)", expected, query)
+	}
+
+	query, err = buildEventSearchQuery(NewEventFilter())
+	if err != nil {
+		t.Fatalf("Failed to build empty event search query (%v)", err)
+	}
+	if query != "" {
+		t.Fatalf("Non-empty search query for an empty filter: %s", query)
+	}
+}
+
+func TestListEventsWithFilter(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	now := time.Now()
+	oldError := m.addEvent(1, EventSeverityError, "old error", "")
+	oldError.time = now.Add(-2 * time.Hour)
+	recentError := m.addEvent(2, EventSeverityError, "recent error", "")
+	recentError.time = now.Add(-30 * time.Minute)
+	recentAlert := m.addEvent(3, EventSeverityAlert, "recent alert", "")
+	recentAlert.time = now.Add(-10 * time.Minute)
+	recentNormal := m.addEvent(4, EventSeverityNormal, "recent info", "")
+	recentNormal.time = now.Add(-5 * time.Minute)
+
+	events, err := m.ListEvents(
+		NewEventFilter().WithSince(now.Add(-time.Hour)).MustWithMinSeverity(EventSeverityError),
+	)
+	if err != nil {
+		t.Fatalf("Failed to list events (%v)", err)
+	}
+	assertEventIDs(t, events, recentAlert.id, recentError.id)
+
+	events, err = m.ListEvents(
+		NewEventFilter().
+			WithSince(now.Add(-time.Hour)).
+			MustWithMinSeverity(EventSeverityError).
+			WithOldestFirst(true),
+	)
+	if err != nil {
+		t.Fatalf("Failed to list events (%v)", err)
+	}
+	assertEventIDs(t, events, recentError.id, recentAlert.id)
+
+	events, err = m.ListEvents(NewEventFilter().WithUntil(now.Add(-time.Hour)))
+	if err != nil {
+		t.Fatalf("Failed to list events (%v)", err)
+	}
+	assertEventIDs(t, events, oldError.id)
+
+	if _, err := NewEventFilter().WithMinSeverity("invalid"); err == nil || !HasErrorCode(err, EBadArgument) {
+		t.Fatalf("Setting an invalid minimum severity did not result in an EBadArgument error (%v)", err)
+	}
+}
+
+func assertEventIDs(t *testing.T, events []Event, expected ...EventID) {
+	t.Helper()
+	if len(events) != len(expected) {
+		t.Fatalf("Incorrect number of events (expected: %d, got: %d)", len(expected), len(events))
+	}
+	for i, e := range events {
+		if e.ID() != expected[i] {
+			t.Fatalf("Incorrect event at position %d (expected: %s, got: %s)", i, expected[i], e.ID())
+		}
+	}
+}
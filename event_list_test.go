@@ -0,0 +1,25 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestListEventsNewestFirst(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	events, err := helper.GetClient().ListEvents(
+		ovirtclient.NewEventFilter().WithSince(time.Now().Add(-time.Hour)),
+	)
+	if err != nil {
+		t.Fatalf("Failed to list events of the last hour (%v)", err)
+	}
+	for i := 1; i < len(events); i++ {
+		if events[i].Time().After(events[i-1].Time()) {
+			t.Fatalf("Events are not sorted newest first (event %s is newer than %s)", events[i].ID(), events[i-1].ID())
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+// newOperationEventFilter returns the filter GetOperationEvents sorts the events with.
+func newOperationEventFilter() EventFilter {
+	return NewEventFilter().WithOldestFirst(true)
+}
+
+// filterOperationEvents returns the events of the specified correlation ID, oldest first.
+func filterOperationEvents(events []Event, correlationID string) []Event {
+	result := make([]Event, 0, len(events))
+	for _, e := range events {
+		if e.CorrelationID() == correlationID {
+			result = append(result, e)
+		}
+	}
+	return filterAndSortEvents(result, newOperationEventFilter())
+}
+
+func validateCorrelationID(correlationID string) error {
+	if correlationID == "" {
+		return newError(EBadArgument, "the correlation ID cannot be empty")
+	}
+	return nil
+}
+
+func (o *oVirtClient) GetOperationEvents(correlationID string, retries ...RetryStrategy) (result []Event, err error) {
+	if err := validateCorrelationID(correlationID); err != nil {
+		return nil, err
+	}
+	query, err := SearchField("correlation_id").Eq(correlationID).Build()
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("listing events for correlation ID %s", correlationID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().EventsService().List().Search(query).Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkObjects, ok := response.Events()
+			if !ok {
+				result = []Event{}
+				return nil
+			}
+			result = make([]Event, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], err = convertSDKEvent(sdkObject)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert event during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	// The search also matches correlation IDs containing wildcards, so the results are filtered again.
+	return filterOperationEvents(result, correlationID), nil
+}
+
+func (m *mockClient) GetOperationEvents(correlationID string, _ ...RetryStrategy) ([]Event, error) {
+	if err := validateCorrelationID(correlationID); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	events := make([]Event, 0, len(m.events))
+	for _, e := range m.events {
+		events = append(events, e)
+	}
+	return filterOperationEvents(events, correlationID), nil
+}
@@ -0,0 +1,34 @@
+package ovirtclient
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetOperationEvents(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	now := time.Now()
+	finished := m.addEvent(2, EventSeverityError, "operation failed", "op_1")
+	finished.time = now.Add(-time.Minute)
+	started := m.addEvent(1, EventSeverityNormal, "operation started", "op_1")
+	started.time = now.Add(-2 * time.Minute)
+	m.addEvent(3, EventSeverityNormal, "other operation", "op_2")
+	m.addEvent(4, EventSeverityNormal, "uncorrelated", "")
+
+	events, err := m.GetOperationEvents("op_1")
+	if err != nil {
+		t.Fatalf("Failed to get operation events (%v)", err)
+	}
+	assertEventIDs(t, events, started.id, finished.id)
+
+	events, err = m.GetOperationEvents("op_3")
+	if err != nil {
+		t.Fatalf("Failed to get operation events (%v)", err)
+	}
+	assertEventIDs(t, events)
+
+	if _, err := m.GetOperationEvents(""); !HasErrorCode(err, EBadArgument) {
+		t.Fatalf("An empty correlation ID did not result in an EBadArgument error (%v)", err)
+	}
+}
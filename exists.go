@@ -0,0 +1,63 @@
+package ovirtclient
+
+// existsFromGetError converts the error returned from a Get* call into the result of an existence check. ENotFound
+// means the object doesn't exist, any other error is passed on since it leaves the question unanswered.
+func existsFromGetError(err error) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+	if HasErrorCode(err, ENotFound) {
+		return false, nil
+	}
+	return false, err
+}
+
+func (o *oVirtClient) VMExists(id VMID, retries ...RetryStrategy) (bool, error) {
+	_, err := o.GetVM(id, retries...)
+	return existsFromGetError(err)
+}
+
+func (o *oVirtClient) DiskExists(diskID DiskID, retries ...RetryStrategy) (bool, error) {
+	_, err := o.GetDisk(diskID, retries...)
+	return existsFromGetError(err)
+}
+
+func (o *oVirtClient) TemplateExists(id TemplateID, retries ...RetryStrategy) (bool, error) {
+	_, err := o.GetTemplate(id, retries...)
+	return existsFromGetError(err)
+}
+
+func (o *oVirtClient) TagExists(id TagID, retries ...RetryStrategy) (bool, error) {
+	_, err := o.GetTag(id, retries...)
+	return existsFromGetError(err)
+}
+
+func (o *oVirtClient) StorageDomainExists(id StorageDomainID, retries ...RetryStrategy) (bool, error) {
+	_, err := o.GetStorageDomain(id, retries...)
+	return existsFromGetError(err)
+}
+
+func (m *mockClient) VMExists(id VMID, retries ...RetryStrategy) (bool, error) {
+	_, err := m.GetVM(id, retries...)
+	return existsFromGetError(err)
+}
+
+func (m *mockClient) DiskExists(diskID DiskID, retries ...RetryStrategy) (bool, error) {
+	_, err := m.GetDisk(diskID, retries...)
+	return existsFromGetError(err)
+}
+
+func (m *mockClient) TemplateExists(id TemplateID, retries ...RetryStrategy) (bool, error) {
+	_, err := m.GetTemplate(id, retries...)
+	return existsFromGetError(err)
+}
+
+func (m *mockClient) TagExists(id TagID, retries ...RetryStrategy) (bool, error) {
+	_, err := m.GetTag(id, retries...)
+	return existsFromGetError(err)
+}
+
+func (m *mockClient) StorageDomainExists(id StorageDomainID, retries ...RetryStrategy) (bool, error) {
+	_, err := m.GetStorageDomain(id, retries...)
+	return existsFromGetError(err)
+}
@@ -0,0 +1,52 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestExists(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+
+	vmID := VMID(m.GenerateUUID())
+	exists, err := m.VMExists(vmID)
+	if err != nil {
+		t.Fatalf("Checking a nonexistent VM resulted in an error (%v)", err)
+	}
+	if exists {
+		t.Fatalf("Nonexistent VM reported as existing.")
+	}
+	m.vms[vmID] = &vm{client: m, id: vmID, name: "test", status: VMStatusDown}
+	exists, err = m.VMExists(vmID)
+	if err != nil {
+		t.Fatalf("Checking an existing VM resulted in an error (%v)", err)
+	}
+	if !exists {
+		t.Fatalf("Existing VM reported as nonexistent.")
+	}
+
+	exists, err = m.DiskExists(DiskID(m.GenerateUUID()))
+	if err != nil || exists {
+		t.Fatalf("Nonexistent disk reported as existing (exists: %t, error: %v)", exists, err)
+	}
+}
+
+func TestExistsFromGetError(t *testing.T) {
+	t.Parallel()
+
+	exists, err := existsFromGetError(newError(ENotFound, "not found"))
+	if err != nil || exists {
+		t.Fatalf("ENotFound not converted to a nonexistent result (exists: %t, error: %v)", exists, err)
+	}
+
+	exists, err = existsFromGetError(newError(ETimeout, "timeout"))
+	if err == nil {
+		t.Fatalf("A transient error was not passed on.")
+	}
+	if exists {
+		t.Fatalf("An object reported as existing despite an error.")
+	}
+	if !HasErrorCode(err, ETimeout) {
+		t.Fatalf("The transient error was changed (%v)", err)
+	}
+}
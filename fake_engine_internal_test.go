@@ -0,0 +1,49 @@
+package ovirtclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+)
+
+// newFakeEngineClient connects a client to a fake engine. The fake engine answers the SSO login itself and passes
+// all API calls to handler.
+func newFakeEngineClient(t *testing.T, handler http.HandlerFunc) Client {
+	return newFakeEngineClientWithSettings(t, nil, handler)
+}
+
+// newFakeEngineClientWithSettings is newFakeEngineClient with extra settings for the connection.
+func newFakeEngineClientWithSettings(t *testing.T, extraSettings ExtraSettings, handler http.HandlerFunc) Client {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/ovirt-engine/sso/oauth/token" {
+			writer.Header().Set("Content-Type", "application/json")
+			_, _ = writer.Write([]byte(`{"access_token":"test"}`))
+			return
+		}
+		handler(writer, request)
+	}))
+	t.Cleanup(srv.Close)
+	client, err := NewWithVerify(
+		srv.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"invalid-password-for-testing-purposes",
+		TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		extraSettings,
+		func(connection Client) error {
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("failed to set up connection (%v)", err)
+	}
+	return client
+}
+
+// writeFakeEngineXML sends an XML response from the fake engine.
+func writeFakeEngineXML(writer http.ResponseWriter, body string) {
+	writer.Header().Set("Content-Type", "application/xml")
+	_, _ = writer.Write([]byte(body))
+}
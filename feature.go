@@ -1,6 +1,8 @@
 package ovirtclient
 
 import (
+	"sync"
+
 	ovirtsdk "github.com/ovirt/go-ovirt"
 )
 
@@ -14,35 +16,75 @@ const (
 
 	// FeaturePlacementPolicy is a feature flag to indicate placement policy support in the oVirt Engine.
 	FeaturePlacementPolicy Feature = "placement_policy"
+
+	// FeatureMemoryHotUnplug is a feature flag for removing memory from running VMs, supported since 4.2.
+	FeatureMemoryHotUnplug Feature = "memory_hot_unplug"
+
+	// FeatureIncrementalBackup is a feature flag for incremental VM backups, supported since 4.4.
+	FeatureIncrementalBackup Feature = "incremental_backup"
+
+	// FeatureQ35SecureBoot is a feature flag for the Q35 chipset with UEFI Secure Boot, supported since 4.4.
+	FeatureQ35SecureBoot Feature = "q35_secure_boot"
 )
 
+// featureMinimumVersions holds the first engine version supporting each feature. New feature flags only need an
+// entry here to work with SupportsFeature and GetEngineCapabilities.
+var featureMinimumVersions = map[Feature]engineVersion{
+	FeatureAutoPinning:       {4, 4, 5, 0},
+	FeaturePlacementPolicy:   {4, 4, 5, 0},
+	FeatureMemoryHotUnplug:   {4, 2, 0, 0},
+	FeatureIncrementalBackup: {4, 4, 0, 0},
+	FeatureQ35SecureBoot:     {4, 4, 0, 0},
+}
+
 // FeatureClient provides the functions to determine the capabilities of the oVirt Engine.
 type FeatureClient interface {
 	// SupportsFeature checks the features supported by the oVirt Engine.
 	SupportsFeature(feature Feature, retries ...RetryStrategy) (bool, error)
+	// GetEngineVersion returns the version of the oVirt Engine. The version is cached, call Refresh to fetch it
+	// again after an engine upgrade.
+	GetEngineVersion(retries ...RetryStrategy) (EngineVersion, error)
+	// GetEngineCapabilities returns the features supported by the oVirt Engine as determined from its version. Use
+	// this instead of comparing engine versions when a call depends on a feature.
+	GetEngineCapabilities(retries ...RetryStrategy) (EngineCapabilities, error)
 }
 
 func (o *oVirtClient) SupportsFeature(feature Feature, retries ...RetryStrategy) (result bool, err error) {
-	var minimumVersion *ovirtsdk.Version
-	switch feature {
-	case FeatureAutoPinning:
-		minimumVersion = ovirtsdk.NewVersionBuilder().
-			Major(4).
-			Minor(4).
-			Build_(5).
-			Revision(0).
-			MustBuild()
-	case FeaturePlacementPolicy:
-		minimumVersion = ovirtsdk.NewVersionBuilder().
-			Major(4).
-			Minor(4).
-			Build_(5).
-			Revision(0).
-			MustBuild()
-	default:
+	if _, ok := featureMinimumVersions[feature]; !ok {
 		return false, newError(EBug, "unknown feature: %s", feature)
 	}
+	capabilities, err := o.GetEngineCapabilities(retries...)
+	if err != nil {
+		return false, err
+	}
+	return capabilities.Supports(feature), nil
+}
+
+func (o *oVirtClient) GetEngineVersion(retries ...RetryStrategy) (EngineVersion, error) {
+	engineVer, err := o.getEngineVersion(retries)
+	if err != nil {
+		return nil, err
+	}
+	return convertSDKVersion(engineVer), nil
+}
+
+func (o *oVirtClient) GetEngineCapabilities(retries ...RetryStrategy) (EngineCapabilities, error) {
+	engineVer, err := o.getEngineVersion(retries)
+	if err != nil {
+		return nil, err
+	}
+	return newEngineCapabilities(convertSDKVersion(engineVer)), nil
+}
+
+// getEngineVersion returns the cached engine version, fetching it first if needed.
+func (o *oVirtClient) getEngineVersion(retries []RetryStrategy) (*ovirtsdk.Version, error) {
+	if engineVer := o.engineVersion.get(); engineVer != nil {
+		return engineVer, nil
+	}
+	return o.fetchEngineVersion(retries)
+}
 
+func (o *oVirtClient) fetchEngineVersion(retries []RetryStrategy) (engineVer *ovirtsdk.Version, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		"fetching engine version",
@@ -53,28 +95,72 @@ func (o *oVirtClient) SupportsFeature(feature Feature, retries ...RetryStrategy)
 			if err != nil {
 				return err
 			}
-			engineVer := systemGetResponse.MustApi().MustProductInfo().MustVersion()
-			versionCompareResult := versionCompare(engineVer, minimumVersion)
-			result = versionCompareResult >= 0
+			engineVer = systemGetResponse.MustApi().MustProductInfo().MustVersion()
 			return nil
 		})
-	return result, err
+	if err != nil {
+		return nil, err
+	}
+	o.engineVersion.set(engineVer)
+	return engineVer, nil
+}
+
+func (o *oVirtClient) Refresh(retries ...RetryStrategy) error {
+	o.engineVersion.clear()
+	_, err := o.fetchEngineVersion(retries)
+	return err
+}
+
+// engineVersionCache holds the engine version for a client and its subclients. A nil cache caches nothing.
+type engineVersionCache struct {
+	lock    *sync.Mutex
+	version *ovirtsdk.Version
 }
 
-func versionCompare(v *ovirtsdk.Version, other *ovirtsdk.Version) int64 {
-	if result := v.MustMajor() - other.MustMajor(); result != 0 {
-		return result
+func newEngineVersionCache() *engineVersionCache {
+	return &engineVersionCache{
+		lock: &sync.Mutex{},
 	}
-	if result := v.MustMinor() - other.MustMinor(); result != 0 {
-		return result
+}
+
+func (e *engineVersionCache) get() *ovirtsdk.Version {
+	if e == nil {
+		return nil
 	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.version
+}
 
-	if result := v.MustBuild() - other.MustBuild(); result != 0 {
-		return result
+func (e *engineVersionCache) set(version *ovirtsdk.Version) {
+	if e == nil {
+		return
 	}
-	return v.MustRevision() - other.MustRevision()
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.version = version
+}
+
+func (e *engineVersionCache) clear() {
+	e.set(nil)
 }
 
 func (m *mockClient) SupportsFeature(_ Feature, _ ...RetryStrategy) (bool, error) {
 	return true, nil
 }
+
+// mockEngineVersion is the engine version the mock reports. It is recent enough to support all features.
+var mockEngineVersion = engineVersion{4, 5, 4, 0}
+
+func (m *mockClient) GetEngineVersion(_ ...RetryStrategy) (EngineVersion, error) {
+	return mockEngineVersion, nil
+}
+
+func (m *mockClient) GetEngineCapabilities(_ ...RetryStrategy) (EngineCapabilities, error) {
+	return newEngineCapabilities(mockEngineVersion), nil
+}
+
+// Refresh does nothing since the mock doesn't cache anything.
+func (m *mockClient) Refresh(_ ...RetryStrategy) error {
+	return nil
+}
@@ -19,6 +19,9 @@ func TestFeatureFlags(t *testing.T) {
 		"Feature Placement Policy": {
 			input: ovirtclient.FeatureAutoPinning,
 		},
+		"Feature Memory Hot-Unplug": {
+			input: ovirtclient.FeatureMemoryHotUnplug,
+		},
 	}
 
 	for name, tc := range testcases {
@@ -35,3 +38,63 @@ func TestFeatureFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestRefreshFeatureFlags(t *testing.T) {
+	t.Parallel()
+	client := getHelper(t).GetClient()
+
+	before, err := client.SupportsFeature(ovirtclient.FeatureMemoryHotUnplug)
+	if err != nil {
+		t.Fatalf("Failed to check feature support (%v)", err)
+	}
+	if err := client.Refresh(); err != nil {
+		t.Fatalf("Failed to refresh the client (%v)", err)
+	}
+	after, err := client.SupportsFeature(ovirtclient.FeatureMemoryHotUnplug)
+	if err != nil {
+		t.Fatalf("Failed to check feature support after refresh (%v)", err)
+	}
+	if before != after {
+		t.Fatalf("Feature support changed after refreshing without an engine upgrade.")
+	}
+}
+
+func TestEngineCapabilities(t *testing.T) {
+	t.Parallel()
+	client := getHelper(t).GetClient()
+
+	version, err := client.GetEngineVersion()
+	if err != nil {
+		t.Fatalf("Failed to fetch engine version (%v)", err)
+	}
+	if version.Major() < 4 {
+		t.Fatalf("Unexpected engine version %s", version)
+	}
+	capabilities, err := client.GetEngineCapabilities()
+	if err != nil {
+		t.Fatalf("Failed to fetch engine capabilities (%v)", err)
+	}
+	if capabilities.Version().String() != version.String() {
+		t.Fatalf(
+			"The capabilities were determined from a different version (expected: %s, got: %s)",
+			version,
+			capabilities.Version(),
+		)
+	}
+	for _, feature := range []ovirtclient.Feature{
+		ovirtclient.FeatureMemoryHotUnplug,
+		ovirtclient.FeatureIncrementalBackup,
+		ovirtclient.FeatureQ35SecureBoot,
+	} {
+		supported, err := client.SupportsFeature(feature)
+		if err != nil {
+			t.Fatalf("Failed to check '%s' support (%v)", feature, err)
+		}
+		if supported != capabilities.Supports(feature) {
+			t.Fatalf("SupportsFeature and the engine capabilities disagree on '%s'", feature)
+		}
+	}
+	if capabilities.Supports("nonexistent") {
+		t.Fatalf("An unknown feature was reported as supported.")
+	}
+}
@@ -1,6 +1,8 @@
 package ovirtclient
 
 import (
+	"strings"
+
 	ovirtsdk4 "github.com/ovirt/go-ovirt"
 )
 
@@ -13,16 +15,86 @@ type HostID string
 type HostClient interface {
 	ListHosts(retries ...RetryStrategy) ([]Host, error)
 	GetHost(id HostID, retries ...RetryStrategy) (Host, error)
+	// FenceHost executes a power management action on the specified host. The host must have power management
+	// configured, otherwise an EConflict error is returned. Use GetHostPowerStatus to query the power status, the
+	// FenceActionStatus action only checks that the power management agent is reachable.
+	FenceHost(id HostID, action FenceAction, retries ...RetryStrategy) error
+	// GetHostPowerStatus returns the power status of the host as reported by its power management agent. The host
+	// must have power management configured, otherwise an EConflict error is returned.
+	GetHostPowerStatus(id HostID, retries ...RetryStrategy) (HostPowerStatus, error)
+	// DeactivateHost puts the host into maintenance and waits until it reaches HostStatusMaintenance. The engine
+	// live migrates the VMs running on the host to other hosts first. A warning is logged if the hosted engine is in
+	// global maintenance.
+	DeactivateHost(id HostID, retries ...RetryStrategy) error
+	// DeactivateHostWithReason is identical to DeactivateHost, but records the reason for the maintenance in the audit
+	// log of the engine. The reason may be at most ActionReasonMaxLength characters long.
+	DeactivateHostWithReason(id HostID, reason string, retries ...RetryStrategy) error
+	// ActivateHost takes the host out of maintenance and waits until it reaches HostStatusUp.
+	ActivateHost(id HostID, retries ...RetryStrategy) error
+	// WaitForHostStatus waits until the host reaches the specified status.
+	WaitForHostStatus(id HostID, status HostStatus, retries ...RetryStrategy) (Host, error)
+	// MoveHost moves the host to a different cluster. An active host is put into maintenance first and is activated
+	// again after the move. If the host has running VMs, an EConflict error is returned unless VM migration is
+	// allowed in params. The params may be nil.
+	MoveHost(id HostID, clusterID ClusterID, params MoveHostParameters, retries ...RetryStrategy) error
+	// DiscoverISCSITargets asks the host to discover the iSCSI targets exported on the portal at address and port and
+	// returns their IQNs and portals. Most portals listen on DefaultISCSIPort. The host must be up.
+	DiscoverISCSITargets(hostID HostID, address string, port uint16, retries ...RetryStrategy) ([]ISCSITarget, error)
+	// GetGlobalMaintenanceMode returns true if the hosted engine is in global maintenance. While global maintenance is
+	// active the HA agents don't monitor the engine VM, so operations touching the hosted-engine hosts may fail in
+	// unexpected ways. An EUnsupported error is returned if the engine is not a hosted engine deployment.
+	GetGlobalMaintenanceMode(retries ...RetryStrategy) (bool, error)
+	// ListHostNICs returns the network interfaces of the host.
+	ListHostNICs(hostID HostID, retries ...RetryStrategy) ([]HostNIC, error)
+	// AttachNetworkToHost attaches the logical network to the network interface named nicName on the host and
+	// configures its IP address. The engine verifies the connectivity to the host and commits the configuration, so
+	// it survives a reboot. An existing attachment of the network is moved to the interface. An ENotFound error is
+	// returned if the interface or the network doesn't exist. A nil config attaches the network without an IP
+	// address.
+	AttachNetworkToHost(
+		hostID HostID,
+		networkID NetworkID,
+		nicName string,
+		config IPConfig,
+		retries ...RetryStrategy,
+	) error
+	// DetachNetworkFromHost removes the logical network from the host and commits the configuration. An ENotFound
+	// error is returned if the network is not attached to the host.
+	DetachNetworkFromHost(hostID HostID, networkID NetworkID, retries ...RetryStrategy) error
+	// AddHost adds a new host with the specified name and address to the cluster and waits until the engine has
+	// installed it and the host is up. The engine connects to the host via SSH using the credentials in params. An
+	// EHostInstallFailed error is returned if the installation fails.
+	AddHost(
+		clusterID ClusterID,
+		name string,
+		address string,
+		params HostInstallParameters,
+		retries ...RetryStrategy,
+	) (Host, error)
+	// ReinstallHost reinstalls the host and waits until it is up again. The host must be in maintenance, otherwise an
+	// EConflict error is returned. An EHostInstallFailed error is returned if the installation fails.
+	ReinstallHost(id HostID, params HostInstallParameters, retries ...RetryStrategy) error
+	// ListHostDevices returns the devices of the host, such as PCI cards, that can be passed through to VMs.
+	ListHostDevices(hostID HostID, retries ...RetryStrategy) ([]HostDevice, error)
 }
 
 // HostData is the core of Host, providing only data access functions.
 type HostData interface {
 	// ID returns the identifier of the host in question.
 	ID() HostID
+	// Name returns the user-given name of the host.
+	Name() string
 	// ClusterID returns the ID of the cluster this host belongs to.
 	ClusterID() ClusterID
 	// Status returns the status of this host.
 	Status() HostStatus
+	// PowerManagementEnabled returns true if power management (fencing) is configured for this host.
+	PowerManagementEnabled() bool
+	// Memory returns the physical memory of the host in bytes, or 0 if the engine hasn't reported it yet.
+	Memory() uint64
+	// CPUCount returns the number of logical CPUs (sockets * cores * threads) of the host, or 0 if the engine hasn't
+	// reported the CPU topology yet.
+	CPUCount() uint
 }
 
 // Host is the representation of a host returned from the oVirt Engine API. Hosts, also known as hypervisors, are the
@@ -32,6 +104,106 @@ type HostData interface {
 // See https://www.ovirt.org/documentation/administration_guide/#chap-Hosts for details.
 type Host interface {
 	HostData
+
+	// Fence executes a power management action on the current host. See HostClient.FenceHost for details.
+	Fence(action FenceAction, retries ...RetryStrategy) error
+	// PowerStatus queries the power management agent of the host for its power status.
+	PowerStatus(retries ...RetryStrategy) (HostPowerStatus, error)
+	// Deactivate puts the current host into maintenance. See HostClient.DeactivateHost for details.
+	Deactivate(retries ...RetryStrategy) error
+	// Activate takes the current host out of maintenance. See HostClient.ActivateHost for details.
+	Activate(retries ...RetryStrategy) error
+	// Reinstall reinstalls the current host. See HostClient.ReinstallHost for details.
+	Reinstall(params HostInstallParameters, retries ...RetryStrategy) error
+	// MoveToCluster moves the current host to a different cluster. See HostClient.MoveHost for details.
+	MoveToCluster(clusterID ClusterID, params MoveHostParameters, retries ...RetryStrategy) error
+	// DiscoverISCSITargets discovers the iSCSI targets on a portal through the current host. See
+	// HostClient.DiscoverISCSITargets for details.
+	DiscoverISCSITargets(address string, port uint16, retries ...RetryStrategy) ([]ISCSITarget, error)
+}
+
+// FenceAction is a power management action that can be executed on a host.
+type FenceAction string
+
+const (
+	// FenceActionStart powers on the host.
+	FenceActionStart FenceAction = "start"
+	// FenceActionStop powers off the host.
+	FenceActionStop FenceAction = "stop"
+	// FenceActionRestart power cycles the host.
+	FenceActionRestart FenceAction = "restart"
+	// FenceActionStatus checks the status of the power management agent.
+	FenceActionStatus FenceAction = "status"
+)
+
+// FenceActionList is a list of FenceAction.
+type FenceActionList []FenceAction
+
+// FenceActionValues returns all possible FenceAction values.
+func FenceActionValues() FenceActionList {
+	return []FenceAction{
+		FenceActionStart,
+		FenceActionStop,
+		FenceActionRestart,
+		FenceActionStatus,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l FenceActionList) Strings() []string {
+	result := make([]string, len(l))
+	for i, action := range l {
+		result[i] = string(action)
+	}
+	return result
+}
+
+// Validate returns an error if the fence action is not valid.
+func (f FenceAction) Validate() error {
+	for _, action := range FenceActionValues() {
+		if action == f {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid fence action: %s must be one of: %s",
+		f,
+		strings.Join(FenceActionValues().Strings(), ", "),
+	)
+}
+
+// HostPowerStatus is the power status of a host as reported by its power management agent.
+type HostPowerStatus string
+
+const (
+	// HostPowerStatusOn indicates that the host is powered on.
+	HostPowerStatusOn HostPowerStatus = "on"
+	// HostPowerStatusOff indicates that the host is powered off.
+	HostPowerStatusOff HostPowerStatus = "off"
+	// HostPowerStatusUnknown indicates that the power management agent could not determine the power status.
+	HostPowerStatusUnknown HostPowerStatus = "unknown"
+)
+
+// HostPowerStatusList is a list of HostPowerStatus.
+type HostPowerStatusList []HostPowerStatus
+
+// HostPowerStatusValues returns all possible HostPowerStatus values.
+func HostPowerStatusValues() HostPowerStatusList {
+	return []HostPowerStatus{
+		HostPowerStatusOn,
+		HostPowerStatusOff,
+		HostPowerStatusUnknown,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l HostPowerStatusList) Strings() []string {
+	result := make([]string, len(l))
+	for i, status := range l {
+		result[i] = string(status)
+	}
+	return result
 }
 
 // HostStatus represents the complex states an oVirt host can be in.
@@ -130,26 +302,78 @@ func convertSDKHost(sdkHost *ovirtsdk4.Host, client Client) (Host, error) {
 	if !ok {
 		return nil, newError(EFieldMissing, "failed to fetch cluster ID from host %s", id)
 	}
-	return &host{
-		client:    client,
-		id:        HostID(id),
-		status:    HostStatus(status),
-		clusterID: ClusterID(clusterID),
-	}, nil
+	powerManagementEnabled := false
+	if powerManagement, ok := sdkHost.PowerManagement(); ok {
+		if enabled, ok := powerManagement.Enabled(); ok {
+			powerManagementEnabled = enabled
+		}
+	}
+	name, _ := sdkHost.Name()
+	memory, _ := sdkHost.Memory()
+	if memory < 0 {
+		return nil, newError(EBug, "invalid memory returned for host %s: %d", id, memory)
+	}
+	result := &host{
+		client:                 client,
+		id:                     HostID(id),
+		name:                   name,
+		status:                 HostStatus(status),
+		clusterID:              ClusterID(clusterID),
+		powerManagementEnabled: powerManagementEnabled,
+		memory:                 uint64(memory),
+		cpuCount:               convertSDKHostCPUCount(sdkHost),
+	}
+	if hostedEngine, ok := sdkHost.HostedEngine(); ok {
+		result.hostedEngineConfigured, _ = hostedEngine.Configured()
+		result.globalMaintenance, _ = hostedEngine.GlobalMaintenance()
+	}
+	return result, nil
+}
+
+func convertSDKHostCPUCount(sdkHost *ovirtsdk4.Host) uint {
+	cpu, ok := sdkHost.Cpu()
+	if !ok {
+		return 0
+	}
+	topology, ok := cpu.Topology()
+	if !ok {
+		return 0
+	}
+	sockets, _ := topology.Sockets()
+	cores, _ := topology.Cores()
+	threads, _ := topology.Threads()
+	if sockets <= 0 || cores <= 0 || threads <= 0 {
+		return 0
+	}
+	return uint(sockets * cores * threads)
 }
 
 type host struct {
 	client Client
 
-	id        HostID
-	clusterID ClusterID
-	status    HostStatus
+	id                     HostID
+	name                   string
+	clusterID              ClusterID
+	status                 HostStatus
+	powerManagementEnabled bool
+	memory                 uint64
+	cpuCount               uint
+	// nics and devices hold the network interfaces and devices of the host. They are only used by the mock.
+	nics    []*hostNIC
+	devices []*hostDevice
+
+	hostedEngineConfigured bool
+	globalMaintenance      bool
 }
 
 func (h host) ID() HostID {
 	return h.id
 }
 
+func (h host) Name() string {
+	return h.name
+}
+
 func (h host) ClusterID() ClusterID {
 	return h.clusterID
 }
@@ -157,3 +381,43 @@ func (h host) ClusterID() ClusterID {
 func (h host) Status() HostStatus {
 	return h.status
 }
+
+func (h host) PowerManagementEnabled() bool {
+	return h.powerManagementEnabled
+}
+
+func (h host) Memory() uint64 {
+	return h.memory
+}
+
+func (h host) CPUCount() uint {
+	return h.cpuCount
+}
+
+func (h host) Fence(action FenceAction, retries ...RetryStrategy) error {
+	return h.client.FenceHost(h.id, action, retries...)
+}
+
+func (h host) PowerStatus(retries ...RetryStrategy) (HostPowerStatus, error) {
+	return h.client.GetHostPowerStatus(h.id, retries...)
+}
+
+func (h host) Deactivate(retries ...RetryStrategy) error {
+	return h.client.DeactivateHost(h.id, retries...)
+}
+
+func (h host) Activate(retries ...RetryStrategy) error {
+	return h.client.ActivateHost(h.id, retries...)
+}
+
+func (h host) Reinstall(params HostInstallParameters, retries ...RetryStrategy) error {
+	return h.client.ReinstallHost(h.id, params, retries...)
+}
+
+func (h host) MoveToCluster(clusterID ClusterID, params MoveHostParameters, retries ...RetryStrategy) error {
+	return h.client.MoveHost(h.id, clusterID, params, retries...)
+}
+
+func (h host) DiscoverISCSITargets(address string, port uint16, retries ...RetryStrategy) ([]ISCSITarget, error) {
+	return h.client.DiscoverISCSITargets(h.id, address, port, retries...)
+}
@@ -0,0 +1,147 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// HostDevice is a device of a host, such as a PCI card, that can be passed through to a VM.
+type HostDevice interface {
+	// Name returns the name of the device on the host, for example pci_0000_00_02_0.
+	Name() string
+	// HostID returns the ID of the host the device belongs to.
+	HostID() HostID
+	// Capability returns the kind of the device, for example pci or usb.
+	Capability() string
+	// ProductName returns the product name reported by the device, if any.
+	ProductName() string
+	// VendorName returns the vendor name reported by the device, if any.
+	VendorName() string
+	// VMID returns the ID of the VM the device is attached to, or nil if it is not attached to a VM.
+	VMID() *VMID
+}
+
+func convertSDKHostDevice(object *ovirtsdk4.HostDevice, hostID HostID) (*hostDevice, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("host device", "ID")
+	}
+	name, ok := object.Name()
+	if !ok {
+		return nil, newFieldNotFound("host device", "name")
+	}
+	result := &hostDevice{
+		id:     id,
+		name:   name,
+		hostID: hostID,
+	}
+	if host, ok := object.Host(); ok {
+		if id, ok := host.Id(); ok {
+			result.hostID = HostID(id)
+		}
+	}
+	result.capability, _ = object.Capability()
+	if product, ok := object.Product(); ok {
+		result.productName, _ = product.Name()
+	}
+	if vendor, ok := object.Vendor(); ok {
+		result.vendorName, _ = vendor.Name()
+	}
+	if sdkVM, ok := object.Vm(); ok {
+		if id, ok := sdkVM.Id(); ok {
+			vmID := VMID(id)
+			result.vmID = &vmID
+		}
+	}
+	return result, nil
+}
+
+type hostDevice struct {
+	id          string
+	name        string
+	hostID      HostID
+	capability  string
+	productName string
+	vendorName  string
+	vmID        *VMID
+}
+
+func (h *hostDevice) Name() string {
+	return h.name
+}
+
+func (h *hostDevice) HostID() HostID {
+	return h.hostID
+}
+
+func (h *hostDevice) Capability() string {
+	return h.capability
+}
+
+func (h *hostDevice) ProductName() string {
+	return h.productName
+}
+
+func (h *hostDevice) VendorName() string {
+	return h.vendorName
+}
+
+func (h *hostDevice) VMID() *VMID {
+	return h.vmID
+}
+
+func (o *oVirtClient) ListHostDevices(hostID HostID, retries ...RetryStrategy) (result []HostDevice, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	devices, err := o.listHostDevices(hostID, retries)
+	if err != nil {
+		return nil, err
+	}
+	result = make([]HostDevice, len(devices))
+	for i, device := range devices {
+		result[i] = device
+	}
+	return result, nil
+}
+
+func (o *oVirtClient) listHostDevices(hostID HostID, retries []RetryStrategy) (result []*hostDevice, err error) {
+	action := fmt.Sprintf("listing devices of host %s", hostID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().HostsService().HostService(string(hostID)).DevicesService().List().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			result = []*hostDevice{}
+			sdkDevices, ok := response.Devices()
+			if !ok {
+				return nil
+			}
+			for i, sdkDevice := range sdkDevices.Slice() {
+				device, err := convertSDKHostDevice(sdkDevice, hostID)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert host device #%d", i)
+				}
+				result = append(result, device)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) ListHostDevices(hostID HostID, _ ...RetryStrategy) ([]HostDevice, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.hosts[hostID]
+	if !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	result := make([]HostDevice, len(item.devices))
+	for i, device := range item.devices {
+		result[i] = device
+	}
+	return result, nil
+}
@@ -0,0 +1,123 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) FenceHost(id HostID, action FenceAction, retries ...RetryStrategy) (err error) {
+	if err := action.Validate(); err != nil {
+		return err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := checkHostFenceable(o, id, retries); err != nil {
+		return err
+	}
+	if action != FenceActionStatus {
+		o.warnIfGlobalMaintenance(fmt.Sprintf("executing fence action %s on host %s", action, id), retries)
+	}
+	err = retry(
+		fmt.Sprintf("executing fence action %s on host %s", action, id),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.fenceHost(id, action)
+			return err
+		})
+	return
+}
+
+func (o *oVirtClient) GetHostPowerStatus(id HostID, retries ...RetryStrategy) (result HostPowerStatus, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	if err := checkHostFenceable(o, id, retries); err != nil {
+		return "", err
+	}
+	err = retry(
+		fmt.Sprintf("getting power status of host %s", id),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.fenceHost(id, FenceActionStatus)
+			if err != nil {
+				return err
+			}
+			powerManagement, ok := response.PowerManagement()
+			if !ok {
+				return newFieldNotFound("fence response", "power management")
+			}
+			status, ok := powerManagement.Status()
+			if !ok {
+				return newFieldNotFound("power management", "status")
+			}
+			result = HostPowerStatus(status)
+			return nil
+		})
+	return
+}
+
+// checkHostFenceable returns an EConflict error if the host has no power management enabled. The check is needed
+// because the engine returns a generic error message when fencing a host without it. It runs before the fence request
+// is retried, since retrying cannot change the configuration of the host.
+func checkHostFenceable(client HostClient, id HostID, retries []RetryStrategy) error {
+	host, err := client.GetHost(id, retries...)
+	if err != nil {
+		return err
+	}
+	if !host.PowerManagementEnabled() {
+		return newError(EConflict, "host %s has no power management configured", id)
+	}
+	return nil
+}
+
+// fenceHost sends the fence request for the host.
+func (o *oVirtClient) fenceHost(id HostID, action FenceAction) (*ovirtsdk4.HostServiceFenceResponse, error) {
+	response, err := o.conn.SystemService().HostsService().HostService(string(id)).Fence().FenceType(string(action)).Send()
+	if err != nil {
+		return nil, wrapSDKError(fmt.Sprintf("executing fence action %s on host %s", action, id), err)
+	}
+	return response, nil
+}
+
+func (m *mockClient) FenceHost(id HostID, action FenceAction, _ ...RetryStrategy) error {
+	if err := action.Validate(); err != nil {
+		return err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, err := m.getFenceableHost(id)
+	if err != nil {
+		return err
+	}
+	switch action {
+	case FenceActionStart, FenceActionRestart:
+		item.status = HostStatusUp
+	case FenceActionStop:
+		item.status = HostStatusDown
+	}
+	return nil
+}
+
+func (m *mockClient) GetHostPowerStatus(id HostID, _ ...RetryStrategy) (HostPowerStatus, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, err := m.getFenceableHost(id)
+	if err != nil {
+		return "", err
+	}
+	if item.status == HostStatusDown {
+		return HostPowerStatusOff, nil
+	}
+	return HostPowerStatusOn, nil
+}
+
+func (m *mockClient) getFenceableHost(id HostID) (*host, error) {
+	item, ok := m.hosts[id]
+	if !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", id)
+	}
+	if !item.powerManagementEnabled {
+		return nil, newError(EConflict, "host %s has no power management configured", id)
+	}
+	return item, nil
+}
@@ -0,0 +1,52 @@
+package ovirtclient
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFenceHostWithoutPowerManagementIsNotRetried(t *testing.T) {
+	t.Parallel()
+	var lock sync.Mutex
+	gets := 0
+	fenceRequests := 0
+	client := newFakeEngineClient(t, func(writer http.ResponseWriter, request *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		switch request.URL.Path {
+		case "/ovirt-engine/api/hosts/00000000-0000-0000-0000-000000000030":
+			gets++
+			writeFakeEngineXML(
+				writer,
+				`<host id="00000000-0000-0000-0000-000000000030"><status>up</status>`+
+					`<cluster id="00000000-0000-0000-0000-000000000020"/>`+
+					`<power_management><enabled>false</enabled></power_management></host>`,
+			)
+		case "/ovirt-engine/api/hosts/00000000-0000-0000-0000-000000000030/fence":
+			fenceRequests++
+			writeFakeEngineXML(writer, `<action><status>complete</status></action>`)
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	err := client.FenceHost(
+		"00000000-0000-0000-0000-000000000030",
+		FenceActionRestart,
+		MaxTries(3),
+		FixedWait(time.Millisecond),
+	)
+	if !HasErrorCode(err, EConflict) {
+		t.Fatalf("Fencing a host without power management did not result in an EConflict error (%v)", err)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if gets != 1 {
+		t.Fatalf("The host was fetched %d times instead of once.", gets)
+	}
+	if fenceRequests != 0 {
+		t.Fatalf("%d fence requests were sent for a host without power management.", fenceRequests)
+	}
+}
@@ -0,0 +1,68 @@
+package ovirtclient_test
+
+import (
+	"errors"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestHostPowerStatus(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	host := getTestHost(t, helper)
+	status, err := client.GetHostPowerStatus(host.ID())
+	if !host.PowerManagementEnabled() {
+		if err == nil {
+			t.Fatalf("Getting the power status of a host without power management did not result in an error.")
+		}
+		var e ovirtclient.EngineError
+		if !errors.As(err, &e) {
+			t.Fatalf("Failed to convert returned error to EngineError (%v)", err)
+		}
+		if !e.HasCode(ovirtclient.EConflict) {
+			t.Fatalf("Unexpected error code: %s, instead of: %s", e.Code(), ovirtclient.EConflict)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("Failed to get host power status (%v)", err)
+	}
+	if status != ovirtclient.HostPowerStatusOn {
+		t.Fatalf("Unexpected power status for a running host: %s", status)
+	}
+}
+
+func TestFenceHostInvalidAction(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	host := getTestHost(t, helper)
+	err := host.Fence("invalid")
+	if err == nil {
+		t.Fatalf("Fencing a host with an invalid action did not result in an error.")
+	}
+	var e ovirtclient.EngineError
+	if !errors.As(err, &e) {
+		t.Fatalf("Failed to convert returned error to EngineError (%v)", err)
+	}
+	if !e.HasCode(ovirtclient.EBadArgument) {
+		t.Fatalf("Unexpected error code: %s, instead of: %s", e.Code(), ovirtclient.EBadArgument)
+	}
+}
+
+func getTestHost(t *testing.T, helper ovirtclient.TestHelper) ovirtclient.Host {
+	hosts, err := helper.GetClient().ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	for _, host := range hosts {
+		if host.ClusterID() == helper.GetClusterID() && host.Status() == ovirtclient.HostStatusUp {
+			return host
+		}
+	}
+	t.Skipf("No running host found in the test cluster.")
+	return nil
+}
@@ -7,6 +7,9 @@ import (
 )
 
 func (o *oVirtClient) GetHost(id HostID, retries ...RetryStrategy) (result Host, err error) {
+	if err := validateNotBlankUUID("host", string(id)); err != nil {
+		return nil, err
+	}
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting host %s", id),
@@ -15,7 +18,7 @@ func (o *oVirtClient) GetHost(id HostID, retries ...RetryStrategy) (result Host,
 		func() error {
 			response, err := o.conn.SystemService().HostsService().HostService(string(id)).Get().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting host %s", id), err)
 			}
 			sdkObject, ok := response.Host()
 			if !ok {
@@ -40,6 +43,9 @@ func (o *oVirtClient) GetHost(id HostID, retries ...RetryStrategy) (result Host,
 }
 
 func (m *mockClient) GetHost(id HostID, _ ...RetryStrategy) (Host, error) {
+	if err := validateNotBlankUUID("host", string(id)); err != nil {
+		return nil, err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.hosts[id]; ok {
@@ -0,0 +1,83 @@
+package ovirtclient
+
+func (o *oVirtClient) GetGlobalMaintenanceMode(retries ...RetryStrategy) (result bool, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := "checking hosted engine global maintenance mode"
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			// The hosted engine state is only included in the response if all content is requested.
+			response, err := o.conn.SystemService().HostsService().List().AllContent(true).Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkHosts, ok := response.Hosts()
+			if !ok {
+				result, err = globalMaintenanceMode(nil)
+				return err
+			}
+			hosts := make([]*host, len(sdkHosts.Slice()))
+			for i, sdkHost := range sdkHosts.Slice() {
+				converted, err := convertSDKHost(sdkHost, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert host during listing item #%d", i)
+				}
+				hosts[i] = converted.(*host)
+			}
+			result, err = globalMaintenanceMode(hosts)
+			return err
+		})
+	return result, err
+}
+
+// globalMaintenanceMode determines the global maintenance state from the hosted engine hosts. Every hosted engine
+// host reports the same, deployment-wide state, hosts without a hosted engine configuration are ignored.
+func globalMaintenanceMode(hosts []*host) (bool, error) {
+	configured := false
+	result := false
+	for _, h := range hosts {
+		if !h.hostedEngineConfigured {
+			continue
+		}
+		configured = true
+		if h.globalMaintenance {
+			result = true
+		}
+	}
+	if !configured {
+		return false, newError(
+			EUnsupported,
+			"none of the hosts has a hosted engine configuration, global maintenance only exists on hosted engine"+
+				" deployments",
+		)
+	}
+	return result, nil
+}
+
+// warnIfGlobalMaintenance logs a warning before a destructive host operation if the hosted engine is in global
+// maintenance. Failing to determine the state is not an error since most deployments are not hosted engines.
+func (o *oVirtClient) warnIfGlobalMaintenance(operation string, retries []RetryStrategy) {
+	globalMaintenance, err := o.GetGlobalMaintenanceMode(retries...)
+	if err != nil {
+		o.logger.Debugf("Could not determine the hosted engine global maintenance mode. (%v)", err)
+		return
+	}
+	if globalMaintenance {
+		o.logger.Warningf(
+			"The hosted engine is in global maintenance, %s may not behave as expected.",
+			operation,
+		)
+	}
+}
+
+func (m *mockClient) GetGlobalMaintenanceMode(_ ...RetryStrategy) (bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	hosts := make([]*host, 0, len(m.hosts))
+	for _, h := range m.hosts {
+		hosts = append(hosts, h)
+	}
+	return globalMaintenanceMode(hosts)
+}
@@ -0,0 +1,40 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestGetGlobalMaintenanceMode(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+
+	if _, err := m.GetGlobalMaintenanceMode(); !HasErrorCode(err, EUnsupported) {
+		t.Fatalf("Querying global maintenance without a hosted engine did not result in an EUnsupported error (%v)", err)
+	}
+
+	var cluster *cluster
+	for _, c := range m.clusters {
+		cluster = c
+		break
+	}
+	hostedEngineHost := generateTestHost(cluster)
+	hostedEngineHost.hostedEngineConfigured = true
+	m.hosts[hostedEngineHost.ID()] = hostedEngineHost
+
+	globalMaintenance, err := m.GetGlobalMaintenanceMode()
+	if err != nil {
+		t.Fatalf("Failed to query global maintenance mode (%v)", err)
+	}
+	if globalMaintenance {
+		t.Fatalf("Global maintenance reported as active when it is not.")
+	}
+
+	hostedEngineHost.globalMaintenance = true
+	globalMaintenance, err = m.GetGlobalMaintenanceMode()
+	if err != nil {
+		t.Fatalf("Failed to query global maintenance mode (%v)", err)
+	}
+	if !globalMaintenance {
+		t.Fatalf("Global maintenance not reported as active.")
+	}
+}
@@ -0,0 +1,417 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// DefaultSSHPort is the port the engine uses to connect to hosts via SSH if no other port is configured.
+const DefaultSSHPort uint16 = 22
+
+// HostInstallParameters contains the credentials the engine uses to connect to a host via SSH when installing it.
+// Exactly one of the root password and the engine public key authentication must be set.
+type HostInstallParameters interface {
+	// RootPassword returns the password of the root user on the host. It returns an empty string if the engine
+	// public key is used instead.
+	RootPassword() string
+	// EnginePublicKeyAuth returns true if the engine should authenticate with its own SSH key. The public key of the
+	// engine must be present in the authorized_keys file of the root user on the host.
+	EnginePublicKeyAuth() bool
+	// SSHPort returns the SSH port of the host. If it returns nil, DefaultSSHPort is used.
+	SSHPort() *uint16
+}
+
+// BuildableHostInstallParameters is a buildable version of HostInstallParameters.
+type BuildableHostInstallParameters interface {
+	HostInstallParameters
+
+	// WithRootPassword sets the root password used to connect to the host. It returns an EBadArgument error if the
+	// password is empty.
+	WithRootPassword(password string) (BuildableHostInstallParameters, error)
+	// MustWithRootPassword is identical to WithRootPassword, but panics instead of returning an error.
+	MustWithRootPassword(password string) BuildableHostInstallParameters
+
+	// WithEnginePublicKeyAuth sets whether the engine authenticates with its own SSH key instead of a password.
+	WithEnginePublicKeyAuth(enabled bool) (BuildableHostInstallParameters, error)
+	// MustWithEnginePublicKeyAuth is identical to WithEnginePublicKeyAuth, but panics instead of returning an error.
+	MustWithEnginePublicKeyAuth(enabled bool) BuildableHostInstallParameters
+
+	// WithSSHPort sets the port the SSH daemon of the host listens on. It returns an EBadArgument error for port 0.
+	WithSSHPort(port uint16) (BuildableHostInstallParameters, error)
+	// MustWithSSHPort is identical to WithSSHPort, but panics instead of returning an error.
+	MustWithSSHPort(port uint16) BuildableHostInstallParameters
+}
+
+// HostInstallParams creates a new set of parameters for AddHost and ReinstallHost.
+func HostInstallParams() BuildableHostInstallParameters {
+	return &hostInstallParams{}
+}
+
+type hostInstallParams struct {
+	rootPassword        string
+	enginePublicKeyAuth bool
+	sshPort             *uint16
+}
+
+func (h *hostInstallParams) RootPassword() string {
+	return h.rootPassword
+}
+
+func (h *hostInstallParams) WithRootPassword(password string) (BuildableHostInstallParameters, error) {
+	if password == "" {
+		return h, newError(EBadArgument, "the root password of the host cannot be empty")
+	}
+	h.rootPassword = password
+	return h, nil
+}
+
+func (h *hostInstallParams) MustWithRootPassword(password string) BuildableHostInstallParameters {
+	builder, err := h.WithRootPassword(password)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *hostInstallParams) EnginePublicKeyAuth() bool {
+	return h.enginePublicKeyAuth
+}
+
+func (h *hostInstallParams) WithEnginePublicKeyAuth(enabled bool) (BuildableHostInstallParameters, error) {
+	h.enginePublicKeyAuth = enabled
+	return h, nil
+}
+
+func (h *hostInstallParams) MustWithEnginePublicKeyAuth(enabled bool) BuildableHostInstallParameters {
+	builder, err := h.WithEnginePublicKeyAuth(enabled)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *hostInstallParams) SSHPort() *uint16 {
+	return h.sshPort
+}
+
+func (h *hostInstallParams) WithSSHPort(port uint16) (BuildableHostInstallParameters, error) {
+	if port == 0 {
+		return h, newError(EBadArgument, "the SSH port of the host cannot be 0")
+	}
+	h.sshPort = &port
+	return h, nil
+}
+
+func (h *hostInstallParams) MustWithSSHPort(port uint16) BuildableHostInstallParameters {
+	builder, err := h.WithSSHPort(port)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func validateHostInstallParams(params HostInstallParameters) error {
+	if params == nil || (params.RootPassword() == "" && !params.EnginePublicKeyAuth()) {
+		return newError(
+			EBadArgument,
+			"either a root password or the engine public key authentication is required to install a host",
+		)
+	}
+	if params.RootPassword() != "" && params.EnginePublicKeyAuth() {
+		return newError(
+			EBadArgument,
+			"the root password and the engine public key authentication cannot be used at the same time",
+		)
+	}
+	return nil
+}
+
+func validateAddHost(clusterID ClusterID, name string, address string, params HostInstallParameters) error {
+	if clusterID == "" {
+		return newError(EBadArgument, "the cluster ID is required to add a host")
+	}
+	if err := ValidateResourceName(name); err != nil {
+		return wrap(err, EBadArgument, "invalid host name")
+	}
+	if address == "" {
+		return newError(EBadArgument, "the address is required to add a host")
+	}
+	return validateHostInstallParams(params)
+}
+
+// checkHostInstallStatus returns nil once the host is up, an EPending error while the installation is in progress,
+// and an EHostInstallFailed error if the host ended up in a status it won't leave on its own.
+func checkHostInstallStatus(id HostID, status HostStatus, statusDetail string) error {
+	switch status {
+	case HostStatusUp:
+		return nil
+	case HostStatusInstallFailed, HostStatusNonOperational, HostStatusNonResponsive, HostStatusError:
+		if statusDetail != "" {
+			return newError(
+				EHostInstallFailed,
+				"installing host %s failed, the host is in status %s (%s), check the engine events for details",
+				id,
+				status,
+				statusDetail,
+			)
+		}
+		return newError(
+			EHostInstallFailed,
+			"installing host %s failed, the host is in status %s, check the engine events for details",
+			id,
+			status,
+		)
+	default:
+		return newError(EPending, "host %s is in status %s, not %s", id, status, HostStatusUp)
+	}
+}
+
+func buildSDKHostSSH(params HostInstallParameters) *ovirtsdk4.Ssh {
+	ssh := ovirtsdk4.NewSshBuilder()
+	if params.EnginePublicKeyAuth() {
+		ssh.AuthenticationMethod(ovirtsdk4.SSHAUTHENTICATIONMETHOD_PUBLICKEY)
+	} else {
+		ssh.AuthenticationMethod(ovirtsdk4.SSHAUTHENTICATIONMETHOD_PASSWORD)
+	}
+	port := DefaultSSHPort
+	if sshPort := params.SSHPort(); sshPort != nil {
+		port = *sshPort
+	}
+	ssh.Port(int64(port))
+	return ssh.MustBuild()
+}
+
+func (o *oVirtClient) AddHost(
+	clusterID ClusterID,
+	name string,
+	address string,
+	params HostInstallParameters,
+	retries ...RetryStrategy,
+) (result Host, err error) {
+	if err := validateAddHost(clusterID, name, address, params); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+
+	hostBuilder := ovirtsdk4.NewHostBuilder().
+		Name(name).
+		Address(address).
+		Cluster(ovirtsdk4.NewClusterBuilder().Id(string(clusterID)).MustBuild()).
+		Ssh(buildSDKHostSSH(params))
+	if password := params.RootPassword(); password != "" {
+		hostBuilder.RootPassword(password)
+	}
+	sdkHost := hostBuilder.MustBuild()
+
+	var id HostID
+	action := fmt.Sprintf("adding host %s in cluster %s", name, clusterID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().HostsService().Add().Host(sdkHost).Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			responseHost, ok := response.Host()
+			if !ok {
+				return newFieldNotFound("host add response", "host")
+			}
+			hostID, ok := responseHost.Id()
+			if !ok {
+				return newFieldNotFound("host", "ID")
+			}
+			id = HostID(hostID)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return o.waitForHostInstalled(id, "", retries)
+}
+
+func (o *oVirtClient) ReinstallHost(id HostID, params HostInstallParameters, retries ...RetryStrategy) error {
+	if err := validateHostInstallParams(params); err != nil {
+		return err
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	host, err := o.GetHost(id, retries...)
+	if err != nil {
+		return err
+	}
+	if err := checkHostReinstallable(host); err != nil {
+		return err
+	}
+	action := fmt.Sprintf("reinstalling host %s", id)
+	correlationID := fmt.Sprintf("host_reinstall_%s", generateRandomID(5, o.nonSecureRandom))
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			request := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(id)).
+				Install().
+				Activate(true).
+				Ssh(buildSDKHostSSH(params)).
+				Query("correlation_id", correlationID)
+			if password := params.RootPassword(); password != "" {
+				request.RootPassword(password)
+			}
+			_, err := request.Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return err
+	}
+	if err := o.waitForJobSucceeded(correlationID, retries); err != nil {
+		return wrap(err, EHostInstallFailed, "reinstalling host %s failed, check the engine events for details", id)
+	}
+	_, err = o.waitForHostInstalled(id, host.Status(), retries)
+	return err
+}
+
+func checkHostReinstallable(host Host) error {
+	switch host.Status() {
+	case HostStatusMaintenance, HostStatusInstallFailed, HostStatusNonOperational:
+		return nil
+	default:
+		return newError(
+			EConflict,
+			"host %s must be in maintenance to reinstall it (status: %s)",
+			host.ID(),
+			host.Status(),
+		)
+	}
+}
+
+// waitForHostInstalled follows the host through the installing and initializing states until it is up. The host
+// status is read directly instead of using WaitForHostStatus to also get the status detail for failed installations.
+//
+// previousStatus is the status of the host before a reinstall. The engine may still report it right after the install
+// request, so it is not treated as a failure until the host has been seen installing or initializing.
+func (o *oVirtClient) waitForHostInstalled(
+	id HostID,
+	previousStatus HostStatus,
+	retries []RetryStrategy,
+) (result Host, err error) {
+	action := fmt.Sprintf("waiting for host %s to be installed", id)
+	installSeen := previousStatus == ""
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().HostsService().HostService(string(id)).Get().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkHost, ok := response.Host()
+			if !ok {
+				return newError(ENotFound, "no host returned when getting host ID %s", id)
+			}
+			result, err = convertSDKHost(sdkHost, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert host %s", id)
+			}
+			reportStatus(o.ctx, action, string(result.Status()))
+			switch result.Status() {
+			case HostStatusInstalling, HostStatusInitializing:
+				installSeen = true
+			case previousStatus:
+				if !installSeen {
+					return newError(EPending, "host %s has not started installing yet (status: %s)", id, previousStatus)
+				}
+			}
+			statusDetail, _ := sdkHost.StatusDetail()
+			return checkHostInstallStatus(id, result.Status(), statusDetail)
+		})
+	return result, err
+}
+
+func (m *mockClient) AddHost(
+	clusterID ClusterID,
+	name string,
+	address string,
+	params HostInstallParameters,
+	retries ...RetryStrategy,
+) (Host, error) {
+	if err := validateAddHost(clusterID, name, address, params); err != nil {
+		return nil, err
+	}
+	id, err := m.addHost(clusterID, name)
+	if err != nil {
+		return nil, err
+	}
+	return m.waitForHostInstalled(id, defaultRetries(retries, defaultLongTimeouts(m)))
+}
+
+func (m *mockClient) addHost(clusterID ClusterID, name string) (HostID, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.clusters[clusterID]; !ok {
+		return "", newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	for _, item := range m.hosts {
+		if item.name == name {
+			return "", newError(EConflict, "a host with the name %s already exists", name)
+		}
+	}
+	// The mock has no installation to wait for, so the host is up right away.
+	item := &host{
+		client:    m,
+		id:        HostID(uuid.NewString()),
+		name:      name,
+		clusterID: clusterID,
+		status:    HostStatusUp,
+	}
+	m.hosts[item.id] = item
+	return item.id, nil
+}
+
+func (m *mockClient) ReinstallHost(id HostID, params HostInstallParameters, retries ...RetryStrategy) error {
+	if err := validateHostInstallParams(params); err != nil {
+		return err
+	}
+	if err := m.reinstallHost(id); err != nil {
+		return err
+	}
+	_, err := m.waitForHostInstalled(id, defaultRetries(retries, defaultLongTimeouts(m)))
+	return err
+}
+
+func (m *mockClient) reinstallHost(id HostID) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.hosts[id]
+	if !ok {
+		return newError(ENotFound, "host with ID %s not found", id)
+	}
+	if err := checkHostReinstallable(item); err != nil {
+		return err
+	}
+	item.status = HostStatusUp
+	return nil
+}
+
+func (m *mockClient) waitForHostInstalled(id HostID, retries []RetryStrategy) (result Host, err error) {
+	action := fmt.Sprintf("waiting for host %s to be installed", id)
+	err = retry(
+		action,
+		m.logger,
+		retries,
+		func() error {
+			result, err = m.GetHost(id, retries...)
+			if err != nil {
+				return err
+			}
+			reportStatus(m.ctx, action, string(result.Status()))
+			return checkHostInstallStatus(id, result.Status(), "")
+		})
+	return result, err
+}
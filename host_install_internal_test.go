@@ -0,0 +1,122 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAddAndReinstallHost(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	cluster := generateTestCluster()
+	m.clusters[cluster.ID()] = cluster
+	params := HostInstallParams().MustWithRootPassword("secret")
+
+	if _, err := m.AddHost(cluster.ID(), "new-host", "", params); !HasErrorCode(err, EBadArgument) {
+		t.Fatalf("Adding a host without an address did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := m.AddHost(cluster.ID(), "new-host", "192.0.2.10", HostInstallParams()); !HasErrorCode(
+		err,
+		EBadArgument,
+	) {
+		t.Fatalf("Adding a host without credentials did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := m.AddHost(
+		cluster.ID(),
+		"new-host",
+		"192.0.2.10",
+		HostInstallParams().MustWithRootPassword("secret").MustWithEnginePublicKeyAuth(true),
+	); !HasErrorCode(err, EBadArgument) {
+		t.Fatalf("Adding a host with both a password and public key auth did not result in an EBadArgument error (%v)", err)
+	}
+
+	host, err := m.AddHost(cluster.ID(), "new-host", "192.0.2.10", params)
+	if err != nil {
+		t.Fatalf("Failed to add host (%v)", err)
+	}
+	if host.Status() != HostStatusUp {
+		t.Fatalf("Incorrect host status after adding (expected: %s, got: %s)", HostStatusUp, host.Status())
+	}
+	if host.ClusterID() != cluster.ID() {
+		t.Fatalf("Incorrect cluster after adding (expected: %s, got: %s)", cluster.ID(), host.ClusterID())
+	}
+	if _, err := m.AddHost(cluster.ID(), "new-host", "192.0.2.11", params); !HasErrorCode(err, EConflict) {
+		t.Fatalf("Adding a second host with the same name did not result in an EConflict error (%v)", err)
+	}
+
+	if err := host.Reinstall(params); !HasErrorCode(err, EConflict) {
+		t.Fatalf("Reinstalling an active host did not result in an EConflict error (%v)", err)
+	}
+	if err := host.Deactivate(); err != nil {
+		t.Fatalf("Failed to deactivate host (%v)", err)
+	}
+	if err := host.Reinstall(HostInstallParams().MustWithEnginePublicKeyAuth(true)); err != nil {
+		t.Fatalf("Failed to reinstall host (%v)", err)
+	}
+	if host.Status() != HostStatusUp {
+		t.Fatalf("Incorrect host status after reinstalling (expected: %s, got: %s)", HostStatusUp, host.Status())
+	}
+}
+
+func TestCheckHostInstallStatus(t *testing.T) {
+	t.Parallel()
+	if err := checkHostInstallStatus("test", HostStatusInstalling, ""); !HasErrorCode(err, EPending) {
+		t.Fatalf("An installing host did not result in an EPending error (%v)", err)
+	}
+	if err := checkHostInstallStatus("test", HostStatusInstallFailed, "ssh failed"); !HasErrorCode(
+		err,
+		EHostInstallFailed,
+	) {
+		t.Fatalf("A failed installation did not result in an EHostInstallFailed error (%v)", err)
+	}
+	if err := checkHostInstallStatus("test", HostStatusUp, ""); err != nil {
+		t.Fatalf("An up host resulted in an error (%v)", err)
+	}
+}
+
+func TestReinstallHostIgnoresStaleFailureStatus(t *testing.T) {
+	t.Parallel()
+	lock := &sync.Mutex{}
+	installed := false
+	// After the install request, the engine reports the old status once more before the host starts installing.
+	statuses := []HostStatus{HostStatusInstallFailed, HostStatusInstalling, HostStatusUp}
+	client := newFakeEngineClient(t, func(writer http.ResponseWriter, request *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		switch request.URL.Path {
+		case "/ovirt-engine/api/hosts/00000000-0000-0000-0000-000000000030":
+			status := HostStatusInstallFailed
+			if installed {
+				status = statuses[0]
+				if len(statuses) > 1 {
+					statuses = statuses[1:]
+				}
+			}
+			writeFakeEngineXML(writer, fmt.Sprintf(
+				`<host id="00000000-0000-0000-0000-000000000030"><status>%s</status>`+
+					`<cluster id="00000000-0000-0000-0000-000000000020"/></host>`,
+				status,
+			))
+		case "/ovirt-engine/api/hosts/00000000-0000-0000-0000-000000000030/install":
+			installed = true
+			writeFakeEngineXML(writer, `<action><status>complete</status></action>`)
+		case "/ovirt-engine/api/jobs":
+			writeFakeEngineXML(writer, `<jobs><job id="1"><status>finished</status></job></jobs>`)
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	err := client.ReinstallHost(
+		"00000000-0000-0000-0000-000000000030",
+		HostInstallParams().MustWithRootPassword("secret"),
+		MaxTries(10),
+		FixedWait(time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("Reinstalling a host that failed to install before did not succeed (%v)", err)
+	}
+}
@@ -0,0 +1,162 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// DefaultISCSIPort is the TCP port iSCSI targets listen on unless configured otherwise.
+const DefaultISCSIPort uint16 = 3260
+
+// ISCSITarget is an iSCSI target discovered through a host.
+type ISCSITarget interface {
+	// Target returns the iSCSI qualified name (IQN) of the target.
+	Target() string
+	// Address returns the address of the portal the target was discovered on.
+	Address() string
+	// Port returns the port of the portal the target was discovered on.
+	Port() uint16
+	// Portal returns the portal of the target as reported by the target, in the address:port,group format.
+	Portal() string
+}
+
+// hostnameLabel is a single label of a host name as defined in RFC 1123.
+const hostnameLabel = `[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?`
+
+var hostnameRegexp = regexp.MustCompile(`^` + hostnameLabel + `(\.` + hostnameLabel + `)*$`)
+
+func validateISCSIPortal(address string, port uint16) error {
+	if address == "" {
+		return newError(EBadArgument, "the iSCSI portal address must not be empty")
+	}
+	if net.ParseIP(address) == nil && (len(address) > 253 || !hostnameRegexp.MatchString(address)) {
+		return newError(EBadArgument, "invalid iSCSI portal address: %s (must be an IP address or host name)", address)
+	}
+	if port == 0 {
+		return newError(EBadArgument, "the iSCSI portal port must not be 0")
+	}
+	return nil
+}
+
+func (o *oVirtClient) DiscoverISCSITargets(
+	hostID HostID,
+	address string,
+	port uint16,
+	retries ...RetryStrategy,
+) (result []ISCSITarget, err error) {
+	if err := validateISCSIPortal(address, port); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	action := fmt.Sprintf("discovering iSCSI targets on %s:%d from host %s", address, port, hostID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(hostID)).
+				DiscoverIscsi().
+				Iscsi(ovirtsdk.NewIscsiDetailsBuilder().Address(address).Port(int64(port)).MustBuild()).
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			result = []ISCSITarget{}
+			sdkTargets, ok := response.DiscoveredTargets()
+			if !ok {
+				return nil
+			}
+			for i, sdkTarget := range sdkTargets.Slice() {
+				target, err := convertSDKISCSITarget(sdkTarget, address, port)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert discovered iSCSI target #%d", i)
+				}
+				result = append(result, target)
+			}
+			return nil
+		})
+	return result, err
+}
+
+// convertSDKISCSITarget converts a discovered target. The engine doesn't always fill in the address and port of the
+// portal, so the values the discovery was started with are used as a fallback.
+func convertSDKISCSITarget(object *ovirtsdk.IscsiDetails, address string, port uint16) (*iscsiTarget, error) {
+	target, ok := object.Target()
+	if !ok {
+		return nil, newFieldNotFound("iSCSI target", "target")
+	}
+	if a, ok := object.Address(); ok && a != "" {
+		address = a
+	}
+	if p, ok := object.Port(); ok && p > 0 {
+		if p > 65535 {
+			return nil, newError(EBug, "invalid port returned for iSCSI target %s: %d", target, p)
+		}
+		port = uint16(p)
+	}
+	portal, _ := object.Portal()
+	return &iscsiTarget{
+		target:  target,
+		address: address,
+		port:    port,
+		portal:  portal,
+	}, nil
+}
+
+type iscsiTarget struct {
+	target  string
+	address string
+	port    uint16
+	portal  string
+}
+
+func (i iscsiTarget) Target() string {
+	return i.target
+}
+
+func (i iscsiTarget) Address() string {
+	return i.address
+}
+
+func (i iscsiTarget) Port() uint16 {
+	return i.port
+}
+
+func (i iscsiTarget) Portal() string {
+	return i.portal
+}
+
+func (m *mockClient) DiscoverISCSITargets(
+	hostID HostID,
+	address string,
+	port uint16,
+	_ ...RetryStrategy,
+) ([]ISCSITarget, error) {
+	if err := validateISCSIPortal(address, port); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.hosts[hostID]
+	if !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	if item.status != HostStatusUp {
+		return nil, newError(EConflict, "host %s is in status %s, not %s", hostID, item.status, HostStatusUp)
+	}
+	// The mock pretends that every portal exports a single target.
+	return []ISCSITarget{
+		&iscsiTarget{
+			target:  fmt.Sprintf("iqn.2003-01.org.ovirt.mock:%s", address),
+			address: address,
+			port:    port,
+			portal:  fmt.Sprintf("%s:%d,1", address, port),
+		},
+	}, nil
+}
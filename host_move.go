@@ -0,0 +1,370 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// MoveHostParameters contains the optional parameters for moving a host to a different cluster.
+type MoveHostParameters interface {
+	// AllowVMMigration permits putting a host with running VMs into maintenance. The engine then live migrates the
+	// VMs to other hosts before the host is moved. If false, moving a host with running VMs results in an EConflict
+	// error.
+	AllowVMMigration() bool
+}
+
+// BuildableMoveHostParameters is a buildable version of MoveHostParameters.
+type BuildableMoveHostParameters interface {
+	MoveHostParameters
+
+	// WithAllowVMMigration sets whether running VMs may be migrated away from the host.
+	WithAllowVMMigration(allow bool) BuildableMoveHostParameters
+}
+
+// MoveHostParams creates a new set of parameters for MoveHost.
+func MoveHostParams() BuildableMoveHostParameters {
+	return &moveHostParams{}
+}
+
+type moveHostParams struct {
+	allowVMMigration bool
+}
+
+func (m *moveHostParams) AllowVMMigration() bool {
+	return m.allowVMMigration
+}
+
+func (m *moveHostParams) WithAllowVMMigration(allow bool) BuildableMoveHostParameters {
+	m.allowVMMigration = allow
+	return m
+}
+
+// hostMover contains the operations moveHost needs from the live and the mock client.
+type hostMover interface {
+	HostClient
+
+	countRunningVMsOnHost(id HostID, retries []RetryStrategy) (int64, error)
+	setHostCluster(id HostID, clusterID ClusterID, retries []RetryStrategy) error
+}
+
+// moveHost puts the host into maintenance if needed, changes its cluster, and activates it again if it was active
+// before.
+func moveHost(
+	client hostMover,
+	id HostID,
+	clusterID ClusterID,
+	params MoveHostParameters,
+	retries []RetryStrategy,
+) error {
+	if params == nil {
+		params = MoveHostParams()
+	}
+	host, err := client.GetHost(id, retries...)
+	if err != nil {
+		return err
+	}
+	if host.ClusterID() == clusterID {
+		return nil
+	}
+	wasActive := host.Status() != HostStatusMaintenance
+	if wasActive {
+		runningVMs, err := client.countRunningVMsOnHost(id, retries)
+		if err != nil {
+			return err
+		}
+		if runningVMs > 0 && !params.AllowVMMigration() {
+			return newError(
+				EConflict,
+				"host %s has %d running VMs, enable VM migration to move it to cluster %s",
+				id,
+				runningVMs,
+				clusterID,
+			)
+		}
+		if err := client.DeactivateHost(id, retries...); err != nil {
+			return err
+		}
+	}
+	if err := client.setHostCluster(id, clusterID, retries); err != nil {
+		return err
+	}
+	if wasActive {
+		return client.ActivateHost(id, retries...)
+	}
+	return nil
+}
+
+func (o *oVirtClient) MoveHost(
+	id HostID,
+	clusterID ClusterID,
+	params MoveHostParameters,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	return moveHost(o, id, clusterID, params, retries)
+}
+
+func (o *oVirtClient) DeactivateHost(id HostID, retries ...RetryStrategy) error {
+	return o.DeactivateHostWithReason(id, "", retries...)
+}
+
+func (o *oVirtClient) DeactivateHostWithReason(id HostID, reason string, retries ...RetryStrategy) error {
+	if err := validateActionReason(reason); err != nil {
+		return err
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	correlationID := fmt.Sprintf("host_deactivate_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf("putting host %s into maintenance", id)
+	o.warnIfGlobalMaintenance(action, retries)
+	err := retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			request := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(id)).
+				Deactivate().
+				Query("correlation_id", correlationID)
+			if reason != "" {
+				request.Reason(reason)
+			}
+			_, err := request.Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return err
+	}
+	if err := o.waitForJobFinished(correlationID, retries); err != nil {
+		return err
+	}
+	_, err = o.WaitForHostStatus(id, HostStatusMaintenance, retries...)
+	return err
+}
+
+func (o *oVirtClient) ActivateHost(id HostID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	correlationID := fmt.Sprintf("host_activate_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf("activating host %s", id)
+	err := retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(id)).
+				Activate().
+				Query("correlation_id", correlationID).
+				Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return err
+	}
+	if err := o.waitForJobFinished(correlationID, retries); err != nil {
+		return err
+	}
+	_, err = o.WaitForHostStatus(id, HostStatusUp, retries...)
+	return err
+}
+
+func (o *oVirtClient) WaitForHostStatus(id HostID, status HostStatus, retries ...RetryStrategy) (host Host, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	action := fmt.Sprintf("waiting for host %s status %s", id, status)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			host, err = o.GetHost(id, retries...)
+			if err != nil {
+				return err
+			}
+			reportStatus(o.ctx, action, string(host.Status()))
+			if host.Status() != status {
+				return newError(EPending, "host status is %s, not %s", host.Status(), status)
+			}
+			return nil
+		})
+	return
+}
+
+func (o *oVirtClient) countRunningVMsOnHost(id HostID, retries []RetryStrategy) (result int64, err error) {
+	action := fmt.Sprintf("counting running VMs on host %s", id)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().HostsService().HostService(string(id)).Get().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkHost, ok := response.Host()
+			if !ok {
+				return newError(ENotFound, "no host returned when getting host ID %s", id)
+			}
+			result = 0
+			if summary, ok := sdkHost.Summary(); ok {
+				result, _ = summary.Active()
+			}
+			return nil
+		})
+	return
+}
+
+func (o *oVirtClient) setHostCluster(id HostID, clusterID ClusterID, retries []RetryStrategy) error {
+	action := fmt.Sprintf("moving host %s to cluster %s", id, clusterID)
+	return retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(id)).
+				Update().
+				Host(ovirtsdk4.NewHostBuilder().Cluster(
+					ovirtsdk4.NewClusterBuilder().Id(string(clusterID)).MustBuild(),
+				).MustBuild()).
+				Send()
+			return wrapSDKError(action, err)
+		})
+}
+
+func (m *mockClient) MoveHost(
+	id HostID,
+	clusterID ClusterID,
+	params MoveHostParameters,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	return moveHost(m, id, clusterID, params, retries)
+}
+
+func (m *mockClient) DeactivateHost(id HostID, retries ...RetryStrategy) error {
+	return m.DeactivateHostWithReason(id, "", retries...)
+}
+
+func (m *mockClient) DeactivateHostWithReason(id HostID, reason string, _ ...RetryStrategy) error {
+	if err := validateActionReason(reason); err != nil {
+		return err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.hosts[id]
+	if !ok {
+		return newError(ENotFound, "host with ID %s not found", id)
+	}
+	if item.status == HostStatusMaintenance {
+		return nil
+	}
+	if err := m.migrateVMsAwayFromHost(item); err != nil {
+		return err
+	}
+	item.status = HostStatusMaintenance
+	return nil
+}
+
+// migrateVMsAwayFromHost moves all VMs running on the host to another active host in the same cluster, imitating
+// the live migration the engine performs when a host is put into maintenance. The caller must hold the lock.
+func (m *mockClient) migrateVMsAwayFromHost(item *host) error {
+	var targetHost *host
+	for _, candidate := range m.hosts {
+		if candidate.id != item.id && candidate.clusterID == item.clusterID && candidate.status == HostStatusUp {
+			targetHost = candidate
+			break
+		}
+	}
+	for _, vm := range m.vms {
+		if vm.hostID == nil || *vm.hostID != item.id {
+			continue
+		}
+		if targetHost == nil {
+			return newError(
+				EConflict,
+				"cannot migrate VM %s away from host %s, no other active host in cluster %s",
+				vm.id,
+				item.id,
+				item.clusterID,
+			)
+		}
+		targetHostID := targetHost.id
+		vm.hostID = &targetHostID
+	}
+	return nil
+}
+
+func (m *mockClient) ActivateHost(id HostID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.hosts[id]
+	if !ok {
+		return newError(ENotFound, "host with ID %s not found", id)
+	}
+	item.status = HostStatusUp
+	return nil
+}
+
+func (m *mockClient) WaitForHostStatus(id HostID, status HostStatus, retries ...RetryStrategy) (host Host, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	action := fmt.Sprintf("waiting for host %s status %s", id, status)
+	err = retry(
+		action,
+		m.logger,
+		retries,
+		func() error {
+			host, err = m.GetHost(id, retries...)
+			if err != nil {
+				return err
+			}
+			reportStatus(m.ctx, action, string(host.Status()))
+			if host.Status() != status {
+				return newError(EPending, "host status is %s, not %s", host.Status(), status)
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) countRunningVMsOnHost(id HostID, _ []RetryStrategy) (int64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[id]; !ok {
+		return 0, newError(ENotFound, "host with ID %s not found", id)
+	}
+	var result int64
+	for _, vm := range m.vms {
+		if vm.hostID != nil && *vm.hostID == id {
+			result++
+		}
+	}
+	return result, nil
+}
+
+func (m *mockClient) setHostCluster(id HostID, clusterID ClusterID, _ []RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.hosts[id]
+	if !ok {
+		return newError(ENotFound, "host with ID %s not found", id)
+	}
+	if _, ok := m.clusters[clusterID]; !ok {
+		return newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	if item.status != HostStatusMaintenance {
+		return newError(
+			EConflict,
+			"host %s must be in maintenance to change its cluster (status: %s)",
+			id,
+			item.status,
+		)
+	}
+	item.clusterID = clusterID
+	return nil
+}
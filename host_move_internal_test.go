@@ -0,0 +1,70 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestMoveHost(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	sourceCluster := generateTestCluster()
+	targetCluster := generateTestCluster()
+	m.clusters[sourceCluster.ID()] = sourceCluster
+	m.clusters[targetCluster.ID()] = targetCluster
+	movedHost := generateTestHost(sourceCluster)
+	otherHost := generateTestHost(sourceCluster)
+	m.hosts[movedHost.ID()] = movedHost
+	m.hosts[otherHost.ID()] = otherHost
+
+	vmID := VMID(m.GenerateUUID())
+	hostID := movedHost.ID()
+	m.vms[vmID] = &vm{client: m, id: vmID, name: "test", status: VMStatusUp, hostID: &hostID}
+
+	if err := m.MoveHost(hostID, targetCluster.ID(), nil); err == nil || !HasErrorCode(err, EConflict) {
+		t.Fatalf("Moving a host with running VMs did not result in an EConflict error (%v)", err)
+	}
+	if movedHost.ClusterID() != sourceCluster.ID() || movedHost.Status() != HostStatusUp {
+		t.Fatalf("The host was changed despite the failed move.")
+	}
+
+	if err := m.MoveHost(hostID, targetCluster.ID(), MoveHostParams().WithAllowVMMigration(true)); err != nil {
+		t.Fatalf("Failed to move host with VM migration enabled (%v)", err)
+	}
+	if movedHost.ClusterID() != targetCluster.ID() {
+		t.Fatalf("Incorrect cluster after move (expected: %s, got: %s)", targetCluster.ID(), movedHost.ClusterID())
+	}
+	if movedHost.Status() != HostStatusUp {
+		t.Fatalf("The host was not activated after the move (status: %s)", movedHost.Status())
+	}
+	if vmHostID := m.vms[vmID].hostID; vmHostID == nil || *vmHostID != otherHost.ID() {
+		t.Fatalf("The running VM was not migrated to the other host in the cluster.")
+	}
+}
+
+func TestMoveHostInMaintenance(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	targetCluster := generateTestCluster()
+	m.clusters[targetCluster.ID()] = targetCluster
+	var item *host
+	for _, h := range m.hosts {
+		item = h
+	}
+	if err := m.DeactivateHost(item.ID()); err != nil {
+		t.Fatalf("Failed to put host into maintenance (%v)", err)
+	}
+
+	if err := m.MoveHost(item.ID(), targetCluster.ID(), nil); err != nil {
+		t.Fatalf("Failed to move host in maintenance (%v)", err)
+	}
+	if item.ClusterID() != targetCluster.ID() {
+		t.Fatalf("Incorrect cluster after move (expected: %s, got: %s)", targetCluster.ID(), item.ClusterID())
+	}
+	if item.Status() != HostStatusMaintenance {
+		t.Fatalf("A host that was in maintenance before the move was activated (status: %s)", item.Status())
+	}
+
+	if err := m.MoveHost(item.ID(), ClusterID(m.GenerateUUID()), nil); err == nil || !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Moving a host to a nonexistent cluster did not result in an ENotFound error (%v)", err)
+	}
+}
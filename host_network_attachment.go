@@ -0,0 +1,372 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// BootProtocol determines how a host network interface obtains its IP address.
+type BootProtocol string
+
+const (
+	// BootProtocolNone configures no IP address, for example for networks only used by VMs.
+	BootProtocolNone BootProtocol = "none"
+	// BootProtocolDHCP obtains the IP address via DHCP.
+	BootProtocolDHCP BootProtocol = "dhcp"
+	// BootProtocolStatic uses a static IP address.
+	BootProtocolStatic BootProtocol = "static"
+)
+
+// BootProtocolList is a list of BootProtocol values.
+type BootProtocolList []BootProtocol
+
+// BootProtocolValues returns all possible BootProtocol values.
+func BootProtocolValues() BootProtocolList {
+	return []BootProtocol{
+		BootProtocolNone,
+		BootProtocolDHCP,
+		BootProtocolStatic,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l BootProtocolList) Strings() []string {
+	result := make([]string, len(l))
+	for i, protocol := range l {
+		result[i] = string(protocol)
+	}
+	return result
+}
+
+// Validate returns an error if the boot protocol is not valid.
+func (b BootProtocol) Validate() error {
+	for _, protocol := range BootProtocolValues() {
+		if protocol == b {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid boot protocol: %s must be one of: %s",
+		b,
+		strings.Join(BootProtocolValues().Strings(), ", "),
+	)
+}
+
+// IPConfig is the IPv4 configuration of a network attached to a host network interface.
+type IPConfig interface {
+	// BootProtocol returns how the interface obtains its IP address.
+	BootProtocol() BootProtocol
+	// IP returns the static IP configuration. It is only set for BootProtocolStatic.
+	IP() *IP
+}
+
+// NewIPConfig creates an IPConfig. The ip must be passed for BootProtocolStatic and must be nil otherwise. The gateway
+// of a static configuration is optional.
+func NewIPConfig(bootProtocol BootProtocol, ip *IP) (IPConfig, error) {
+	if err := bootProtocol.Validate(); err != nil {
+		return nil, err
+	}
+	if bootProtocol != BootProtocolStatic {
+		if ip != nil {
+			return nil, newError(EBadArgument, "an IP address can only be set with the %s boot protocol", BootProtocolStatic)
+		}
+		return &ipConfig{bootProtocol: bootProtocol}, nil
+	}
+	if ip == nil {
+		return nil, newError(EBadArgument, "the %s boot protocol requires an IP address", BootProtocolStatic)
+	}
+	if parsed := net.ParseIP(ip.Address); parsed == nil || parsed.To4() == nil {
+		return nil, newError(EBadArgument, "invalid IPv4 address: %s", ip.Address)
+	}
+	if parsed := net.ParseIP(ip.Netmask); parsed == nil || parsed.To4() == nil {
+		return nil, newError(EBadArgument, "invalid IPv4 netmask: %s", ip.Netmask)
+	}
+	if ip.Gateway != "" {
+		if parsed := net.ParseIP(ip.Gateway); parsed == nil || parsed.To4() == nil {
+			return nil, newError(EBadArgument, "invalid IPv4 gateway: %s", ip.Gateway)
+		}
+	}
+	ipCopy := *ip
+	ipCopy.Version = IPVERSION_V4
+	return &ipConfig{bootProtocol: bootProtocol, ip: &ipCopy}, nil
+}
+
+// MustNewIPConfig is identical to NewIPConfig, but panics instead of returning an error.
+func MustNewIPConfig(bootProtocol BootProtocol, ip *IP) IPConfig {
+	config, err := NewIPConfig(bootProtocol, ip)
+	if err != nil {
+		panic(err)
+	}
+	return config
+}
+
+type ipConfig struct {
+	bootProtocol BootProtocol
+	ip           *IP
+}
+
+func (i *ipConfig) BootProtocol() BootProtocol {
+	return i.bootProtocol
+}
+
+func (i *ipConfig) IP() *IP {
+	return i.ip
+}
+
+func buildSDKNetworkAttachment(
+	attachmentID string,
+	networkID NetworkID,
+	nic HostNIC,
+	config IPConfig,
+) (*ovirtsdk4.NetworkAttachment, error) {
+	assignment := ovirtsdk4.NewIpAddressAssignmentBuilder().
+		AssignmentMethod(ovirtsdk4.BootProtocol(config.BootProtocol()))
+	if ip := config.IP(); ip != nil {
+		ipBuilder := ovirtsdk4.NewIpBuilder().
+			Address(ip.Address).
+			Netmask(ip.Netmask).
+			Version(ovirtsdk4.IPVERSION_V4)
+		if ip.Gateway != "" {
+			ipBuilder.Gateway(ip.Gateway)
+		}
+		assignment.IpBuilder(ipBuilder)
+	}
+	builder := ovirtsdk4.NewNetworkAttachmentBuilder().
+		Network(ovirtsdk4.NewNetworkBuilder().Id(string(networkID)).MustBuild()).
+		HostNic(ovirtsdk4.NewHostNicBuilder().Id(string(nic.ID())).MustBuild()).
+		IpAddressAssignmentsBuilderOfAny(*assignment)
+	if attachmentID != "" {
+		builder.Id(attachmentID)
+	}
+	result, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build network attachment")
+	}
+	return result, nil
+}
+
+func (o *oVirtClient) AttachNetworkToHost(
+	hostID HostID,
+	networkID NetworkID,
+	nicName string,
+	config IPConfig,
+	retries ...RetryStrategy,
+) error {
+	if config == nil {
+		config = MustNewIPConfig(BootProtocolNone, nil)
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	nic, err := findHostNICByName(o, hostID, nicName, retries)
+	if err != nil {
+		return err
+	}
+	if _, err := o.GetNetwork(networkID, retries...); err != nil {
+		return err
+	}
+	// An existing attachment of the network is modified instead, which moves it to the requested interface.
+	existing, err := o.findHostNetworkAttachment(hostID, networkID, retries)
+	if err != nil && !HasErrorCode(err, ENotFound) {
+		return err
+	}
+	attachmentID := ""
+	if existing != nil {
+		attachmentID, _ = existing.Id()
+	}
+	attachment, err := buildSDKNetworkAttachment(attachmentID, networkID, nic, config)
+	if err != nil {
+		return err
+	}
+	correlationID := fmt.Sprintf("host_network_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf("attaching network %s to interface %s of host %s", networkID, nicName, hostID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(hostID)).
+				SetupNetworks().
+				ModifiedNetworkAttachmentsOfAny(attachment).
+				CheckConnectivity(true).
+				CommitOnSuccess(true).
+				Query("correlation_id", correlationID).
+				Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return err
+	}
+	if err := o.waitForJobSucceeded(correlationID, retries); err != nil {
+		return err
+	}
+	return retry(
+		fmt.Sprintf("waiting for network %s to be attached to interface %s of host %s", networkID, nicName, hostID),
+		o.logger,
+		retries,
+		func() error {
+			attachment, err := o.findHostNetworkAttachment(hostID, networkID, retries)
+			if err != nil {
+				if HasErrorCode(err, ENotFound) {
+					return newError(EPending, "network %s is not attached to host %s yet", networkID, hostID)
+				}
+				return err
+			}
+			if sdkNIC, ok := attachment.HostNic(); ok {
+				if id, ok := sdkNIC.Id(); ok && HostNICID(id) != nic.ID() {
+					return newError(EPending, "network %s is not attached to interface %s yet", networkID, nicName)
+				}
+			}
+			return nil
+		})
+}
+
+func (o *oVirtClient) DetachNetworkFromHost(hostID HostID, networkID NetworkID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	existing, err := o.findHostNetworkAttachment(hostID, networkID, retries)
+	if err != nil {
+		return err
+	}
+	attachmentID, ok := existing.Id()
+	if !ok {
+		return newFieldNotFound("network attachment", "ID")
+	}
+	correlationID := fmt.Sprintf("host_network_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf("detaching network %s from host %s", networkID, hostID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(hostID)).
+				SetupNetworks().
+				RemovedNetworkAttachmentsOfAny(ovirtsdk4.NewNetworkAttachmentBuilder().Id(attachmentID).MustBuild()).
+				CheckConnectivity(true).
+				CommitOnSuccess(true).
+				Query("correlation_id", correlationID).
+				Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return err
+	}
+	if err := o.waitForJobSucceeded(correlationID, retries); err != nil {
+		return err
+	}
+	return retry(
+		fmt.Sprintf("waiting for network %s to be detached from host %s", networkID, hostID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.findHostNetworkAttachment(hostID, networkID, retries)
+			if err == nil {
+				return newError(EPending, "network %s is still attached to host %s", networkID, hostID)
+			}
+			if HasErrorCode(err, ENotFound) {
+				return nil
+			}
+			return err
+		})
+}
+
+// findHostNetworkAttachment returns the attachment of the network to the host, or an ENotFound error if the network
+// is not attached to any interface of the host.
+func (o *oVirtClient) findHostNetworkAttachment(
+	hostID HostID,
+	networkID NetworkID,
+	retries []RetryStrategy,
+) (result *ovirtsdk4.NetworkAttachment, err error) {
+	action := fmt.Sprintf("listing network attachments of host %s", hostID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(hostID)).
+				NetworkAttachmentsService().
+				List().
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			if attachments, ok := response.Attachments(); ok {
+				for _, attachment := range attachments.Slice() {
+					network, ok := attachment.Network()
+					if !ok {
+						continue
+					}
+					if id, ok := network.Id(); ok && NetworkID(id) == networkID {
+						result = attachment
+						return nil
+					}
+				}
+			}
+			return newError(ENotFound, "network %s is not attached to host %s", networkID, hostID)
+		})
+	return result, err
+}
+
+func (m *mockClient) AttachNetworkToHost(
+	hostID HostID,
+	networkID NetworkID,
+	nicName string,
+	config IPConfig,
+	_ ...RetryStrategy,
+) error {
+	if config == nil {
+		config = MustNewIPConfig(BootProtocolNone, nil)
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.hosts[hostID]
+	if !ok {
+		return newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	if _, ok := m.networks[networkID]; !ok {
+		return newError(ENotFound, "network with ID %s not found", networkID)
+	}
+	var target *hostNIC
+	for _, nic := range item.nics {
+		if nic.name == nicName {
+			target = nic
+		}
+	}
+	if target == nil {
+		return newError(ENotFound, "host %s has no network interface named %s", hostID, nicName)
+	}
+	for _, nic := range item.nics {
+		delete(nic.networks, networkID)
+	}
+	if target.networks == nil {
+		target.networks = map[NetworkID]IPConfig{}
+	}
+	target.networks[networkID] = config
+	return nil
+}
+
+func (m *mockClient) DetachNetworkFromHost(hostID HostID, networkID NetworkID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.hosts[hostID]
+	if !ok {
+		return newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	for _, nic := range item.nics {
+		if _, ok := nic.networks[networkID]; ok {
+			delete(nic.networks, networkID)
+			return nil
+		}
+	}
+	return newError(ENotFound, "network %s is not attached to host %s", networkID, hostID)
+}
@@ -0,0 +1,74 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestHostNetworkAttachment(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+
+	var hostID HostID
+	for id := range m.hosts {
+		hostID = id
+		break
+	}
+	var networkID NetworkID
+	for id := range m.networks {
+		networkID = id
+		break
+	}
+	nic, err := findHostNICByName(m, hostID, "eth0", nil)
+	if err != nil {
+		t.Fatalf("Failed to find eth0 on the test host (%v)", err)
+	}
+
+	config := MustNewIPConfig(BootProtocolStatic, &IP{Address: "192.168.0.10", Netmask: "255.255.255.0"})
+	if err := m.AttachNetworkToHost(hostID, networkID, "eth1", config); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Attaching a network to a nonexistent interface did not result in an ENotFound error (%v)", err)
+	}
+	if err := m.AttachNetworkToHost(hostID, networkID, nic.Name(), config); err != nil {
+		t.Fatalf("Failed to attach network %s to host %s (%v)", networkID, hostID, err)
+	}
+	if _, ok := m.hosts[hostID].nics[0].networks[networkID]; !ok {
+		t.Fatalf("Network %s is not attached to interface %s.", networkID, nic.Name())
+	}
+
+	if err := m.DetachNetworkFromHost(hostID, networkID); err != nil {
+		t.Fatalf("Failed to detach network %s from host %s (%v)", networkID, hostID, err)
+	}
+	if err := m.DetachNetworkFromHost(hostID, networkID); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Detaching a network that is not attached did not result in an ENotFound error (%v)", err)
+	}
+}
+
+func TestNewIPConfig(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name         string
+		bootProtocol BootProtocol
+		ip           *IP
+		valid        bool
+	}{
+		{"dhcp", BootProtocolDHCP, nil, true},
+		{"none", BootProtocolNone, nil, true},
+		{"static", BootProtocolStatic, &IP{Address: "10.0.0.2", Netmask: "255.0.0.0", Gateway: "10.0.0.1"}, true},
+		{"static without IP", BootProtocolStatic, nil, false},
+		{"static with invalid address", BootProtocolStatic, &IP{Address: "10.0.0", Netmask: "255.0.0.0"}, false},
+		{"dhcp with IP", BootProtocolDHCP, &IP{Address: "10.0.0.2", Netmask: "255.0.0.0"}, false},
+		{"invalid protocol", BootProtocol("autoconf"), nil, false},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewIPConfig(testCase.bootProtocol, testCase.ip)
+			if testCase.valid && err != nil {
+				t.Fatalf("Failed to create IP config (%v)", err)
+			}
+			if !testCase.valid && !HasErrorCode(err, EBadArgument) {
+				t.Fatalf("Creating an invalid IP config did not result in an EBadArgument error (%v)", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// HostNICID is the identifier of a network interface of a host.
+type HostNICID string
+
+// HostNIC is a physical network interface, bond or VLAN interface of a host.
+type HostNIC interface {
+	// ID returns the identifier of the network interface.
+	ID() HostNICID
+	// HostID returns the ID of the host the network interface belongs to.
+	HostID() HostID
+	// Name returns the name of the network interface on the host, for example eth0.
+	Name() string
+}
+
+func convertSDKHostNIC(object *ovirtsdk4.HostNic, hostID HostID) (*hostNIC, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("host NIC", "ID")
+	}
+	name, ok := object.Name()
+	if !ok {
+		return nil, newFieldNotFound("host NIC", "name")
+	}
+	return &hostNIC{
+		id:     HostNICID(id),
+		hostID: hostID,
+		name:   name,
+	}, nil
+}
+
+type hostNIC struct {
+	id     HostNICID
+	hostID HostID
+	name   string
+
+	// networks holds the networks attached to the interface with their IP configuration. It is only used by the
+	// mock.
+	networks map[NetworkID]IPConfig
+}
+
+func (h *hostNIC) ID() HostNICID {
+	return h.id
+}
+
+func (h *hostNIC) HostID() HostID {
+	return h.hostID
+}
+
+func (h *hostNIC) Name() string {
+	return h.name
+}
+
+func (o *oVirtClient) ListHostNICs(hostID HostID, retries ...RetryStrategy) (result []HostNIC, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("listing network interfaces of host %s", hostID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().HostsService().HostService(string(hostID)).NicsService().List().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			result = []HostNIC{}
+			sdkNICs, ok := response.Nics()
+			if !ok {
+				return nil
+			}
+			for i, sdkNIC := range sdkNICs.Slice() {
+				nic, err := convertSDKHostNIC(sdkNIC, hostID)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert host NIC during listing item #%d", i)
+				}
+				result = append(result, nic)
+			}
+			return nil
+		})
+	return result, err
+}
+
+// findHostNICByName returns the network interface of the host with the specified name, or an ENotFound error.
+func findHostNICByName(client HostClient, hostID HostID, name string, retries []RetryStrategy) (HostNIC, error) {
+	nics, err := client.ListHostNICs(hostID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	for _, nic := range nics {
+		if nic.Name() == name {
+			return nic, nil
+		}
+	}
+	return nil, newError(ENotFound, "host %s has no network interface named %s", hostID, name)
+}
+
+func (m *mockClient) ListHostNICs(hostID HostID, _ ...RetryStrategy) ([]HostNIC, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.hosts[hostID]
+	if !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	result := make([]HostNIC, len(item.nics))
+	for i, nic := range item.nics {
+		result[i] = nic
+	}
+	return result, nil
+}
@@ -13,7 +13,7 @@ func (o *oVirtClient) GetInstanceType(id InstanceTypeID, retries ...RetryStrateg
 		func() error {
 			response, err := o.conn.SystemService().InstanceTypesService().InstanceTypeService(string(id)).Get().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting instance type %s", id), err)
 			}
 			sdkObject, ok := response.InstanceType()
 			if !ok {
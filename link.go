@@ -0,0 +1,75 @@
+package ovirtclient
+
+// This file contains the helpers to follow the links from one object to related objects, for example from a VM to
+// its cluster. The related objects are always fetched anew, so the result reflects the engine state at the time of
+// the call.
+
+func (o *oVirtClient) GetVMCluster(id VMID, retries ...RetryStrategy) (Cluster, error) {
+	return getVMCluster(o, id, retries)
+}
+
+func (m *mockClient) GetVMCluster(id VMID, retries ...RetryStrategy) (Cluster, error) {
+	return getVMCluster(m, id, retries)
+}
+
+func (o *oVirtClient) GetDiskStorageDomains(diskID DiskID, retries ...RetryStrategy) ([]StorageDomain, error) {
+	return getDiskStorageDomains(o, diskID, retries)
+}
+
+func (m *mockClient) GetDiskStorageDomains(diskID DiskID, retries ...RetryStrategy) ([]StorageDomain, error) {
+	return getDiskStorageDomains(m, diskID, retries)
+}
+
+func getVMCluster(client Client, id VMID, retries []RetryStrategy) (Cluster, error) {
+	vm, err := client.GetVM(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return followClusterLink(client, "VM", string(id), vm.ClusterID(), retries)
+}
+
+func getDiskStorageDomains(client Client, diskID DiskID, retries []RetryStrategy) ([]StorageDomain, error) {
+	disk, err := client.GetDisk(diskID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return followStorageDomainLinks(client, "disk", string(diskID), disk.StorageDomainIDs(), retries)
+}
+
+func followClusterLink(
+	client Client,
+	fromType string,
+	fromID string,
+	clusterID ClusterID,
+	retries []RetryStrategy,
+) (Cluster, error) {
+	cluster, err := client.GetCluster(clusterID, retries...)
+	if err != nil {
+		return nil, wrapLinkError(err, fromType, fromID, "cluster", string(clusterID))
+	}
+	return cluster, nil
+}
+
+func followStorageDomainLinks(
+	client Client,
+	fromType string,
+	fromID string,
+	storageDomainIDs []StorageDomainID,
+	retries []RetryStrategy,
+) ([]StorageDomain, error) {
+	storageDomains := make([]StorageDomain, len(storageDomainIDs))
+	for i, id := range storageDomainIDs {
+		storageDomain, err := client.GetStorageDomain(id, retries...)
+		if err != nil {
+			return nil, wrapLinkError(err, fromType, fromID, "storage domain", string(id))
+		}
+		storageDomains[i] = storageDomain
+	}
+	return storageDomains, nil
+}
+
+// wrapLinkError adds the object the link was followed from to the error message. The error code is kept, so a
+// missing related object still results in an ENotFound error.
+func wrapLinkError(err error, fromType string, fromID string, toType string, toID string) error {
+	return wrap(err, EUnidentified, "failed to fetch %s %s of %s %s", toType, toID, fromType, fromID)
+}
@@ -0,0 +1,51 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestGetVMCluster(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	cluster, err := client.GetVMCluster(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch the cluster of VM %s (%v)", vm.ID(), err)
+	}
+	if cluster.ID() != helper.GetClusterID() {
+		t.Fatalf("Incorrect cluster returned for VM %s (expected: %s, got: %s)", vm.ID(), helper.GetClusterID(), cluster.ID())
+	}
+
+	if _, err := client.GetVMCluster("00000000-0000-0000-0000-000000000001"); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.ENotFound,
+	) {
+		t.Fatalf("Fetching the cluster of a nonexistent VM did not result in an ENotFound error (%v)", err)
+	}
+}
+
+func TestGetDiskStorageDomains(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	disk := assertCanCreateDisk(t, helper)
+	storageDomains, err := client.GetDiskStorageDomains(disk.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch the storage domains of disk %s (%v)", disk.ID(), err)
+	}
+	if len(storageDomains) != 1 {
+		t.Fatalf("Incorrect number of storage domains returned (expected: 1, got: %d)", len(storageDomains))
+	}
+	if storageDomains[0].ID() != helper.GetStorageDomainID() {
+		t.Fatalf(
+			"Incorrect storage domain returned (expected: %s, got: %s)",
+			helper.GetStorageDomainID(),
+			storageDomains[0].ID(),
+		)
+	}
+}
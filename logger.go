@@ -1,6 +1,11 @@
 package ovirtclient
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
 	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
 )
 
@@ -8,3 +13,94 @@ import (
 type Logger interface {
 	ovirtclientlog.Logger
 }
+
+type logFieldsContextKey struct{}
+
+// WithLogFields returns a context that carries the specified fields for structured logging. When a client is
+// scoped to this context using WithContext, every log line the client emits carries the fields, for example:
+//
+//	ctx = ovirtclient.WithLogFields(ctx, map[string]interface{}{"request_id": requestID})
+//	vm, err := client.WithContext(ctx).GetVM(id)
+//
+// Calling WithLogFields on a context that already carries fields merges the two sets, the new values take
+// precedence on duplicate keys.
+func WithLogFields(ctx context.Context, fields map[string]interface{}) context.Context {
+	merged := LogFieldsFromContext(ctx)
+	for key, value := range fields {
+		merged[key] = value
+	}
+	return context.WithValue(ctx, logFieldsContextKey{}, merged)
+}
+
+// LogFieldsFromContext returns a copy of the log fields stored in the context by WithLogFields. Logger
+// implementations can use this function in WithContext to render the fields in their own format. The returned map
+// is empty if the context carries no fields.
+func LogFieldsFromContext(ctx context.Context) map[string]interface{} {
+	result := map[string]interface{}{}
+	if ctx == nil {
+		return result
+	}
+	fields, _ := ctx.Value(logFieldsContextKey{}).(map[string]interface{})
+	for key, value := range fields {
+		result[key] = value
+	}
+	return result
+}
+
+// newContextLogger scopes the logger to the context and appends the log fields of the context to every log message.
+func newContextLogger(logger ovirtclientlog.Logger, ctx context.Context) ovirtclientlog.Logger {
+	if l, ok := logger.(*contextFieldsLogger); ok {
+		logger = l.backend
+	}
+	logger = logger.WithContext(ctx)
+	fields := LogFieldsFromContext(ctx)
+	if len(fields) == 0 {
+		return logger
+	}
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	renderedFields := make([]string, len(keys))
+	for i, key := range keys {
+		renderedFields[i] = fmt.Sprintf("%s=%v", key, fields[key])
+	}
+	return &contextFieldsLogger{
+		backend: logger,
+		fields:  strings.Join(renderedFields, " "),
+	}
+}
+
+// contextFieldsLogger renders the log fields of a context after the log message and passes it to the backend.
+type contextFieldsLogger struct {
+	backend ovirtclientlog.Logger
+	fields  string
+}
+
+// withFields returns a copy of the arguments with the rendered fields appended, leaving the caller's slice intact.
+func (c *contextFieldsLogger) withFields(args []interface{}) []interface{} {
+	result := make([]interface{}, len(args), len(args)+1)
+	copy(result, args)
+	return append(result, c.fields)
+}
+
+func (c *contextFieldsLogger) Debugf(format string, args ...interface{}) {
+	c.backend.Debugf(format+" [%s]", c.withFields(args)...)
+}
+
+func (c *contextFieldsLogger) Infof(format string, args ...interface{}) {
+	c.backend.Infof(format+" [%s]", c.withFields(args)...)
+}
+
+func (c *contextFieldsLogger) Warningf(format string, args ...interface{}) {
+	c.backend.Warningf(format+" [%s]", c.withFields(args)...)
+}
+
+func (c *contextFieldsLogger) Errorf(format string, args ...interface{}) {
+	c.backend.Errorf(format+" [%s]", c.withFields(args)...)
+}
+
+func (c *contextFieldsLogger) WithContext(ctx context.Context) ovirtclientlog.Logger {
+	return newContextLogger(c, ctx)
+}
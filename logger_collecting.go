@@ -0,0 +1,139 @@
+package ovirtclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+)
+
+// LogLevel is the level a message was logged at.
+type LogLevel string
+
+const (
+	// LogLevelDebug is the level of messages logged with Debugf.
+	LogLevelDebug LogLevel = "debug"
+	// LogLevelInfo is the level of messages logged with Infof.
+	LogLevelInfo LogLevel = "info"
+	// LogLevelWarning is the level of messages logged with Warningf.
+	LogLevelWarning LogLevel = "warning"
+	// LogLevelError is the level of messages logged with Errorf.
+	LogLevelError LogLevel = "error"
+)
+
+// LogLevelList is a list of LogLevel values.
+type LogLevelList []LogLevel
+
+// LogLevelValues returns all possible LogLevel values.
+func LogLevelValues() LogLevelList {
+	return []LogLevel{
+		LogLevelDebug,
+		LogLevelInfo,
+		LogLevelWarning,
+		LogLevelError,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l LogLevelList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// LogEntry is a single message recorded by a CollectingLogger.
+type LogEntry interface {
+	// Level returns the level the message was logged at.
+	Level() LogLevel
+	// Message returns the message with the format arguments applied.
+	Message() string
+}
+
+// CollectingLogger is a Logger that keeps all messages in memory, so tests can assert what the client logged. It is
+// safe for concurrent use. Loggers returned from WithContext record into the same list of entries.
+type CollectingLogger interface {
+	Logger
+
+	// Entries returns the recorded messages in the order they were logged.
+	Entries() []LogEntry
+	// EntriesAtLevel returns the recorded messages logged at the specified level.
+	EntriesAtLevel(level LogLevel) []LogEntry
+}
+
+// NewCollectingLogger creates a new, empty CollectingLogger.
+func NewCollectingLogger() CollectingLogger {
+	return &collectingLogger{
+		lock: &sync.Mutex{},
+	}
+}
+
+type logEntry struct {
+	level   LogLevel
+	message string
+}
+
+func (l logEntry) Level() LogLevel {
+	return l.level
+}
+
+func (l logEntry) Message() string {
+	return l.message
+}
+
+type collectingLogger struct {
+	lock    *sync.Mutex
+	entries []logEntry
+}
+
+func (c *collectingLogger) record(level LogLevel, format string, args []interface{}) {
+	message := fmt.Sprintf(format, args...)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries = append(c.entries, logEntry{level: level, message: message})
+}
+
+func (c *collectingLogger) Debugf(format string, args ...interface{}) {
+	c.record(LogLevelDebug, format, args)
+}
+
+func (c *collectingLogger) Infof(format string, args ...interface{}) {
+	c.record(LogLevelInfo, format, args)
+}
+
+func (c *collectingLogger) Warningf(format string, args ...interface{}) {
+	c.record(LogLevelWarning, format, args)
+}
+
+func (c *collectingLogger) Errorf(format string, args ...interface{}) {
+	c.record(LogLevelError, format, args)
+}
+
+// WithContext returns the logger itself, the context doesn't change where the entries are recorded.
+func (c *collectingLogger) WithContext(_ context.Context) ovirtclientlog.Logger {
+	return c
+}
+
+func (c *collectingLogger) Entries() []LogEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	result := make([]LogEntry, len(c.entries))
+	for i, entry := range c.entries {
+		result[i] = entry
+	}
+	return result
+}
+
+func (c *collectingLogger) EntriesAtLevel(level LogLevel) []LogEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var result []LogEntry
+	for _, entry := range c.entries {
+		if entry.level == level {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
@@ -0,0 +1,49 @@
+package ovirtclient_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestCollectingLogger(t *testing.T) {
+	t.Parallel()
+	logger := ovirtclient.NewCollectingLogger()
+
+	const goroutines = 10
+	wg := &sync.WaitGroup{}
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			logger.Debugf("debug %d", i)
+			logger.WithContext(context.Background()).Warningf("warning %d", i)
+		}(i)
+	}
+	wg.Wait()
+	logger.Errorf("done after %d%%", 100)
+
+	if entries := logger.Entries(); len(entries) != 2*goroutines+1 {
+		t.Fatalf("Incorrect number of entries (expected: %d, got: %d)", 2*goroutines+1, len(entries))
+	}
+	warnings := logger.EntriesAtLevel(ovirtclient.LogLevelWarning)
+	if len(warnings) != goroutines {
+		t.Fatalf("Incorrect number of warnings (expected: %d, got: %d)", goroutines, len(warnings))
+	}
+	seen := map[string]bool{}
+	for _, entry := range warnings {
+		seen[entry.Message()] = true
+	}
+	for i := 0; i < goroutines; i++ {
+		if message := fmt.Sprintf("warning %d", i); !seen[message] {
+			t.Fatalf("Warning %q was not recorded.", message)
+		}
+	}
+	errors := logger.EntriesAtLevel(ovirtclient.LogLevelError)
+	if len(errors) != 1 || errors[0].Message() != "done after 100%" {
+		t.Fatalf("The error was not recorded with its format applied (%v)", errors)
+	}
+}
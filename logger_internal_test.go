@@ -0,0 +1,71 @@
+package ovirtclient
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+)
+
+func TestWithLogFieldsMerges(t *testing.T) {
+	t.Parallel()
+	ctx := WithLogFields(context.Background(), map[string]interface{}{"request_id": "abc", "vm_id": "1"})
+	ctx = WithLogFields(ctx, map[string]interface{}{"vm_id": "2", "operation": "start"})
+
+	fields := LogFieldsFromContext(ctx)
+	expected := map[string]interface{}{"request_id": "abc", "vm_id": "2", "operation": "start"}
+	if len(fields) != len(expected) {
+		t.Fatalf("Incorrect number of log fields (expected: %d, got: %d)", len(expected), len(fields))
+	}
+	for key, value := range expected {
+		if fields[key] != value {
+			t.Fatalf("Incorrect value for log field %s (expected: %v, got: %v)", key, value, fields[key])
+		}
+	}
+}
+
+func TestContextLoggerRendersFields(t *testing.T) {
+	t.Parallel()
+	recorder := &recordingLogger{}
+	logger := ovirtclientlog.Logger(recorder)
+
+	ctx := WithLogFields(context.Background(), map[string]interface{}{"vm_id": "1", "operation": "start"})
+	logger = newContextLogger(logger, ctx)
+	logger.Debugf("value: %d%%", 42)
+	// Scoping the logger again must replace the fields, not render them twice.
+	logger = logger.WithContext(WithLogFields(ctx, map[string]interface{}{"request_id": "abc"}))
+	logger.Debugf("done")
+
+	expected := []string{
+		"value: 42% [operation=start vm_id=1]",
+		"done [operation=start request_id=abc vm_id=1]",
+	}
+	lines := recorder.debug
+	if len(lines) != len(expected) {
+		t.Fatalf("Incorrect number of log lines (expected: %d, got: %d)", len(expected), len(lines))
+	}
+	for i, line := range expected {
+		if lines[i] != line {
+			t.Fatalf("Incorrect log line %d (expected: %s, got: %s)", i, line, lines[i])
+		}
+	}
+}
+
+func TestLogRetryUsesCollectingLogger(t *testing.T) {
+	t.Parallel()
+	logger := NewCollectingLogger()
+	logRetry("testing", logger, newError(EPending, "not done yet"))
+	logRetry("testing", logger, newError(EConnection, "connection refused"))
+
+	entries := logger.EntriesAtLevel(LogLevelDebug)
+	if len(entries) != 2 {
+		t.Fatalf("Incorrect number of debug entries (expected: %d, got: %d)", 2, len(entries))
+	}
+	if !strings.HasPrefix(entries[0].Message(), "Still testing, retrying...") {
+		t.Fatalf("Incorrect message for a pending error: %s", entries[0].Message())
+	}
+	if !strings.HasPrefix(entries[1].Message(), "Failed testing, retrying...") {
+		t.Fatalf("Incorrect message for a failed attempt: %s", entries[1].Message())
+	}
+}
@@ -35,7 +35,7 @@ type mockClient struct {
 	networks                          map[NetworkID]*network
 	dataCenters                       map[DatacenterID]*datacenterWithClusters
 	vmDiskAttachmentsByVM             map[VMID]map[DiskAttachmentID]*diskAttachment
-	vmDiskAttachmentsByDisk           map[DiskID]*diskAttachment
+	vmDiskAttachmentsByDisk           map[DiskID]map[DiskAttachmentID]*diskAttachment
 	templateDiskAttachmentsByTemplate map[TemplateID][]*templateDiskAttachment
 	templateDiskAttachmentsByDisk     map[DiskID]*templateDiskAttachment
 	tags                              map[TagID]*tag
@@ -43,12 +43,27 @@ type mockClient struct {
 	vmIPs                             map[VMID]map[string][]net.IP
 	instanceTypes                     map[InstanceTypeID]*instanceType
 	graphicsConsolesByVM              map[VMID][]*vmGraphicsConsole
+	exportedTemplates                 map[StorageDomainID]map[TemplateID]*mockExportedTemplate
+	networkFilters                    map[NetworkFilterID]*networkFilter
+	watchdogsByVM                     map[VMID]*vmWatchdog
+	events                            map[EventID]*event
+	storageConnections                map[StorageConnectionID]*storageConnection
+	affinityLabels                    map[AffinityLabelID]*affinityLabel
+	networkProviders                  map[NetworkProviderID]*networkProvider
+	imageProviders                    map[ImageProviderID]*mockImageProvider
+	snapshots                         map[SnapshotID]*snapshot
+	roles                             map[RoleID]*role
+	permissions                       map[PermissionID]*permission
+	clusterMigrationPolicies          map[ClusterID]migrationPolicy
+	diskCheckpoints                   map[DiskID]CheckpointID
+	clusterSchedulingPolicies         map[ClusterID]schedulingPolicy
+	nicNetworkFilterParameters        map[NICID][]NetworkFilterParameter
 }
 
 func (m *mockClient) WithContext(ctx context.Context) Client {
 	return &mockClient{
 		ctx,
-		m.logger.WithContext(ctx),
+		newContextLogger(m.logger, ctx),
 		m.url,
 		m.lock,
 		m.nonSecureRandom,
@@ -71,6 +86,21 @@ func (m *mockClient) WithContext(ctx context.Context) Client {
 		m.vmIPs,
 		m.instanceTypes,
 		m.graphicsConsolesByVM,
+		m.exportedTemplates,
+		m.networkFilters,
+		m.watchdogsByVM,
+		m.events,
+		m.storageConnections,
+		m.affinityLabels,
+		m.networkProviders,
+		m.imageProviders,
+		m.snapshots,
+		m.roles,
+		m.permissions,
+		m.clusterMigrationPolicies,
+		m.diskCheckpoints,
+		m.clusterSchedulingPolicies,
+		m.nicNetworkFilterParameters,
 	}
 }
 
@@ -28,6 +28,9 @@ type NetworkData interface {
 	Name() string
 	// DatacenterID is the identifier of the datacenter object.
 	DatacenterID() DatacenterID
+	// ExternalProviderID returns the ID of the network provider the network was imported from, or an empty string
+	// if the network is managed by the oVirt Engine itself.
+	ExternalProviderID() NetworkProviderID
 }
 
 // Network is the interface defining the fields for networks.
@@ -55,20 +58,28 @@ func convertSDKNetwork(sdkObject *ovirtsdk4.Network, client *oVirtClient) (Netwo
 	if !ok {
 		return nil, newFieldNotFound("datacenter on network", "ID")
 	}
+	var externalProviderID NetworkProviderID
+	if provider, ok := sdkObject.ExternalProvider(); ok {
+		if providerID, ok := provider.Id(); ok {
+			externalProviderID = NetworkProviderID(providerID)
+		}
+	}
 	return &network{
-		client: client,
-		id:     NetworkID(id),
-		name:   name,
-		dcID:   DatacenterID(dcID),
+		client:             client,
+		id:                 NetworkID(id),
+		name:               name,
+		dcID:               DatacenterID(dcID),
+		externalProviderID: externalProviderID,
 	}, nil
 }
 
 type network struct {
 	client Client
 
-	id   NetworkID
-	name string
-	dcID DatacenterID
+	id                 NetworkID
+	name               string
+	dcID               DatacenterID
+	externalProviderID NetworkProviderID
 }
 
 func (n network) ID() NetworkID {
@@ -83,6 +94,10 @@ func (n network) DatacenterID() DatacenterID {
 	return n.dcID
 }
 
+func (n network) ExternalProviderID() NetworkProviderID {
+	return n.externalProviderID
+}
+
 func (n network) Datacenter(retries ...RetryStrategy) (Datacenter, error) {
 	return n.client.GetDatacenter(n.dcID, retries...)
 }
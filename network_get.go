@@ -7,6 +7,9 @@ import (
 )
 
 func (o *oVirtClient) GetNetwork(id NetworkID, retries ...RetryStrategy) (result Network, err error) {
+	if err := validateNotBlankUUID("network", string(id)); err != nil {
+		return nil, err
+	}
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting network %s", id),
@@ -15,7 +18,7 @@ func (o *oVirtClient) GetNetwork(id NetworkID, retries ...RetryStrategy) (result
 		func() error {
 			response, err := o.conn.SystemService().NetworksService().NetworkService(string(id)).Get().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting network %s", id), err)
 			}
 			sdkObject, ok := response.Network()
 			if !ok {
@@ -40,6 +43,9 @@ func (o *oVirtClient) GetNetwork(id NetworkID, retries ...RetryStrategy) (result
 }
 
 func (m *mockClient) GetNetwork(id NetworkID, _ ...RetryStrategy) (Network, error) {
+	if err := validateNotBlankUUID("network", string(id)); err != nil {
+		return nil, err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.networks[id]; ok {
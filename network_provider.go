@@ -0,0 +1,113 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// NetworkProviderID is the identifier of an external network provider.
+type NetworkProviderID string
+
+// NetworkProviderClient contains the methods to work with external network providers, such as the OVN provider
+// installed alongside the oVirt Engine.
+type NetworkProviderClient interface {
+	// ListOpenStackNetworkProviders returns all OpenStack-compatible network providers configured on the oVirt
+	// Engine. This includes the OVN provider.
+	ListOpenStackNetworkProviders(retries ...RetryStrategy) ([]NetworkProvider, error)
+	// ImportOVNNetwork imports the network identified by externalNetworkID from the specified provider into the
+	// datacenter and waits until the imported network shows up as a logical network. An ENotFound error is
+	// returned if the provider, the external network or the datacenter doesn't exist.
+	ImportOVNNetwork(
+		providerID NetworkProviderID,
+		externalNetworkID string,
+		datacenterID DatacenterID,
+		retries ...RetryStrategy,
+	) (Network, error)
+}
+
+// NetworkProvider is an external provider that supplies networks to the oVirt Engine.
+type NetworkProvider interface {
+	// ID returns the identifier of the provider.
+	ID() NetworkProviderID
+	// Name returns the user-given name of the provider.
+	Name() string
+	// Type returns the API flavor of the provider. The OVN provider is NetworkProviderTypeExternal.
+	Type() NetworkProviderType
+	// URL returns the address the oVirt Engine uses to reach the provider.
+	URL() string
+}
+
+// NetworkProviderType is the API flavor of an OpenStack-compatible network provider.
+type NetworkProviderType string
+
+const (
+	// NetworkProviderTypeExternal is a provider implementing the OpenStack Networking API without being Neutron,
+	// for example the OVN provider.
+	NetworkProviderTypeExternal NetworkProviderType = "external"
+	// NetworkProviderTypeNeutron is an OpenStack Neutron installation.
+	NetworkProviderTypeNeutron NetworkProviderType = "neutron"
+)
+
+// NetworkProviderTypeList is a list of NetworkProviderType values.
+type NetworkProviderTypeList []NetworkProviderType
+
+// NetworkProviderTypeValues returns all possible NetworkProviderType values.
+func NetworkProviderTypeValues() NetworkProviderTypeList {
+	return []NetworkProviderType{
+		NetworkProviderTypeExternal,
+		NetworkProviderTypeNeutron,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l NetworkProviderTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+func convertSDKNetworkProvider(object *ovirtsdk.OpenStackNetworkProvider) (*networkProvider, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("network provider", "ID")
+	}
+	name, ok := object.Name()
+	if !ok {
+		return nil, newFieldNotFound("network provider", "name")
+	}
+	providerType, _ := object.Type()
+	url, _ := object.Url()
+	return &networkProvider{
+		id:           NetworkProviderID(id),
+		name:         name,
+		providerType: NetworkProviderType(providerType),
+		url:          url,
+	}, nil
+}
+
+type networkProvider struct {
+	id           NetworkProviderID
+	name         string
+	providerType NetworkProviderType
+	url          string
+
+	// externalNetworks is used by the mock only and maps the external network IDs to their names.
+	externalNetworks map[string]string
+}
+
+func (n networkProvider) ID() NetworkProviderID {
+	return n.id
+}
+
+func (n networkProvider) Name() string {
+	return n.name
+}
+
+func (n networkProvider) Type() NetworkProviderType {
+	return n.providerType
+}
+
+func (n networkProvider) URL() string {
+	return n.url
+}
@@ -0,0 +1,209 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ImportOVNNetwork(
+	providerID NetworkProviderID,
+	externalNetworkID string,
+	datacenterID DatacenterID,
+	retries ...RetryStrategy,
+) (Network, error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	if _, err := o.GetDatacenter(datacenterID, retries...); err != nil {
+		return nil, err
+	}
+	networkName, err := o.getExternalNetworkName(providerID, externalNetworkID, retries)
+	if err != nil {
+		return nil, err
+	}
+	// The import is checked up front because the engine answers a repeated import with a conflict, which would
+	// otherwise be retried until the timeout.
+	networks, err := o.ListNetworks(retries...)
+	if err != nil {
+		return nil, err
+	}
+	if existing := findImportedNetwork(networks, providerID, networkName, datacenterID); existing != nil {
+		return nil, newError(
+			EConflict,
+			"network %s from provider %s is already imported into datacenter %s as %s",
+			externalNetworkID,
+			providerID,
+			datacenterID,
+			existing.ID(),
+		)
+	}
+
+	action := fmt.Sprintf(
+		"importing network %s from provider %s into datacenter %s",
+		externalNetworkID,
+		providerID,
+		datacenterID,
+	)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				OpenstackNetworkProvidersService().
+				ProviderService(string(providerID)).
+				NetworksService().
+				NetworkService(externalNetworkID).
+				Import().
+				DataCenter(ovirtsdk.NewDataCenterBuilder().Id(string(datacenterID)).MustBuild()).
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return o.waitForImportedNetwork(providerID, networkName, datacenterID, retries)
+}
+
+func (o *oVirtClient) getExternalNetworkName(
+	providerID NetworkProviderID,
+	externalNetworkID string,
+	retries []RetryStrategy,
+) (name string, err error) {
+	action := fmt.Sprintf("getting network %s from provider %s", externalNetworkID, providerID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			providerService := o.conn.SystemService().
+				OpenstackNetworkProvidersService().
+				ProviderService(string(providerID))
+			if _, err := providerService.Get().Send(); err != nil {
+				return wrapSDKError(fmt.Sprintf("getting network provider %s", providerID), err)
+			}
+			response, err := providerService.NetworksService().NetworkService(externalNetworkID).Get().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkNetwork, ok := response.Network()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no network returned when getting network %s from provider %s",
+					externalNetworkID,
+					providerID,
+				)
+			}
+			name, ok = sdkNetwork.Name()
+			if !ok {
+				return newFieldNotFound("external network", "name")
+			}
+			return nil
+		},
+	)
+	return name, err
+}
+
+func (o *oVirtClient) waitForImportedNetwork(
+	providerID NetworkProviderID,
+	networkName string,
+	datacenterID DatacenterID,
+	retries []RetryStrategy,
+) (result Network, err error) {
+	err = retry(
+		fmt.Sprintf("waiting for network %s to appear in datacenter %s", networkName, datacenterID),
+		o.logger,
+		retries,
+		func() error {
+			networks, err := o.ListNetworks(retries...)
+			if err != nil {
+				return err
+			}
+			result = findImportedNetwork(networks, providerID, networkName, datacenterID)
+			if result == nil {
+				return newError(
+					EPending,
+					"network %s is not yet available in datacenter %s",
+					networkName,
+					datacenterID,
+				)
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+// findImportedNetwork returns the network imported from the provider under the given name, or nil if there is none.
+// The engine names imported networks after the external network, which is how they are matched.
+func findImportedNetwork(
+	networks []Network,
+	providerID NetworkProviderID,
+	networkName string,
+	datacenterID DatacenterID,
+) Network {
+	for _, network := range networks {
+		if network.ExternalProviderID() == providerID &&
+			network.Name() == networkName &&
+			network.DatacenterID() == datacenterID {
+			return network
+		}
+	}
+	return nil
+}
+
+func (m *mockClient) ImportOVNNetwork(
+	providerID NetworkProviderID,
+	externalNetworkID string,
+	datacenterID DatacenterID,
+	_ ...RetryStrategy,
+) (Network, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.dataCenters[datacenterID]; !ok {
+		return nil, newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+	}
+	provider, ok := m.networkProviders[providerID]
+	if !ok {
+		return nil, newError(ENotFound, "network provider with ID %s not found", providerID)
+	}
+	networkName, ok := provider.externalNetworks[externalNetworkID]
+	if !ok {
+		return nil, newError(
+			ENotFound,
+			"network with ID %s not found on provider %s",
+			externalNetworkID,
+			providerID,
+		)
+	}
+	networks := make([]Network, 0, len(m.networks))
+	for _, item := range m.networks {
+		networks = append(networks, item)
+	}
+	if existing := findImportedNetwork(networks, providerID, networkName, datacenterID); existing != nil {
+		return nil, newError(
+			EConflict,
+			"network %s from provider %s is already imported into datacenter %s as %s",
+			externalNetworkID,
+			providerID,
+			datacenterID,
+			existing.ID(),
+		)
+	}
+
+	result := &network{
+		client:             m,
+		id:                 NetworkID(m.GenerateUUID()),
+		name:               networkName,
+		dcID:               datacenterID,
+		externalProviderID: providerID,
+	}
+	m.networks[result.id] = result
+	return result, nil
+}
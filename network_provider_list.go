@@ -0,0 +1,41 @@
+package ovirtclient
+
+func (o *oVirtClient) ListOpenStackNetworkProviders(retries ...RetryStrategy) (result []NetworkProvider, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []NetworkProvider{}
+	err = retry(
+		"listing OpenStack network providers",
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.SystemService().OpenstackNetworkProvidersService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Providers()
+			if !ok {
+				return nil
+			}
+			result = make([]NetworkProvider, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKNetworkProvider(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert network provider during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListOpenStackNetworkProviders(_ ...RetryStrategy) ([]NetworkProvider, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := make([]NetworkProvider, len(m.networkProviders))
+	i := 0
+	for _, item := range m.networkProviders {
+		result[i] = item
+		i++
+	}
+	return result, nil
+}
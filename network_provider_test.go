@@ -0,0 +1,52 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestImportOVNNetworkValidation(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	providers, err := client.ListOpenStackNetworkProviders()
+	if err != nil {
+		t.Fatalf("Failed to list OpenStack network providers (%v)", err)
+	}
+	if len(providers) == 0 {
+		t.Skipf("No OpenStack network provider is configured on the engine.")
+	}
+	provider := providers[0]
+
+	datacenters, err := client.ListDatacenters()
+	if err != nil {
+		t.Fatalf("Failed to list datacenters (%v)", err)
+	}
+	if len(datacenters) == 0 {
+		t.Fatalf("No datacenters found.")
+	}
+
+	if _, err := client.ImportOVNNetwork(
+		provider.ID(),
+		"00000000-0000-0000-0000-000000000001",
+		datacenters[0].ID(),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Importing a nonexistent external network did not result in an ENotFound error (%v)", err)
+	}
+	if _, err := client.ImportOVNNetwork(
+		"00000000-0000-0000-0000-000000000001",
+		"00000000-0000-0000-0000-000000000001",
+		datacenters[0].ID(),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Importing from a nonexistent network provider did not result in an ENotFound error (%v)", err)
+	}
+	if _, err := client.ImportOVNNetwork(
+		provider.ID(),
+		"00000000-0000-0000-0000-000000000001",
+		"00000000-0000-0000-0000-000000000001",
+	); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Importing into a nonexistent datacenter did not result in an ENotFound error (%v)", err)
+	}
+}
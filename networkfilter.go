@@ -0,0 +1,61 @@
+package ovirtclient
+
+import ovirtsdk "github.com/ovirt/go-ovirt"
+
+// NetworkFilterID is the identifier of a network filter.
+type NetworkFilterID string
+
+// NetworkFilterClient lists the methods for working with network filters. Network filters are predefined by the
+// oVirt Engine and can be applied to VNIC profiles, for example to prevent MAC or IP spoofing.
+type NetworkFilterClient interface {
+	// GetNetworkFilter returns a single network filter.
+	GetNetworkFilter(id NetworkFilterID, retries ...RetryStrategy) (NetworkFilter, error)
+	// ListNetworkFilters lists all network filters available on the oVirt Engine.
+	ListNetworkFilters(retries ...RetryStrategy) ([]NetworkFilter, error)
+}
+
+// NetworkFilterData is the data segment of the NetworkFilter type.
+type NetworkFilterData interface {
+	// ID returns the identifier of the network filter.
+	ID() NetworkFilterID
+	// Name returns the libvirt name of the network filter, for example "vdsm-no-mac-spoofing".
+	Name() string
+}
+
+// NetworkFilter is a set of traffic filtering rules that can be applied to VNIC profiles.
+type NetworkFilter interface {
+	NetworkFilterData
+}
+
+func convertSDKNetworkFilter(object *ovirtsdk.NetworkFilter, o *oVirtClient) (NetworkFilter, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("network filter", "ID")
+	}
+	name, ok := object.Name()
+	if !ok {
+		return nil, newFieldNotFound("network filter", "name")
+	}
+
+	return &networkFilter{
+		o,
+
+		NetworkFilterID(id),
+		name,
+	}, nil
+}
+
+type networkFilter struct {
+	client Client
+
+	id   NetworkFilterID
+	name string
+}
+
+func (n networkFilter) Name() string {
+	return n.name
+}
+
+func (n networkFilter) ID() NetworkFilterID {
+	return n.id
+}
@@ -0,0 +1,47 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetNetworkFilter(id NetworkFilterID, retries ...RetryStrategy) (result NetworkFilter, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting network filter %s", id),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().NetworkFiltersService().NetworkFilterService(string(id)).Get().Send()
+			if err != nil {
+				return wrapSDKError(fmt.Sprintf("getting network filter %s", id), err)
+			}
+			sdkObject, ok := response.NetworkFilter()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no network filter returned when getting network filter ID %s",
+					id,
+				)
+			}
+			result, err = convertSDKNetworkFilter(sdkObject, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert network filter %s",
+					id,
+				)
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) GetNetworkFilter(id NetworkFilterID, _ ...RetryStrategy) (NetworkFilter, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if item, ok := m.networkFilters[id]; ok {
+		return item, nil
+	}
+	return nil, newError(ENotFound, "network filter with ID %s not found", id)
+}
@@ -0,0 +1,42 @@
+package ovirtclient
+
+func (o *oVirtClient) ListNetworkFilters(retries ...RetryStrategy) (result []NetworkFilter, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []NetworkFilter{}
+	err = retry(
+		"listing network filters",
+		o.logger,
+		retries,
+		func() error {
+			// The SDK names the list field "filters" instead of "network_filters", so this can't be generated.
+			response, e := o.conn.SystemService().NetworkFiltersService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Filters()
+			if !ok {
+				return nil
+			}
+			result = make([]NetworkFilter, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKNetworkFilter(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert network filter during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListNetworkFilters(_ ...RetryStrategy) ([]NetworkFilter, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := make([]NetworkFilter, len(m.networkFilters))
+	i := 0
+	for _, item := range m.networkFilters {
+		result[i] = item
+		i++
+	}
+	return result, nil
+}
@@ -0,0 +1,32 @@
+package ovirtclient_test
+
+import (
+	"testing"
+)
+
+func TestNetworkFilterListAndGet(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	networkFilters, err := client.ListNetworkFilters()
+	if err != nil {
+		t.Fatalf("Failed to list network filters (%v)", err)
+	}
+	if len(networkFilters) == 0 {
+		t.Fatalf("No network filters found.")
+	}
+	for _, networkFilter := range networkFilters {
+		fetchedNetworkFilter, err := client.GetNetworkFilter(networkFilter.ID())
+		if err != nil {
+			t.Fatalf("Failed to fetch network filter %s (%v)", networkFilter.ID(), err)
+		}
+		if fetchedNetworkFilter.Name() != networkFilter.Name() {
+			t.Fatalf(
+				"Network filter name mismatch (expected: %s, got: %s)",
+				networkFilter.Name(),
+				fetchedNetworkFilter.Name(),
+			)
+		}
+	}
+}
@@ -1,9 +1,12 @@
 package ovirtclient
 
 import (
-	"math/rand"
+	"crypto/tls"
+	"fmt"
+	"net"
 	"net/http"
 	"net/url"
+	"runtime/debug"
 	"strings"
 	"sync"
 	"time"
@@ -25,9 +28,59 @@ type ExtraSettings interface {
 	Proxy() *string
 }
 
+// ExtraSettingsV2 extends ExtraSettings with additional options passed through to the underlying SDK connection.
+type ExtraSettingsV2 interface {
+	ExtraSettings
+
+	// Timeout returns the timeout for individual API requests made to the oVirt Engine. Zero means no timeout. Image
+	// transfers only use it to limit connecting and waiting for the response headers.
+	Timeout() time.Duration
+	// UserAgent returns the User-Agent header sent with each request. If empty, DefaultUserAgent() is used.
+	UserAgent() string
+}
+
+// ExtraSettingsV3 extends ExtraSettingsV2 with the polling intervals used when waiting for the engine. Each interval
+// applies only if the caller passes no wait strategy, such as ExponentialBackoff, to the call. Zero keeps the default,
+// an exponential backoff starting at 1 second and doubling after each poll.
+type ExtraSettingsV3 interface {
+	ExtraSettingsV2
+
+	// VMStatusPollInterval returns the interval for polling the VM status in WaitForVMStatus. VM status changes
+	// usually take seconds, so a short interval makes waits more responsive.
+	VMStatusPollInterval() time.Duration
+	// DiskStatusPollInterval returns the interval for polling the disk status in WaitForDiskStatus.
+	DiskStatusPollInterval() time.Duration
+	// TransferPollInterval returns the interval for polling the state of image uploads and downloads. Transfers of
+	// large images take minutes, so a longer interval reduces the load on the engine.
+	TransferPollInterval() time.Duration
+}
+
+// ExtraSettingsV4 extends ExtraSettingsV3 with a retry policy replacing the built-in error classification.
+type ExtraSettingsV4 interface {
+	ExtraSettingsV3
+
+	// RetryPolicy returns the policy deciding which errors are retried, or nil to use DefaultRetryPolicy. A
+	// CustomRetryPolicy or AutoRetry strategy passed to a call takes precedence.
+	RetryPolicy() RetryPolicy
+}
+
+// ExtraSettingsV5 extends ExtraSettingsV4 with the option to configure image transfers like the API calls.
+type ExtraSettingsV5 interface {
+	ExtraSettingsV4
+
+	// UnifiedTransport returns true if the HTTP client used for image transfers, see GetHTTPClient, should be set up
+	// from the same settings as the SDK connection. The SDK doesn't allow passing in a transport, so both keep
+	// their own, but with this option the transfer client also sends the extra headers, honors the compression
+	// setting and closes connections after each request like the SDK does. By default, the transfer client only
+	// shares the TLS, proxy and User-Agent settings and keeps connections alive. The request timeout is never
+	// shared: on the transfer client it only limits connecting and waiting for the response headers, so that
+	// transfers of large images are not aborted.
+	UnifiedTransport() bool
+}
+
 // ExtraSettingsBuilder is a buildable version of ExtraSettings.
 type ExtraSettingsBuilder interface {
-	ExtraSettings
+	ExtraSettingsV5
 
 	// WithExtraHeaders adds extra headers to send along with each request.
 	WithExtraHeaders(map[string]string) ExtraSettingsBuilder
@@ -35,6 +88,22 @@ type ExtraSettingsBuilder interface {
 	WithCompression() ExtraSettingsBuilder
 	// WithProxy explicitly sets a proxy server to use for requests.
 	WithProxy(string) ExtraSettingsBuilder
+	// WithTimeout sets the timeout for individual HTTP requests. A timeout must not be negative.
+	WithTimeout(time.Duration) ExtraSettingsBuilder
+	// WithUserAgent sets the User-Agent header to identify your application in the engine access logs.
+	WithUserAgent(string) ExtraSettingsBuilder
+	// WithVMStatusPollInterval sets the interval for polling the VM status. The interval must not be negative.
+	WithVMStatusPollInterval(time.Duration) ExtraSettingsBuilder
+	// WithDiskStatusPollInterval sets the interval for polling the disk status. The interval must not be negative.
+	WithDiskStatusPollInterval(time.Duration) ExtraSettingsBuilder
+	// WithTransferPollInterval sets the interval for polling image transfers. The interval must not be negative.
+	WithTransferPollInterval(time.Duration) ExtraSettingsBuilder
+	// WithRetryPolicy sets the policy deciding which errors are retried, for example to retry on a specific fault
+	// returned by a proxy in front of the engine.
+	WithRetryPolicy(RetryPolicy) ExtraSettingsBuilder
+	// WithUnifiedTransport configures the HTTP client for image transfers like the SDK connection, for example when
+	// a proxy in front of the engine requires the extra headers on every request.
+	WithUnifiedTransport() ExtraSettingsBuilder
 }
 
 // NewExtraSettings creates a builder for ExtraSettings.
@@ -46,6 +115,16 @@ type extraSettings struct {
 	headers     map[string]string
 	compression bool
 	proxy       *string
+	timeout     time.Duration
+	userAgent   string
+
+	vmStatusPollInterval   time.Duration
+	diskStatusPollInterval time.Duration
+	transferPollInterval   time.Duration
+
+	retryPolicy RetryPolicy
+
+	unifiedTransport bool
 }
 
 func (e *extraSettings) ExtraHeaders() map[string]string {
@@ -60,6 +139,34 @@ func (e *extraSettings) Proxy() *string {
 	return e.proxy
 }
 
+func (e *extraSettings) Timeout() time.Duration {
+	return e.timeout
+}
+
+func (e *extraSettings) UserAgent() string {
+	return e.userAgent
+}
+
+func (e *extraSettings) VMStatusPollInterval() time.Duration {
+	return e.vmStatusPollInterval
+}
+
+func (e *extraSettings) DiskStatusPollInterval() time.Duration {
+	return e.diskStatusPollInterval
+}
+
+func (e *extraSettings) TransferPollInterval() time.Duration {
+	return e.transferPollInterval
+}
+
+func (e *extraSettings) RetryPolicy() RetryPolicy {
+	return e.retryPolicy
+}
+
+func (e *extraSettings) UnifiedTransport() bool {
+	return e.unifiedTransport
+}
+
 func (e *extraSettings) WithExtraHeaders(m map[string]string) ExtraSettingsBuilder {
 	e.headers = m
 	return e
@@ -75,6 +182,56 @@ func (e *extraSettings) WithProxy(addr string) ExtraSettingsBuilder {
 	return e
 }
 
+func (e *extraSettings) WithTimeout(timeout time.Duration) ExtraSettingsBuilder {
+	e.timeout = timeout
+	return e
+}
+
+func (e *extraSettings) WithUserAgent(userAgent string) ExtraSettingsBuilder {
+	e.userAgent = userAgent
+	return e
+}
+
+func (e *extraSettings) WithVMStatusPollInterval(interval time.Duration) ExtraSettingsBuilder {
+	e.vmStatusPollInterval = interval
+	return e
+}
+
+func (e *extraSettings) WithDiskStatusPollInterval(interval time.Duration) ExtraSettingsBuilder {
+	e.diskStatusPollInterval = interval
+	return e
+}
+
+func (e *extraSettings) WithTransferPollInterval(interval time.Duration) ExtraSettingsBuilder {
+	e.transferPollInterval = interval
+	return e
+}
+
+func (e *extraSettings) WithRetryPolicy(policy RetryPolicy) ExtraSettingsBuilder {
+	e.retryPolicy = policy
+	return e
+}
+
+func (e *extraSettings) WithUnifiedTransport() ExtraSettingsBuilder {
+	e.unifiedTransport = true
+	return e
+}
+
+// DefaultUserAgent returns the User-Agent header sent to the oVirt Engine if no other user agent is configured in
+// ExtraSettingsV2. It contains the version of this library if it is available from the build information.
+func DefaultUserAgent() string {
+	version := "v3"
+	if buildInfo, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range buildInfo.Deps {
+			if dep.Path == "github.com/ovirt/go-ovirt-client/v3" {
+				version = dep.Version
+				break
+			}
+		}
+	}
+	return fmt.Sprintf("go-ovirt-client/%s", version)
+}
+
 // New creates a new copy of the enhanced oVirt client. It accepts the following options:
 //
 //	url
@@ -101,7 +258,9 @@ func (e *extraSettings) WithProxy(addr string) ExtraSettingsBuilder {
 //	extraSettings
 //
 // This is an implementation of the ExtraSettings interface, allowing for customization of headers and turning on
-// compression.
+// compression. If it also implements ExtraSettingsV2, the request timeout is applied as well. ExtraSettingsV3 adds the
+// polling intervals for waits, ExtraSettingsV4 a retry policy and ExtraSettingsV5 the option to configure image
+// transfers like the API calls.
 //
 // # TLS
 //
@@ -166,6 +325,9 @@ func NewWithVerify(
 	if err := validateUsername(username); err != nil {
 		return nil, wrap(err, EBadArgument, "invalid username: %s", username)
 	}
+	if err := validateExtraSettings(extraSettings); err != nil {
+		return nil, wrap(err, EBadArgument, "invalid extra settings")
+	}
 	tlsConfig, err := tls.CreateTLSConfig()
 	if err != nil {
 		return nil, wrap(err, ETLSError, "failed to create TLS configuration")
@@ -176,15 +338,12 @@ func NewWithVerify(
 		return nil, err
 	}
 	httpClient := http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: tlsConfig,
-			Proxy:           proxyFunc,
-		},
+		Transport: newTransferTransport(extraSettings, tlsConfig, proxyFunc),
 	}
 
 	client := &oVirtClient{
 		&sync.Mutex{},
-		nil,
+		newSDKConnection(nil),
 		nil,
 		httpClient,
 		logger,
@@ -193,8 +352,9 @@ func NewWithVerify(
 		password,
 		tlsConfig,
 		extraSettings,
-		rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
+		newNonSecureRandom(),
 		verify,
+		newEngineVersionCache(),
 	}
 
 	if err := client.Reconnect(); err != nil {
@@ -227,6 +387,62 @@ func getProxyFunc(extraSettings ExtraSettings) (func(req *http.Request) (*url.UR
 	return proxyFunc, nil
 }
 
+// newTransferTransport creates the transport for the HTTP client used for image transfers. With
+// ExtraSettingsV5.UnifiedTransport it mirrors the transport the SDK builds for its connection in
+// ConnectionBuilder.Build.
+//
+// The request timeout from ExtraSettingsV2 only limits connecting and waiting for the response headers. A transfer
+// sends or receives the whole image in one request, so limiting the entire request would abort large transfers.
+func newTransferTransport(
+	extraSettings ExtraSettings,
+	tlsConfig *tls.Config,
+	proxyFunc func(req *http.Request) (*url.URL, error),
+) http.RoundTripper {
+	transport := &http.Transport{
+		TLSClientConfig: tlsConfig,
+		Proxy:           proxyFunc,
+	}
+	if extraSettingsV2, ok := extraSettings.(ExtraSettingsV2); ok && extraSettingsV2.Timeout() > 0 {
+		transport.DialContext = (&net.Dialer{Timeout: extraSettingsV2.Timeout()}).DialContext
+		transport.ResponseHeaderTimeout = extraSettingsV2.Timeout()
+	}
+	result := &userAgentTransport{
+		userAgent: getUserAgent(extraSettings),
+		transport: transport,
+	}
+	if extraSettingsV5, ok := extraSettings.(ExtraSettingsV5); ok && extraSettingsV5.UnifiedTransport() {
+		transport.DisableKeepAlives = true
+		transport.DisableCompression = !extraSettingsV5.Compression()
+		result.headers = extraSettingsV5.ExtraHeaders()
+	}
+	return result
+}
+
+// userAgentTransport sets the User-Agent header, and the extra headers if configured, on all requests sent through
+// the HTTP client used for image transfers.
+type userAgentTransport struct {
+	userAgent string
+	headers   map[string]string
+	transport http.RoundTripper
+}
+
+func (u *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrip must not modify the original request.
+	req = req.Clone(req.Context())
+	for name, value := range u.headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("User-Agent", u.userAgent)
+	return u.transport.RoundTrip(req)
+}
+
+func getUserAgent(extraSettings ExtraSettings) string {
+	if extraSettingsV2, ok := extraSettings.(ExtraSettingsV2); ok && extraSettingsV2.UserAgent() != "" {
+		return extraSettingsV2.UserAgent()
+	}
+	return DefaultUserAgent()
+}
+
 func processExtraSettings(
 	extraSettings ExtraSettings,
 	connBuilder *ovirtsdk4.ConnectionBuilder,
@@ -241,6 +457,7 @@ func processExtraSettings(
 	if extraSettings.Compression() {
 		connBuilder.Compress(true)
 	}
+	// The request timeout is enforced by the transport of the SDK connection, see sdkTransport.
 	proxy := extraSettings.Proxy()
 	if proxy == nil {
 		connBuilder.ProxyFromEnvironment()
@@ -256,6 +473,54 @@ func processExtraSettings(
 	return nil
 }
 
+func validateExtraSettings(extraSettings ExtraSettings) error {
+	if extraSettings == nil {
+		return nil
+	}
+	if extraSettingsV2, ok := extraSettings.(ExtraSettingsV2); ok && extraSettingsV2.Timeout() < 0 {
+		return newError(EBadArgument, "the timeout must not be negative (%s given)", extraSettingsV2.Timeout())
+	}
+	if extraSettingsV3, ok := extraSettings.(ExtraSettingsV3); ok {
+		for _, interval := range []struct {
+			name  string
+			value time.Duration
+		}{
+			{"VM status", extraSettingsV3.VMStatusPollInterval()},
+			{"disk status", extraSettingsV3.DiskStatusPollInterval()},
+			{"transfer", extraSettingsV3.TransferPollInterval()},
+		} {
+			if interval.value < 0 {
+				return newError(
+					EBadArgument,
+					"the %s poll interval must not be negative (%s given)",
+					interval.name,
+					interval.value,
+				)
+			}
+		}
+	}
+	if proxy := extraSettings.Proxy(); proxy != nil && *proxy != "" {
+		u, err := url.Parse(*proxy)
+		if err != nil {
+			return wrap(err, EBadArgument, "failed to parse proxy URL: %s", *proxy)
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return newError(
+				EBadArgument,
+				"unsupported proxy scheme %s in %s, must be one of: http, https, socks5",
+				u.Scheme,
+				*proxy,
+			)
+		}
+		if u.Host == "" {
+			return newError(EBadArgument, "the proxy URL %s does not contain a host", *proxy)
+		}
+	}
+	return nil
+}
+
 func testConnection(conn Client) error {
 	return conn.Test()
 }
@@ -8,9 +8,11 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
 	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
@@ -101,6 +103,203 @@ func TestBadURL(t *testing.T) {
 	}
 }
 
+func TestInvalidExtraSettings(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]ovirtclient.ExtraSettings{
+		"negative timeout":   ovirtclient.NewExtraSettings().WithTimeout(-1 * time.Second),
+		"bad proxy scheme":   ovirtclient.NewExtraSettings().WithProxy("ftp://localhost:3128"),
+		"proxy without host": ovirtclient.NewExtraSettings().WithProxy("http://"),
+		"negative poll":      ovirtclient.NewExtraSettings().WithTransferPollInterval(-1 * time.Second),
+	}
+
+	for name, extraSettings := range testcases {
+		extraSettings := extraSettings
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			_, err := ovirtclient.New(
+				"https://localhost/ovirt-engine/api",
+				"admin@internal",
+				"invalid-password-for-testing-purposes",
+				ovirtclient.TLS().Insecure(),
+				ovirtclientlog.NewTestLogger(t),
+				extraSettings,
+			)
+			if err == nil {
+				t.Fatalf("no error returned from New on invalid extra settings")
+			}
+			if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+				t.Fatalf("the returned error was not an EBadArgument (%v)", err)
+			}
+		})
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	t.Parallel()
+	const userAgent = "test-agent/1.0"
+
+	lock := &sync.Mutex{}
+	var userAgents []string
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/ovirt-engine/sso/oauth/token" {
+			writer.Header().Set("Content-Type", "application/json")
+			_, _ = writer.Write([]byte(`{"access_token":"test"}`))
+			return
+		}
+		lock.Lock()
+		userAgents = append(userAgents, strings.Join(request.Header.Values("User-Agent"), ", "))
+		lock.Unlock()
+		writer.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := ovirtclient.NewWithVerify(
+		srv.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"invalid-password-for-testing-purposes",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		ovirtclient.NewExtraSettings().WithUserAgent(userAgent),
+		func(connection ovirtclient.Client) error {
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("failed to set up connection (%v)", err)
+	}
+
+	// The responses are irrelevant, we only need the requests to reach the server.
+	_, _ = client.GetSDKClient().SystemService().Get().Send()
+	httpClient := client.GetHTTPClient()
+	response, err := httpClient.Get(srv.URL + "/ovirt-engine/test")
+	if err != nil {
+		t.Fatalf("failed to send request via the HTTP client (%v)", err)
+	}
+	_ = response.Body.Close()
+
+	lock.Lock()
+	defer lock.Unlock()
+	if len(userAgents) != 2 {
+		t.Fatalf("incorrect number of requests received (expected: 2, got: %d)", len(userAgents))
+	}
+	// Each request must carry exactly one User-Agent value, the SDK must not add its own.
+	for _, ua := range userAgents {
+		if ua != userAgent {
+			t.Fatalf("incorrect User-Agent header received (expected: %s, got: %s)", userAgent, ua)
+		}
+	}
+}
+
+func TestUnifiedTransport(t *testing.T) {
+	t.Parallel()
+	const headerName = "X-Test-Header"
+
+	for name, unified := range map[string]bool{"separate": false, "unified": true} {
+		unified := unified
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			lock := &sync.Mutex{}
+			var transferHeaders []string
+			srv := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				if request.URL.Path == "/ovirt-engine/test" {
+					lock.Lock()
+					transferHeaders = append(transferHeaders, request.Header.Values(headerName)...)
+					lock.Unlock()
+				}
+				writer.WriteHeader(http.StatusNotFound)
+			}))
+			t.Cleanup(srv.Close)
+
+			extraSettings := ovirtclient.NewExtraSettings().WithExtraHeaders(map[string]string{headerName: "test"})
+			if unified {
+				extraSettings = extraSettings.WithUnifiedTransport()
+			}
+			client, err := ovirtclient.NewWithVerify(
+				srv.URL+"/ovirt-engine/api",
+				"admin@internal",
+				"invalid-password-for-testing-purposes",
+				ovirtclient.TLS().Insecure(),
+				ovirtclientlog.NewTestLogger(t),
+				extraSettings,
+				nil,
+			)
+			if err != nil {
+				t.Fatalf("failed to set up connection (%v)", err)
+			}
+			httpClient := client.GetHTTPClient()
+			response, err := httpClient.Get(srv.URL + "/ovirt-engine/test")
+			if err != nil {
+				t.Fatalf("failed to send request via the HTTP client (%v)", err)
+			}
+			_ = response.Body.Close()
+
+			lock.Lock()
+			defer lock.Unlock()
+			if received := len(transferHeaders) == 1; received != unified {
+				t.Fatalf("incorrect extra headers on the HTTP client (unified: %t, received: %v)", unified, transferHeaders)
+			}
+		})
+	}
+}
+
+func TestTransferClientTimeout(t *testing.T) {
+	t.Parallel()
+	const timeout = 100 * time.Millisecond
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/ovirt-engine/slow-body":
+			// The headers arrive in time, the transfer of the body takes longer than the request timeout.
+			writer.WriteHeader(http.StatusOK)
+			for i := 0; i < 4; i++ {
+				_, _ = writer.Write([]byte("data"))
+				writer.(http.Flusher).Flush()
+				time.Sleep(timeout)
+			}
+		case "/ovirt-engine/slow-headers":
+			time.Sleep(3 * timeout)
+			writer.WriteHeader(http.StatusOK)
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := ovirtclient.NewWithVerify(
+		srv.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"invalid-password-for-testing-purposes",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		ovirtclient.NewExtraSettings().WithTimeout(timeout),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to set up connection (%v)", err)
+	}
+	httpClient := client.GetHTTPClient()
+
+	response, err := httpClient.Get(srv.URL + "/ovirt-engine/slow-body")
+	if err != nil {
+		t.Fatalf("failed to send request via the HTTP client (%v)", err)
+	}
+	body, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		t.Fatalf("a transfer taking longer than the request timeout was aborted (%v)", err)
+	}
+	if string(body) != "datadatadatadata" {
+		t.Fatalf("incorrect body received (%s)", body)
+	}
+
+	response, err = httpClient.Get(srv.URL + "/ovirt-engine/slow-headers")
+	if err == nil {
+		_ = response.Body.Close()
+		t.Fatalf("waiting for the response headers was not limited by the request timeout")
+	}
+}
+
 func TestBadTLS(t *testing.T) {
 	t.Parallel()
 	// False CA is the CA we will give to the client
@@ -1,10 +1,9 @@
 package ovirtclient
 
 import (
-	"math/rand"
+	"fmt"
 	"net"
 	"sync"
-	"time"
 
 	"github.com/google/uuid"
 )
@@ -39,7 +38,9 @@ func NewMockWithLogger(logger Logger) MockClient {
 				sockets: 1,
 			},
 			nil,
+			"",
 		},
+		BIOSTypeClusterDefault,
 	}
 
 	client := getClient(
@@ -84,7 +85,7 @@ func getClient(
 		lock:            &sync.Mutex{},
 		vms:             map[VMID]*vm{},
 		tags:            map[TagID]*tag{},
-		nonSecureRandom: rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
+		nonSecureRandom: newNonSecureRandom(),
 		storageDomains: map[StorageDomainID]*storageDomain{
 			testStorageDomain.ID():      testStorageDomain,
 			secondaryStorageDomain.ID(): secondaryStorageDomain,
@@ -110,7 +111,7 @@ func getClient(
 			testDatacenter.ID(): testDatacenter,
 		},
 		vmDiskAttachmentsByVM:   map[VMID]map[DiskAttachmentID]*diskAttachment{},
-		vmDiskAttachmentsByDisk: map[DiskID]*diskAttachment{},
+		vmDiskAttachmentsByDisk: map[DiskID]map[DiskAttachmentID]*diskAttachment{},
 		templateDiskAttachmentsByTemplate: map[TemplateID][]*templateDiskAttachment{
 			blankTemplate.ID(): {},
 		},
@@ -118,14 +119,109 @@ func getClient(
 		affinityGroups: map[ClusterID]map[AffinityGroupID]*affinityGroup{
 			testCluster.ID(): {},
 		},
-		vmIPs:                map[VMID]map[string][]net.IP{},
-		instanceTypes:        nil,
-		graphicsConsolesByVM: map[VMID][]*vmGraphicsConsole{},
+		vmIPs:                      map[VMID]map[string][]net.IP{},
+		instanceTypes:              nil,
+		graphicsConsolesByVM:       map[VMID][]*vmGraphicsConsole{},
+		exportedTemplates:          map[StorageDomainID]map[TemplateID]*mockExportedTemplate{},
+		watchdogsByVM:              map[VMID]*vmWatchdog{},
+		events:                     map[EventID]*event{},
+		storageConnections:         map[StorageConnectionID]*storageConnection{},
+		affinityLabels:             map[AffinityLabelID]*affinityLabel{},
+		networkProviders:           map[NetworkProviderID]*networkProvider{},
+		imageProviders:             map[ImageProviderID]*mockImageProvider{},
+		snapshots:                  map[SnapshotID]*snapshot{},
+		permissions:                map[PermissionID]*permission{},
+		clusterMigrationPolicies:   map[ClusterID]migrationPolicy{},
+		diskCheckpoints:            map[DiskID]CheckpointID{},
+		clusterSchedulingPolicies:  map[ClusterID]schedulingPolicy{},
+		nicNetworkFilterParameters: map[NICID][]NetworkFilterParameter{},
 	}
+	for _, storageDomain := range client.storageDomains {
+		connection := generateTestStorageConnection(storageDomain)
+		client.storageConnections[connection.id] = connection
+	}
+	testNetworkProvider := generateTestNetworkProvider()
+	client.networkProviders[testNetworkProvider.id] = testNetworkProvider
 	client.instanceTypes = getInstanceTypes(client)
+	client.networkFilters = getNetworkFilters(client)
+	client.roles = getRoles(client)
+	adminPermission := &permission{
+		client:        client,
+		id:            PermissionID(uuid.NewString()),
+		roleID:        mockSuperUserRoleID,
+		principalType: PermissionPrincipalTypeUser,
+		principalID:   mockAdminUserID,
+	}
+	client.permissions[adminPermission.id] = adminPermission
 	return client
 }
 
+const (
+	// mockSuperUserRoleID is the ID of the SuperUser role, same as the oVirt Engine.
+	mockSuperUserRoleID RoleID = "00000000-0000-0000-0000-000000000001"
+	// mockAdminUserID is the user the mock grants the SuperUser role on the system, like the admin@internal user of
+	// a new engine.
+	mockAdminUserID = "00000000-0000-0000-0000-0000000000ad"
+)
+
+// getRoles returns a subset of the predefined roles of the oVirt Engine.
+func getRoles(client *mockClient) map[RoleID]*role {
+	roles := []*role{
+		{client, mockSuperUserRoleID, "SuperUser", "Roles management administrator", true, false},
+		{client, "00000000-0000-0000-0001-000000000001", "UserRole", "Standard User Role", false, false},
+		{
+			client,
+			"00000000-0000-0000-0001-000000000002",
+			"PowerUserRole",
+			"User Role, allowed to create VMs, Templates and Disks",
+			false,
+			false,
+		},
+		{
+			client,
+			"00000000-0000-0000-0001-000000000006",
+			"UserVmManager",
+			"User Role, with permission for any operation on VMs",
+			false,
+			false,
+		},
+	}
+	result := make(map[RoleID]*role, len(roles))
+	for _, item := range roles {
+		result[item.id] = item
+	}
+	return result
+}
+
+// mockDefaultNetworkFilterID is the network filter the mock assigns to new VNIC profiles, same as the oVirt Engine.
+const mockDefaultNetworkFilterID NetworkFilterID = "0000000f-000f-000f-000f-000000000001"
+
+func getNetworkFilters(client *mockClient) map[NetworkFilterID]*networkFilter {
+	networkFilters := map[NetworkFilterID]*networkFilter{
+		mockDefaultNetworkFilterID: {
+			client,
+			mockDefaultNetworkFilterID,
+			"vdsm-no-mac-spoofing",
+		},
+		"0000000f-000f-000f-000f-000000000002": {
+			client,
+			"0000000f-000f-000f-000f-000000000002",
+			"clean-traffic",
+		},
+		"0000000f-000f-000f-000f-000000000003": {
+			client,
+			"0000000f-000f-000f-000f-000000000003",
+			"clean-traffic-gateway",
+		},
+		"0000000f-000f-000f-000f-000000000004": {
+			client,
+			"0000000f-000f-000f-000f-000000000004",
+			"no-mac-spoofing",
+		},
+	}
+	return networkFilters
+}
+
 func getInstanceTypes(client *mockClient) map[InstanceTypeID]*instanceType {
 	instanceTypes := map[InstanceTypeID]*instanceType{
 		"00000009-0009-0009-0009-0000000000f1": {
@@ -158,10 +254,12 @@ func getInstanceTypes(client *mockClient) map[InstanceTypeID]*instanceType {
 }
 
 func generateTestVNICProfile(testNetwork *network) *vnicProfile {
+	networkFilterID := mockDefaultNetworkFilterID
 	return &vnicProfile{
-		id:        VNICProfileID(uuid.NewString()),
-		name:      "test",
-		networkID: testNetwork.ID(),
+		id:              VNICProfileID(uuid.NewString()),
+		name:            "test",
+		networkID:       testNetwork.ID(),
+		networkFilterID: &networkFilterID,
 	}
 }
 
@@ -193,6 +291,30 @@ func generateTestStorageDomain() *storageDomain {
 		status:         StorageDomainStatusActive,
 		externalStatus: StorageDomainExternalStatusNA,
 		storageType:    StorageDomainTypeNFS,
+		function:       StorageDomainFunctionData,
+	}
+}
+
+func generateTestStorageConnection(storageDomain *storageDomain) *storageConnection {
+	return &storageConnection{
+		id:          StorageConnectionID(uuid.NewString()),
+		storageType: storageDomain.storageType,
+		address:     "localhost",
+		path:        fmt.Sprintf("/exports/%s", storageDomain.id),
+	}
+}
+
+// generateTestNetworkProvider creates an OVN provider with a single external network, similar to the provider the
+// engine setup installs by default.
+func generateTestNetworkProvider() *networkProvider {
+	return &networkProvider{
+		id:           NetworkProviderID(uuid.NewString()),
+		name:         "ovirt-provider-ovn",
+		providerType: NetworkProviderTypeExternal,
+		url:          "https://localhost:9696",
+		externalNetworks: map[string]string{
+			uuid.NewString(): "ovn-test",
+		},
 	}
 }
 
@@ -200,13 +322,39 @@ func generateTestCluster() *cluster {
 	return &cluster{
 		id:   ClusterID(uuid.NewString()),
 		name: "Test cluster",
+		version: &compatibilityVersion{
+			major: 4,
+			minor: 7,
+		},
 	}
 }
 
 func generateTestHost(c *cluster) *host {
-	return &host{
-		id:        HostID(uuid.NewString()),
-		clusterID: c.ID(),
-		status:    HostStatusUp,
+	result := &host{
+		id:                     HostID(uuid.NewString()),
+		name:                   "test-host",
+		clusterID:              c.ID(),
+		status:                 HostStatusUp,
+		powerManagementEnabled: true,
+		memory:                 32 * 1024 * 1024 * 1024,
+		cpuCount:               8,
+	}
+	result.nics = []*hostNIC{
+		{
+			id:     HostNICID(uuid.NewString()),
+			hostID: result.id,
+			name:   "eth0",
+		},
+	}
+	result.devices = []*hostDevice{
+		{
+			id:          uuid.NewString(),
+			name:        "pci_0000_00_02_0",
+			hostID:      result.id,
+			capability:  "pci",
+			productName: "Test GPU",
+			vendorName:  "Test Vendor",
+		},
 	}
+	return result
 }
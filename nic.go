@@ -30,6 +30,24 @@ type NICClient interface {
 	ListNICs(vmid VMID, retries ...RetryStrategy) ([]NIC, error)
 	// RemoveNIC removes the network interface specified.
 	RemoveNIC(vmid VMID, id NICID, retries ...RetryStrategy) error
+	// PlugNIC hot-plugs a NIC that was unplugged with UnplugNIC. The MAC address and the vNIC profile of the NIC are
+	// kept while it is unplugged. An EConflict error is returned if the VM is in a state that doesn't allow changing
+	// its devices, for example while it is migrating.
+	PlugNIC(vmid VMID, id NICID, retries ...RetryStrategy) error
+	// UnplugNIC disconnects the NIC from the VM without removing it. See PlugNIC for details.
+	UnplugNIC(vmid VMID, id NICID, retries ...RetryStrategy) error
+	// GetNICNetworkFilterParameters returns the variables passed to the network filter of the NIC. The network
+	// filter itself is set on the vNIC profile, see VNICProfileClient.GetVNICProfileNetworkFilter.
+	GetNICNetworkFilterParameters(vmid VMID, id NICID, retries ...RetryStrategy) ([]NetworkFilterParameter, error)
+	// SetNICNetworkFilterParameters replaces the network filter parameters of the NIC with params and waits until
+	// the engine reports them. An empty list removes all parameters. Use NewNetworkFilterParameter to create the
+	// parameters.
+	SetNICNetworkFilterParameters(
+		vmid VMID,
+		id NICID,
+		params []NetworkFilterParameter,
+		retries ...RetryStrategy,
+	) error
 }
 
 // OptionalNICParameters is an interface that declares the source of optional parameters for NIC creation.
@@ -183,6 +201,8 @@ type NICData interface {
 	VNICProfileID() VNICProfileID
 	// Mac returns a MacAddress for a nic
 	Mac() string
+	// Plugged returns true if the NIC is connected to the VM. See NICClient.UnplugNIC.
+	Plugged() bool
 }
 
 // NIC represents a network interface.
@@ -199,6 +219,16 @@ type NIC interface {
 	Update(params UpdateNICParameters, retries ...RetryStrategy) (NIC, error)
 	// Remove removes the current network interface. This involves an API call and may be slow.
 	Remove(retries ...RetryStrategy) error
+	// Plug hot-plugs the network interface. See NICClient.PlugNIC for details.
+	Plug(retries ...RetryStrategy) error
+	// Unplug disconnects the network interface without removing it. See NICClient.UnplugNIC for details.
+	Unplug(retries ...RetryStrategy) error
+	// GetNetworkFilterParameters returns the network filter parameters of the NIC. See
+	// NICClient.GetNICNetworkFilterParameters for details.
+	GetNetworkFilterParameters(retries ...RetryStrategy) ([]NetworkFilterParameter, error)
+	// SetNetworkFilterParameters replaces the network filter parameters of the NIC. See
+	// NICClient.SetNICNetworkFilterParameters for details.
+	SetNetworkFilterParameters(params []NetworkFilterParameter, retries ...RetryStrategy) error
 }
 
 func convertSDKNIC(sdkObject *ovirtsdk.Nic, cli Client) (NIC, error) {
@@ -234,6 +264,11 @@ func convertSDKNIC(sdkObject *ovirtsdk.Nic, cli Client) (NIC, error) {
 	if !ok {
 		return nil, newFieldNotFound("address", "mac")
 	}
+	// The engine only leaves out the plugged flag for NICs that were never unplugged.
+	plugged, ok := sdkObject.Plugged()
+	if !ok {
+		plugged = true
+	}
 	return &nic{
 		cli,
 		NICID(id),
@@ -241,6 +276,7 @@ func convertSDKNIC(sdkObject *ovirtsdk.Nic, cli Client) (NIC, error) {
 		VMID(vmid),
 		VNICProfileID(vnicProfileID),
 		macAddr,
+		plugged,
 	}, nil
 }
 
@@ -252,6 +288,7 @@ type nic struct {
 	vmid          VMID
 	vnicProfileID VNICProfileID
 	mac           string
+	plugged       bool
 }
 
 func (n nic) Update(params UpdateNICParameters, retries ...RetryStrategy) (NIC, error) {
@@ -286,10 +323,30 @@ func (n nic) Mac() string {
 	return n.mac
 }
 
+func (n nic) Plugged() bool {
+	return n.plugged
+}
+
 func (n nic) Remove(retries ...RetryStrategy) error {
 	return n.client.RemoveNIC(n.vmid, n.id, retries...)
 }
 
+func (n nic) Plug(retries ...RetryStrategy) error {
+	return n.client.PlugNIC(n.vmid, n.id, retries...)
+}
+
+func (n nic) Unplug(retries ...RetryStrategy) error {
+	return n.client.UnplugNIC(n.vmid, n.id, retries...)
+}
+
+func (n nic) GetNetworkFilterParameters(retries ...RetryStrategy) ([]NetworkFilterParameter, error) {
+	return n.client.GetNICNetworkFilterParameters(n.vmid, n.id, retries...)
+}
+
+func (n nic) SetNetworkFilterParameters(params []NetworkFilterParameter, retries ...RetryStrategy) error {
+	return n.client.SetNICNetworkFilterParameters(n.vmid, n.id, params, retries...)
+}
+
 func (n nic) withName(name string) *nic {
 	return &nic{
 		client:        n.client,
@@ -298,6 +355,7 @@ func (n nic) withName(name string) *nic {
 		vmid:          n.vmid,
 		vnicProfileID: n.vnicProfileID,
 		mac:           n.mac,
+		plugged:       n.plugged,
 	}
 }
 
@@ -309,6 +367,7 @@ func (n nic) withVNICProfileID(vnicProfileID VNICProfileID) *nic {
 		vmid:          n.vmid,
 		vnicProfileID: vnicProfileID,
 		mac:           n.mac,
+		plugged:       n.plugged,
 	}
 }
 
@@ -320,5 +379,6 @@ func (n nic) withMac(mac string) *nic {
 		vmid:          n.vmid,
 		vnicProfileID: n.vnicProfileID,
 		mac:           mac,
+		plugged:       n.plugged,
 	}
 }
@@ -100,6 +100,7 @@ func (m *mockClient) CreateNIC(
 		name:          name,
 		vmid:          vmid,
 		vnicProfileID: vnicProfileID,
+		plugged:       true,
 	}
 
 	if params != nil {
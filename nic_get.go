@@ -13,7 +13,7 @@ func (o *oVirtClient) GetNIC(vmid VMID, id NICID, retries ...RetryStrategy) (res
 		func() error {
 			response, err := o.conn.SystemService().VmsService().VmService(string(vmid)).NicsService().NicService(string(id)).Get().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting NIC %s for VM %s", id, vmid), err)
 			}
 			sdkObject, ok := response.Nic()
 			if !ok {
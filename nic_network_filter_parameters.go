@@ -0,0 +1,301 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// networkFilterParameterNameRegexp matches the variable names libvirt accepts in network filters, for example IP or
+// CTRL_IP_LEARNING.
+var networkFilterParameterNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// NetworkFilterParameter is a variable passed to the network filter of a NIC, for example the IP address the
+// vdsm-no-mac-spoofing or clean-traffic filters allow. A name can appear more than once to pass a list of values.
+type NetworkFilterParameter interface {
+	// Name returns the name of the variable, for example "IP".
+	Name() string
+	// Value returns the value of the variable.
+	Value() string
+}
+
+// NewNetworkFilterParameter creates a network filter parameter for SetNICNetworkFilterParameters. The name must be a
+// valid libvirt variable name.
+func NewNetworkFilterParameter(name string, value string) (NetworkFilterParameter, error) {
+	if err := validateNetworkFilterParameterName(name); err != nil {
+		return nil, err
+	}
+	return networkFilterParameter{name: name, value: value}, nil
+}
+
+// MustNewNetworkFilterParameter is identical to NewNetworkFilterParameter, but panics instead of returning an error.
+func MustNewNetworkFilterParameter(name string, value string) NetworkFilterParameter {
+	parameter, err := NewNetworkFilterParameter(name, value)
+	if err != nil {
+		panic(err)
+	}
+	return parameter
+}
+
+type networkFilterParameter struct {
+	name  string
+	value string
+}
+
+func (n networkFilterParameter) Name() string {
+	return n.name
+}
+
+func (n networkFilterParameter) Value() string {
+	return n.value
+}
+
+func validateNetworkFilterParameterName(name string) error {
+	if !networkFilterParameterNameRegexp.MatchString(name) {
+		return newError(
+			EBadArgument,
+			"invalid network filter parameter name %q, it must start with a letter or underscore and only contain "+
+				"letters, digits and underscores",
+			name,
+		)
+	}
+	return nil
+}
+
+// validateNetworkFilterParameters repeats the name check for parameters implemented outside this package and
+// rejects identical name and value pairs, which the engine would store twice.
+func validateNetworkFilterParameters(params []NetworkFilterParameter) error {
+	seen := map[networkFilterParameter]int{}
+	for i, param := range params {
+		if param == nil {
+			return newError(EBadArgument, "network filter parameter %d is nil", i)
+		}
+		if err := validateNetworkFilterParameterName(param.Name()); err != nil {
+			return err
+		}
+		key := networkFilterParameter{name: param.Name(), value: param.Value()}
+		if previous, ok := seen[key]; ok {
+			return newError(
+				EBadArgument,
+				"network filter parameter %s=%s appears twice, in position %d and %d",
+				param.Name(),
+				param.Value(),
+				previous,
+				i,
+			)
+		}
+		seen[key] = i
+	}
+	return nil
+}
+
+// sameNetworkFilterParameters returns true if both lists contain the same name and value pairs in any order.
+func sameNetworkFilterParameters(a []NetworkFilterParameter, b []NetworkFilterParameter) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	keys := func(params []NetworkFilterParameter) []string {
+		result := make([]string, len(params))
+		for i, param := range params {
+			result[i] = param.Name() + "=" + param.Value()
+		}
+		sort.Strings(result)
+		return result
+	}
+	aKeys := keys(a)
+	bKeys := keys(b)
+	for i := range aKeys {
+		if aKeys[i] != bKeys[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (o *oVirtClient) nicNetworkFilterParametersService(
+	vmid VMID,
+	id NICID,
+) *ovirtsdk.NicNetworkFilterParametersService {
+	return o.conn.
+		SystemService().
+		VmsService().
+		VmService(string(vmid)).
+		NicsService().
+		NicService(string(id)).
+		NetworkFilterParametersService()
+}
+
+// listSDKNICNetworkFilterParameters returns the parameters of the NIC keyed by their ID.
+func (o *oVirtClient) listSDKNICNetworkFilterParameters(
+	vmid VMID,
+	id NICID,
+	retries []RetryStrategy,
+) (result map[string]NetworkFilterParameter, err error) {
+	action := fmt.Sprintf("listing network filter parameters of NIC %s of VM %s", id, vmid)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.nicNetworkFilterParametersService(vmid, id).List().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			result = map[string]NetworkFilterParameter{}
+			sdkParameters, ok := response.Parameters()
+			if !ok {
+				return nil
+			}
+			for _, sdkParameter := range sdkParameters.Slice() {
+				parameterID, ok := sdkParameter.Id()
+				if !ok {
+					return newFieldNotFound("network filter parameter", "ID")
+				}
+				name, ok := sdkParameter.Name()
+				if !ok {
+					return newFieldNotFound("network filter parameter", "name")
+				}
+				value, _ := sdkParameter.Value()
+				result[parameterID] = networkFilterParameter{name: name, value: value}
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) GetNICNetworkFilterParameters(
+	vmid VMID,
+	id NICID,
+	retries ...RetryStrategy,
+) ([]NetworkFilterParameter, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	parameters, err := o.listSDKNICNetworkFilterParameters(vmid, id, retries)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]NetworkFilterParameter, 0, len(parameters))
+	for _, parameter := range parameters {
+		result = append(result, parameter)
+	}
+	return result, nil
+}
+
+func (o *oVirtClient) SetNICNetworkFilterParameters(
+	vmid VMID,
+	id NICID,
+	params []NetworkFilterParameter,
+	retries ...RetryStrategy,
+) error {
+	if err := validateNetworkFilterParameters(params); err != nil {
+		return err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	current, err := o.listSDKNICNetworkFilterParameters(vmid, id, retries)
+	if err != nil {
+		return err
+	}
+	service := o.nicNetworkFilterParametersService(vmid, id)
+	for parameterID, parameter := range current {
+		action := fmt.Sprintf(
+			"removing network filter parameter %s from NIC %s of VM %s",
+			parameter.Name(),
+			id,
+			vmid,
+		)
+		if err := retry(
+			action,
+			o.logger,
+			retries,
+			func() error {
+				_, err := service.ParameterService(parameterID).Remove().Send()
+				return wrapSDKError(action, err)
+			},
+		); err != nil {
+			return err
+		}
+	}
+	for _, parameter := range params {
+		action := fmt.Sprintf("adding network filter parameter %s to NIC %s of VM %s", parameter.Name(), id, vmid)
+		sdkParameter := ovirtsdk.NewNetworkFilterParameterBuilder().
+			Name(parameter.Name()).
+			Value(parameter.Value()).
+			MustBuild()
+		if err := retry(
+			action,
+			o.logger,
+			retries,
+			func() error {
+				_, err := service.Add().Parameter(sdkParameter).Send()
+				return wrapSDKError(action, err)
+			},
+		); err != nil {
+			return err
+		}
+	}
+	// The engine applies the parameters asynchronously, wait until the list reflects the change.
+	return retry(
+		fmt.Sprintf("waiting for the network filter parameters of NIC %s of VM %s to be applied", id, vmid),
+		o.logger,
+		retries,
+		func() error {
+			applied, err := o.GetNICNetworkFilterParameters(vmid, id, retries...)
+			if err != nil {
+				return err
+			}
+			if !sameNetworkFilterParameters(applied, params) {
+				return newError(EPending, "the network filter parameters of NIC %s are not applied yet", id)
+			}
+			return nil
+		})
+}
+
+// checkMockNIC returns an ENotFound error if the NIC doesn't exist on the VM. The caller must hold the lock.
+func (m *mockClient) checkMockNIC(vmid VMID, id NICID) error {
+	if _, ok := m.vms[vmid]; !ok {
+		return newError(ENotFound, "VM with ID %s not found", vmid)
+	}
+	item, ok := m.nics[id]
+	if !ok || item.vmid != vmid {
+		return newError(ENotFound, "NIC with ID %s not found on VM with ID %s", id, vmid)
+	}
+	return nil
+}
+
+func (m *mockClient) GetNICNetworkFilterParameters(
+	vmid VMID,
+	id NICID,
+	_ ...RetryStrategy,
+) ([]NetworkFilterParameter, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if err := m.checkMockNIC(vmid, id); err != nil {
+		return nil, err
+	}
+	result := make([]NetworkFilterParameter, len(m.nicNetworkFilterParameters[id]))
+	copy(result, m.nicNetworkFilterParameters[id])
+	return result, nil
+}
+
+func (m *mockClient) SetNICNetworkFilterParameters(
+	vmid VMID,
+	id NICID,
+	params []NetworkFilterParameter,
+	_ ...RetryStrategy,
+) error {
+	if err := validateNetworkFilterParameters(params); err != nil {
+		return err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if err := m.checkMockNIC(vmid, id); err != nil {
+		return err
+	}
+	parameters := make([]NetworkFilterParameter, len(params))
+	for i, param := range params {
+		parameters[i] = networkFilterParameter{name: param.Name(), value: param.Value()}
+	}
+	m.nicNetworkFilterParameters[id] = parameters
+	return nil
+}
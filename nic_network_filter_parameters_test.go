@@ -0,0 +1,59 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestNICNetworkFilterParameters(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	nic := assertCanCreateNIC(t, helper, vm, "eth0", nil)
+
+	params := []ovirtclient.NetworkFilterParameter{
+		ovirtclient.MustNewNetworkFilterParameter("IP", "192.0.2.10"),
+		ovirtclient.MustNewNetworkFilterParameter("IP", "192.0.2.11"),
+	}
+	if err := nic.SetNetworkFilterParameters(params); err != nil {
+		t.Fatalf("Failed to set network filter parameters on NIC %s (%v)", nic.ID(), err)
+	}
+	applied, err := client.GetNICNetworkFilterParameters(vm.ID(), nic.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch network filter parameters of NIC %s (%v)", nic.ID(), err)
+	}
+	if len(applied) != len(params) {
+		t.Fatalf("Incorrect number of network filter parameters (expected: %d, got: %d)", len(params), len(applied))
+	}
+	for _, parameter := range applied {
+		if parameter.Name() != "IP" {
+			t.Fatalf("Incorrect network filter parameter name (expected: IP, got: %s)", parameter.Name())
+		}
+	}
+
+	if err := nic.SetNetworkFilterParameters(nil); err != nil {
+		t.Fatalf("Failed to clear network filter parameters on NIC %s (%v)", nic.ID(), err)
+	}
+	applied, err = nic.GetNetworkFilterParameters()
+	if err != nil {
+		t.Fatalf("Failed to fetch network filter parameters of NIC %s (%v)", nic.ID(), err)
+	}
+	if len(applied) != 0 {
+		t.Fatalf("NIC %s still has %d network filter parameters after clearing them.", nic.ID(), len(applied))
+	}
+}
+
+func TestNewNetworkFilterParameterInvalidName(t *testing.T) {
+	t.Parallel()
+	for _, name := range []string{"", "1IP", "IP ADDRESS", "IP-ADDRESS"} {
+		if _, err := ovirtclient.NewNetworkFilterParameter(name, "192.0.2.10"); !ovirtclient.HasErrorCode(
+			err,
+			ovirtclient.EBadArgument,
+		) {
+			t.Fatalf("Creating a network filter parameter named %q did not result in an EBadArgument error (%v)", name, err)
+		}
+	}
+}
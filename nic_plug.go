@@ -0,0 +1,93 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// checkVMAllowsNICPlugging returns an EConflict error if the VM is in a transitional state in which the engine
+// rejects device changes. NICs can be plugged and unplugged while the VM is down, up or paused.
+func checkVMAllowsNICPlugging(vm VMData) error {
+	switch vm.Status() {
+	case VMStatusDown, VMStatusUp, VMStatusPaused:
+		return nil
+	default:
+		return newError(
+			EConflict,
+			"the NICs of VM %s can't be plugged or unplugged in the %s status",
+			vm.ID(),
+			vm.Status(),
+		)
+	}
+}
+
+func (o *oVirtClient) PlugNIC(vmid VMID, id NICID, retries ...RetryStrategy) error {
+	return o.setNICPlugged(vmid, id, true, retries)
+}
+
+func (o *oVirtClient) UnplugNIC(vmid VMID, id NICID, retries ...RetryStrategy) error {
+	return o.setNICPlugged(vmid, id, false, retries)
+}
+
+func (o *oVirtClient) setNICPlugged(vmid VMID, id NICID, plugged bool, retries []RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	vm, err := o.GetVM(vmid, retries...)
+	if err != nil {
+		return err
+	}
+	if err := checkVMAllowsNICPlugging(vm); err != nil {
+		return err
+	}
+	verb := "unplugging"
+	if plugged {
+		verb = "plugging"
+	}
+	action := fmt.Sprintf("%s NIC %s of VM %s", verb, id, vmid)
+	sdkNIC := &ovirtsdk.Nic{}
+	sdkNIC.SetPlugged(plugged)
+	return retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmid)).
+				NicsService().
+				NicService(string(id)).
+				Update().
+				Nic(sdkNIC).
+				Send()
+			return wrapSDKError(action, err)
+		})
+}
+
+func (m *mockClient) PlugNIC(vmid VMID, id NICID, _ ...RetryStrategy) error {
+	return m.setNICPlugged(vmid, id, true)
+}
+
+func (m *mockClient) UnplugNIC(vmid VMID, id NICID, _ ...RetryStrategy) error {
+	return m.setNICPlugged(vmid, id, false)
+}
+
+func (m *mockClient) setNICPlugged(vmid VMID, id NICID, plugged bool) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	vm, ok := m.vms[vmid]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", vmid)
+	}
+	item, ok := m.nics[id]
+	if !ok || item.vmid != vmid {
+		return newError(ENotFound, "NIC with ID %s not found on VM with ID %s", id, vmid)
+	}
+	if err := checkVMAllowsNICPlugging(vm); err != nil {
+		return err
+	}
+	updated := *item
+	updated.plugged = plugged
+	m.nics[id] = &updated
+	return nil
+}
@@ -0,0 +1,34 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestNICPlugWhileMigrating(t *testing.T) {
+	m := NewMock().(*mockClient)
+	var clusterID ClusterID
+	for id := range m.clusters {
+		clusterID = id
+		break
+	}
+	var vnicProfileID VNICProfileID
+	for id := range m.vnicProfiles {
+		vnicProfileID = id
+		break
+	}
+	vm, err := m.CreateVM(clusterID, DefaultBlankTemplateID, "migrating", nil)
+	if err != nil {
+		t.Fatalf("Failed to create VM (%v)", err)
+	}
+	nic, err := m.CreateNIC(vm.ID(), vnicProfileID, "eth0", nil)
+	if err != nil {
+		t.Fatalf("Failed to create NIC (%v)", err)
+	}
+	m.lock.Lock()
+	m.vms[vm.ID()].status = VMStatusMigrating
+	m.lock.Unlock()
+
+	if err := m.UnplugNIC(vm.ID(), nic.ID()); !HasErrorCode(err, EConflict) {
+		t.Fatalf("Unplugging a NIC of a migrating VM did not result in an EConflict error (%v)", err)
+	}
+}
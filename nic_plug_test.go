@@ -0,0 +1,42 @@
+package ovirtclient_test
+
+import (
+	"testing"
+)
+
+func TestNICPlugUnplug(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	nic := assertCanCreateNIC(t, helper, vm, "eth0", nil)
+	if !nic.Plugged() {
+		t.Fatalf("A newly created NIC is not plugged.")
+	}
+
+	if err := nic.Unplug(); err != nil {
+		t.Fatalf("Failed to unplug NIC %s (%v)", nic.ID(), err)
+	}
+	unpluggedNIC, err := client.GetNIC(vm.ID(), nic.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch NIC %s (%v)", nic.ID(), err)
+	}
+	if unpluggedNIC.Plugged() {
+		t.Fatalf("NIC %s is still plugged after unplugging it.", nic.ID())
+	}
+	if unpluggedNIC.Mac() != nic.Mac() || unpluggedNIC.VNICProfileID() != nic.VNICProfileID() {
+		t.Fatalf("Unplugging NIC %s changed its MAC address or vNIC profile.", nic.ID())
+	}
+
+	if err := unpluggedNIC.Plug(); err != nil {
+		t.Fatalf("Failed to plug NIC %s (%v)", nic.ID(), err)
+	}
+	pluggedNIC, err := client.GetNIC(vm.ID(), nic.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch NIC %s (%v)", nic.ID(), err)
+	}
+	if !pluggedNIC.Plugged() {
+		t.Fatalf("NIC %s is not plugged after plugging it.", nic.ID())
+	}
+}
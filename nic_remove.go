@@ -30,5 +30,6 @@ func (m *mockClient) RemoveNIC(vmid VMID, id NICID, _ ...RetryStrategy) error {
 		return newError(ENotFound, "NIC with ID %s not found on VM with ID %s", id, vmid)
 	}
 	delete(m.nics, id)
+	delete(m.nicNetworkFilterParameters, id)
 	return nil
 }
@@ -0,0 +1,326 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// PermissionID is the identifier of a permission.
+type PermissionID string
+
+// PermissionClient contains the methods for reading and granting access to VMs.
+type PermissionClient interface {
+	// ListVMPermissions lists the permissions that apply to the VM. This includes the permissions inherited from
+	// the objects containing the VM, such as its cluster or the whole system, see Permission.Inherited.
+	ListVMPermissions(vmID VMID, retries ...RetryStrategy) ([]Permission, error)
+	// AddVMPermission grants the role to the user or group identified by principalID on the VM. An ENotFound error
+	// is returned if the VM, the role or the principal doesn't exist.
+	AddVMPermission(
+		vmID VMID,
+		principalType PermissionPrincipalType,
+		principalID string,
+		roleID RoleID,
+		retries ...RetryStrategy,
+	) (Permission, error)
+}
+
+// PermissionPrincipalType is the kind of principal a permission is granted to.
+type PermissionPrincipalType string
+
+const (
+	// PermissionPrincipalTypeUser is a single user of the engine.
+	PermissionPrincipalTypeUser PermissionPrincipalType = "user"
+	// PermissionPrincipalTypeGroup is a group of users, usually from an external directory.
+	PermissionPrincipalTypeGroup PermissionPrincipalType = "group"
+)
+
+// Validate returns an error if the principal type doesn't have a valid value.
+func (p PermissionPrincipalType) Validate() error {
+	for _, principalType := range PermissionPrincipalTypeValues() {
+		if principalType == p {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid permission principal type: %s must be one of: %s",
+		p,
+		strings.Join(PermissionPrincipalTypeValues().Strings(), ", "),
+	)
+}
+
+// PermissionPrincipalTypeList is a list of PermissionPrincipalType values.
+type PermissionPrincipalTypeList []PermissionPrincipalType
+
+// PermissionPrincipalTypeValues returns all possible PermissionPrincipalType values.
+func PermissionPrincipalTypeValues() PermissionPrincipalTypeList {
+	return []PermissionPrincipalType{
+		PermissionPrincipalTypeUser,
+		PermissionPrincipalTypeGroup,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l PermissionPrincipalTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// PermissionData is the data segment of the Permission type.
+type PermissionData interface {
+	// ID returns the identifier of the permission.
+	ID() PermissionID
+	// RoleID returns the role granted by the permission.
+	RoleID() RoleID
+	// PrincipalType returns if the permission is granted to a user or a group.
+	PrincipalType() PermissionPrincipalType
+	// PrincipalID returns the ID of the user or group the permission is granted to.
+	PrincipalID() string
+	// Inherited returns true if the permission is not assigned on the object it was listed for, but on an object
+	// containing it, for example the cluster of a VM.
+	Inherited() bool
+}
+
+// Permission grants a role to a user or group on an object.
+type Permission interface {
+	PermissionData
+
+	// Role fetches the role granted by the permission.
+	Role(retries ...RetryStrategy) (Role, error)
+}
+
+// convertSDKVMPermission converts a permission listed on the VM with the specified ID.
+func convertSDKVMPermission(object *ovirtsdk.Permission, vmID VMID, client Client) (*permission, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("permission", "ID")
+	}
+	sdkRole, ok := object.Role()
+	if !ok {
+		return nil, newFieldNotFound(fmt.Sprintf("permission %s", id), "role")
+	}
+	roleID, ok := sdkRole.Id()
+	if !ok {
+		return nil, newFieldNotFound(fmt.Sprintf("role of permission %s", id), "ID")
+	}
+	result := &permission{
+		client: client,
+		id:     PermissionID(id),
+		roleID: RoleID(roleID),
+	}
+	if user, ok := object.User(); ok {
+		result.principalType = PermissionPrincipalTypeUser
+		result.principalID, _ = user.Id()
+	} else if group, ok := object.Group(); ok {
+		result.principalType = PermissionPrincipalTypeGroup
+		result.principalID, _ = group.Id()
+	}
+	if result.principalID == "" {
+		return nil, newFieldNotFound(fmt.Sprintf("permission %s", id), "user or group")
+	}
+	result.inherited = true
+	if sdkVM, ok := object.Vm(); ok {
+		if objectID, _ := sdkVM.Id(); VMID(objectID) == vmID {
+			result.inherited = false
+		}
+	}
+	return result, nil
+}
+
+type permission struct {
+	client Client
+
+	id            PermissionID
+	roleID        RoleID
+	principalType PermissionPrincipalType
+	principalID   string
+	inherited     bool
+
+	// vmID is used by the mock only and contains the VM the permission is assigned on. Permissions without a VM ID
+	// are assigned on the system.
+	vmID VMID
+}
+
+func (p permission) ID() PermissionID {
+	return p.id
+}
+
+func (p permission) RoleID() RoleID {
+	return p.roleID
+}
+
+func (p permission) PrincipalType() PermissionPrincipalType {
+	return p.principalType
+}
+
+func (p permission) PrincipalID() string {
+	return p.principalID
+}
+
+func (p permission) Inherited() bool {
+	return p.inherited
+}
+
+func (p permission) Role(retries ...RetryStrategy) (Role, error) {
+	return p.client.GetRole(p.roleID, retries...)
+}
+
+func validateAddPermission(principalType PermissionPrincipalType, principalID string, roleID RoleID) error {
+	if err := principalType.Validate(); err != nil {
+		return err
+	}
+	if principalID == "" {
+		return newError(EBadArgument, "the ID of the %s to grant the permission to cannot be empty", principalType)
+	}
+	if roleID == "" {
+		return newError(EBadArgument, "the ID of the role to grant cannot be empty")
+	}
+	return nil
+}
+
+func (o *oVirtClient) ListVMPermissions(vmID VMID, retries ...RetryStrategy) (result []Permission, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("listing permissions of VM %s", vmID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().VmsService().VmService(string(vmID)).PermissionsService().List().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			result = []Permission{}
+			sdkObjects, ok := response.Permissions()
+			if !ok {
+				return nil
+			}
+			for i, sdkObject := range sdkObjects.Slice() {
+				item, err := convertSDKVMPermission(sdkObject, vmID, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert permission #%d of VM %s", i, vmID)
+				}
+				result = append(result, item)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) AddVMPermission(
+	vmID VMID,
+	principalType PermissionPrincipalType,
+	principalID string,
+	roleID RoleID,
+	retries ...RetryStrategy,
+) (result Permission, err error) {
+	if err := validateAddPermission(principalType, principalID, roleID); err != nil {
+		return nil, err
+	}
+	builder := ovirtsdk.NewPermissionBuilder().Role(ovirtsdk.NewRoleBuilder().Id(string(roleID)).MustBuild())
+	switch principalType {
+	case PermissionPrincipalTypeUser:
+		builder.User(ovirtsdk.NewUserBuilder().Id(principalID).MustBuild())
+	case PermissionPrincipalTypeGroup:
+		builder.Group(ovirtsdk.NewGroupBuilder().Id(principalID).MustBuild())
+	}
+	sdkPermission, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build permission object")
+	}
+
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	action := fmt.Sprintf("granting role %s to %s %s on VM %s", roleID, principalType, principalID, vmID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				PermissionsService().
+				Add().
+				Permission(sdkPermission).
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkObject, ok := response.Permission()
+			if !ok {
+				return newError(EFieldMissing, "no permission returned while %s", action)
+			}
+			result, err = convertSDKVMPermission(sdkObject, vmID, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert permission")
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) ListVMPermissions(vmID VMID, _ ...RetryStrategy) ([]Permission, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	result := []Permission{}
+	for _, item := range m.permissions {
+		if item.vmID != "" && item.vmID != vmID {
+			continue
+		}
+		listed := *item
+		listed.inherited = item.vmID == ""
+		result = append(result, listed)
+	}
+	return result, nil
+}
+
+func (m *mockClient) AddVMPermission(
+	vmID VMID,
+	principalType PermissionPrincipalType,
+	principalID string,
+	roleID RoleID,
+	_ ...RetryStrategy,
+) (Permission, error) {
+	if err := validateAddPermission(principalType, principalID, roleID); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	if _, ok := m.roles[roleID]; !ok {
+		return nil, newError(ENotFound, "role with ID %s not found", roleID)
+	}
+	for _, item := range m.permissions {
+		if item.vmID == vmID && item.roleID == roleID && item.principalID == principalID {
+			return nil, newError(
+				EConflict,
+				"%s %s already has role %s on VM %s",
+				principalType,
+				principalID,
+				roleID,
+				vmID,
+			)
+		}
+	}
+	item := &permission{
+		client:        m,
+		id:            PermissionID(m.GenerateUUID()),
+		roleID:        roleID,
+		principalType: principalType,
+		principalID:   principalID,
+		vmID:          vmID,
+	}
+	m.permissions[item.id] = item
+	return *item, nil
+}
@@ -0,0 +1,82 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMPermissions(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	roles, err := client.ListRoles()
+	if err != nil {
+		t.Fatalf("Failed to list roles (%v)", err)
+	}
+	var vmManagerRole ovirtclient.Role
+	for _, role := range roles {
+		if role.Name() == "UserVmManager" {
+			vmManagerRole = role
+		}
+	}
+	if vmManagerRole == nil {
+		t.Fatalf("The UserVmManager role was not found.")
+	}
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	permissions, err := vm.ListPermissions()
+	if err != nil {
+		t.Fatalf("Failed to list permissions of VM %s (%v)", vm.ID(), err)
+	}
+	var adminPermission ovirtclient.Permission
+	for _, permission := range permissions {
+		if permission.Inherited() && permission.PrincipalType() == ovirtclient.PermissionPrincipalTypeUser {
+			adminPermission = permission
+		}
+	}
+	if adminPermission == nil {
+		t.Fatalf("No inherited user permission found on VM %s.", vm.ID())
+	}
+
+	addedPermission, err := vm.AddPermission(
+		ovirtclient.PermissionPrincipalTypeUser,
+		adminPermission.PrincipalID(),
+		vmManagerRole.ID(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to add permission to VM %s (%v)", vm.ID(), err)
+	}
+	if addedPermission.Inherited() {
+		t.Fatalf("The permission added on the VM is reported as inherited.")
+	}
+	role, err := addedPermission.Role()
+	if err != nil {
+		t.Fatalf("Failed to fetch the role of permission %s (%v)", addedPermission.ID(), err)
+	}
+	if role.ID() != vmManagerRole.ID() {
+		t.Fatalf("Incorrect role on added permission (expected: %s, got: %s)", vmManagerRole.ID(), role.ID())
+	}
+
+	permissions, err = vm.ListPermissions()
+	if err != nil {
+		t.Fatalf("Failed to list permissions of VM %s (%v)", vm.ID(), err)
+	}
+	found := false
+	for _, permission := range permissions {
+		if permission.ID() == addedPermission.ID() {
+			found = !permission.Inherited()
+		}
+	}
+	if !found {
+		t.Fatalf("The added permission was not listed as a direct permission on VM %s.", vm.ID())
+	}
+
+	if _, err := vm.AddPermission("robot", adminPermission.PrincipalID(), vmManagerRole.ID()); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Adding a permission with an invalid principal type did not result in an EBadArgument error (%v)", err)
+	}
+}
@@ -0,0 +1,93 @@
+package ovirtclient
+
+import (
+	"context"
+)
+
+// Progress is the intermediate state of a long-running operation as passed to a ProgressReporter.
+type Progress interface {
+	// Action describes the operation in progress, for example "waiting for VM 123 status up".
+	Action() string
+	// Status returns the last status reported by the oVirt Engine, for example the current VM status while waiting
+	// for a VM to start. It is empty for operations that don't poll a status, such as image transfers.
+	Status() string
+	// Percentage returns the completion of the operation between 0 and 100, or nil if the operation cannot measure
+	// it. Image transfers compute it from the transferred bytes.
+	Percentage() *float64
+}
+
+// ProgressReporter is a callback that receives the intermediate progress of long-running operations, for example to
+// render a spinner or a progress bar. It is called from the goroutine running the operation and may be called very
+// frequently during image transfers, so it should return quickly.
+type ProgressReporter func(progress Progress)
+
+type progressReporterContextKey struct{}
+
+// WithProgressReporter returns a copy of ctx that makes the client report progress to reporter while polling the
+// engine. Pass the returned context to Client.WithContext:
+//
+//	vm, err := client.WithContext(ovirtclient.WithProgressReporter(ctx, func(p ovirtclient.Progress) {
+//	    fmt.Printf("%s: %s\n", p.Action(), p.Status())
+//	})).WaitForVMStatus(id, ovirtclient.VMStatusUp)
+//
+// The status waits (WaitForVMStatus, WaitForHostStatus, WaitForTemplateStatus, WaitForDiskOK) report the current
+// status on each poll, image uploads and downloads report the percentage of transferred bytes. Without a reporter
+// the client doesn't report progress.
+func WithProgressReporter(ctx context.Context, reporter ProgressReporter) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, progressReporterContextKey{}, reporter)
+}
+
+// progressReporterFromContext returns the reporter set by WithProgressReporter, or nil if there is none.
+func progressReporterFromContext(ctx context.Context) ProgressReporter {
+	if ctx == nil {
+		return nil
+	}
+	reporter, _ := ctx.Value(progressReporterContextKey{}).(ProgressReporter)
+	return reporter
+}
+
+// reportStatus sends the current status of a polling operation to the reporter in ctx, if any.
+func reportStatus(ctx context.Context, action string, status string) {
+	if reporter := progressReporterFromContext(ctx); reporter != nil {
+		reporter(&progress{
+			action: action,
+			status: status,
+		})
+	}
+}
+
+// reportBytes sends the number of transferred bytes as a percentage to the reporter, if it is not nil.
+func reportBytes(reporter ProgressReporter, action string, transferredBytes uint64, totalBytes uint64) {
+	if reporter == nil {
+		return
+	}
+	percentage := float64(100)
+	if totalBytes > 0 {
+		percentage = 100 * float64(transferredBytes) / float64(totalBytes)
+	}
+	reporter(&progress{
+		action:     action,
+		percentage: &percentage,
+	})
+}
+
+type progress struct {
+	action     string
+	status     string
+	percentage *float64
+}
+
+func (p *progress) Action() string {
+	return p.action
+}
+
+func (p *progress) Status() string {
+	return p.status
+}
+
+func (p *progress) Percentage() *float64 {
+	return p.percentage
+}
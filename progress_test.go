@@ -0,0 +1,64 @@
+package ovirtclient_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestProgressReporterOnStatusWait(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+
+	lock := &sync.Mutex{}
+	var statuses []string
+	ctx := ovirtclient.WithProgressReporter(context.Background(), func(progress ovirtclient.Progress) {
+		lock.Lock()
+		defer lock.Unlock()
+		statuses = append(statuses, progress.Status())
+	})
+	if _, err := helper.GetClient().WithContext(ctx).WaitForVMStatus(vm.ID(), ovirtclient.VMStatusDown); err != nil {
+		t.Fatalf("Failed to wait for VM status (%v)", err)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	if len(statuses) == 0 {
+		t.Fatalf("No progress reported while waiting for the VM status.")
+	}
+	if last := statuses[len(statuses)-1]; last != string(ovirtclient.VMStatusDown) {
+		t.Fatalf("Incorrect last reported status (expected: %s, got: %s)", ovirtclient.VMStatusDown, last)
+	}
+}
+
+func TestProgressReporterOnUpload(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	disk := assertCanCreateDisk(t, helper)
+	fh, size := getTestImageFile(t)
+
+	lock := &sync.Mutex{}
+	var lastPercentage *float64
+	ctx := ovirtclient.WithProgressReporter(context.Background(), func(progress ovirtclient.Progress) {
+		lock.Lock()
+		defer lock.Unlock()
+		lastPercentage = progress.Percentage()
+	})
+	if err := helper.GetClient().WithContext(ctx).UploadToDisk(disk.ID(), size, fh); err != nil {
+		t.Fatalf("Failed to upload image (%v)", err)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	if lastPercentage == nil {
+		t.Fatalf("No percentage reported during the image upload.")
+	}
+	if *lastPercentage != 100 {
+		t.Fatalf("Incorrect percentage after the upload (expected: 100, got: %f)", *lastPercentage)
+	}
+}
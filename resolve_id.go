@@ -0,0 +1,141 @@
+package ovirtclient
+
+// resolveByName picks the single ID from the objects matching a name. ENotFound is returned if nothing matched,
+// EConflict if the name is ambiguous.
+func resolveByName(objectType string, nameOrID string, ids []string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", newError(ENotFound, "no %s found with ID or name %s", objectType, nameOrID)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", newError(
+			EConflict,
+			"%d %ss found with the name %s, please use the ID instead",
+			len(ids),
+			objectType,
+			nameOrID,
+		)
+	}
+}
+
+func resolveVMID(client Client, nameOrID string, retries []RetryStrategy) (VMID, error) {
+	vm, err := client.GetVM(VMID(nameOrID), retries...)
+	if err == nil {
+		return vm.ID(), nil
+	}
+	if !HasErrorCode(err, ENotFound) {
+		return "", err
+	}
+	vms, err := client.SearchVMs(VMSearchParams().WithName(nameOrID).WithCaseSensitive(true), retries...)
+	if err != nil {
+		return "", err
+	}
+	var ids []string
+	for _, vm := range vms {
+		// The search may return partial matches, so the name is checked again.
+		if vm.Name() == nameOrID {
+			ids = append(ids, string(vm.ID()))
+		}
+	}
+	id, err := resolveByName("VM", nameOrID, ids)
+	return VMID(id), err
+}
+
+func resolveDiskID(client Client, aliasOrID string, retries []RetryStrategy) (DiskID, error) {
+	disk, err := client.GetDisk(DiskID(aliasOrID), retries...)
+	if err == nil {
+		return disk.ID(), nil
+	}
+	if !HasErrorCode(err, ENotFound) {
+		return "", err
+	}
+	disks, err := client.ListDisksByAlias(aliasOrID, retries...)
+	if err != nil {
+		return "", err
+	}
+	var ids []string
+	for _, disk := range disks {
+		if disk.Alias() == aliasOrID {
+			ids = append(ids, string(disk.ID()))
+		}
+	}
+	id, err := resolveByName("disk", aliasOrID, ids)
+	return DiskID(id), err
+}
+
+func resolveClusterID(client Client, nameOrID string, retries []RetryStrategy) (ClusterID, error) {
+	cluster, err := client.GetCluster(ClusterID(nameOrID), retries...)
+	if err == nil {
+		return cluster.ID(), nil
+	}
+	if !HasErrorCode(err, ENotFound) {
+		return "", err
+	}
+	clusters, err := client.ListClusters(retries...)
+	if err != nil {
+		return "", err
+	}
+	var ids []string
+	for _, cluster := range clusters {
+		if cluster.Name() == nameOrID {
+			ids = append(ids, string(cluster.ID()))
+		}
+	}
+	id, err := resolveByName("cluster", nameOrID, ids)
+	return ClusterID(id), err
+}
+
+func resolveStorageDomainID(client Client, nameOrID string, retries []RetryStrategy) (StorageDomainID, error) {
+	storageDomain, err := client.GetStorageDomain(StorageDomainID(nameOrID), retries...)
+	if err == nil {
+		return storageDomain.ID(), nil
+	}
+	if !HasErrorCode(err, ENotFound) {
+		return "", err
+	}
+	storageDomains, err := client.ListStorageDomains(retries...)
+	if err != nil {
+		return "", err
+	}
+	var ids []string
+	for _, storageDomain := range storageDomains {
+		if storageDomain.Name() == nameOrID {
+			ids = append(ids, string(storageDomain.ID()))
+		}
+	}
+	id, err := resolveByName("storage domain", nameOrID, ids)
+	return StorageDomainID(id), err
+}
+
+func (o *oVirtClient) ResolveVMID(nameOrID string, retries ...RetryStrategy) (VMID, error) {
+	return resolveVMID(o, nameOrID, defaultRetries(retries, defaultReadTimeouts(o)))
+}
+
+func (o *oVirtClient) ResolveDiskID(aliasOrID string, retries ...RetryStrategy) (DiskID, error) {
+	return resolveDiskID(o, aliasOrID, defaultRetries(retries, defaultReadTimeouts(o)))
+}
+
+func (o *oVirtClient) ResolveClusterID(nameOrID string, retries ...RetryStrategy) (ClusterID, error) {
+	return resolveClusterID(o, nameOrID, defaultRetries(retries, defaultReadTimeouts(o)))
+}
+
+func (o *oVirtClient) ResolveStorageDomainID(nameOrID string, retries ...RetryStrategy) (StorageDomainID, error) {
+	return resolveStorageDomainID(o, nameOrID, defaultRetries(retries, defaultReadTimeouts(o)))
+}
+
+func (m *mockClient) ResolveVMID(nameOrID string, retries ...RetryStrategy) (VMID, error) {
+	return resolveVMID(m, nameOrID, retries)
+}
+
+func (m *mockClient) ResolveDiskID(aliasOrID string, retries ...RetryStrategy) (DiskID, error) {
+	return resolveDiskID(m, aliasOrID, retries)
+}
+
+func (m *mockClient) ResolveClusterID(nameOrID string, retries ...RetryStrategy) (ClusterID, error) {
+	return resolveClusterID(m, nameOrID, retries)
+}
+
+func (m *mockClient) ResolveStorageDomainID(nameOrID string, retries ...RetryStrategy) (StorageDomainID, error) {
+	return resolveStorageDomainID(m, nameOrID, retries)
+}
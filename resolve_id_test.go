@@ -0,0 +1,83 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestResolveVMID(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	for _, nameOrID := range []string{string(vm.ID()), vm.Name()} {
+		id, err := client.ResolveVMID(nameOrID)
+		if err != nil {
+			t.Fatalf("Failed to resolve VM %s (%v)", nameOrID, err)
+		}
+		if id != vm.ID() {
+			t.Fatalf("Incorrect VM ID resolved for %s (expected: %s, got: %s)", nameOrID, vm.ID(), id)
+		}
+	}
+	if _, err := client.ResolveVMID(helper.GenerateTestResourceName(t)); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.ENotFound,
+	) {
+		t.Fatalf("Resolving a nonexistent VM did not result in an ENotFound error (%v)", err)
+	}
+}
+
+func TestResolveDiskIDAmbiguous(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	alias := helper.GenerateTestResourceName(t)
+	params := ovirtclient.CreateDiskParams().MustWithAlias(alias)
+	disk := assertCanCreateDiskWithParameters(t, helper, ovirtclient.ImageFormatRaw, params)
+	id, err := client.ResolveDiskID(alias)
+	if err != nil {
+		t.Fatalf("Failed to resolve disk %s (%v)", alias, err)
+	}
+	if id != disk.ID() {
+		t.Fatalf("Incorrect disk ID resolved for %s (expected: %s, got: %s)", alias, disk.ID(), id)
+	}
+
+	assertCanCreateDiskWithParameters(t, helper, ovirtclient.ImageFormatRaw, params)
+	if _, err := client.ResolveDiskID(alias); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Resolving an ambiguous disk alias did not result in an EConflict error (%v)", err)
+	}
+}
+
+func TestResolveClusterAndStorageDomainID(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	cluster, err := client.GetCluster(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to get cluster (%v)", err)
+	}
+	clusterID, err := client.ResolveClusterID(cluster.Name())
+	if err != nil {
+		t.Fatalf("Failed to resolve cluster %s (%v)", cluster.Name(), err)
+	}
+	if clusterID != cluster.ID() {
+		t.Fatalf("Incorrect cluster ID resolved (expected: %s, got: %s)", cluster.ID(), clusterID)
+	}
+
+	// Storage domain names are not unique in the mock, so the lookup by ID is tested here.
+	storageDomainID, err := client.ResolveStorageDomainID(string(helper.GetStorageDomainID()))
+	if err != nil {
+		t.Fatalf("Failed to resolve storage domain %s (%v)", helper.GetStorageDomainID(), err)
+	}
+	if storageDomainID != helper.GetStorageDomainID() {
+		t.Fatalf(
+			"Incorrect storage domain ID resolved (expected: %s, got: %s)",
+			helper.GetStorageDomainID(),
+			storageDomainID,
+		)
+	}
+}
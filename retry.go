@@ -19,6 +19,9 @@ import (
 // - what is the function that should be called repeatedly.
 // - logger is an optional logger that can be passed to log retry actions.
 // - howLong is the retry configuration that should be used.
+//
+// A call to what in progress is not interrupted by retry. The clients pass their context on to each engine request, so
+// cancelling the context of the client aborts the request and what returns with the error of the context.
 func retry(
 	action string,
 	logger ovirtclientlog.Logger,
@@ -33,9 +36,15 @@ func retry(
 	if logger == nil {
 		logger = &noopLogger{}
 	}
+	contexts := retryContexts(retries)
+
 	logger.Infof("%s%s...", strings.ToUpper(action[:1]), action[1:])
 	for {
-		err := what()
+		err := callUnlessDone(contexts, what)
+		if ctxErr := contextError(contexts); ctxErr != nil && errors.Is(err, ctxErr) {
+			logger.Infof("Giving up %s (%v)", action, err)
+			return wrap(err, ETimeout, "cancelled while %s", action)
+		}
 		if err == nil {
 			logger.Infof("Completed %s.", action)
 			return nil
@@ -54,8 +63,16 @@ func retry(
 		// supports fixed cases and b) the channel types are different. Context returns a <-chan struct{}, while
 		// time.After() returns <-chan time.Time. Go doesn't support type assertions, so we have to result to
 		// the reflection library to do this.
+		//
+		// If the server told us how long to wait, that wait replaces the one of the backoff strategies, but the
+		// strategies that abort the loop, such as a context, are still honored.
+		retryAfter, hasRetryAfter := getRetryAfter(err)
 		var chans []reflect.SelectCase
-		for _, r := range retries {
+		var chanOwners []RetryInstance
+		for i, r := range retries {
+			if hasRetryAfter && howLong[i].CanWait() {
+				continue
+			}
 			c := r.Wait(err)
 			if c != nil {
 				chans = append(
@@ -65,8 +82,20 @@ func retry(
 						Send: reflect.Value{},
 					},
 				)
+				chanOwners = append(chanOwners, r)
 			}
 		}
+		if hasRetryAfter {
+			logger.Debugf("Waiting %s before retrying %s as requested by the server.", retryAfter, action)
+			chans = append(
+				chans, reflect.SelectCase{
+					Dir:  reflect.SelectRecv,
+					Chan: reflect.ValueOf(time.After(retryAfter)),
+					Send: reflect.Value{},
+				},
+			)
+			chanOwners = append(chanOwners, nil)
+		}
 		if len(chans) == 0 {
 			logger.Errorf(
 				"No retry strategies with waiting function specified for %s.",
@@ -75,11 +104,43 @@ func retry(
 			return newError(EBug, "no retry strategies with waiting function specified for %s", action)
 		}
 		chosen, _, _ := reflect.Select(chans)
-		if err := retries[chosen].OnWaitExpired(err, action); err != nil {
-			logger.Infof("Giving up %s (%v)", action, err)
+		if owner := chanOwners[chosen]; owner != nil {
+			if err := owner.OnWaitExpired(err, action); err != nil {
+				logger.Infof("Giving up %s (%v)", action, err)
+				return err
+			}
+		}
+	}
+}
+
+// retryContexts returns the contexts of the context strategies among the retries.
+func retryContexts(retries []RetryInstance) []context.Context {
+	var contexts []context.Context
+	for _, r := range retries {
+		if c, ok := r.(*contextStrategy); ok {
+			contexts = append(contexts, c.ctx)
+		}
+	}
+	return contexts
+}
+
+// contextError returns the error of the first context that is done, or nil.
+func contextError(contexts []context.Context) error {
+	for _, ctx := range contexts {
+		if err := ctx.Err(); err != nil {
 			return err
 		}
 	}
+	return nil
+}
+
+// callUnlessDone calls what, unless one of the contexts is already done. The call itself is not raced against the
+// contexts: a call abandoned in the background could still write the results the caller reads.
+func callUnlessDone(contexts []context.Context, what func() error) error {
+	if err := contextError(contexts); err != nil {
+		return err
+	}
+	return what()
 }
 
 func recoverFailure(action string, retries []RetryInstance, err error, logger ovirtclientlog.Logger) bool {
@@ -209,7 +270,7 @@ type RetryInstance interface {
 }
 
 // ContextStrategy provides a timeout based on a context in the ctx parameter. If the context is canceled the
-// retry loop is aborted.
+// retry loop is aborted. A request in progress is only aborted if the context is also passed to Client.WithContext.
 func ContextStrategy(ctx context.Context) RetryStrategy {
 	return &retryStrategyContainer{
 		func() RetryInstance {
@@ -293,6 +354,114 @@ func (e *exponentialBackoff) Continue(_ error, _ string) error {
 	return nil
 }
 
+// FixedWait is a retry strategy that waits the same amount of time between all calls. It suits polling for state
+// changes that take a predictable time, where an exponential backoff would either poll too often or react too late.
+func FixedWait(interval time.Duration) RetryStrategy {
+	return &retryStrategyContainer{
+		func() RetryInstance {
+			return &fixedWait{
+				interval: interval,
+			}
+		},
+		false,
+		true,
+		false,
+		false,
+	}
+}
+
+type fixedWait struct {
+	interval time.Duration
+}
+
+func (f *fixedWait) Recover(err error) error { return err }
+
+func (f *fixedWait) Name() string {
+	return fmt.Sprintf("fixed wait strategy of %s", f.interval)
+}
+
+func (f *fixedWait) Wait(_ error) interface{} {
+	return time.After(f.interval)
+}
+
+func (f *fixedWait) OnWaitExpired(_ error, _ string) error {
+	return nil
+}
+
+func (f *fixedWait) Continue(_ error, _ string) error {
+	return nil
+}
+
+// BackoffThenFixed is a retry strategy for operations that are slow to start and then settle, such as creating a
+// disk on a busy storage. The first switchAfter waits start at initial and grow by factor, but never exceed maxWait.
+// All later waits are fixed. The strategy never gives up on its own, so it needs to be combined with a timeout.
+func BackoffThenFixed(
+	initial time.Duration,
+	factor uint8,
+	maxWait time.Duration,
+	fixed time.Duration,
+	switchAfter uint16,
+) RetryStrategy {
+	return &retryStrategyContainer{
+		func() RetryInstance {
+			return &backoffThenFixed{
+				waitTime:    initial,
+				factor:      factor,
+				maxWait:     maxWait,
+				fixed:       fixed,
+				switchAfter: switchAfter,
+			}
+		},
+		false,
+		true,
+		false,
+		false,
+	}
+}
+
+type backoffThenFixed struct {
+	waitTime    time.Duration
+	factor      uint8
+	maxWait     time.Duration
+	fixed       time.Duration
+	switchAfter uint16
+	waits       uint16
+}
+
+func (b *backoffThenFixed) Recover(err error) error { return err }
+
+func (b *backoffThenFixed) Name() string {
+	return fmt.Sprintf("backoff then fixed wait strategy of %s after %d waits", b.fixed, b.switchAfter)
+}
+
+// nextWait returns the duration of the next wait and advances the sequence.
+func (b *backoffThenFixed) nextWait() time.Duration {
+	if b.waits >= b.switchAfter {
+		return b.fixed
+	}
+	b.waits++
+	waitTime := b.waitTime
+	if waitTime > b.maxWait {
+		waitTime = b.maxWait
+	}
+	if b.waitTime < b.maxWait {
+		b.waitTime *= time.Duration(b.factor)
+	}
+	return waitTime
+}
+
+func (b *backoffThenFixed) Wait(_ error) interface{} {
+	return time.After(b.nextWait())
+}
+
+func (b *backoffThenFixed) OnWaitExpired(_ error, _ string) error {
+	return nil
+}
+
+func (b *backoffThenFixed) Continue(_ error, _ string) error {
+	return nil
+}
+
 // AutoRetry retries an action only if it doesn't return a non-retryable error.
 func AutoRetry() RetryStrategy {
 	return &retryStrategyContainer{
@@ -315,20 +484,7 @@ func (a *autoRetryStrategy) Name() string {
 }
 
 func (a *autoRetryStrategy) Continue(err error, action string) error {
-	var engineErr EngineError
-	if errors.As(err, &engineErr) {
-		if !engineErr.CanAutoRetry() {
-			return wrap(
-				err,
-				EUnidentified,
-				"non-retryable error encountered while %s, giving up",
-				action,
-			)
-		}
-		return nil
-	}
-	identifiedError := realIdentify(err)
-	if identifiedError == nil {
+	if !DefaultRetryPolicy(err, 0) {
 		return wrap(
 			err,
 			EUnidentified,
@@ -336,22 +492,98 @@ func (a *autoRetryStrategy) Continue(err error, action string) error {
 			action,
 		)
 	}
-	if !identifiedError.CanAutoRetry() {
+	return nil
+}
+
+func (a *autoRetryStrategy) Wait(_ error) interface{} {
+	return nil
+}
+
+func (a *autoRetryStrategy) OnWaitExpired(_ error, _ string) error {
+	return nil
+}
+
+// RetryPolicy decides if a failed call should be retried. The attempt is the number of failed calls so far, starting
+// at 1. Returning false gives up and returns the error to the caller.
+type RetryPolicy func(err error, attempt int) bool
+
+// DefaultRetryPolicy is the built-in classification used by AutoRetry. It retries errors with an error code that
+// is marked as retryable, such as EConflict or EPending, and gives up on all others. Custom policies can call it as a
+// fallback for the errors they don't handle themselves.
+func DefaultRetryPolicy(err error, _ int) bool {
+	var engineErr EngineError
+	if errors.As(err, &engineErr) {
+		return engineErr.CanAutoRetry()
+	}
+	identifiedError := realIdentify(err)
+	return identifiedError != nil && identifiedError.CanAutoRetry()
+}
+
+// RetriableStatusCodes returns a RetryPolicy that retries errors caused by an HTTP response with one of the listed
+// status codes, in addition to the errors DefaultRetryPolicy retries. This is useful for proxies in front of the
+// engine that answer with an HTML page and a gateway error, which the built-in classification can't identify. Use it
+// with CustomRetryPolicy for a single call, or with ExtraSettingsBuilder.WithRetryPolicy for all calls:
+//
+//	ovirtclient.NewExtraSettings().WithRetryPolicy(ovirtclient.RetriableStatusCodes(502, 504))
+func RetriableStatusCodes(statusCodes ...int) RetryPolicy {
+	return func(err error, attempt int) bool {
+		if statusCode, ok := sdkErrorHTTPStatusCode(err); ok {
+			for _, retriable := range statusCodes {
+				if statusCode == retriable {
+					return true
+				}
+			}
+		}
+		return DefaultRetryPolicy(err, attempt)
+	}
+}
+
+// CustomRetryPolicy classifies errors using the passed policy instead of the built-in classification of
+// AutoRetry. Pass it to a single call, or configure it for all calls with ExtraSettingsBuilder.WithRetryPolicy.
+// Timeouts and the maximum number of tries still apply.
+func CustomRetryPolicy(policy RetryPolicy) RetryStrategy {
+	return &retryStrategyContainer{
+		func() RetryInstance {
+			return &customRetryPolicyStrategy{
+				policy: policy,
+			}
+		},
+		true,
+		false,
+		false,
+		false,
+	}
+}
+
+type customRetryPolicyStrategy struct {
+	policy   RetryPolicy
+	attempts int
+}
+
+func (c *customRetryPolicyStrategy) Recover(err error) error { return err }
+
+func (c *customRetryPolicyStrategy) Name() string {
+	return "custom retry policy strategy"
+}
+
+func (c *customRetryPolicyStrategy) Continue(err error, action string) error {
+	c.attempts++
+	if !c.policy(err, c.attempts) {
 		return wrap(
 			err,
 			EUnidentified,
-			"non-retryable error encountered while %s, giving up",
+			"the retry policy rejected retrying the error encountered while %s, giving up",
 			action,
 		)
 	}
 	return nil
 }
 
-func (a *autoRetryStrategy) Wait(_ error) interface{} {
+func (c *customRetryPolicyStrategy) Wait(_ error) interface{} {
 	return nil
 }
 
-func (a *autoRetryStrategy) OnWaitExpired(_ error, _ string) error {
+func (c *customRetryPolicyStrategy) OnWaitExpired(_ error, _ string) error {
 	return nil
 }
 
@@ -520,68 +752,144 @@ func defaultRetries(retries []RetryStrategy, timeout []RetryStrategy) []RetryStr
 			foundClassifier = true
 		}
 	}
+	// The default timeouts may contain the retry policy configured for the client. It is used instead of AutoRetry
+	// unless the caller passed a classifier, even if the caller passed their own timeouts.
+	var defaultClassifiers []RetryStrategy
+	var defaultTimeouts []RetryStrategy
+	for _, r := range timeout {
+		if r.CanClassifyErrors() {
+			defaultClassifiers = append(defaultClassifiers, r)
+		} else {
+			defaultTimeouts = append(defaultTimeouts, r)
+		}
+	}
 	if !foundWait {
 		retries = append(retries, ExponentialBackoff(2))
 	}
 	if !foundTimeout {
-		retries = append(retries, timeout...)
+		retries = append(retries, defaultTimeouts...)
 	}
 	if !foundClassifier {
-		retries = append(retries, AutoRetry())
+		if len(defaultClassifiers) > 0 {
+			retries = append(retries, defaultClassifiers...)
+		} else {
+			retries = append(retries, AutoRetry())
+		}
 	}
 	return retries
 }
 
+// retriesForCleanup returns the retry strategies for cleaning up after a failed operation. If the context of the
+// client has been cancelled, the passed retries would give up immediately, so the default write timeouts without the
+// context are used instead. Otherwise, the passed retries are returned unchanged.
+func retriesForCleanup(client Client, retries []RetryStrategy) []RetryStrategy {
+	ctx := client.GetContext()
+	if ctx == nil || ctx.Err() == nil {
+		return retries
+	}
+	return defaultRetries(nil, defaultWriteTimeouts(client.WithContext(nil)))
+}
+
+// withConfiguredRetryPolicy adds the retry policy configured in ExtraSettingsV4 to the default strategies.
+func withConfiguredRetryPolicy(client Client, strategies []RetryStrategy) []RetryStrategy {
+	o, ok := client.(*oVirtClient)
+	if !ok {
+		return strategies
+	}
+	extraSettingsV4, ok := o.extraSettings.(ExtraSettingsV4)
+	if !ok || extraSettingsV4.RetryPolicy() == nil {
+		return strategies
+	}
+	return append(strategies, CustomRetryPolicy(extraSettingsV4.RetryPolicy()))
+}
+
 // defaultReadTimeouts returns a list of retry strategies suitable for read calls. There are view retries and
 // individual calls with retries shouldn't last longer than a minute, otherwise something went wrong.
 func defaultReadTimeouts(client Client) []RetryStrategy {
 	if ctx := client.GetContext(); ctx != nil {
-		return []RetryStrategy{
+		return withConfiguredRetryPolicy(client, []RetryStrategy{
 			MaxTries(10),
 			ContextStrategy(ctx),
 			ReconnectStrategy(client),
-		}
+		})
 	}
-	return []RetryStrategy{
+	return withConfiguredRetryPolicy(client, []RetryStrategy{
 		MaxTries(3),
 		CallTimeout(time.Minute),
 		Timeout(5 * time.Minute),
 		ReconnectStrategy(client),
-	}
+	})
 }
 
 // defaultWriteTimeouts has slightly higher tolerances for write API calls, as they may need longer waiting
 // times.
 func defaultWriteTimeouts(client Client) []RetryStrategy {
 	if ctx := client.GetContext(); ctx != nil {
-		return []RetryStrategy{
+		return withConfiguredRetryPolicy(client, []RetryStrategy{
 			MaxTries(10),
 			ContextStrategy(ctx),
 			ReconnectStrategy(client),
-		}
+		})
 	}
-	return []RetryStrategy{
+	return withConfiguredRetryPolicy(client, []RetryStrategy{
 		MaxTries(10),
 		CallTimeout(5 * time.Minute),
 		Timeout(10 * time.Minute),
 		ReconnectStrategy(client),
+	})
+}
+
+// pollKind identifies the kind of wait an ExtraSettingsV3 polling interval applies to.
+type pollKind int
+
+const (
+	pollVMStatus pollKind = iota
+	pollDiskStatus
+	pollTransfer
+)
+
+// withPollInterval adds a FixedWait strategy with the polling interval configured for the kind of wait, unless the
+// caller passed a wait strategy or no interval is configured. It must be called before defaultRetries, which would
+// otherwise add the default exponential backoff.
+func (o *oVirtClient) withPollInterval(retries []RetryStrategy, kind pollKind) []RetryStrategy {
+	for _, r := range retries {
+		if r.CanWait() {
+			return retries
+		}
+	}
+	extraSettingsV3, ok := o.extraSettings.(ExtraSettingsV3)
+	if !ok {
+		return retries
 	}
+	var interval time.Duration
+	switch kind {
+	case pollVMStatus:
+		interval = extraSettingsV3.VMStatusPollInterval()
+	case pollDiskStatus:
+		interval = extraSettingsV3.DiskStatusPollInterval()
+	case pollTransfer:
+		interval = extraSettingsV3.TransferPollInterval()
+	}
+	if interval <= 0 {
+		return retries
+	}
+	return append(retries, FixedWait(interval))
 }
 
 // defaultLongTimeouts contains a strategy to wait for calls that typically take longer, for example waiting for a
 // disk to become ready.
 func defaultLongTimeouts(client Client) []RetryStrategy {
 	if ctx := client.GetContext(); ctx != nil {
-		return []RetryStrategy{
+		return withConfiguredRetryPolicy(client, []RetryStrategy{
 			MaxTries(10),
 			ContextStrategy(ctx),
 			ReconnectStrategy(client),
-		}
+		})
 	}
-	return []RetryStrategy{
+	return withConfiguredRetryPolicy(client, []RetryStrategy{
 		MaxTries(30),
 		CallTimeout(15 * time.Minute),
 		Timeout(30 * time.Minute),
 		ReconnectStrategy(client),
-	}
+	})
 }
@@ -0,0 +1,113 @@
+package ovirtclient_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+// newProxyErrorServer returns a fake engine behind a proxy that answers all API calls with an HTML error page and the
+// passed status code. The returned function reports the number of API calls received.
+func newProxyErrorServer(t *testing.T, statusCode int) (*httptest.Server, func() int) {
+	lock := &sync.Mutex{}
+	calls := 0
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/ovirt-engine/sso/oauth/token" {
+			writer.Header().Set("Content-Type", "application/json")
+			_, _ = writer.Write([]byte(`{"access_token":"test"}`))
+			return
+		}
+		lock.Lock()
+		calls++
+		lock.Unlock()
+		writer.Header().Set("Content-Type", "text/html")
+		writer.WriteHeader(statusCode)
+		_, _ = writer.Write([]byte("<html><body><h1>Proxy error</h1></body></html>"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		return calls
+	}
+}
+
+func TestRetriableStatusCodes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy        ovirtclient.RetryPolicy
+		expectedCalls int
+	}{
+		"default": {
+			nil,
+			1,
+		},
+		"retriable": {
+			ovirtclient.RetriableStatusCodes(http.StatusRequestTimeout),
+			3,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			srv, calls := newProxyErrorServer(t, http.StatusRequestTimeout)
+			client, err := ovirtclient.NewWithVerify(
+				srv.URL+"/ovirt-engine/api",
+				"admin@internal",
+				"invalid-password-for-testing-purposes",
+				ovirtclient.TLS().Insecure(),
+				ovirtclientlog.NewTestLogger(t),
+				ovirtclient.NewExtraSettings().WithRetryPolicy(testCase.policy),
+				nil,
+			)
+			if err != nil {
+				t.Fatalf("failed to set up connection (%v)", err)
+			}
+
+			_, err = client.GetTag(
+				"00000000-0000-0000-0000-000000000001",
+				ovirtclient.MaxTries(2),
+				ovirtclient.FixedWait(time.Millisecond),
+			)
+			if err == nil {
+				t.Fatalf("the proxy error did not result in an error")
+			}
+			if calls() != testCase.expectedCalls {
+				t.Fatalf("incorrect number of calls (expected: %d, got: %d)", testCase.expectedCalls, calls())
+			}
+		})
+	}
+}
+
+func TestRateLimitedSDKCallIsRetried(t *testing.T) {
+	t.Parallel()
+	srv, calls := newProxyErrorServer(t, http.StatusTooManyRequests)
+	client, err := ovirtclient.NewWithVerify(
+		srv.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"invalid-password-for-testing-purposes",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to set up connection (%v)", err)
+	}
+
+	// ListTags passes the SDK error on without wrapSDKError, so the 429 must be identified by the retry policy.
+	_, err = client.ListTags(ovirtclient.MaxTries(2), ovirtclient.FixedWait(time.Millisecond))
+	if err == nil {
+		t.Fatalf("the 429 response did not result in an error")
+	}
+	if calls() != 3 {
+		t.Fatalf("the rate limited call was not retried (expected: 3 calls, got: %d)", calls())
+	}
+}
@@ -4,7 +4,11 @@ package ovirtclient //nolint:testpackage
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 )
@@ -47,6 +51,120 @@ func TestTimeoutStrategy(t *testing.T) {
 	}
 }
 
+func TestPollInterval(t *testing.T) {
+	t.Parallel()
+	client := &oVirtClient{
+		extraSettings: NewExtraSettings().WithVMStatusPollInterval(100 * time.Millisecond),
+	}
+
+	retries := client.withPollInterval(nil, pollVMStatus)
+	if len(retries) != 1 || !retries[0].CanWait() {
+		t.Fatalf("No wait strategy added for the configured VM status poll interval.")
+	}
+	if retries := client.withPollInterval(nil, pollDiskStatus); len(retries) != 0 {
+		t.Fatalf("A wait strategy was added for the unconfigured disk status poll interval.")
+	}
+	if retries := client.withPollInterval([]RetryStrategy{ExponentialBackoff(2)}, pollVMStatus); len(retries) != 1 {
+		t.Fatalf("The configured poll interval overrode the wait strategy of the caller.")
+	}
+
+	r := &retryFail{}
+	startTime := time.Now()
+	err := retry("test", nil, append(retries, MaxTries(4)), r.run)
+	if err == nil {
+		t.Fatalf("retry on a failing call did not return with an error")
+	}
+	if elapsedTime := time.Since(startTime); elapsedTime < 300*time.Millisecond || elapsedTime > 2*time.Second {
+		t.Fatalf("retry with a fixed wait of 100ms took %s for 4 tries", elapsedTime)
+	}
+}
+
+func TestBackoffThenFixed(t *testing.T) {
+	t.Parallel()
+	strategy := BackoffThenFixed(100*time.Millisecond, 2, 500*time.Millisecond, time.Second, 5)
+	instance := strategy.Get().(*backoffThenFixed)
+	expected := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		500 * time.Millisecond,
+		500 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	for i, expectedWait := range expected {
+		if wait := instance.nextWait(); wait != expectedWait {
+			t.Fatalf("Incorrect wait %d (expected: %s, got: %s)", i, expectedWait, wait)
+		}
+	}
+	if wait := strategy.Get().(*backoffThenFixed).nextWait(); wait != 100*time.Millisecond {
+		t.Fatalf("A new instance of the strategy did not start with the initial wait (got: %s)", wait)
+	}
+
+	r := &retryFail{}
+	startTime := time.Now()
+	err := retry(
+		"test",
+		nil,
+		[]RetryStrategy{BackoffThenFixed(10*time.Millisecond, 2, 40*time.Millisecond, 20*time.Millisecond, 3), MaxTries(4)},
+		r.run,
+	)
+	if err == nil {
+		t.Fatalf("retry on a failing call did not return with an error")
+	}
+	// The waits are 10ms, 20ms, 40ms and 20ms before the fifth and last try.
+	if elapsedTime := time.Since(startTime); elapsedTime < 90*time.Millisecond || elapsedTime > 2*time.Second {
+		t.Fatalf("retry with a backoff then fixed wait took %s for %d tries", elapsedTime, r.failCount)
+	}
+}
+
+func TestCustomRetryPolicy(t *testing.T) {
+	t.Parallel()
+	var attempts []int
+	policy := func(err error, attempt int) bool {
+		attempts = append(attempts, attempt)
+		// Retry the bad argument errors a proxy returns while the engine restarts, but only twice.
+		return HasErrorCode(err, EBadArgument) && attempt < 3
+	}
+	client := &oVirtClient{
+		extraSettings: NewExtraSettings().WithRetryPolicy(policy),
+	}
+
+	calls := 0
+	err := retry(
+		"test",
+		nil,
+		defaultRetries([]RetryStrategy{FixedWait(time.Millisecond)}, defaultReadTimeouts(client)),
+		func() error {
+			calls++
+			return newError(EBadArgument, "bad request")
+		},
+	)
+	if !HasErrorCode(err, EBadArgument) {
+		t.Fatalf("retry did not return the error rejected by the policy (%v)", err)
+	}
+	if calls != 3 {
+		t.Fatalf("Incorrect number of calls with the configured retry policy (expected: 3, got: %d)", calls)
+	}
+	if len(attempts) != 3 || attempts[0] != 1 || attempts[2] != 3 {
+		t.Fatalf("Incorrect attempts passed to the retry policy: %v", attempts)
+	}
+
+	calls = 0
+	err = retry(
+		"test",
+		nil,
+		defaultRetries([]RetryStrategy{FixedWait(time.Millisecond), AutoRetry()}, defaultReadTimeouts(client)),
+		func() error {
+			calls++
+			return newError(EBadArgument, "bad request")
+		},
+	)
+	if err == nil || calls != 1 {
+		t.Fatalf("AutoRetry passed to the call did not take precedence over the configured policy (%d calls)", calls)
+	}
+}
+
 func TestContextStrategy(t *testing.T) {
 	t.Parallel()
 
@@ -93,3 +211,125 @@ func TestContextStrategy(t *testing.T) {
 		t.Fatalf("retry didn't run for enough time")
 	}
 }
+
+func TestContextCancelDuringCall(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+	aborted := make(chan struct{})
+	client := newFakeEngineClient(t, func(writer http.ResponseWriter, request *http.Request) {
+		close(started)
+		// Simulates an engine request that doesn't return until the client aborts it.
+		<-request.Context().Done()
+		close(aborted)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.WithContext(ctx).ListTags(ExponentialBackoff(1))
+		done <- err
+	}()
+
+	<-started
+	cancel()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Cancelling the context mid-call did not result in a context.Canceled error (%v)", err)
+		}
+		if !HasErrorCode(err, ETimeout) {
+			t.Fatalf("Cancelling the context mid-call did not result in an ETimeout error (%v)", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Cancelling the context did not abort the call in progress.")
+	}
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Cancelling the context did not abort the request to the engine.")
+	}
+}
+
+// rateLimitingTransport responds with HTTP 429 and a Retry-After header to the first request and with 200 afterwards.
+type rateLimitingTransport struct {
+	requests int
+}
+
+func (r *rateLimitingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	r.requests++
+	response := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    request,
+	}
+	if r.requests == 1 {
+		response.StatusCode = http.StatusTooManyRequests
+		response.Header.Set("Retry-After", "2")
+	}
+	return response, nil
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+	transport := &rateLimitingTransport{}
+	client := &http.Client{Transport: transport}
+
+	startTime := time.Now()
+	err := retry(
+		"test",
+		nil,
+		[]RetryStrategy{
+			AutoRetry(),
+			ExponentialBackoff(1),
+			Timeout(10 * time.Second),
+		},
+		func() error {
+			request, err := http.NewRequest(http.MethodGet, "https://localhost/", nil)
+			if err != nil {
+				return err
+			}
+			response, err := client.Do(request)
+			if err != nil {
+				return err
+			}
+			_ = response.Body.Close()
+			return checkRateLimitedResponse(response)
+		},
+	)
+	elapsedTime := time.Since(startTime)
+	if err != nil {
+		t.Fatalf("retry failed despite the server recovering from rate limiting (%v)", err)
+	}
+	if transport.requests != 2 {
+		t.Fatalf("incorrect number of requests (expected: 2, got: %d)", transport.requests)
+	}
+	// The backoff alone would retry after one second.
+	if elapsedTime < 2*time.Second {
+		t.Fatalf("retry didn't honor the Retry-After header (retried after %s)", elapsedTime)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	testcases := map[string]time.Duration{
+		"5":                             5 * time.Second,
+		"Sat, 01 Jan 2022 12:00:30 GMT": 30 * time.Second,
+		"Sat, 01 Jan 2022 11:00:00 GMT": 0,
+		"86400":                         maxRetryAfter,
+	}
+	for value, expected := range testcases {
+		retryAfter, ok := parseRetryAfter(value, now)
+		if !ok {
+			t.Fatalf("failed to parse Retry-After value %q", value)
+		}
+		if retryAfter != expected {
+			t.Fatalf("incorrect wait time for %q (expected: %s, got: %s)", value, expected, retryAfter)
+		}
+	}
+	if _, ok := parseRetryAfter("soon", now); ok {
+		t.Fatalf("invalid Retry-After value was parsed")
+	}
+}
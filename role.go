@@ -0,0 +1,90 @@
+package ovirtclient
+
+import ovirtsdk "github.com/ovirt/go-ovirt"
+
+//go:generate go run scripts/rest/rest.go -i "Role" -n "role" -T RoleID
+
+// RoleID is the identifier of a role.
+type RoleID string
+
+// RoleClient lists the methods for reading the roles defined on the oVirt Engine. Roles are the sets of permits
+// granted to a user or group through a Permission.
+type RoleClient interface {
+	// GetRole returns a single role.
+	GetRole(id RoleID, retries ...RetryStrategy) (Role, error)
+	// ListRoles lists all roles defined on the oVirt Engine, both the predefined and the custom ones.
+	ListRoles(retries ...RetryStrategy) ([]Role, error)
+}
+
+// RoleData is the data segment of the Role type.
+type RoleData interface {
+	// ID returns the identifier of the role.
+	ID() RoleID
+	// Name returns the name of the role, for example "UserVmManager".
+	Name() string
+	// Description returns the description of the role.
+	Description() string
+	// Administrative returns true if the role grants access to the administration portal and the full API.
+	Administrative() bool
+	// Mutable returns false for the predefined roles of the engine, which cannot be changed.
+	Mutable() bool
+}
+
+// Role is a named set of permits that can be granted on an object.
+type Role interface {
+	RoleData
+}
+
+func convertSDKRole(object *ovirtsdk.Role, o *oVirtClient) (Role, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("role", "ID")
+	}
+	name, ok := object.Name()
+	if !ok {
+		return nil, newFieldNotFound("role", "name")
+	}
+	description, _ := object.Description()
+	administrative, _ := object.Administrative()
+	mutable, _ := object.Mutable()
+
+	return &role{
+		o,
+
+		RoleID(id),
+		name,
+		description,
+		administrative,
+		mutable,
+	}, nil
+}
+
+type role struct {
+	client Client
+
+	id             RoleID
+	name           string
+	description    string
+	administrative bool
+	mutable        bool
+}
+
+func (r role) ID() RoleID {
+	return r.id
+}
+
+func (r role) Name() string {
+	return r.name
+}
+
+func (r role) Description() string {
+	return r.description
+}
+
+func (r role) Administrative() bool {
+	return r.administrative
+}
+
+func (r role) Mutable() bool {
+	return r.mutable
+}
@@ -0,0 +1,39 @@
+package ovirtclient
+
+import (
+	"context"
+	"time"
+)
+
+type sdkTimeoutContextKey struct{}
+
+// WithSDKTimeout returns a copy of ctx that limits each API request the client makes to the oVirt Engine to timeout.
+// Pass the returned context to Client.WithContext:
+//
+//	vms, err := client.WithContext(ovirtclient.WithSDKTimeout(ctx, 5*time.Second)).ListVMs()
+//
+// The timeout replaces the one configured with ExtraSettingsBuilder.WithTimeout for the requests of that client, so
+// it can be shorter or longer. If ctx has a deadline that expires earlier, the deadline wins and the operation is
+// cancelled. A timeout of zero or less leaves the client default in place.
+//
+// A request that doesn't complete within the timeout is aborted. Requests that only read from the engine are retried
+// like a failed request, so the retry strategies still decide when to give up. Requests that create or change a
+// resource fail with EUncertainOutcome instead, since the engine may still carry them out.
+func WithSDKTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, sdkTimeoutContextKey{}, timeout)
+}
+
+// sdkTimeoutFromContext returns the timeout set by WithSDKTimeout, or 0 if there is none.
+func sdkTimeoutFromContext(ctx context.Context) time.Duration {
+	if ctx == nil {
+		return 0
+	}
+	timeout, _ := ctx.Value(sdkTimeoutContextKey{}).(time.Duration)
+	if timeout < 0 {
+		return 0
+	}
+	return timeout
+}
@@ -0,0 +1,140 @@
+package ovirtclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+const sdkTimeoutTestTags = `<tags><tag id="1"><name>test</name></tag></tags>`
+
+// slowTagsEngine is a fake engine that delays the responses to the tag requests by the durations in delays. The first
+// delay applies to the first request, and so on. Requests beyond the list are answered immediately.
+type slowTagsEngine struct {
+	lock     sync.Mutex
+	delays   []time.Duration
+	requests int
+}
+
+func (s *slowTagsEngine) handle(writer http.ResponseWriter, request *http.Request) {
+	// The server only notices that the client aborted the request once the body is read.
+	_, _ = io.Copy(io.Discard, request.Body)
+	s.lock.Lock()
+	var delay time.Duration
+	if s.requests < len(s.delays) {
+		delay = s.delays[s.requests]
+	}
+	s.requests++
+	s.lock.Unlock()
+	select {
+	case <-time.After(delay):
+	case <-request.Context().Done():
+		return
+	}
+	if request.Method == http.MethodPost {
+		writeFakeEngineXML(writer, `<tag id="1"><name>test</name></tag>`)
+		return
+	}
+	writeFakeEngineXML(writer, sdkTimeoutTestTags)
+}
+
+func (s *slowTagsEngine) requestCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.requests
+}
+
+func TestSDKTimeoutAbortsSlowCalls(t *testing.T) {
+	t.Parallel()
+	engine := &slowTagsEngine{delays: []time.Duration{time.Minute}}
+	client := newFakeEngineClient(t, engine.handle)
+
+	tags, err := client.
+		WithContext(WithSDKTimeout(context.Background(), 50*time.Millisecond)).
+		ListTags(MaxTries(3), FixedWait(time.Millisecond))
+	if err != nil {
+		t.Fatalf("A read request exceeding the SDK timeout was not retried (%v)", err)
+	}
+	if len(tags) != 1 {
+		t.Fatalf("Incorrect number of tags returned (expected: 1, got: %d)", len(tags))
+	}
+	if requests := engine.requestCount(); requests != 2 {
+		t.Fatalf("Incorrect number of requests (expected: 2, got: %d)", requests)
+	}
+}
+
+func TestSDKTimeoutDoesNotRetryChanges(t *testing.T) {
+	t.Parallel()
+	engine := &slowTagsEngine{delays: []time.Duration{time.Minute}}
+	client := newFakeEngineClient(t, engine.handle)
+
+	_, err := client.
+		WithContext(WithSDKTimeout(context.Background(), 50*time.Millisecond)).
+		CreateTag("test", nil, MaxTries(3), FixedWait(time.Millisecond))
+	if !HasErrorCode(err, EUncertainOutcome) {
+		t.Fatalf("An aborted create request did not result in an EUncertainOutcome error (%v)", err)
+	}
+	if !HasErrorCode(err, ETimeout) {
+		t.Fatalf("An aborted create request did not result in an ETimeout error (%v)", err)
+	}
+	if requests := engine.requestCount(); requests != 1 {
+		t.Fatalf("An aborted create request was sent again (requests: %d)", requests)
+	}
+}
+
+func TestSDKTimeoutContextDeadlineWins(t *testing.T) {
+	t.Parallel()
+	engine := &slowTagsEngine{delays: []time.Duration{time.Minute}}
+	client := newFakeEngineClient(t, engine.handle)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	startTime := time.Now()
+	_, err := client.WithContext(WithSDKTimeout(ctx, time.Hour)).ListTags()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("The earlier context deadline did not end the call (%v)", err)
+	}
+	if elapsed := time.Since(startTime); elapsed > 5*time.Second {
+		t.Fatalf("The call waited for the SDK timeout instead of the context deadline (%s)", elapsed)
+	}
+	if requests := engine.requestCount(); requests != 1 {
+		t.Fatalf("The call was retried after the context deadline (requests: %d)", requests)
+	}
+}
+
+func TestSDKTimeoutOverridesClientDefault(t *testing.T) {
+	t.Parallel()
+	engine := &slowTagsEngine{delays: []time.Duration{
+		300 * time.Millisecond,
+		300 * time.Millisecond,
+		300 * time.Millisecond,
+		300 * time.Millisecond,
+	}}
+	client := newFakeEngineClientWithSettings(
+		t,
+		NewExtraSettings().WithTimeout(100*time.Millisecond),
+		engine.handle,
+	)
+
+	// A longer SDK timeout extends the client-wide timeout.
+	if _, err := client.
+		WithContext(WithSDKTimeout(context.Background(), 5*time.Second)).
+		ListTags(MaxTries(0)); err != nil {
+		t.Fatalf("A request within the SDK timeout but beyond the client timeout failed (%v)", err)
+	}
+
+	// Without a usable SDK timeout, the client default applies.
+	for name, ctx := range map[string]context.Context{
+		"none":     context.Background(),
+		"zero":     WithSDKTimeout(context.Background(), 0),
+		"negative": WithSDKTimeout(context.Background(), -time.Second),
+	} {
+		if _, err := client.WithContext(ctx).ListTags(MaxTries(0)); !HasErrorCode(err, ETimeout) {
+			t.Fatalf("The client timeout did not apply for the %s SDK timeout (%v)", name, err)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package ovirtclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+	"unsafe"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// sdkTransport is the transport of the HTTP client of the SDK connection. The SDK builds its requests itself, without
+// a context, and adds its own User-Agent header to each of them, so both can only be replaced on the way out. The
+// request timeout is enforced here instead of by the HTTP client, so WithSDKTimeout can also extend it.
+type sdkTransport struct {
+	userAgent string
+	// timeout is the default timeout of each request, configured with ExtraSettingsV2. Zero means no timeout.
+	timeout time.Duration
+	// ctx is the context of the client the requests are sent for, or nil for the client without context.
+	ctx       context.Context
+	transport http.RoundTripper
+}
+
+func (s *sdkTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	timeout := s.timeout
+	if s.ctx != nil {
+		ctx = s.ctx
+		if sdkTimeout := sdkTimeoutFromContext(s.ctx); sdkTimeout > 0 {
+			timeout = sdkTimeout
+		}
+	}
+	cancel := context.CancelFunc(func() {})
+	if timeout > 0 {
+		// If ctx has an earlier deadline, it is kept.
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	// RoundTrip must not modify the original request.
+	timedReq := req.Clone(ctx)
+	timedReq.Header.Set("User-Agent", s.userAgent)
+	response, err := s.transport.RoundTrip(timedReq)
+	if err != nil {
+		cancel()
+		return nil, s.checkTimeout(timedReq, timeout, err)
+	}
+	response.Body = &sdkResponseBody{
+		body:      response.Body,
+		transport: s,
+		req:       timedReq,
+		timeout:   timeout,
+		cancel:    cancel,
+	}
+	return response, nil
+}
+
+// checkTimeout replaces err with an ETimeout error if the request was aborted because it exceeded the timeout. If
+// the request creates or changes a resource, the engine may still carry it out, so the error is marked with
+// EUncertainOutcome to keep it from being retried. Errors of the client context are returned unchanged, so retry
+// gives up.
+func (s *sdkTransport) checkTimeout(req *http.Request, timeout time.Duration, err error) error {
+	if req.Context().Err() == nil || (s.ctx != nil && s.ctx.Err() != nil) {
+		return err
+	}
+	timeoutErr := wrap(err, ETimeout, "the request to %s did not complete within %s", req.URL.Path, timeout)
+	if req.Method != http.MethodPost || strings.HasPrefix(req.URL.Path, "/ovirt-engine/sso/") {
+		return timeoutErr
+	}
+	return wrap(
+		timeoutErr,
+		EUncertainOutcome,
+		"the %s request to %s was aborted, the engine may still carry it out",
+		req.Method,
+		req.URL.Path,
+	)
+}
+
+// sdkResponseBody ends the timeout of the request once the SDK has read the response.
+type sdkResponseBody struct {
+	body      io.ReadCloser
+	transport *sdkTransport
+	req       *http.Request
+	timeout   time.Duration
+	cancel    context.CancelFunc
+}
+
+func (s *sdkResponseBody) Read(p []byte) (int, error) {
+	n, err := s.body.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		err = s.transport.checkTimeout(s.req, s.timeout, err)
+	}
+	return n, err
+}
+
+func (s *sdkResponseBody) Close() error {
+	defer s.cancel()
+	return s.body.Close()
+}
+
+// sdkHTTPClientField returns the field of the SDK connection holding the HTTP client the requests are sent with. The
+// SDK neither exposes the client nor accepts one when building the connection, so the unexported field is accessed
+// with reflection.
+func sdkHTTPClientField(conn *ovirtsdk4.Connection) (reflect.Value, error) {
+	field := reflect.ValueOf(conn).Elem().FieldByName("client")
+	if !field.IsValid() || field.Type() != reflect.TypeOf(&http.Client{}) {
+		return reflect.Value{}, newError(
+			EBug,
+			"the SDK connection has no HTTP client field, the SDK version is not supported",
+		)
+	}
+	fieldPointer := unsafe.Pointer(field.UnsafeAddr()) //nolint:gosec
+	return reflect.NewAt(field.Type(), fieldPointer).Elem(), nil
+}
+
+// sdkHTTPClient returns the HTTP client the SDK connection sends its requests with.
+func sdkHTTPClient(conn *ovirtsdk4.Connection) (*http.Client, error) {
+	field, err := sdkHTTPClientField(conn)
+	if err != nil {
+		return nil, err
+	}
+	client := field.Interface().(*http.Client)
+	if client == nil {
+		return nil, newError(EBug, "the SDK connection has no HTTP client")
+	}
+	return client, nil
+}
+
+// installSDKTransport wraps the transport of the SDK connection in an sdkTransport.
+func installSDKTransport(conn *ovirtsdk4.Connection, extraSettings ExtraSettings) error {
+	client, err := sdkHTTPClient(conn)
+	if err != nil {
+		return err
+	}
+	transport := &sdkTransport{
+		userAgent: getUserAgent(extraSettings),
+		transport: client.Transport,
+	}
+	if extraSettingsV2, ok := extraSettings.(ExtraSettingsV2); ok {
+		transport.timeout = extraSettingsV2.Timeout()
+	}
+	client.Transport = transport
+	return nil
+}
+
+// bindSDKConnection returns a copy of the SDK connection that sends its requests bound to ctx, so cancelling ctx
+// aborts requests in progress and the timeout set with WithSDKTimeout applies. The copy keeps the SSO token of conn,
+// so it doesn't log in again if conn already did.
+func bindSDKConnection(ctx context.Context, conn *ovirtsdk4.Connection) (*ovirtsdk4.Connection, error) {
+	client, err := sdkHTTPClient(conn)
+	if err != nil {
+		return nil, err
+	}
+	transport, ok := client.Transport.(*sdkTransport)
+	if !ok {
+		return nil, newError(EBug, "the SDK connection does not use the transport of the client")
+	}
+	boundTransport := *transport
+	boundTransport.ctx = ctx
+	boundClient := *client
+	boundClient.Transport = &boundTransport
+	boundConn := *conn
+	field, err := sdkHTTPClientField(&boundConn)
+	if err != nil {
+		return nil, err
+	}
+	field.Set(reflect.ValueOf(&boundClient))
+	return &boundConn, nil
+}
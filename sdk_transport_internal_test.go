@@ -0,0 +1,44 @@
+package ovirtclient
+
+import (
+	"net/http"
+	"testing"
+)
+
+// recordingTransport records the requests passed to it and responds with HTTP 404.
+type recordingTransport struct {
+	requests []*http.Request
+}
+
+func (r *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.requests = append(r.requests, req)
+	return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody, Request: req}, nil
+}
+
+func TestSDKTransportReplacesUserAgent(t *testing.T) {
+	t.Parallel()
+	recorder := &recordingTransport{}
+	transport := &sdkTransport{userAgent: "test-agent/1.0", transport: recorder}
+
+	req, err := http.NewRequest(http.MethodGet, "https://localhost/ovirt-engine/api", nil)
+	if err != nil {
+		t.Fatalf("failed to create request (%v)", err)
+	}
+	// The SDK adds its own User-Agent to each request.
+	req.Header.Add("User-Agent", "GoSDK/4.4")
+	response, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("failed to send request (%v)", err)
+	}
+	_ = response.Body.Close()
+
+	if len(recorder.requests) != 1 {
+		t.Fatalf("incorrect number of requests sent (expected: 1, got: %d)", len(recorder.requests))
+	}
+	if values := recorder.requests[0].Header.Values("User-Agent"); len(values) != 1 || values[0] != "test-agent/1.0" {
+		t.Fatalf("incorrect User-Agent values sent (%v)", values)
+	}
+	if values := req.Header.Values("User-Agent"); len(values) != 1 || values[0] != "GoSDK/4.4" {
+		t.Fatalf("the original request was modified (%v)", values)
+	}
+}
@@ -0,0 +1,118 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// SearchQuery is an oVirt Engine search query as passed in the search parameter of list calls. Queries are built from
+// SearchField conditions and combined with And and Or, which takes care of quoting and escaping the values. Values
+// that cannot be expressed safely are reported as an EBadArgument error when calling Build.
+//
+// For example:
+//
+//	query := ovirtclient.SearchField("name").Eq("my vm").And(ovirtclient.SearchField("status").Eq("up"))
+type SearchQuery interface {
+	// And returns a query that matches if both the current and all the passed queries match.
+	And(queries ...SearchQuery) SearchQuery
+	// Or returns a query that matches if either the current or any of the passed queries match.
+	Or(queries ...SearchQuery) SearchQuery
+	// Build returns the search string to pass to the oVirt Engine.
+	Build() (string, error)
+}
+
+// SearchField is the name of a field in an oVirt Engine search query, such as "name" or "status".
+type SearchField string
+
+// Eq returns a query that matches if the field is exactly equal to the value. The value is quoted, so spaces in the
+// value are matched literally. Quotes and wildcards are not allowed since the engine has no way to escape them, use
+// Like if you need wildcard matching.
+func (s SearchField) Eq(value string) SearchQuery {
+	return s.condition("=", value, false)
+}
+
+// NotEq returns a query that matches if the field is not equal to the value. See Eq for the quoting rules.
+func (s SearchField) NotEq(value string) SearchQuery {
+	return s.condition("!=", value, false)
+}
+
+// Like returns a query that matches the field against a pattern where * matches any number of characters.
+func (s SearchField) Like(pattern string) SearchQuery {
+	return s.condition("=", pattern, true)
+}
+
+// GreaterThan returns a query that matches if the field is greater than the value, for example a time or a number.
+// See Eq for the quoting rules.
+func (s SearchField) GreaterThan(value string) SearchQuery {
+	return s.condition(">", value, false)
+}
+
+// LessThan returns a query that matches if the field is less than the value. See Eq for the quoting rules.
+func (s SearchField) LessThan(value string) SearchQuery {
+	return s.condition("<", value, false)
+}
+
+var searchFieldRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+(\.[a-zA-Z0-9_]+)*$`)
+
+func (s SearchField) condition(operator string, value string, allowWildcards bool) SearchQuery {
+	if !searchFieldRegexp.MatchString(string(s)) {
+		return &searchQuery{err: newError(EBadArgument, "invalid search field name: %q", s)}
+	}
+	quotedValue, err := quoteSearchValue(value, allowWildcards)
+	if err != nil {
+		return &searchQuery{err: wrap(err, EBadArgument, "invalid value for search field %s", s)}
+	}
+	return &searchQuery{text: fmt.Sprintf("%s %s %s", s, operator, quotedValue)}
+}
+
+// quoteSearchValue quotes the value for use in a search query.
+func quoteSearchValue(value string, allowWildcards bool) (string, error) {
+	if strings.Contains(value, `"`) {
+		return "", newError(EBadArgument, "quotes are not allowed in search values: %q", value)
+	}
+	if !allowWildcards && strings.Contains(value, "*") {
+		return "", newError(EBadArgument, "wildcards are not allowed in exact search values: %q", value)
+	}
+	if strings.ContainsAny(value, "\r\n") {
+		return "", newError(EBadArgument, "line breaks are not allowed in search values: %q", value)
+	}
+	return fmt.Sprintf("\"%s\"", value), nil
+}
+
+type searchQuery struct {
+	text string
+	err  error
+}
+
+func (s *searchQuery) And(queries ...SearchQuery) SearchQuery {
+	return combineSearchQueries("AND", append([]SearchQuery{s}, queries...))
+}
+
+func (s *searchQuery) Or(queries ...SearchQuery) SearchQuery {
+	return combineSearchQueries("OR", append([]SearchQuery{s}, queries...))
+}
+
+func (s *searchQuery) Build() (string, error) {
+	return s.text, s.err
+}
+
+// combineSearchQueries joins the queries with the operator. Each part is wrapped in parentheses so the precedence of
+// nested queries is preserved.
+func combineSearchQueries(operator string, queries []SearchQuery) SearchQuery {
+	if len(queries) == 1 {
+		return queries[0]
+	}
+	parts := make([]string, len(queries))
+	for i, query := range queries {
+		if query == nil {
+			return &searchQuery{err: newError(EBadArgument, "nil search query passed to %s", operator)}
+		}
+		text, err := query.Build()
+		if err != nil {
+			return &searchQuery{err: err}
+		}
+		parts[i] = fmt.Sprintf("(%s)", text)
+	}
+	return &searchQuery{text: strings.Join(parts, fmt.Sprintf(" %s ", operator))}
+}
@@ -0,0 +1,86 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestSearchQuery(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		query    ovirtclient.SearchQuery
+		expected string
+	}{
+		"simple": {
+			ovirtclient.SearchField("name").Eq("test"),
+			`name = "test"`,
+		},
+		"spaces": {
+			ovirtclient.SearchField("name").Eq("my test vm"),
+			`name = "my test vm"`,
+		},
+		"wildcard": {
+			ovirtclient.SearchField("name").Like("test-*"),
+			`name = "test-*"`,
+		},
+		"not-equal": {
+			ovirtclient.SearchField("status").NotEq("up"),
+			`status != "up"`,
+		},
+		"and": {
+			ovirtclient.SearchField("name").Eq("test").And(ovirtclient.SearchField("status").Eq("up")),
+			`(name = "test") AND (status = "up")`,
+		},
+		"nested": {
+			ovirtclient.SearchField("name").Eq("test").And(
+				ovirtclient.SearchField("status").Eq("up").Or(ovirtclient.SearchField("status").Eq("down")),
+			),
+			`(name = "test") AND ((status = "up") OR (status = "down"))`,
+		},
+		"dotted-field": {
+			ovirtclient.SearchField("datacenter.name").Eq("Default"),
+			`datacenter.name = "Default"`,
+		},
+	}
+	for name, testCase := range testCases {
+		tc := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			result, err := tc.query.Build()
+			if err != nil {
+				t.Fatalf("Failed to build search query (%v)", err)
+			}
+			if result != tc.expected {
+				t.Fatalf("Incorrect search query (expected: %s, got: %s)", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestSearchQueryInvalid(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]ovirtclient.SearchQuery{
+		"wildcard-in-eq":   ovirtclient.SearchField("name").Eq("test*"),
+		"invalid-field":    ovirtclient.SearchField("name = x or name").Eq("test"),
+		"line-break":       ovirtclient.SearchField("name").Eq("test\nvm"),
+		"quote":            ovirtclient.SearchField("name").Eq(`test" or name = "other`),
+		"quote-in-like":    ovirtclient.SearchField("name").Like(`test"*`),
+		"invalid-in-and":   ovirtclient.SearchField("name").Eq("test").And(ovirtclient.SearchField("tag").Eq("*")),
+		"invalid-in-or":    ovirtclient.SearchField("name").Eq("*").Or(ovirtclient.SearchField("tag").Eq("test")),
+		"empty-field-name": ovirtclient.SearchField("").Eq("test"),
+	}
+	for name, testCase := range testCases {
+		query := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			_, err := query.Build()
+			if err == nil {
+				t.Fatalf("Building an invalid search query did not result in an error.")
+			}
+			if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+				t.Fatalf("Building an invalid search query did not result in an EBadArgument error (%v)", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package ovirtclient
+
+import (
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// SnapshotID is the identifier of a VM snapshot.
+type SnapshotID string
+
+// SnapshotClient contains the methods to work with VM snapshots.
+//
+// See https://www.ovirt.org/documentation/virtual_machine_management_guide/#sect-Snapshots for details.
+type SnapshotClient interface {
+	// CreateSnapshot creates a snapshot of the VM and waits until the snapshot is in the SnapshotStatusOK status. By
+	// default all disks of the VM are included, use CreateSnapshotParams().WithDiskIDs to snapshot only some of them.
+	// The params may be nil.
+	CreateSnapshot(vmID VMID, params OptionalSnapshotParameters, retries ...RetryStrategy) (Snapshot, error)
+	// GetSnapshot returns a single snapshot of the VM.
+	GetSnapshot(vmID VMID, id SnapshotID, retries ...RetryStrategy) (Snapshot, error)
+	// ListSnapshots returns all snapshots of the VM. The engine also lists the current state of the VM as a snapshot
+	// of the SnapshotTypeActive type.
+	ListSnapshots(vmID VMID, retries ...RetryStrategy) ([]Snapshot, error)
+	// RemoveSnapshot removes the snapshot and waits until it is gone. The data of the snapshot is merged into the
+	// following snapshot, which can take a long time for large disks.
+	RemoveSnapshot(vmID VMID, id SnapshotID, retries ...RetryStrategy) error
+	// PruneSnapshots applies a retention policy to the regular snapshots of the VM. The keepLast newest snapshots
+	// are always kept, of the others those taken more than olderThan ago are removed. Passing 0 for either disables
+	// that limit, passing 0 for both returns an EBadArgument error. The active snapshot is never removed.
+	// The snapshots are removed one at a time, oldest first, since the engine can't merge several snapshots of a VM
+	// in parallel. The IDs of the removed snapshots are returned, together with a MultiError for the snapshots that
+	// could not be removed.
+	PruneSnapshots(vmID VMID, keepLast uint, olderThan time.Duration, retries ...RetryStrategy) ([]SnapshotID, error)
+	// RemoveSnapshotDisk removes a single disk from the snapshot and waits until it is gone, leaving the other disks
+	// of the snapshot in place. An EBadArgument error is returned if the disk is not part of the snapshot.
+	RemoveSnapshotDisk(vmID VMID, snapshotID SnapshotID, diskID DiskID, retries ...RetryStrategy) error
+	// StartDownloadSnapshotDisk starts the download of the image of a disk as it was when the snapshot was taken.
+	// This allows for consistent backups of running VMs. The disk must be part of the snapshot, otherwise an
+	// EBadArgument error is returned. As with StartDownloadDisk, the caller MUST close the returned download to
+	// finalize the transfer.
+	StartDownloadSnapshotDisk(
+		vmID VMID,
+		snapshotID SnapshotID,
+		diskID DiskID,
+		format ImageFormat,
+		retries ...RetryStrategy,
+	) (ImageDownload, error)
+	// DownloadSnapshotDisk runs StartDownloadSnapshotDisk, then waits for the download to be ready before returning
+	// the reader. The caller MUST close the reader to finalize the transfer.
+	DownloadSnapshotDisk(
+		vmID VMID,
+		snapshotID SnapshotID,
+		diskID DiskID,
+		format ImageFormat,
+		retries ...RetryStrategy,
+	) (ImageDownloadReader, error)
+
+	// PreviewSnapshot starts the VM from the snapshot on the next run, without discarding the current state yet. The
+	// VM must be down, otherwise an EConflict error is returned. The call waits until the snapshot is in the
+	// SnapshotStatusInPreview status. Finish the preview with CommitSnapshot or UndoSnapshot.
+	PreviewSnapshot(vmID VMID, snapshotID SnapshotID, retries ...RetryStrategy) error
+	// CommitSnapshot makes the previewed snapshot the current state of the VM. Snapshots taken after the previewed
+	// one are removed. An EConflict error is returned if no snapshot is in preview.
+	CommitSnapshot(vmID VMID, retries ...RetryStrategy) error
+	// UndoSnapshot ends the preview and returns the VM to the state it had before PreviewSnapshot. An EConflict error
+	// is returned if no snapshot is in preview.
+	UndoSnapshot(vmID VMID, retries ...RetryStrategy) error
+}
+
+// SnapshotData contains the data access functions of a Snapshot.
+type SnapshotData interface {
+	// ID returns the identifier of the snapshot.
+	ID() SnapshotID
+	// VMID returns the ID of the VM the snapshot belongs to.
+	VMID() VMID
+	// Description returns the user-given description of the snapshot.
+	Description() string
+	// Status returns the status of the snapshot.
+	Status() SnapshotStatus
+	// Type returns the type of the snapshot.
+	Type() SnapshotType
+	// Date returns the time the snapshot was taken.
+	Date() time.Time
+}
+
+// Snapshot is a point-in-time copy of the disks and configuration of a VM.
+type Snapshot interface {
+	SnapshotData
+
+	// Remove removes the current snapshot. See SnapshotClient.RemoveSnapshot for details.
+	Remove(retries ...RetryStrategy) error
+}
+
+// SnapshotStatus is the status of a snapshot.
+type SnapshotStatus string
+
+const (
+	// SnapshotStatusOK indicates that the snapshot is ready.
+	SnapshotStatusOK SnapshotStatus = "ok"
+	// SnapshotStatusLocked indicates that an operation, such as the creation, is in progress on the snapshot.
+	SnapshotStatusLocked SnapshotStatus = "locked"
+	// SnapshotStatusInPreview indicates that the VM is running from the snapshot for a preview.
+	SnapshotStatusInPreview SnapshotStatus = "in_preview"
+)
+
+// SnapshotStatusList is a list of SnapshotStatus values.
+type SnapshotStatusList []SnapshotStatus
+
+// SnapshotStatusValues returns all possible SnapshotStatus values.
+func SnapshotStatusValues() SnapshotStatusList {
+	return []SnapshotStatus{
+		SnapshotStatusOK,
+		SnapshotStatusLocked,
+		SnapshotStatusInPreview,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l SnapshotStatusList) Strings() []string {
+	result := make([]string, len(l))
+	for i, status := range l {
+		result[i] = string(status)
+	}
+	return result
+}
+
+// SnapshotType is the type of a snapshot.
+type SnapshotType string
+
+const (
+	// SnapshotTypeRegular is a snapshot taken by a user.
+	SnapshotTypeRegular SnapshotType = "regular"
+	// SnapshotTypeActive is the current state of the VM, listed as a snapshot by the engine.
+	SnapshotTypeActive SnapshotType = "active"
+	// SnapshotTypePreview is the snapshot a VM is previewing.
+	SnapshotTypePreview SnapshotType = "preview"
+	// SnapshotTypeStateless is the snapshot the engine takes when running a stateless VM.
+	SnapshotTypeStateless SnapshotType = "stateless"
+)
+
+// SnapshotTypeList is a list of SnapshotType values.
+type SnapshotTypeList []SnapshotType
+
+// SnapshotTypeValues returns all possible SnapshotType values.
+func SnapshotTypeValues() SnapshotTypeList {
+	return []SnapshotType{
+		SnapshotTypeRegular,
+		SnapshotTypeActive,
+		SnapshotTypePreview,
+		SnapshotTypeStateless,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l SnapshotTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, snapshotType := range l {
+		result[i] = string(snapshotType)
+	}
+	return result
+}
+
+func convertSDKSnapshot(sdkObject *ovirtsdk.Snapshot, vmID VMID, client Client) (*snapshot, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("snapshot", "ID")
+	}
+	status, ok := sdkObject.SnapshotStatus()
+	if !ok {
+		return nil, newFieldNotFound("snapshot", "status")
+	}
+	description, _ := sdkObject.Description()
+	snapshotType, _ := sdkObject.SnapshotType()
+	date, _ := sdkObject.Date()
+	return &snapshot{
+		client:       client,
+		id:           SnapshotID(id),
+		vmID:         vmID,
+		description:  description,
+		status:       SnapshotStatus(status),
+		snapshotType: SnapshotType(snapshotType),
+		date:         date,
+	}, nil
+}
+
+type snapshot struct {
+	client Client
+
+	id           SnapshotID
+	vmID         VMID
+	description  string
+	status       SnapshotStatus
+	snapshotType SnapshotType
+	date         time.Time
+	// diskIDs holds the disks included in the snapshot. It is only used by the mock.
+	diskIDs []DiskID
+}
+
+func (s *snapshot) ID() SnapshotID {
+	return s.id
+}
+
+func (s *snapshot) VMID() VMID {
+	return s.vmID
+}
+
+func (s *snapshot) Description() string {
+	return s.description
+}
+
+func (s *snapshot) Status() SnapshotStatus {
+	return s.status
+}
+
+func (s *snapshot) Type() SnapshotType {
+	return s.snapshotType
+}
+
+func (s *snapshot) Date() time.Time {
+	return s.date
+}
+
+func (s *snapshot) Remove(retries ...RetryStrategy) error {
+	return s.client.RemoveSnapshot(s.vmID, s.id, retries...)
+}
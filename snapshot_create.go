@@ -0,0 +1,240 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// OptionalSnapshotParameters contains the optional parameters for creating a snapshot.
+type OptionalSnapshotParameters interface {
+	// Description returns the description of the snapshot.
+	Description() string
+	// DiskIDs returns the disks to include in the snapshot. If empty, all disks of the VM are included.
+	DiskIDs() []DiskID
+}
+
+// BuildableSnapshotParameters is a buildable version of OptionalSnapshotParameters.
+type BuildableSnapshotParameters interface {
+	OptionalSnapshotParameters
+
+	// WithDescription sets the description of the snapshot.
+	WithDescription(description string) (BuildableSnapshotParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableSnapshotParameters
+
+	// WithDiskIDs limits the snapshot to the specified disks, for example to take an application-consistent
+	// snapshot of the data disks only. All disks must be attached to the VM.
+	WithDiskIDs(diskIDs ...DiskID) (BuildableSnapshotParameters, error)
+	// MustWithDiskIDs is identical to WithDiskIDs, but panics instead of returning an error.
+	MustWithDiskIDs(diskIDs ...DiskID) BuildableSnapshotParameters
+}
+
+// CreateSnapshotParams creates a new set of optional parameters for CreateSnapshot.
+func CreateSnapshotParams() BuildableSnapshotParameters {
+	return &snapshotParams{}
+}
+
+type snapshotParams struct {
+	description string
+	diskIDs     []DiskID
+}
+
+func (s *snapshotParams) Description() string {
+	return s.description
+}
+
+func (s *snapshotParams) DiskIDs() []DiskID {
+	return s.diskIDs
+}
+
+func (s *snapshotParams) WithDescription(description string) (BuildableSnapshotParameters, error) {
+	s.description = description
+	return s, nil
+}
+
+func (s *snapshotParams) MustWithDescription(description string) BuildableSnapshotParameters {
+	builder, err := s.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *snapshotParams) WithDiskIDs(diskIDs ...DiskID) (BuildableSnapshotParameters, error) {
+	seen := make(map[DiskID]struct{}, len(diskIDs))
+	for _, diskID := range diskIDs {
+		if diskID == "" {
+			return nil, newError(EBadArgument, "empty disk ID passed for snapshot")
+		}
+		if _, ok := seen[diskID]; ok {
+			return nil, newError(EBadArgument, "disk %s passed more than once for snapshot", diskID)
+		}
+		seen[diskID] = struct{}{}
+	}
+	s.diskIDs = diskIDs
+	return s, nil
+}
+
+func (s *snapshotParams) MustWithDiskIDs(diskIDs ...DiskID) BuildableSnapshotParameters {
+	builder, err := s.WithDiskIDs(diskIDs...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// validateSnapshotDisks checks that every disk requested for the snapshot is attached to the VM.
+func validateSnapshotDisks(vmID VMID, diskIDs []DiskID, attachments []DiskAttachment) error {
+	attached := make(map[DiskID]struct{}, len(attachments))
+	for _, attachment := range attachments {
+		attached[attachment.DiskID()] = struct{}{}
+	}
+	for _, diskID := range diskIDs {
+		if _, ok := attached[diskID]; !ok {
+			return newError(EBadArgument, "disk %s is not attached to VM %s, cannot include it in a snapshot", diskID, vmID)
+		}
+	}
+	return nil
+}
+
+func (o *oVirtClient) CreateSnapshot(
+	vmID VMID,
+	params OptionalSnapshotParameters,
+	retries ...RetryStrategy,
+) (result Snapshot, err error) {
+	if params == nil {
+		params = CreateSnapshotParams()
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+
+	builder := ovirtsdk.NewSnapshotBuilder().Description(params.Description())
+	if diskIDs := params.DiskIDs(); len(diskIDs) > 0 {
+		attachments, err := o.ListDiskAttachments(vmID, retries...)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateSnapshotDisks(vmID, diskIDs, attachments); err != nil {
+			return nil, err
+		}
+		sdkAttachments := make([]*ovirtsdk.DiskAttachment, len(diskIDs))
+		for i, diskID := range diskIDs {
+			sdkAttachments[i] = ovirtsdk.NewDiskAttachmentBuilder().
+				Disk(ovirtsdk.NewDiskBuilder().Id(string(diskID)).MustBuild()).
+				MustBuild()
+		}
+		builder.DiskAttachmentsOfAny(sdkAttachments...)
+	}
+	sdkSnapshot, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build snapshot")
+	}
+
+	correlationID := fmt.Sprintf("snapshot_create_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf("creating snapshot of VM %s", vmID)
+	var snapshotID SnapshotID
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				SnapshotsService().
+				Add().
+				Snapshot(sdkSnapshot).
+				Query("correlation_id", correlationID).
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			createdSnapshot, ok := response.Snapshot()
+			if !ok {
+				return newFieldNotFound("snapshot creation response", "snapshot")
+			}
+			id, ok := createdSnapshot.Id()
+			if !ok {
+				return newFieldNotFound("snapshot", "ID")
+			}
+			snapshotID = SnapshotID(id)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	if err := o.waitForJobFinished(correlationID, retries); err != nil {
+		return nil, err
+	}
+	return o.waitForSnapshotStatus(vmID, snapshotID, SnapshotStatusOK, retries)
+}
+
+func (o *oVirtClient) waitForSnapshotStatus(
+	vmID VMID,
+	id SnapshotID,
+	status SnapshotStatus,
+	retries []RetryStrategy,
+) (result Snapshot, err error) {
+	action := fmt.Sprintf("waiting for snapshot %s of VM %s to reach status %s", id, vmID, status)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			result, err = o.GetSnapshot(vmID, id, retries...)
+			if err != nil {
+				return err
+			}
+			reportStatus(o.ctx, action, string(result.Status()))
+			if result.Status() != status {
+				return newError(EPending, "snapshot status is %s, not %s", result.Status(), status)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) CreateSnapshot(
+	vmID VMID,
+	params OptionalSnapshotParameters,
+	_ ...RetryStrategy,
+) (Snapshot, error) {
+	if params == nil {
+		params = CreateSnapshotParams()
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	attachments := make([]DiskAttachment, 0, len(m.vmDiskAttachmentsByVM[vmID]))
+	for _, attachment := range m.vmDiskAttachmentsByVM[vmID] {
+		attachments = append(attachments, attachment)
+	}
+	diskIDs := params.DiskIDs()
+	if len(diskIDs) > 0 {
+		if err := validateSnapshotDisks(vmID, diskIDs, attachments); err != nil {
+			return nil, err
+		}
+	} else {
+		for _, attachment := range attachments {
+			diskIDs = append(diskIDs, attachment.DiskID())
+		}
+	}
+
+	result := &snapshot{
+		client:       m,
+		id:           SnapshotID(m.GenerateUUID()),
+		vmID:         vmID,
+		description:  params.Description(),
+		status:       SnapshotStatusOK,
+		snapshotType: SnapshotTypeRegular,
+		date:         time.Now(),
+		diskIDs:      append([]DiskID{}, diskIDs...),
+	}
+	m.snapshots[result.id] = result
+	return result, nil
+}
@@ -0,0 +1,127 @@
+package ovirtclient
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+)
+
+func (o *oVirtClient) StartDownloadSnapshotDisk(
+	vmID VMID,
+	snapshotID SnapshotID,
+	diskID DiskID,
+	format ImageFormat,
+	retries ...RetryStrategy,
+) (ImageDownload, error) {
+	retries = defaultRetries(o.withPollInterval(retries, pollTransfer), defaultLongTimeouts(o))
+
+	o.logger.Infof("Starting download of disk %s from snapshot %s of VM %s...", diskID, snapshotID, vmID)
+	if _, err := o.GetSnapshot(vmID, snapshotID, retries...); err != nil {
+		return nil, err
+	}
+	sdkDisk, err := o.getSnapshotDisk(vmID, snapshotID, diskID, retries)
+	if err != nil {
+		return nil, err
+	}
+	imageID, ok := sdkDisk.ImageId()
+	if !ok {
+		return nil, newFieldNotFound("snapshot disk", "image ID")
+	}
+	disk, err := o.GetDisk(diskID, retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to fetch disk for snapshot image download")
+	}
+	return o.startDownload(
+		disk,
+		imageID,
+		fmt.Sprintf("downloading image of disk %s from snapshot %s", diskID, snapshotID),
+		format,
+		retries,
+	), nil
+}
+
+func (o *oVirtClient) DownloadSnapshotDisk(
+	vmID VMID,
+	snapshotID SnapshotID,
+	diskID DiskID,
+	format ImageFormat,
+	retries ...RetryStrategy,
+) (ImageDownloadReader, error) {
+	download, err := o.StartDownloadSnapshotDisk(vmID, snapshotID, diskID, format, retries...)
+	if err != nil {
+		return nil, err
+	}
+	<-download.Initialized()
+	if err := download.Err(); err != nil {
+		_ = download.Close()
+		return nil, err
+	}
+	return download, nil
+}
+
+// StartDownloadSnapshotDisk in the mock returns the current data of the disk since the mock doesn't keep the disk
+// contents of snapshots.
+func (m *mockClient) StartDownloadSnapshotDisk(
+	vmID VMID,
+	snapshotID SnapshotID,
+	diskID DiskID,
+	format ImageFormat,
+	_ ...RetryStrategy,
+) (ImageDownload, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	item, ok := m.snapshots[snapshotID]
+	if !ok || item.vmID != vmID {
+		return nil, newError(ENotFound, "snapshot with ID %s not found on VM %s", snapshotID, vmID)
+	}
+	found := false
+	for _, id := range item.diskIDs {
+		if id == diskID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, newError(EBadArgument, "disk %s is not part of snapshot %s of VM %s", diskID, snapshotID, vmID)
+	}
+	disk, ok := m.disks[diskID]
+	if !ok {
+		return nil, newError(ENotFound, "disk with ID %s not found", diskID)
+	}
+	if disk.format != format {
+		m.logger.Warningf(
+			"the snapshot download requested a conversion from %s to %s, which the mock doesn't support",
+			disk.format,
+			format,
+		)
+	}
+
+	dl := &mockImageDownload{
+		disk:     disk,
+		done:     make(chan struct{}),
+		lock:     &sync.Mutex{},
+		reader:   bytes.NewReader(disk.data),
+		reporter: progressReporterFromContext(m.ctx),
+	}
+	go dl.prepare()
+	return dl, nil
+}
+
+func (m *mockClient) DownloadSnapshotDisk(
+	vmID VMID,
+	snapshotID SnapshotID,
+	diskID DiskID,
+	format ImageFormat,
+	retries ...RetryStrategy,
+) (ImageDownloadReader, error) {
+	download, err := m.StartDownloadSnapshotDisk(vmID, snapshotID, diskID, format, retries...)
+	if err != nil {
+		return nil, err
+	}
+	<-download.Initialized()
+	if err := download.Err(); err != nil {
+		return nil, err
+	}
+	return download, nil
+}
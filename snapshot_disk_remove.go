@@ -0,0 +1,132 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) RemoveSnapshotDisk(
+	vmID VMID,
+	snapshotID SnapshotID,
+	diskID DiskID,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	sdkDisk, err := o.getSnapshotDisk(vmID, snapshotID, diskID, retries)
+	if err != nil {
+		return err
+	}
+	// The disk in the snapshot is addressed by its image ID on the storage domain holding it.
+	imageID, ok := sdkDisk.ImageId()
+	if !ok {
+		return newFieldNotFound("snapshot disk", "image ID")
+	}
+	storageDomains, ok := sdkDisk.StorageDomains()
+	if !ok || len(storageDomains.Slice()) == 0 {
+		return newFieldNotFound("snapshot disk", "storage domain")
+	}
+	storageDomainID, ok := storageDomains.Slice()[0].Id()
+	if !ok {
+		return newFieldNotFound("storage domain of snapshot disk", "ID")
+	}
+
+	correlationID := fmt.Sprintf("snapshot_disk_remove_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf("removing disk %s from snapshot %s of VM %s", diskID, snapshotID, vmID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				StorageDomainsService().
+				StorageDomainService(storageDomainID).
+				DiskSnapshotsService().
+				SnapshotService(imageID).
+				Remove().
+				Query("correlation_id", correlationID).
+				Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return err
+	}
+	if err := o.waitForJobFinished(correlationID, retries); err != nil {
+		return err
+	}
+	return retry(
+		fmt.Sprintf("waiting for disk %s to be removed from snapshot %s of VM %s", diskID, snapshotID, vmID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.getSnapshotDisk(vmID, snapshotID, diskID, retries)
+			if err == nil {
+				return newError(EPending, "disk %s is still part of snapshot %s", diskID, snapshotID)
+			}
+			if HasErrorCode(err, EBadArgument) {
+				return nil
+			}
+			return err
+		})
+}
+
+// getSnapshotDisk returns the disk from the disks of the snapshot. An EBadArgument error is returned if the disk is
+// not part of the snapshot.
+func (o *oVirtClient) getSnapshotDisk(
+	vmID VMID,
+	snapshotID SnapshotID,
+	diskID DiskID,
+	retries []RetryStrategy,
+) (result *ovirtsdk.Disk, err error) {
+	action := fmt.Sprintf("listing disks of snapshot %s of VM %s", snapshotID, vmID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				SnapshotsService().
+				SnapshotService(string(snapshotID)).
+				DisksService().
+				List().
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			if sdkDisks, ok := response.Disks(); ok {
+				for _, sdkDisk := range sdkDisks.Slice() {
+					if id, ok := sdkDisk.Id(); ok && DiskID(id) == diskID {
+						result = sdkDisk
+						return nil
+					}
+				}
+			}
+			return newError(EBadArgument, "disk %s is not part of snapshot %s of VM %s", diskID, snapshotID, vmID)
+		})
+	return result, err
+}
+
+func (m *mockClient) RemoveSnapshotDisk(
+	vmID VMID,
+	snapshotID SnapshotID,
+	diskID DiskID,
+	_ ...RetryStrategy,
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.snapshots[snapshotID]
+	if !ok || item.vmID != vmID {
+		return newError(ENotFound, "snapshot with ID %s not found on VM %s", snapshotID, vmID)
+	}
+	for i, id := range item.diskIDs {
+		if id == diskID {
+			item.diskIDs = append(item.diskIDs[:i], item.diskIDs[i+1:]...)
+			return nil
+		}
+	}
+	return newError(EBadArgument, "disk %s is not part of snapshot %s of VM %s", diskID, snapshotID, vmID)
+}
@@ -0,0 +1,47 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetSnapshot(vmID VMID, id SnapshotID, retries ...RetryStrategy) (result Snapshot, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("getting snapshot %s of VM %s", id, vmID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				SnapshotsService().
+				SnapshotService(string(id)).
+				Get().
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkSnapshot, ok := response.Snapshot()
+			if !ok {
+				return newError(ENotFound, "no snapshot returned when getting snapshot %s of VM %s", id, vmID)
+			}
+			result, err = convertSDKSnapshot(sdkSnapshot, vmID, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert snapshot %s", id)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetSnapshot(vmID VMID, id SnapshotID, _ ...RetryStrategy) (Snapshot, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.snapshots[id]
+	if !ok || item.vmID != vmID {
+		return nil, newError(ENotFound, "snapshot with ID %s not found on VM %s", id, vmID)
+	}
+	return item, nil
+}
@@ -0,0 +1,55 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListSnapshots(vmID VMID, retries ...RetryStrategy) (result []Snapshot, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("listing snapshots of VM %s", vmID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				SnapshotsService().
+				List().
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkSnapshots, ok := response.Snapshots()
+			if !ok {
+				result = []Snapshot{}
+				return nil
+			}
+			result = make([]Snapshot, len(sdkSnapshots.Slice()))
+			for i, sdkSnapshot := range sdkSnapshots.Slice() {
+				result[i], err = convertSDKSnapshot(sdkSnapshot, vmID, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert snapshot during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) ListSnapshots(vmID VMID, _ ...RetryStrategy) ([]Snapshot, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	result := []Snapshot{}
+	for _, item := range m.snapshots {
+		if item.vmID == vmID {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
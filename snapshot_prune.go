@@ -0,0 +1,86 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+func (o *oVirtClient) PruneSnapshots(
+	vmID VMID,
+	keepLast uint,
+	olderThan time.Duration,
+	retries ...RetryStrategy,
+) ([]SnapshotID, error) {
+	return pruneSnapshots(o, vmID, keepLast, olderThan, retries)
+}
+
+func (m *mockClient) PruneSnapshots(
+	vmID VMID,
+	keepLast uint,
+	olderThan time.Duration,
+	retries ...RetryStrategy,
+) ([]SnapshotID, error) {
+	return pruneSnapshots(m, vmID, keepLast, olderThan, retries)
+}
+
+func pruneSnapshots(
+	client Client,
+	vmID VMID,
+	keepLast uint,
+	olderThan time.Duration,
+	retries []RetryStrategy,
+) ([]SnapshotID, error) {
+	if keepLast == 0 && olderThan <= 0 {
+		return nil, newError(
+			EBadArgument,
+			"pruning snapshots of VM %s requires a count or an age limit, refusing to remove all snapshots",
+			vmID,
+		)
+	}
+	snapshots, err := client.ListSnapshots(vmID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	candidates := selectSnapshotsToPrune(snapshots, keepLast, olderThan, time.Now())
+
+	// The engine rejects removing a snapshot while another snapshot of the same VM is being merged, so the snapshots
+	// are removed one after the other, oldest first.
+	var removed []SnapshotID
+	snapshotErrors := map[string]error{}
+	for _, snapshot := range candidates {
+		if err := client.RemoveSnapshot(vmID, snapshot.ID(), retries...); err != nil {
+			snapshotErrors[string(snapshot.ID())] = err
+			continue
+		}
+		removed = append(removed, snapshot.ID())
+	}
+	return removed, newMultiError(fmt.Sprintf("pruning snapshots of VM %s", vmID), len(candidates), snapshotErrors)
+}
+
+// selectSnapshotsToPrune returns the regular snapshots that are not among the keepLast newest ones and were taken
+// more than olderThan before now, oldest first. A zero keepLast or olderThan disables the respective limit. The
+// active snapshot, which holds the current state of the VM, and snapshots in use for a preview or a stateless run
+// are never selected.
+func selectSnapshotsToPrune(snapshots []Snapshot, keepLast uint, olderThan time.Duration, now time.Time) []Snapshot {
+	var regular []Snapshot
+	for _, snapshot := range snapshots {
+		if snapshot.Type() == SnapshotTypeRegular && snapshot.Status() == SnapshotStatusOK {
+			regular = append(regular, snapshot)
+		}
+	}
+	sort.SliceStable(regular, func(i, j int) bool {
+		return regular[i].Date().After(regular[j].Date())
+	})
+	var result []Snapshot
+	for i := len(regular) - 1; i >= 0; i-- {
+		snapshot := regular[i]
+		// A disabled limit doesn't protect any snapshot, but the keepLast newest ones are kept regardless of their age.
+		beyondCount := keepLast == 0 || uint(i) >= keepLast
+		beyondAge := olderThan <= 0 || now.Sub(snapshot.Date()) > olderThan
+		if beyondCount && beyondAge {
+			result = append(result, snapshot)
+		}
+	}
+	return result
+}
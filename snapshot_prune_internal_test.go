@@ -0,0 +1,61 @@
+package ovirtclient
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSelectSnapshotsToPrune(t *testing.T) {
+	now := time.Now()
+	snapshotAt := func(id SnapshotID, snapshotType SnapshotType, status SnapshotStatus, age time.Duration) Snapshot {
+		return &snapshot{id: id, snapshotType: snapshotType, status: status, date: now.Add(-age)}
+	}
+	snapshots := []Snapshot{
+		snapshotAt("active", SnapshotTypeActive, SnapshotStatusOK, 72*time.Hour),
+		snapshotAt("old", SnapshotTypeRegular, SnapshotStatusOK, 48*time.Hour),
+		snapshotAt("locked", SnapshotTypeRegular, SnapshotStatusLocked, 47*time.Hour),
+		snapshotAt("new", SnapshotTypeRegular, SnapshotStatusOK, time.Hour),
+	}
+
+	selected := selectSnapshotsToPrune(snapshots, 0, 24*time.Hour, now)
+	if len(selected) != 1 || selected[0].ID() != "old" {
+		t.Fatalf("Incorrect snapshots selected by age: %v", selected)
+	}
+	selected = selectSnapshotsToPrune(snapshots, 2, 0, now)
+	if len(selected) != 0 {
+		t.Fatalf("Snapshots selected although the count limit is not reached: %v", selected)
+	}
+	selected = selectSnapshotsToPrune(snapshots, 1, 0, now)
+	if len(selected) != 1 || selected[0].ID() != "old" {
+		t.Fatalf("Incorrect snapshots selected by count: %v", selected)
+	}
+}
+
+func TestSelectSnapshotsToPruneKeepsNewestOldSnapshots(t *testing.T) {
+	now := time.Now()
+	snapshotAt := func(id SnapshotID, age time.Duration) Snapshot {
+		return &snapshot{id: id, snapshotType: SnapshotTypeRegular, status: SnapshotStatusOK, date: now.Add(-age)}
+	}
+	snapshots := []Snapshot{
+		snapshotAt("oldest", 96*time.Hour),
+		snapshotAt("older", 72*time.Hour),
+		snapshotAt("old", 48*time.Hour),
+		snapshotAt("new", time.Hour),
+	}
+
+	// All snapshots but one are beyond the age limit, the two newest must be kept anyway.
+	selected := selectSnapshotsToPrune(snapshots, 2, 24*time.Hour, now)
+	if len(selected) != 2 || selected[0].ID() != "oldest" || selected[1].ID() != "older" {
+		t.Fatalf("Incorrect snapshots selected with both limits: %v", selected)
+	}
+	// Every snapshot is beyond the age limit, the newest must still be kept.
+	selected = selectSnapshotsToPrune(snapshots, 1, time.Minute, now)
+	if len(selected) != 3 || selected[len(selected)-1].ID() != "old" {
+		t.Fatalf("The newest snapshot was selected although keepLast is set: %v", selected)
+	}
+	// No snapshot beyond the count limit is old enough.
+	selected = selectSnapshotsToPrune(snapshots, 3, 24*time.Hour, now)
+	if len(selected) != 1 || selected[0].ID() != "oldest" {
+		t.Fatalf("Incorrect snapshots selected with both limits: %v", selected)
+	}
+}
@@ -0,0 +1,50 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestPruneSnapshots(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+
+	var snapshots []ovirtclient.Snapshot
+	for i := 0; i < 3; i++ {
+		snapshot, err := vm.CreateSnapshot(
+			ovirtclient.CreateSnapshotParams().MustWithDescription(fmt.Sprintf("backup %d", i)),
+		)
+		if err != nil {
+			t.Fatalf("Failed to create snapshot %d of VM %s (%v)", i, vm.ID(), err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	if _, err := client.PruneSnapshots(vm.ID(), 0, 0); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Pruning snapshots without limits did not result in an EBadArgument error (%v)", err)
+	}
+
+	removed, err := client.PruneSnapshots(vm.ID(), 1, 0)
+	if err != nil {
+		t.Fatalf("Failed to prune snapshots of VM %s (%v)", vm.ID(), err)
+	}
+	if len(removed) != 2 || removed[0] != snapshots[0].ID() || removed[1] != snapshots[1].ID() {
+		t.Fatalf("Incorrect snapshots removed (expected: %s, %s, got: %v)", snapshots[0].ID(), snapshots[1].ID(), removed)
+	}
+	remaining, err := client.ListSnapshots(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to list snapshots of VM %s (%v)", vm.ID(), err)
+	}
+	for _, snapshot := range remaining {
+		if snapshot.Type() == ovirtclient.SnapshotTypeRegular && snapshot.ID() != snapshots[2].ID() {
+			t.Fatalf("Snapshot %s was not pruned.", snapshot.ID())
+		}
+	}
+}
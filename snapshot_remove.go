@@ -0,0 +1,58 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveSnapshot(vmID VMID, id SnapshotID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	correlationID := fmt.Sprintf("snapshot_remove_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf("removing snapshot %s of VM %s", id, vmID)
+	err := retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				SnapshotsService().
+				SnapshotService(string(id)).
+				Remove().
+				Query("correlation_id", correlationID).
+				Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return err
+	}
+	if err := o.waitForJobFinished(correlationID, retries); err != nil {
+		return err
+	}
+	return retry(
+		fmt.Sprintf("waiting for snapshot %s of VM %s to be removed", id, vmID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.GetSnapshot(vmID, id, retries...)
+			if err == nil {
+				return newError(EPending, "snapshot %s still exists", id)
+			}
+			if HasErrorCode(err, ENotFound) {
+				return nil
+			}
+			return err
+		})
+}
+
+func (m *mockClient) RemoveSnapshot(vmID VMID, id SnapshotID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.snapshots[id]
+	if !ok || item.vmID != vmID {
+		return newError(ENotFound, "snapshot with ID %s not found on VM %s", id, vmID)
+	}
+	delete(m.snapshots, id)
+	return nil
+}
@@ -0,0 +1,190 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) PreviewSnapshot(vmID VMID, snapshotID SnapshotID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	vm, err := o.GetVM(vmID, retries...)
+	if err != nil {
+		return err
+	}
+	if err := checkVMDownForPreview(vm); err != nil {
+		return err
+	}
+	if _, err := o.GetSnapshot(vmID, snapshotID, retries...); err != nil {
+		return err
+	}
+	correlationID := fmt.Sprintf("snapshot_preview_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf("previewing snapshot %s of VM %s", snapshotID, vmID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				PreviewSnapshot().
+				Snapshot(ovirtsdk.NewSnapshotBuilder().Id(string(snapshotID)).MustBuild()).
+				RestoreMemory(false).
+				Query("correlation_id", correlationID).
+				Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return err
+	}
+	if err := o.waitForJobFinished(correlationID, retries); err != nil {
+		return err
+	}
+	if _, err := o.waitForSnapshotStatus(vmID, snapshotID, SnapshotStatusInPreview, retries); err != nil {
+		return err
+	}
+	_, err = o.WaitForVMStatus(vmID, VMStatusDown, retries...)
+	return err
+}
+
+func (o *oVirtClient) CommitSnapshot(vmID VMID, retries ...RetryStrategy) error {
+	return o.finishSnapshotPreview(vmID, "committing", retries, func(service *ovirtsdk.VmService) error {
+		_, err := service.CommitSnapshot().Send()
+		return err
+	})
+}
+
+func (o *oVirtClient) UndoSnapshot(vmID VMID, retries ...RetryStrategy) error {
+	return o.finishSnapshotPreview(vmID, "undoing", retries, func(service *ovirtsdk.VmService) error {
+		_, err := service.UndoSnapshot().Send()
+		return err
+	})
+}
+
+// finishSnapshotPreview commits or undoes the snapshot preview of the VM and waits until the previewed snapshot is
+// back in the SnapshotStatusOK status and the VM is down.
+func (o *oVirtClient) finishSnapshotPreview(
+	vmID VMID,
+	operation string,
+	retries []RetryStrategy,
+	send func(service *ovirtsdk.VmService) error,
+) error {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	snapshots, err := o.ListSnapshots(vmID, retries...)
+	if err != nil {
+		return err
+	}
+	previewed, err := findPreviewedSnapshot(vmID, snapshots)
+	if err != nil {
+		return err
+	}
+	action := fmt.Sprintf("%s preview of snapshot %s of VM %s", operation, previewed.ID(), vmID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			return wrapSDKError(action, send(o.conn.SystemService().VmsService().VmService(string(vmID))))
+		})
+	if err != nil {
+		return err
+	}
+	if _, err := o.waitForSnapshotStatus(vmID, previewed.ID(), SnapshotStatusOK, retries); err != nil {
+		return err
+	}
+	_, err = o.WaitForVMStatus(vmID, VMStatusDown, retries...)
+	return err
+}
+
+// checkVMDownForPreview returns an EConflict error if the VM is not down, since the engine only previews snapshots of
+// stopped VMs.
+func checkVMDownForPreview(vm VM) error {
+	if vm.Status() != VMStatusDown {
+		return newError(
+			EConflict,
+			"VM %s must be in status %s to preview a snapshot (current status: %s)",
+			vm.ID(),
+			VMStatusDown,
+			vm.Status(),
+		)
+	}
+	return nil
+}
+
+// findPreviewedSnapshot returns the snapshot in preview, or an EConflict error if no snapshot is in preview.
+func findPreviewedSnapshot(vmID VMID, snapshots []Snapshot) (Snapshot, error) {
+	for _, s := range snapshots {
+		if s.Status() == SnapshotStatusInPreview {
+			return s, nil
+		}
+	}
+	return nil, newError(EConflict, "VM %s has no snapshot in preview", vmID)
+}
+
+func (m *mockClient) PreviewSnapshot(vmID VMID, snapshotID SnapshotID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	vm, ok := m.vms[vmID]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	if err := checkVMDownForPreview(vm); err != nil {
+		return err
+	}
+	item, ok := m.snapshots[snapshotID]
+	if !ok || item.vmID != vmID {
+		return newError(ENotFound, "snapshot with ID %s not found on VM %s", snapshotID, vmID)
+	}
+	if _, err := findPreviewedSnapshot(vmID, m.vmSnapshots(vmID)); err == nil {
+		return newError(EConflict, "VM %s already has a snapshot in preview", vmID)
+	}
+	item.status = SnapshotStatusInPreview
+	return nil
+}
+
+func (m *mockClient) CommitSnapshot(vmID VMID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	previewed, err := findPreviewedSnapshot(vmID, m.vmSnapshots(vmID))
+	if err != nil {
+		return err
+	}
+	// Committing discards the snapshots taken after the previewed one, as the engine does.
+	for id, item := range m.snapshots {
+		if item.vmID == vmID && item.date.After(previewed.Date()) {
+			delete(m.snapshots, id)
+		}
+	}
+	m.snapshots[previewed.ID()].status = SnapshotStatusOK
+	return nil
+}
+
+func (m *mockClient) UndoSnapshot(vmID VMID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	previewed, err := findPreviewedSnapshot(vmID, m.vmSnapshots(vmID))
+	if err != nil {
+		return err
+	}
+	m.snapshots[previewed.ID()].status = SnapshotStatusOK
+	return nil
+}
+
+// vmSnapshots returns the snapshots of the VM. The caller must hold the lock.
+func (m *mockClient) vmSnapshots(vmID VMID) []Snapshot {
+	var result []Snapshot
+	for _, item := range m.snapshots {
+		if item.vmID == vmID {
+			result = append(result, item)
+		}
+	}
+	return result
+}
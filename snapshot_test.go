@@ -0,0 +1,194 @@
+package ovirtclient_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestSnapshotOfSelectedDisks(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	includedDisk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, includedDisk)
+	excludedDisk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, excludedDisk)
+	detachedDisk := assertCanCreateDisk(t, helper)
+
+	if _, err := vm.CreateSnapshot(
+		ovirtclient.CreateSnapshotParams().MustWithDiskIDs(includedDisk.ID(), detachedDisk.ID()),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a snapshot of a disk not attached to the VM did not result in an EBadArgument error (%v)", err)
+	}
+
+	snapshot, err := vm.CreateSnapshot(
+		ovirtclient.CreateSnapshotParams().
+			MustWithDescription("data disk only").
+			MustWithDiskIDs(includedDisk.ID()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create snapshot of VM %s (%v)", vm.ID(), err)
+	}
+	if snapshot.Status() != ovirtclient.SnapshotStatusOK {
+		t.Fatalf("Incorrect snapshot status (expected: %s, got: %s)", ovirtclient.SnapshotStatusOK, snapshot.Status())
+	}
+	if snapshot.Description() != "data disk only" {
+		t.Fatalf("Incorrect snapshot description (%s)", snapshot.Description())
+	}
+
+	snapshots, err := vm.ListSnapshots()
+	if err != nil {
+		t.Fatalf("Failed to list snapshots of VM %s (%v)", vm.ID(), err)
+	}
+	found := false
+	for _, s := range snapshots {
+		if s.ID() == snapshot.ID() {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("The created snapshot is not listed.")
+	}
+
+	if err := snapshot.Remove(); err != nil {
+		t.Fatalf("Failed to remove snapshot %s (%v)", snapshot.ID(), err)
+	}
+	if _, err := client.GetSnapshot(vm.ID(), snapshot.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Getting a removed snapshot did not result in an ENotFound error (%v)", err)
+	}
+
+	_, err = ovirtclient.CreateSnapshotParams().WithDiskIDs(includedDisk.ID(), includedDisk.ID())
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Passing a disk twice did not result in an EBadArgument error (%v)", err)
+	}
+}
+
+func TestRemoveSnapshotDisk(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	removedDisk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, removedDisk)
+	keptDisk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, keptDisk)
+	otherDisk := assertCanCreateDisk(t, helper)
+
+	snapshot, err := vm.CreateSnapshot(nil)
+	if err != nil {
+		t.Fatalf("Failed to create snapshot of VM %s (%v)", vm.ID(), err)
+	}
+	if err := client.RemoveSnapshotDisk(vm.ID(), snapshot.ID(), otherDisk.ID()); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Removing a disk that is not part of the snapshot did not result in an EBadArgument error (%v)", err)
+	}
+	if err := client.RemoveSnapshotDisk(vm.ID(), snapshot.ID(), removedDisk.ID()); err != nil {
+		t.Fatalf("Failed to remove disk %s from snapshot %s (%v)", removedDisk.ID(), snapshot.ID(), err)
+	}
+	if err := client.RemoveSnapshotDisk(vm.ID(), snapshot.ID(), removedDisk.ID()); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Removing a disk from a snapshot twice did not result in an EBadArgument error (%v)", err)
+	}
+	if err := client.RemoveSnapshotDisk(vm.ID(), snapshot.ID(), keptDisk.ID()); err != nil {
+		t.Fatalf("Failed to remove disk %s from snapshot %s (%v)", keptDisk.ID(), snapshot.ID(), err)
+	}
+}
+
+func TestSnapshotPreviewCommitUndo(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+
+	if err := client.CommitSnapshot(vm.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Committing without a snapshot in preview did not result in an EConflict error (%v)", err)
+	}
+
+	first, err := vm.CreateSnapshot(ovirtclient.CreateSnapshotParams().MustWithDescription("first"))
+	if err != nil {
+		t.Fatalf("Failed to create snapshot of VM %s (%v)", vm.ID(), err)
+	}
+	if err := client.PreviewSnapshot(vm.ID(), first.ID()); err != nil {
+		t.Fatalf("Failed to preview snapshot %s (%v)", first.ID(), err)
+	}
+	if err := client.UndoSnapshot(vm.ID()); err != nil {
+		t.Fatalf("Failed to undo preview of snapshot %s (%v)", first.ID(), err)
+	}
+
+	second, err := vm.CreateSnapshot(ovirtclient.CreateSnapshotParams().MustWithDescription("second"))
+	if err != nil {
+		t.Fatalf("Failed to create snapshot of VM %s (%v)", vm.ID(), err)
+	}
+	if err := client.PreviewSnapshot(vm.ID(), first.ID()); err != nil {
+		t.Fatalf("Failed to preview snapshot %s (%v)", first.ID(), err)
+	}
+	if err := client.CommitSnapshot(vm.ID()); err != nil {
+		t.Fatalf("Failed to commit snapshot %s (%v)", first.ID(), err)
+	}
+	committed, err := client.GetSnapshot(vm.ID(), first.ID())
+	if err != nil {
+		t.Fatalf("Failed to get committed snapshot %s (%v)", first.ID(), err)
+	}
+	if committed.Status() != ovirtclient.SnapshotStatusOK {
+		t.Fatalf("Incorrect status of committed snapshot (%s)", committed.Status())
+	}
+	if _, err := client.GetSnapshot(vm.ID(), second.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("The snapshot taken after the committed one still exists (%v)", err)
+	}
+}
+
+func TestDownloadSnapshotDisk(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	testImageData, _ := getTestImageData(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanUploadDiskImage(t, helper, disk)
+	assertCanAttachDisk(t, vm, disk)
+	otherDisk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, otherDisk)
+
+	snapshot, err := vm.CreateSnapshot(ovirtclient.CreateSnapshotParams().MustWithDiskIDs(disk.ID()))
+	if err != nil {
+		t.Fatalf("Failed to create snapshot of VM %s (%v)", vm.ID(), err)
+	}
+
+	if _, err := client.DownloadSnapshotDisk(
+		vm.ID(),
+		snapshot.ID(),
+		otherDisk.ID(),
+		ovirtclient.ImageFormatRaw,
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Downloading a disk that is not part of the snapshot did not result in an EBadArgument error (%v)", err)
+	}
+
+	download, err := client.DownloadSnapshotDisk(vm.ID(), snapshot.ID(), disk.ID(), ovirtclient.ImageFormatRaw)
+	if err != nil {
+		t.Fatalf("Failed to download disk %s from snapshot %s (%v)", disk.ID(), snapshot.ID(), err)
+	}
+	data, err := io.ReadAll(download)
+	if closeErr := download.Close(); closeErr != nil {
+		t.Fatalf("Failed to finalize the snapshot disk download (%v)", closeErr)
+	}
+	if err != nil {
+		t.Fatalf("Failed to read the snapshot disk download (%v)", err)
+	}
+	if len(data) < len(testImageData) || !bytes.Equal(data[:len(testImageData)], testImageData) {
+		t.Fatalf("The downloaded snapshot image did not match the uploaded image.")
+	}
+}
@@ -0,0 +1,99 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// StorageConnectionID is the identifier of a storage connection.
+type StorageConnectionID string
+
+// StorageConnectionClient contains the methods to query the storage connections of the oVirt Engine.
+type StorageConnectionClient interface {
+	// ListStorageConnections returns all storage connections known to the oVirt Engine, regardless of whether they
+	// are used by a storage domain.
+	ListStorageConnections(retries ...RetryStrategy) ([]StorageConnection, error)
+}
+
+// StorageConnection describes how hosts reach a storage server, for example an NFS export or an iSCSI target.
+type StorageConnection interface {
+	// ID returns the identifier of the storage connection.
+	ID() StorageConnectionID
+	// Type returns the storage type of the connection, for example StorageDomainTypeNFS.
+	Type() StorageDomainType
+	// Address returns the host name or IP address of the storage server.
+	Address() string
+	// Port returns the port of the storage server, or 0 if the connection type has none.
+	Port() uint16
+	// Path returns the exported path for file-based connections, for example the NFS export.
+	Path() string
+	// Target returns the iSCSI qualified name (IQN) of the target for iSCSI connections.
+	Target() string
+	// Portal returns the iSCSI portal group of the target for iSCSI connections.
+	Portal() string
+}
+
+func convertSDKStorageConnection(object *ovirtsdk.StorageConnection) (*storageConnection, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("storage connection", "ID")
+	}
+	storageType, ok := object.Type()
+	if !ok {
+		return nil, newFieldNotFound("storage connection", "type")
+	}
+	port, _ := object.Port()
+	if port < 0 || port > 65535 {
+		return nil, newError(EBug, "invalid port returned for storage connection %s: %d", id, port)
+	}
+	address, _ := object.Address()
+	path, _ := object.Path()
+	target, _ := object.Target()
+	portal, _ := object.Portal()
+	return &storageConnection{
+		id:          StorageConnectionID(id),
+		storageType: StorageDomainType(storageType),
+		address:     address,
+		port:        uint16(port),
+		path:        path,
+		target:      target,
+		portal:      portal,
+	}, nil
+}
+
+type storageConnection struct {
+	id          StorageConnectionID
+	storageType StorageDomainType
+	address     string
+	port        uint16
+	path        string
+	target      string
+	portal      string
+}
+
+func (s storageConnection) ID() StorageConnectionID {
+	return s.id
+}
+
+func (s storageConnection) Type() StorageDomainType {
+	return s.storageType
+}
+
+func (s storageConnection) Address() string {
+	return s.address
+}
+
+func (s storageConnection) Port() uint16 {
+	return s.port
+}
+
+func (s storageConnection) Path() string {
+	return s.path
+}
+
+func (s storageConnection) Target() string {
+	return s.target
+}
+
+func (s storageConnection) Portal() string {
+	return s.portal
+}
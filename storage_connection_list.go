@@ -0,0 +1,41 @@
+package ovirtclient
+
+func (o *oVirtClient) ListStorageConnections(retries ...RetryStrategy) (result []StorageConnection, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []StorageConnection{}
+	err = retry(
+		"listing storage connections",
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.SystemService().StorageConnectionsService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Connections()
+			if !ok {
+				return nil
+			}
+			result = make([]StorageConnection, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKStorageConnection(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert storage connection during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListStorageConnections(_ ...RetryStrategy) ([]StorageConnection, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := make([]StorageConnection, len(m.storageConnections))
+	i := 0
+	for _, item := range m.storageConnections {
+		result[i] = item
+		i++
+	}
+	return result, nil
+}
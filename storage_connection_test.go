@@ -0,0 +1,50 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestListStorageConnections(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	connections, err := helper.GetClient().ListStorageConnections()
+	if err != nil {
+		t.Fatalf("Failed to list storage connections (%v)", err)
+	}
+	if len(connections) == 0 {
+		t.Fatalf("No storage connections returned.")
+	}
+	for _, connection := range connections {
+		if connection.ID() == "" {
+			t.Fatalf("Storage connection with empty ID returned.")
+		}
+		if connection.Type() == "" {
+			t.Fatalf("Storage connection %s has no type.", connection.ID())
+		}
+	}
+}
+
+func TestDiscoverISCSITargetsInvalidPortal(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	host := getTestHost(t, helper)
+	for name, portal := range map[string]struct {
+		address string
+		port    uint16
+	}{
+		"empty address":   {"", ovirtclient.DefaultISCSIPort},
+		"invalid address": {"not a host name", ovirtclient.DefaultISCSIPort},
+		"zero port":       {"127.0.0.1", 0},
+	} {
+		if _, err := host.DiscoverISCSITargets(portal.address, portal.port); !ovirtclient.HasErrorCode(
+			err,
+			ovirtclient.EBadArgument,
+		) {
+			t.Fatalf("Discovering iSCSI targets with %s did not result in an EBadArgument error (%v)", name, err)
+		}
+	}
+}
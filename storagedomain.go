@@ -16,11 +16,36 @@ type StorageDomainClient interface {
 	ListStorageDomains(retries ...RetryStrategy) (StorageDomainList, error)
 	// GetStorageDomain returns a single storage domain, or an error if the storage domain could not be found.
 	GetStorageDomain(id StorageDomainID, retries ...RetryStrategy) (StorageDomain, error)
+	// StorageDomainExists returns true if a storage domain with the specified ID exists. Errors other than the
+	// storage domain not being found are returned.
+	StorageDomainExists(id StorageDomainID, retries ...RetryStrategy) (bool, error)
+	// ResolveStorageDomainID returns the ID of the storage domain identified by nameOrID, trying it as an ID first and
+	// as a name second. ENotFound is returned if neither matches, EConflict if the name is ambiguous.
+	ResolveStorageDomainID(nameOrID string, retries ...RetryStrategy) (StorageDomainID, error)
 	// GetDiskFromStorageDomain returns a single disk from a specific storage domain, or an error if no disk can be found.
 	GetDiskFromStorageDomain(id StorageDomainID, diskID DiskID, retries ...RetryStrategy) (result Disk, err error)
 	// RemoveDiskFromStorageDomain removes a disk from a specific storage domain, but leaves the disk on other storage
 	// domains if any. If the disk is not present on any more storage domains, the entire disk will be removed.
 	RemoveDiskFromStorageDomain(id StorageDomainID, diskID DiskID, retries ...RetryStrategy) error
+
+	// ListUnregisteredDisks lists the disks present on the storage domain that are not registered with the engine,
+	// for example after a storage domain was imported.
+	ListUnregisteredDisks(id StorageDomainID, retries ...RetryStrategy) ([]Disk, error)
+	// ListUnregisteredVMs lists the VMs whose configuration is present on the storage domain but which are not
+	// registered with the engine.
+	ListUnregisteredVMs(id StorageDomainID, retries ...RetryStrategy) ([]VM, error)
+	// RegisterVM registers an unregistered VM from the storage domain in the specified cluster and waits until the
+	// registration is complete.
+	RegisterVM(id StorageDomainID, vmID VMID, clusterID ClusterID, retries ...RetryStrategy) (VM, error)
+	// RegisterDisk registers an unregistered disk from the storage domain as a floating disk and waits until it is
+	// ready.
+	RegisterDisk(id StorageDomainID, diskID DiskID, retries ...RetryStrategy) (Disk, error)
+
+	// CreateStorageDomain creates a storage domain on NFS, iSCSI or FCP storage and waits until it is unattached. If
+	// the parameters contain a datacenter, the storage domain is attached to it and the call waits until it is
+	// active. The parameters can be created with NFSStorageDomainParams, ISCSIStorageDomainParams or
+	// FCPStorageDomainParams.
+	CreateStorageDomain(params StorageDomainParameters, retries ...RetryStrategy) (StorageDomain, error)
 }
 
 // StorageDomainData is the core of StorageDomain, providing only data access functions.
@@ -33,6 +58,8 @@ type StorageDomainData interface {
 	Available() uint64
 	// StorageType returns the type of the storage domain
 	StorageType() StorageDomainType
+	// Function returns what the storage domain is used for, for example holding VM disks or ISO images.
+	Function() StorageDomainFunction
 	// Status returns the status of the storage domain. This status may be unknown if the storage domain is external.
 	// Check ExternalStatus as well.
 	Status() StorageDomainStatus
@@ -99,6 +126,17 @@ func FileStorageDomainTypeValues() FileStorageDomainTypeList {
 	}
 }
 
+// BlockStorageDomainTypeList is a list of possible StorageDomainTypes which are considered block storage.
+type BlockStorageDomainTypeList []StorageDomainType
+
+// BlockStorageDomainTypeValues returns all the StorageDomainTypes values which are considered block storage.
+func BlockStorageDomainTypeValues() BlockStorageDomainTypeList {
+	return []StorageDomainType{
+		StorageDomainTypeFCP,
+		StorageDomainTypeISCSI,
+	}
+}
+
 // StorageDomainTypeValues returns all possible StorageDomainTypeValues values.
 func StorageDomainTypeValues() StorageDomainTypeList {
 	return []StorageDomainType{
@@ -218,6 +256,48 @@ const (
 	StorageDomainExternalStatusWarning StorageDomainExternalStatus = "warning"
 )
 
+// StorageDomainFunction describes what a storage domain is used for.
+type StorageDomainFunction string
+
+const (
+	// StorageDomainFunctionData is a storage domain holding the disks of VMs and templates.
+	StorageDomainFunctionData StorageDomainFunction = "data"
+	// StorageDomainFunctionExport is a legacy export storage domain for moving VMs and templates between datacenters.
+	StorageDomainFunctionExport StorageDomainFunction = "export"
+	// StorageDomainFunctionImage is an image provider, such as Glance.
+	StorageDomainFunctionImage StorageDomainFunction = "image"
+	// StorageDomainFunctionISO is a legacy storage domain holding ISO images.
+	StorageDomainFunctionISO StorageDomainFunction = "iso"
+	// StorageDomainFunctionManagedBlockStorage is a storage domain backed by a managed block storage driver.
+	StorageDomainFunctionManagedBlockStorage StorageDomainFunction = "managed_block_storage"
+	// StorageDomainFunctionVolume is a volume storage domain.
+	StorageDomainFunctionVolume StorageDomainFunction = "volume"
+)
+
+// StorageDomainFunctionList is a list of StorageDomainFunction.
+type StorageDomainFunctionList []StorageDomainFunction
+
+// StorageDomainFunctionValues returns all possible StorageDomainFunction values.
+func StorageDomainFunctionValues() StorageDomainFunctionList {
+	return []StorageDomainFunction{
+		StorageDomainFunctionData,
+		StorageDomainFunctionExport,
+		StorageDomainFunctionImage,
+		StorageDomainFunctionISO,
+		StorageDomainFunctionManagedBlockStorage,
+		StorageDomainFunctionVolume,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l StorageDomainFunctionList) Strings() []string {
+	result := make([]string, len(l))
+	for i, function := range l {
+		result[i] = string(function)
+	}
+	return result
+}
+
 // StorageDomainExternalStatusList is a list of StorageDomainStatus.
 type StorageDomainExternalStatusList []StorageDomainExternalStatus
 
@@ -274,6 +354,7 @@ func convertSDKStorageDomain(sdkStorageDomain *ovirtsdk4.StorageDomain, client C
 	if status == "" && externalStatus == "" {
 		return nil, newError(EFieldMissing, "neither the status nor the external status is set for storage domain %s", id)
 	}
+	function, _ := sdkStorageDomain.Type()
 
 	return &storageDomain{
 		client: client,
@@ -282,6 +363,7 @@ func convertSDKStorageDomain(sdkStorageDomain *ovirtsdk4.StorageDomain, client C
 		name:           name,
 		available:      uint64(available),
 		storageType:    StorageDomainType(storageType),
+		function:       StorageDomainFunction(function),
 		status:         StorageDomainStatus(status),
 		externalStatus: StorageDomainExternalStatus(externalStatus),
 	}, nil
@@ -294,14 +376,24 @@ type storageDomain struct {
 	name           string
 	available      uint64
 	storageType    StorageDomainType
+	function       StorageDomainFunction
 	status         StorageDomainStatus
 	externalStatus StorageDomainExternalStatus
+
+	// unregisteredVMs and unregisteredDisks hold the objects present on the storage domain that are not registered
+	// with the engine. They are only used by the mock.
+	unregisteredVMs   map[VMID]*vm
+	unregisteredDisks map[DiskID]*diskWithData
 }
 
 func (s storageDomain) ID() StorageDomainID {
 	return s.id
 }
 
+func (s storageDomain) Function() StorageDomainFunction {
+	return s.function
+}
+
 func (s storageDomain) Name() string {
 	return s.name
 }
@@ -0,0 +1,555 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// defaultISCSIPort is the port iSCSI targets listen on unless configured otherwise.
+const defaultISCSIPort uint16 = 3260
+
+// StorageDomainLUN is a logical unit a block storage domain is created on. The LUN must be visible to the host
+// creating the storage domain.
+type StorageDomainLUN interface {
+	// ID returns the ID of the LUN as reported by the host, usually its WWID.
+	ID() string
+	// Address returns the address of the iSCSI portal the LUN is reached through. It is empty for FCP LUNs.
+	Address() string
+	// Port returns the port of the iSCSI portal. It is 0 for FCP LUNs.
+	Port() uint16
+	// Target returns the iSCSI qualified name (IQN) of the target exporting the LUN. It is empty for FCP LUNs.
+	Target() string
+}
+
+// NewISCSILUN creates a StorageDomainLUN for a LUN exported by an iSCSI target. If port is 0, the default iSCSI port
+// 3260 is used.
+func NewISCSILUN(id string, address string, port uint16, target string) StorageDomainLUN {
+	if port == 0 {
+		port = defaultISCSIPort
+	}
+	return &storageDomainLUN{
+		id:      id,
+		address: address,
+		port:    port,
+		target:  target,
+	}
+}
+
+// NewFCPLUN creates a StorageDomainLUN for a LUN reached via Fibre Channel.
+func NewFCPLUN(id string) StorageDomainLUN {
+	return &storageDomainLUN{
+		id: id,
+	}
+}
+
+type storageDomainLUN struct {
+	id      string
+	address string
+	port    uint16
+	target  string
+}
+
+func (s storageDomainLUN) ID() string {
+	return s.id
+}
+
+func (s storageDomainLUN) Address() string {
+	return s.address
+}
+
+func (s storageDomainLUN) Port() uint16 {
+	return s.port
+}
+
+func (s storageDomainLUN) Target() string {
+	return s.target
+}
+
+// StorageDomainParameters contains the parameters for CreateStorageDomain. Which of the storage parameters are
+// required depends on the storage type: NFS needs an address and a path, iSCSI and FCP need at least one LUN.
+type StorageDomainParameters interface {
+	// Name returns the name of the new storage domain.
+	Name() string
+	// StorageType returns the storage backend of the new storage domain. StorageDomainTypeNFS,
+	// StorageDomainTypeISCSI and StorageDomainTypeFCP are supported.
+	StorageType() StorageDomainType
+	// HostID returns the host that connects to the storage and initializes the storage domain.
+	HostID() HostID
+	// Function returns what the storage domain is used for. It defaults to StorageDomainFunctionData.
+	Function() StorageDomainFunction
+	// DatacenterID returns the datacenter the storage domain is attached to after it was created. If it is nil, the
+	// storage domain is left unattached.
+	DatacenterID() *DatacenterID
+	// Address returns the host name or IP address of the NFS server.
+	Address() string
+	// Path returns the exported path on the NFS server.
+	Path() string
+	// LUNs returns the LUNs of an iSCSI or FCP storage domain.
+	LUNs() []StorageDomainLUN
+}
+
+// BuildableStorageDomainParameters is a buildable version of StorageDomainParameters.
+type BuildableStorageDomainParameters interface {
+	StorageDomainParameters
+
+	// WithFunction sets what the storage domain is used for.
+	WithFunction(function StorageDomainFunction) (BuildableStorageDomainParameters, error)
+	// MustWithFunction is identical to WithFunction, but panics instead of returning an error.
+	MustWithFunction(function StorageDomainFunction) BuildableStorageDomainParameters
+
+	// WithDatacenterID sets the datacenter the storage domain is attached to.
+	WithDatacenterID(id DatacenterID) (BuildableStorageDomainParameters, error)
+	// MustWithDatacenterID is identical to WithDatacenterID, but panics instead of returning an error.
+	MustWithDatacenterID(id DatacenterID) BuildableStorageDomainParameters
+}
+
+// NFSStorageDomainParams creates the parameters for a storage domain on the NFS export path of the server at address.
+func NFSStorageDomainParams(name string, hostID HostID, address string, path string) BuildableStorageDomainParameters {
+	return &storageDomainParams{
+		name:        name,
+		storageType: StorageDomainTypeNFS,
+		hostID:      hostID,
+		function:    StorageDomainFunctionData,
+		address:     address,
+		path:        path,
+	}
+}
+
+// ISCSIStorageDomainParams creates the parameters for a storage domain on iSCSI LUNs. The LUNs can be created with
+// NewISCSILUN.
+func ISCSIStorageDomainParams(name string, hostID HostID, luns ...StorageDomainLUN) BuildableStorageDomainParameters {
+	return &storageDomainParams{
+		name:        name,
+		storageType: StorageDomainTypeISCSI,
+		hostID:      hostID,
+		function:    StorageDomainFunctionData,
+		luns:        luns,
+	}
+}
+
+// FCPStorageDomainParams creates the parameters for a storage domain on Fibre Channel LUNs. The LUNs can be created
+// with NewFCPLUN.
+func FCPStorageDomainParams(name string, hostID HostID, luns ...StorageDomainLUN) BuildableStorageDomainParameters {
+	return &storageDomainParams{
+		name:        name,
+		storageType: StorageDomainTypeFCP,
+		hostID:      hostID,
+		function:    StorageDomainFunctionData,
+		luns:        luns,
+	}
+}
+
+type storageDomainParams struct {
+	name         string
+	storageType  StorageDomainType
+	hostID       HostID
+	function     StorageDomainFunction
+	datacenterID *DatacenterID
+	address      string
+	path         string
+	luns         []StorageDomainLUN
+}
+
+func (s *storageDomainParams) Name() string {
+	return s.name
+}
+
+func (s *storageDomainParams) StorageType() StorageDomainType {
+	return s.storageType
+}
+
+func (s *storageDomainParams) HostID() HostID {
+	return s.hostID
+}
+
+func (s *storageDomainParams) Function() StorageDomainFunction {
+	return s.function
+}
+
+func (s *storageDomainParams) WithFunction(function StorageDomainFunction) (BuildableStorageDomainParameters, error) {
+	if err := validateStorageDomainFunction(function); err != nil {
+		return s, err
+	}
+	s.function = function
+	return s, nil
+}
+
+func (s *storageDomainParams) MustWithFunction(function StorageDomainFunction) BuildableStorageDomainParameters {
+	builder, err := s.WithFunction(function)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *storageDomainParams) DatacenterID() *DatacenterID {
+	return s.datacenterID
+}
+
+func (s *storageDomainParams) WithDatacenterID(id DatacenterID) (BuildableStorageDomainParameters, error) {
+	if id == "" {
+		return s, newError(EBadArgument, "the datacenter ID cannot be empty")
+	}
+	s.datacenterID = &id
+	return s, nil
+}
+
+func (s *storageDomainParams) MustWithDatacenterID(id DatacenterID) BuildableStorageDomainParameters {
+	builder, err := s.WithDatacenterID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *storageDomainParams) Address() string {
+	return s.address
+}
+
+func (s *storageDomainParams) Path() string {
+	return s.path
+}
+
+func (s *storageDomainParams) LUNs() []StorageDomainLUN {
+	return s.luns
+}
+
+// validateStorageDomainFunction returns an EBadArgument error for the functions that can't be created as a storage
+// domain on NFS, iSCSI or FCP.
+func validateStorageDomainFunction(function StorageDomainFunction) error {
+	switch function {
+	case StorageDomainFunctionData, StorageDomainFunctionExport, StorageDomainFunctionISO:
+		return nil
+	default:
+		return newError(
+			EBadArgument,
+			"invalid storage domain function: %s must be one of: %s, %s, %s",
+			function,
+			StorageDomainFunctionData,
+			StorageDomainFunctionExport,
+			StorageDomainFunctionISO,
+		)
+	}
+}
+
+// validateStorageDomainParameters checks that the fields required by the storage type of params are set.
+func validateStorageDomainParameters(params StorageDomainParameters) error {
+	if params.Name() == "" {
+		return newError(EBadArgument, "the storage domain name cannot be empty")
+	}
+	if params.HostID() == "" {
+		return newError(EBadArgument, "a host is required to create storage domain %s", params.Name())
+	}
+	if err := validateStorageDomainFunction(params.Function()); err != nil {
+		return err
+	}
+	if id := params.DatacenterID(); id != nil && *id == "" {
+		return newError(EBadArgument, "the datacenter ID cannot be empty")
+	}
+	switch params.StorageType() {
+	case StorageDomainTypeNFS:
+		if params.Address() == "" || params.Path() == "" {
+			return newError(EBadArgument, "NFS storage domain %s requires an address and a path", params.Name())
+		}
+		if len(params.LUNs()) > 0 {
+			return newError(EBadArgument, "NFS storage domain %s cannot have LUNs", params.Name())
+		}
+	case StorageDomainTypeISCSI, StorageDomainTypeFCP:
+		if params.Function() != StorageDomainFunctionData {
+			return newError(
+				EBadArgument,
+				"%s storage domain %s can only be a %s storage domain",
+				params.StorageType(),
+				params.Name(),
+				StorageDomainFunctionData,
+			)
+		}
+		if len(params.LUNs()) == 0 {
+			return newError(
+				EBadArgument,
+				"%s storage domain %s requires at least one LUN",
+				params.StorageType(),
+				params.Name(),
+			)
+		}
+		for _, lun := range params.LUNs() {
+			if err := validateStorageDomainLUN(params.StorageType(), lun); err != nil {
+				return err
+			}
+		}
+	default:
+		return newError(
+			EBadArgument,
+			"unsupported storage type for storage domain creation: %s must be one of: %s, %s, %s",
+			params.StorageType(),
+			StorageDomainTypeNFS,
+			StorageDomainTypeISCSI,
+			StorageDomainTypeFCP,
+		)
+	}
+	return nil
+}
+
+func validateStorageDomainLUN(storageType StorageDomainType, lun StorageDomainLUN) error {
+	if lun.ID() == "" {
+		return newError(EBadArgument, "the LUN ID cannot be empty")
+	}
+	if storageType == StorageDomainTypeISCSI && (lun.Address() == "" || lun.Target() == "") {
+		return newError(EBadArgument, "iSCSI LUN %s requires the address and target of its portal", lun.ID())
+	}
+	return nil
+}
+
+func buildSDKStorageDomain(params StorageDomainParameters) *ovirtsdk.StorageDomain {
+	storageBuilder := ovirtsdk.NewHostStorageBuilder().Type(ovirtsdk.StorageType(params.StorageType()))
+	if params.StorageType() == StorageDomainTypeNFS {
+		storageBuilder.Address(params.Address()).Path(params.Path())
+	} else {
+		luns := make([]ovirtsdk.LogicalUnitBuilder, len(params.LUNs()))
+		for i, lun := range params.LUNs() {
+			lunBuilder := ovirtsdk.NewLogicalUnitBuilder().Id(lun.ID())
+			if params.StorageType() == StorageDomainTypeISCSI {
+				lunBuilder.Address(lun.Address()).Port(int64(lun.Port())).Target(lun.Target())
+			}
+			luns[i] = *lunBuilder
+		}
+		storageBuilder.LogicalUnitsBuilderOfAny(luns...)
+	}
+	return ovirtsdk.NewStorageDomainBuilder().
+		Name(params.Name()).
+		Type(ovirtsdk.StorageDomainType(params.Function())).
+		HostBuilder(ovirtsdk.NewHostBuilder().Id(string(params.HostID()))).
+		StorageBuilder(storageBuilder).
+		MustBuild()
+}
+
+func (o *oVirtClient) CreateStorageDomain(
+	params StorageDomainParameters,
+	retries ...RetryStrategy,
+) (result StorageDomain, err error) {
+	if err := validateStorageDomainParameters(params); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	action := fmt.Sprintf("creating storage domain %s", params.Name())
+	sdkStorageDomain := buildSDKStorageDomain(params)
+	var id StorageDomainID
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().StorageDomainsService().Add().StorageDomain(sdkStorageDomain).Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			created, ok := response.StorageDomain()
+			if !ok {
+				return newError(EFieldMissing, "no storage domain returned when creating storage domain")
+			}
+			sdkID, ok := created.Id()
+			if !ok {
+				return newFieldNotFound("created storage domain", "ID")
+			}
+			id = StorageDomainID(sdkID)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := o.waitForStorageDomainStatus(id, StorageDomainStatusUnattached, retries); err != nil {
+		return nil, err
+	}
+	datacenterID := params.DatacenterID()
+	if datacenterID == nil {
+		return o.GetStorageDomain(id, retries...)
+	}
+	return o.attachStorageDomain(*datacenterID, id, retries)
+}
+
+// waitForStorageDomainStatus waits for an unattached storage domain to reach the status.
+func (o *oVirtClient) waitForStorageDomainStatus(
+	id StorageDomainID,
+	status StorageDomainStatus,
+	retries []RetryStrategy,
+) (result StorageDomain, err error) {
+	err = retry(
+		fmt.Sprintf("waiting for storage domain %s to be %s", id, status),
+		o.logger,
+		retries,
+		func() error {
+			result, err = o.GetStorageDomain(id, retries...)
+			if err != nil {
+				return err
+			}
+			if result.Status() != status {
+				return newError(
+					EPending,
+					"storage domain %s is in status %s instead of %s",
+					id,
+					result.Status(),
+					status,
+				)
+			}
+			return nil
+		})
+	return result, err
+}
+
+// attachStorageDomain attaches the storage domain to the datacenter and waits until it is active. The status of an
+// attached storage domain is only reported in the context of its datacenter.
+func (o *oVirtClient) attachStorageDomain(
+	datacenterID DatacenterID,
+	id StorageDomainID,
+	retries []RetryStrategy,
+) (result StorageDomain, err error) {
+	action := fmt.Sprintf("attaching storage domain %s to datacenter %s", id, datacenterID)
+	attachedStorageDomainsService := o.conn.
+		SystemService().
+		DataCentersService().
+		DataCenterService(string(datacenterID)).
+		StorageDomainsService()
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := attachedStorageDomainsService.
+				Add().
+				StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(string(id)).MustBuild()).
+				Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("waiting for storage domain %s to be active in datacenter %s", id, datacenterID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := attachedStorageDomainsService.StorageDomainService(string(id)).Get().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkStorageDomain, ok := response.StorageDomain()
+			if !ok {
+				return newError(ENotFound, "storage domain %s not found in datacenter %s", id, datacenterID)
+			}
+			result, err = convertSDKStorageDomain(sdkStorageDomain, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert storage domain %s", id)
+			}
+			if result.Status() != StorageDomainStatusActive {
+				return newError(
+					EPending,
+					"storage domain %s is in status %s in datacenter %s",
+					id,
+					result.Status(),
+					datacenterID,
+				)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) CreateStorageDomain(params StorageDomainParameters, _ ...RetryStrategy) (StorageDomain, error) {
+	if err := validateStorageDomainParameters(params); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[params.HostID()]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", params.HostID())
+	}
+	if id := params.DatacenterID(); id != nil {
+		if _, ok := m.dataCenters[*id]; !ok {
+			return nil, newError(ENotFound, "datacenter with ID %s not found", *id)
+		}
+	}
+	for _, existing := range m.storageDomains {
+		if existing.name == params.Name() {
+			return nil, newError(EConflict, "a storage domain with the name %s already exists", params.Name())
+		}
+	}
+	connections, err := m.mockStorageDomainConnections(params)
+	if err != nil {
+		return nil, err
+	}
+	result := &storageDomain{
+		client:         m,
+		id:             StorageDomainID(m.GenerateUUID()),
+		name:           params.Name(),
+		available:      10 * 1024 * 1024 * 1024,
+		storageType:    params.StorageType(),
+		function:       params.Function(),
+		status:         StorageDomainStatusUnattached,
+		externalStatus: StorageDomainExternalStatusNA,
+	}
+	if params.DatacenterID() != nil {
+		result.status = StorageDomainStatusActive
+	}
+	m.storageDomains[result.id] = result
+	for _, connection := range connections {
+		m.storageConnections[connection.id] = connection
+	}
+	return result, nil
+}
+
+// mockStorageDomainConnections returns the storage connections the engine creates for a new storage domain: the NFS
+// export, or the iSCSI targets of the LUNs that have no connection yet. FCP storage domains have no storage
+// connection. The caller must hold the lock.
+func (m *mockClient) mockStorageDomainConnections(params StorageDomainParameters) ([]*storageConnection, error) {
+	switch params.StorageType() {
+	case StorageDomainTypeNFS:
+		// An NFS export can only hold a single storage domain.
+		for _, existing := range m.storageConnections {
+			if existing.storageType == StorageDomainTypeNFS &&
+				existing.address == params.Address() &&
+				existing.path == params.Path() {
+				return nil, newError(
+					EConflict,
+					"the NFS export %s:%s is already in use (connection: %s)",
+					params.Address(),
+					params.Path(),
+					existing.id,
+				)
+			}
+		}
+		return []*storageConnection{
+			{
+				id:          StorageConnectionID(m.GenerateUUID()),
+				storageType: StorageDomainTypeNFS,
+				address:     params.Address(),
+				path:        params.Path(),
+			},
+		}, nil
+	case StorageDomainTypeISCSI:
+		var result []*storageConnection
+		seen := map[string]bool{}
+		for _, existing := range m.storageConnections {
+			if existing.storageType == StorageDomainTypeISCSI {
+				seen[fmt.Sprintf("%s:%d/%s", existing.address, existing.port, existing.target)] = true
+			}
+		}
+		for _, lun := range params.LUNs() {
+			key := fmt.Sprintf("%s:%d/%s", lun.Address(), lun.Port(), lun.Target())
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			result = append(result, &storageConnection{
+				id:          StorageConnectionID(m.GenerateUUID()),
+				storageType: StorageDomainTypeISCSI,
+				address:     lun.Address(),
+				port:        lun.Port(),
+				target:      lun.Target(),
+			})
+		}
+		return result, nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,97 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestCreateStorageDomain(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	var hostID HostID
+	for id := range m.hosts {
+		hostID = id
+		break
+	}
+	var datacenterID DatacenterID
+	for id := range m.dataCenters {
+		datacenterID = id
+		break
+	}
+
+	unattached, err := m.CreateStorageDomain(NFSStorageDomainParams("nfs-1", hostID, "nfs.example.com", "/exports/1"))
+	if err != nil {
+		t.Fatalf("Failed to create NFS storage domain (%v)", err)
+	}
+	if unattached.Status() != StorageDomainStatusUnattached || unattached.StorageType() != StorageDomainTypeNFS {
+		t.Fatalf(
+			"Incorrect storage domain created (status: %s, type: %s)",
+			unattached.Status(),
+			unattached.StorageType(),
+		)
+	}
+	if _, err := m.CreateStorageDomain(
+		NFSStorageDomainParams("nfs-2", hostID, "nfs.example.com", "/exports/1"),
+	); !HasErrorCode(err, EConflict) {
+		t.Fatalf("Reusing an NFS export did not result in an EConflict error (%v)", err)
+	}
+
+	luns := []StorageDomainLUN{
+		NewISCSILUN("36001405a1", "iscsi.example.com", 0, "iqn.2026-10.com.example:target"),
+		NewISCSILUN("36001405a2", "iscsi.example.com", 0, "iqn.2026-10.com.example:target"),
+	}
+	attached, err := m.CreateStorageDomain(
+		ISCSIStorageDomainParams("iscsi-1", hostID, luns...).MustWithDatacenterID(datacenterID),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create iSCSI storage domain (%v)", err)
+	}
+	if attached.Status() != StorageDomainStatusActive {
+		t.Fatalf("The attached storage domain is not active (status: %s)", attached.Status())
+	}
+	connections, err := m.ListStorageConnections()
+	if err != nil {
+		t.Fatalf("Failed to list storage connections (%v)", err)
+	}
+	iscsiConnections := 0
+	for _, connection := range connections {
+		if connection.Type() == StorageDomainTypeISCSI {
+			iscsiConnections++
+		}
+	}
+	if iscsiConnections != 1 {
+		t.Fatalf("Incorrect number of iSCSI connections for two LUNs on one target (%d)", iscsiConnections)
+	}
+
+	if _, err := m.CreateStorageDomain(
+		NFSStorageDomainParams("nfs-3", "nonexistent", "nfs.example.com", "/exports/3"),
+	); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Creating a storage domain with a nonexistent host did not result in an ENotFound error (%v)", err)
+	}
+}
+
+func TestStorageDomainParametersValidation(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]StorageDomainParameters{
+		"NFS without path":   NFSStorageDomainParams("nfs", "host", "nfs.example.com", ""),
+		"iSCSI without LUNs": ISCSIStorageDomainParams("iscsi", "host"),
+		"iSCSI LUN without target": ISCSIStorageDomainParams(
+			"iscsi",
+			"host",
+			NewISCSILUN("36001405a1", "iscsi.example.com", 0, ""),
+		),
+		"FCP ISO domain": FCPStorageDomainParams("fcp", "host", NewFCPLUN("36001405a1")).
+			MustWithFunction(StorageDomainFunctionISO),
+		"missing host": NFSStorageDomainParams("nfs", "", "nfs.example.com", "/exports"),
+		"missing name": FCPStorageDomainParams("", "host", NewFCPLUN("36001405a1")),
+	}
+	for name, params := range testCases {
+		if err := validateStorageDomainParameters(params); !HasErrorCode(err, EBadArgument) {
+			t.Fatalf("Validating parameters with %s did not result in an EBadArgument error (%v)", name, err)
+		}
+	}
+	if err := validateStorageDomainParameters(
+		FCPStorageDomainParams("fcp", "host", NewFCPLUN("36001405a1")),
+	); err != nil {
+		t.Fatalf("Valid FCP parameters were rejected (%v)", err)
+	}
+}
@@ -15,7 +15,7 @@ func (o *oVirtClient) GetStorageDomain(id StorageDomainID, retries ...RetryStrat
 		func() error {
 			response, err := o.conn.SystemService().StorageDomainsService().StorageDomainService(string(id)).Get().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting storage domain %s", id), err)
 			}
 			sdkObject, ok := response.StorageDomain()
 			if !ok {
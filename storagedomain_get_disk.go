@@ -14,7 +14,7 @@ func (o *oVirtClient) GetDiskFromStorageDomain(id StorageDomainID, diskID DiskID
 			response, err := o.conn.SystemService().StorageDomainsService().
 				StorageDomainService(string(id)).DisksService().DiskService(string(diskID)).Get().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting disk %s from storage domain %s", diskID, id), err)
 			}
 			sdkObject, ok := response.Disk()
 			if !ok {
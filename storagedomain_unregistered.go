@@ -0,0 +1,226 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ListUnregisteredDisks(
+	storageDomainID StorageDomainID,
+	retries ...RetryStrategy,
+) (result []Disk, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("listing unregistered disks on storage domain %s", storageDomainID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				StorageDomainsService().
+				StorageDomainService(string(storageDomainID)).
+				DisksService().
+				List().
+				Unregistered(true).
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			result = []Disk{}
+			sdkDisks, ok := response.Disks()
+			if !ok {
+				return nil
+			}
+			for i, sdkDisk := range sdkDisks.Slice() {
+				disk, err := convertSDKDisk(sdkDisk, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert unregistered disk #%d", i)
+				}
+				result = append(result, disk)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) ListUnregisteredVMs(
+	storageDomainID StorageDomainID,
+	retries ...RetryStrategy,
+) (result []VM, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("listing unregistered VMs on storage domain %s", storageDomainID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				StorageDomainsService().
+				StorageDomainService(string(storageDomainID)).
+				VmsService().
+				List().
+				Unregistered(true).
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			result = []VM{}
+			sdkVMs, ok := response.Vm()
+			if !ok {
+				return nil
+			}
+			for i, sdkVM := range sdkVMs.Slice() {
+				vm, err := convertSDKVM(sdkVM, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert unregistered VM #%d", i)
+				}
+				result = append(result, vm)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) RegisterVM(
+	storageDomainID StorageDomainID,
+	vmID VMID,
+	clusterID ClusterID,
+	retries ...RetryStrategy,
+) (VM, error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	correlationID := fmt.Sprintf("vm_register_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf("registering VM %s from storage domain %s in cluster %s", vmID, storageDomainID, clusterID)
+	err := retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				StorageDomainsService().
+				StorageDomainService(string(storageDomainID)).
+				VmsService().
+				VmService(string(vmID)).
+				Register().
+				Cluster(ovirtsdk.NewClusterBuilder().Id(string(clusterID)).MustBuild()).
+				Query("correlation_id", correlationID).
+				Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return nil, err
+	}
+	if err := o.waitForJobFinished(correlationID, retries); err != nil {
+		return nil, err
+	}
+	// The VM is image locked while its disks are registered.
+	return o.WaitForVMStatus(vmID, VMStatusDown, retries...)
+}
+
+func (o *oVirtClient) RegisterDisk(
+	storageDomainID StorageDomainID,
+	diskID DiskID,
+	retries ...RetryStrategy,
+) (Disk, error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	action := fmt.Sprintf("registering disk %s from storage domain %s", diskID, storageDomainID)
+	err := retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				StorageDomainsService().
+				StorageDomainService(string(storageDomainID)).
+				DisksService().
+				Add().
+				Unregistered(true).
+				Disk(ovirtsdk.NewDiskBuilder().Id(string(diskID)).MustBuild()).
+				Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return o.WaitForDiskOK(diskID, retries...)
+}
+
+func (m *mockClient) ListUnregisteredDisks(storageDomainID StorageDomainID, _ ...RetryStrategy) ([]Disk, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	storageDomain, ok := m.storageDomains[storageDomainID]
+	if !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+	}
+	result := make([]Disk, 0, len(storageDomain.unregisteredDisks))
+	for _, disk := range storageDomain.unregisteredDisks {
+		result = append(result, disk)
+	}
+	return result, nil
+}
+
+func (m *mockClient) ListUnregisteredVMs(storageDomainID StorageDomainID, _ ...RetryStrategy) ([]VM, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	storageDomain, ok := m.storageDomains[storageDomainID]
+	if !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+	}
+	result := make([]VM, 0, len(storageDomain.unregisteredVMs))
+	for _, vm := range storageDomain.unregisteredVMs {
+		result = append(result, vm.snapshot())
+	}
+	return result, nil
+}
+
+func (m *mockClient) RegisterVM(
+	storageDomainID StorageDomainID,
+	vmID VMID,
+	clusterID ClusterID,
+	_ ...RetryStrategy,
+) (VM, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	storageDomain, ok := m.storageDomains[storageDomainID]
+	if !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+	}
+	if _, ok := m.clusters[clusterID]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	vm, ok := storageDomain.unregisteredVMs[vmID]
+	if !ok {
+		return nil, newError(ENotFound, "no unregistered VM with ID %s on storage domain %s", vmID, storageDomainID)
+	}
+	if _, ok := m.vms[vmID]; ok {
+		return nil, newError(EConflict, "VM with ID %s is already registered", vmID)
+	}
+	vm.clusterID = clusterID
+	vm.status = VMStatusDown
+	m.vms[vmID] = vm
+	delete(storageDomain.unregisteredVMs, vmID)
+	return vm.snapshot(), nil
+}
+
+func (m *mockClient) RegisterDisk(storageDomainID StorageDomainID, diskID DiskID, _ ...RetryStrategy) (Disk, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	storageDomain, ok := m.storageDomains[storageDomainID]
+	if !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+	}
+	disk, ok := storageDomain.unregisteredDisks[diskID]
+	if !ok {
+		return nil, newError(ENotFound, "no unregistered disk with ID %s on storage domain %s", diskID, storageDomainID)
+	}
+	if _, ok := m.disks[diskID]; ok {
+		return nil, newError(EConflict, "disk with ID %s is already registered", diskID)
+	}
+	m.disks[diskID] = disk
+	delete(storageDomain.unregisteredDisks, diskID)
+	return disk, nil
+}
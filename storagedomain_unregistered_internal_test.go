@@ -0,0 +1,70 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestRegisterUnregisteredVMAndDisk(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+
+	var clusterID ClusterID
+	for id := range m.clusters {
+		clusterID = id
+		break
+	}
+	var storageDomain *storageDomain
+	for _, sd := range m.storageDomains {
+		storageDomain = sd
+		break
+	}
+	createdVM, err := m.CreateVM(clusterID, DefaultBlankTemplateID, "unregistered", nil)
+	if err != nil {
+		t.Fatalf("Failed to create VM (%v)", err)
+	}
+	createdDisk, err := m.CreateDisk(storageDomain.ID(), ImageFormatRaw, 1024*1024, nil)
+	if err != nil {
+		t.Fatalf("Failed to create disk (%v)", err)
+	}
+
+	// Simulate a storage domain that was imported with objects the engine doesn't know about yet.
+	storageDomain.unregisteredVMs = map[VMID]*vm{createdVM.ID(): m.vms[createdVM.ID()]}
+	storageDomain.unregisteredDisks = map[DiskID]*diskWithData{createdDisk.ID(): m.disks[createdDisk.ID()]}
+	delete(m.vms, createdVM.ID())
+	delete(m.disks, createdDisk.ID())
+
+	vms, err := m.ListUnregisteredVMs(storageDomain.ID())
+	if err != nil {
+		t.Fatalf("Failed to list unregistered VMs (%v)", err)
+	}
+	if len(vms) != 1 || vms[0].ID() != createdVM.ID() {
+		t.Fatalf("Incorrect unregistered VMs returned (%d VMs)", len(vms))
+	}
+	disks, err := m.ListUnregisteredDisks(storageDomain.ID())
+	if err != nil {
+		t.Fatalf("Failed to list unregistered disks (%v)", err)
+	}
+	if len(disks) != 1 || disks[0].ID() != createdDisk.ID() {
+		t.Fatalf("Incorrect unregistered disks returned (%d disks)", len(disks))
+	}
+
+	if _, err := m.RegisterVM(storageDomain.ID(), createdVM.ID(), "nonexistent"); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Registering a VM in a nonexistent cluster did not result in an ENotFound error (%v)", err)
+	}
+	registeredVM, err := m.RegisterVM(storageDomain.ID(), createdVM.ID(), clusterID)
+	if err != nil {
+		t.Fatalf("Failed to register VM %s (%v)", createdVM.ID(), err)
+	}
+	if registeredVM.ClusterID() != clusterID {
+		t.Fatalf("Incorrect cluster on registered VM (expected: %s, got: %s)", clusterID, registeredVM.ClusterID())
+	}
+	if _, err := m.GetVM(createdVM.ID()); err != nil {
+		t.Fatalf("Failed to get registered VM (%v)", err)
+	}
+	if _, err := m.RegisterDisk(storageDomain.ID(), createdDisk.ID()); err != nil {
+		t.Fatalf("Failed to register disk %s (%v)", createdDisk.ID(), err)
+	}
+	if _, err := m.RegisterDisk(storageDomain.ID(), createdDisk.ID()); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Registering a disk twice did not result in an ENotFound error (%v)", err)
+	}
+}
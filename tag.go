@@ -13,12 +13,24 @@ type TagID string
 type TagClient interface {
 	// GetTag returns a single tag based on its ID.
 	GetTag(id TagID, retries ...RetryStrategy) (Tag, error)
+	// TagExists returns true if a tag with the specified ID exists. Errors other than the tag not being found are
+	// returned.
+	TagExists(id TagID, retries ...RetryStrategy) (bool, error)
 	// ListTags returns all tags on the oVirt engine.
 	ListTags(retries ...RetryStrategy) ([]Tag, error)
 	// CreateTag creates a new tag with a name.
 	CreateTag(name string, params CreateTagParams, retries ...RetryStrategy) (result Tag, err error)
 	// RemoveTag removes the tag with the specified ID.
 	RemoveTag(tagID TagID, retries ...RetryStrategy) error
+	// EnsureTag returns the tag with the specified name, creating it if it doesn't exist yet. If another caller creates
+	// the tag at the same time, the tag created by the other caller is returned. The params are only used when
+	// creating the tag, an existing tag is not changed to match them.
+	EnsureTag(name string, params CreateTagParams, retries ...RetryStrategy) (Tag, error)
+	// ListTaggedResources returns the VMs, templates and hosts carrying the tag with the specified name, for example to
+	// remove everything tagged for an environment. The resource types are listed concurrently. If listing some of
+	// them fails, the others are still returned together with a MultiError keyed by TaggedResourceType. Disks can't
+	// be tagged in oVirt, so they are not included. An ENotFound error is returned if the tag doesn't exist.
+	ListTaggedResources(tagName string, retries ...RetryStrategy) (TaggedResources, error)
 }
 
 // TagData is the core of Tag, providing only the data access functions, but not the client
@@ -6,6 +6,9 @@ import (
 
 func (o *oVirtClient) CreateTag(name string, params CreateTagParams, retries ...RetryStrategy) (result Tag, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	if err := validateTagName(name); err != nil {
+		return nil, err
+	}
 	if params == nil {
 		params = NewCreateTagParams()
 	}
@@ -21,7 +24,7 @@ func (o *oVirtClient) CreateTag(name string, params CreateTagParams, retries ...
 			}
 			response, e := o.conn.SystemService().TagsService().Add().Tag(tagBuilder.MustBuild()).Send()
 			if e != nil {
-				return e
+				return wrapSDKError("creating tag", e)
 			}
 
 			tag, ok := response.Tag()
@@ -43,8 +46,16 @@ func (o *oVirtClient) CreateTag(name string, params CreateTagParams, retries ...
 }
 
 func (m *mockClient) CreateTag(name string, params CreateTagParams, _ ...RetryStrategy) (result Tag, err error) {
+	if err := validateTagName(name); err != nil {
+		return nil, err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
+	for _, existingTag := range m.tags {
+		if existingTag.name == name {
+			return nil, newError(EConflict, "a tag with the name %s already exists", name)
+		}
+	}
 	id := TagID(m.GenerateUUID())
 	if params == nil {
 		params = NewCreateTagParams()
@@ -60,3 +71,10 @@ func (m *mockClient) CreateTag(name string, params CreateTagParams, _ ...RetrySt
 	result = tag
 	return
 }
+
+func validateTagName(name string) error {
+	if err := ValidateResourceName(name); err != nil {
+		return wrap(err, EBadArgument, "invalid tag name")
+	}
+	return nil
+}
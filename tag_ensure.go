@@ -0,0 +1,58 @@
+package ovirtclient
+
+func (o *oVirtClient) EnsureTag(name string, params CreateTagParams, retries ...RetryStrategy) (Tag, error) {
+	return ensureTag(o, name, params, retries)
+}
+
+func (m *mockClient) EnsureTag(name string, params CreateTagParams, retries ...RetryStrategy) (Tag, error) {
+	return ensureTag(m, name, params, retries)
+}
+
+func ensureTag(client Client, name string, params CreateTagParams, retries []RetryStrategy) (Tag, error) {
+	if err := validateTagName(name); err != nil {
+		return nil, err
+	}
+	existingTag, err := findTagByName(client, name, retries)
+	if err != nil || existingTag != nil {
+		return existingTag, err
+	}
+	result, err := client.CreateTag(name, params, abortOnConflict(retries)...)
+	if err == nil {
+		return result, nil
+	}
+	if !HasErrorCode(err, EConflict) {
+		return nil, err
+	}
+	// Someone else created the tag between the lookup and the creation, so we return theirs.
+	existingTag, lookupErr := findTagByName(client, name, retries)
+	if lookupErr != nil {
+		return nil, lookupErr
+	}
+	if existingTag == nil {
+		return nil, wrap(err, EConflict, "failed to create tag %s, but no tag with this name exists", name)
+	}
+	return existingTag, nil
+}
+
+// abortOnConflict adds a retry policy that gives up on EConflict errors, which are otherwise retried. When creating
+// an object by name, a conflict means that the object already exists, so retrying it is pointless.
+func abortOnConflict(retries []RetryStrategy) []RetryStrategy {
+	result := make([]RetryStrategy, len(retries), len(retries)+1)
+	copy(result, retries)
+	return append(result, CustomRetryPolicy(func(err error, attempt int) bool {
+		return !HasErrorCode(err, EConflict) && DefaultRetryPolicy(err, attempt)
+	}))
+}
+
+func findTagByName(client Client, name string, retries []RetryStrategy) (Tag, error) {
+	tags, err := client.ListTags(retries...)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tags {
+		if t.Name() == name {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
@@ -7,6 +7,9 @@ import (
 )
 
 func (o *oVirtClient) GetTag(id TagID, retries ...RetryStrategy) (result Tag, err error) {
+	if err := validateNotBlankUUID("tag", string(id)); err != nil {
+		return nil, err
+	}
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting tag %s", id),
@@ -15,7 +18,7 @@ func (o *oVirtClient) GetTag(id TagID, retries ...RetryStrategy) (result Tag, er
 		func() error {
 			response, err := o.conn.SystemService().TagsService().TagService(string(id)).Get().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting tag %s", id), err)
 			}
 			sdkObject, ok := response.Tag()
 			if !ok {
@@ -40,6 +43,9 @@ func (o *oVirtClient) GetTag(id TagID, retries ...RetryStrategy) (result Tag, er
 }
 
 func (m *mockClient) GetTag(id TagID, _ ...RetryStrategy) (Tag, error) {
+	if err := validateNotBlankUUID("tag", string(id)); err != nil {
+		return nil, err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.tags[id]; ok {
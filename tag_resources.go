@@ -0,0 +1,227 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sync"
+)
+
+// TaggedResourceType is a kind of resource ListTaggedResources returns.
+type TaggedResourceType string
+
+const (
+	// TaggedResourceTypeVM are virtual machines.
+	TaggedResourceTypeVM TaggedResourceType = "vm"
+	// TaggedResourceTypeTemplate are templates.
+	TaggedResourceTypeTemplate TaggedResourceType = "template"
+	// TaggedResourceTypeHost are hosts.
+	TaggedResourceTypeHost TaggedResourceType = "host"
+)
+
+// TaggedResourceTypeList is a list of TaggedResourceType values.
+type TaggedResourceTypeList []TaggedResourceType
+
+// TaggedResourceTypeValues returns all possible TaggedResourceType values.
+func TaggedResourceTypeValues() TaggedResourceTypeList {
+	return []TaggedResourceType{
+		TaggedResourceTypeVM,
+		TaggedResourceTypeTemplate,
+		TaggedResourceTypeHost,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l TaggedResourceTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// TaggedResources contains the resources carrying a tag, as returned by ListTaggedResources. The list of a resource
+// type that couldn't be fetched is empty.
+type TaggedResources interface {
+	// TagID returns the ID of the tag the resources carry.
+	TagID() TagID
+	// VMs returns the VMs carrying the tag.
+	VMs() []VM
+	// Templates returns the templates carrying the tag.
+	Templates() []Template
+	// Hosts returns the hosts carrying the tag.
+	Hosts() []Host
+}
+
+type taggedResources struct {
+	tagID     TagID
+	vms       []VM
+	templates []Template
+	hosts     []Host
+}
+
+func (t *taggedResources) TagID() TagID {
+	return t.tagID
+}
+
+func (t *taggedResources) VMs() []VM {
+	return t.vms
+}
+
+func (t *taggedResources) Templates() []Template {
+	return t.templates
+}
+
+func (t *taggedResources) Hosts() []Host {
+	return t.hosts
+}
+
+// findExistingTagByName returns the tag with the specified name, or an ENotFound error if there is none.
+func findExistingTagByName(client Client, tagName string, retries []RetryStrategy) (Tag, error) {
+	if tagName == "" {
+		return nil, newError(EBadArgument, "the tag name cannot be empty")
+	}
+	tag, err := findTagByName(client, tagName, retries)
+	if err != nil {
+		return nil, err
+	}
+	if tag == nil {
+		return nil, newError(ENotFound, "no tag named %s found", tagName)
+	}
+	return tag, nil
+}
+
+func (o *oVirtClient) ListTaggedResources(tagName string, retries ...RetryStrategy) (TaggedResources, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	tag, err := findExistingTagByName(o, tagName, retries)
+	if err != nil {
+		return nil, err
+	}
+	query, err := SearchField("tag").Eq(tagName).Build()
+	if err != nil {
+		return nil, wrap(err, EBadArgument, "invalid tag search string: %s", tagName)
+	}
+	result := &taggedResources{
+		tagID:     tag.ID(),
+		vms:       []VM{},
+		templates: []Template{},
+		hosts:     []Host{},
+	}
+	var lock sync.Mutex
+	typeErrors := map[string]error{}
+	listers := map[TaggedResourceType]func() error{
+		TaggedResourceTypeVM: func() error {
+			vms, err := o.searchVMsByQuery(query, nil, retries)
+			if err == nil {
+				result.vms = vms
+			}
+			return err
+		},
+		TaggedResourceTypeTemplate: func() error {
+			templates, err := o.searchTemplatesByQuery(query, retries)
+			if err == nil {
+				result.templates = templates
+			}
+			return err
+		},
+		TaggedResourceTypeHost: func() error {
+			hosts, err := o.searchHostsByQuery(query, retries)
+			if err == nil {
+				result.hosts = hosts
+			}
+			return err
+		},
+	}
+	resourceTypes := TaggedResourceTypeValues()
+	runBulkOperation(len(resourceTypes), func(i int) {
+		if err := listers[resourceTypes[i]](); err != nil {
+			lock.Lock()
+			typeErrors[string(resourceTypes[i])] = err
+			lock.Unlock()
+		}
+	})
+	return result, newMultiError(
+		fmt.Sprintf("listing resources tagged %s", tagName),
+		len(resourceTypes),
+		typeErrors,
+	)
+}
+
+func (o *oVirtClient) searchTemplatesByQuery(query string, retries []RetryStrategy) (result []Template, err error) {
+	action := fmt.Sprintf("searching templates with %s", query)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().TemplatesService().List().Search(query).Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			result = []Template{}
+			sdkObjects, ok := response.Templates()
+			if !ok {
+				return nil
+			}
+			for i, sdkObject := range sdkObjects.Slice() {
+				template, err := convertSDKTemplate(sdkObject, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert template during searching item #%d", i)
+				}
+				result = append(result, template)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) searchHostsByQuery(query string, retries []RetryStrategy) (result []Host, err error) {
+	action := fmt.Sprintf("searching hosts with %s", query)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().HostsService().List().Search(query).Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			result = []Host{}
+			sdkObjects, ok := response.Hosts()
+			if !ok {
+				return nil
+			}
+			for i, sdkObject := range sdkObjects.Slice() {
+				host, err := convertSDKHost(sdkObject, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert host during searching item #%d", i)
+				}
+				result = append(result, host)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) ListTaggedResources(tagName string, retries ...RetryStrategy) (TaggedResources, error) {
+	tag, err := findExistingTagByName(m, tagName, retries)
+	if err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	// The mock only keeps tags on VMs, so templates and hosts never carry a tag.
+	result := &taggedResources{
+		tagID:     tag.ID(),
+		vms:       []VM{},
+		templates: []Template{},
+		hosts:     []Host{},
+	}
+	for _, vm := range m.vms {
+		for _, tagID := range vm.tagIDs {
+			if tagID == tag.ID() {
+				result.vms = append(result.vms, vm.snapshot())
+				break
+			}
+		}
+	}
+	return result, nil
+}
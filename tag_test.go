@@ -152,3 +152,37 @@ func TestAddTagToVMByName(t *testing.T) {
 		t.Fatalf("Incorrect number of VMs returned (%d)", len(vms))
 	}
 }
+
+func TestListTaggedResources(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	tagName := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+
+	tag := assertCanCreateTag(t, helper, tagName, "")
+	taggedVM := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	if err := client.AddTagToVM(taggedVM.ID(), tag.ID()); err != nil {
+		t.Fatalf("Failed to add tag %s to VM %s (%v)", tag.ID(), taggedVM.ID(), err)
+	}
+
+	resources, err := client.ListTaggedResources(tagName)
+	if err != nil {
+		t.Fatalf("Failed to list resources tagged %s (%v)", tagName, err)
+	}
+	if resources.TagID() != tag.ID() {
+		t.Fatalf("Incorrect tag ID returned (expected: %s, got: %s)", tag.ID(), resources.TagID())
+	}
+	vms := resources.VMs()
+	if len(vms) != 1 || vms[0].ID() != taggedVM.ID() {
+		t.Fatalf("Incorrect VMs returned for tag %s (expected only %s, got %d VMs)", tagName, taggedVM.ID(), len(vms))
+	}
+	if len(resources.Templates()) != 0 || len(resources.Hosts()) != 0 {
+		t.Fatalf("Untagged templates or hosts were returned for tag %s.", tagName)
+	}
+
+	_, err = client.ListTaggedResources(tagName + "-nonexistent")
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Listing resources for a nonexistent tag did not result in an ENotFound error (%v)", err)
+	}
+}
@@ -17,17 +17,41 @@ type TemplateClient interface {
 	ListTemplates(retries ...RetryStrategy) ([]Template, error)
 	// GetTemplateByName returns a template by its Name.
 	GetTemplateByName(templateName string, retries ...RetryStrategy) (Template, error)
+	// ExportVMAsTemplate creates a template from the VM and exports the disks of the template to an external image
+	// provider, such as OpenStack Glance, waiting for each export job to succeed. An ENotFound error is returned if
+	// the image provider doesn't exist. If an export fails, the error contains the job description and the template
+	// is left in place for inspection or removal.
+	ExportVMAsTemplate(vmID VMID, name string, providerID ImageProviderID, retries ...RetryStrategy) (Template, error)
 	// GetTemplate returns a template by its ID.
 	GetTemplate(id TemplateID, retries ...RetryStrategy) (Template, error)
+	// TemplateExists returns true if a template with the specified ID exists. Errors other than the template not
+	// being found are returned.
+	TemplateExists(id TemplateID, retries ...RetryStrategy) (bool, error)
 	// GetBlankTemplate finds a blank template in the oVirt engine and returns it. If no blank template is present,
 	// this function will return an error.
 	GetBlankTemplate(retries ...RetryStrategy) (Template, error)
-	// RemoveTemplate removes the template with the specified ID.
+	// RemoveTemplate removes the template with the specified ID and waits until it is gone. Removing a template that
+	// doesn't exist is not an error. A locked template is retried according to the retry strategy, while a template
+	// still used by a VM results in an EConflict error. The Blank template cannot be removed (EBadArgument).
 	RemoveTemplate(templateID TemplateID, retries ...RetryStrategy) error
 	// WaitForTemplateStatus waits for a template to enter a specific status.
 	WaitForTemplateStatus(templateID TemplateID, status TemplateStatus, retries ...RetryStrategy) (Template, error)
 	// CopyTemplateDiskToStorageDomain copies template disk to the specified storage domain.
 	CopyTemplateDiskToStorageDomain(diskID DiskID, storageDomainID StorageDomainID, retries ...RetryStrategy) (Disk, error)
+	// ExportTemplate exports a template and its disks to an export storage domain, so it can be imported in a
+	// different datacenter using ImportTemplate. The export storage domain must be active. This function waits for the
+	// export to finish and returns the template once it is in the TemplateStatusOK state again.
+	ExportTemplate(id TemplateID, exportStorageDomainID StorageDomainID, retries ...RetryStrategy) (Template, error)
+	// ImportTemplate imports a template from an export storage domain into the specified cluster, copying its disks to
+	// the target storage domain. Both storage domains must be active. This function waits until the imported template
+	// is in the TemplateStatusOK state.
+	ImportTemplate(
+		id TemplateID,
+		exportStorageDomainID StorageDomainID,
+		clusterID ClusterID,
+		targetStorageDomainID StorageDomainID,
+		retries ...RetryStrategy,
+	) (Template, error)
 }
 
 // TemplateID is an identifier for a template. It has a special type so the compiler
@@ -46,6 +70,9 @@ type TemplateData interface {
 	Status() TemplateStatus
 	// CPU returns the CPU configuration of the template if any.
 	CPU() VMCPU
+	// BIOSType returns the chipset and firmware combination VMs created from the template inherit unless they set
+	// their own.
+	BIOSType() BIOSType
 
 	// IsBlank returns true, if the template either has the ID of all zeroes, or if the template has no settings, disks,
 	// or other settings. This function only checks the details supported by go-ovirt-client.
@@ -62,8 +89,12 @@ type Template interface {
 	WaitForStatus(status TemplateStatus, retries ...RetryStrategy) (Template, error)
 	// ListDiskAttachments lists all disk attachments for the current template.
 	ListDiskAttachments(retries ...RetryStrategy) ([]TemplateDiskAttachment, error)
+	// ListDisks lists all disks of the current template. See TemplateDiskClient.ListTemplateDisks for details.
+	ListDisks(retries ...RetryStrategy) ([]Disk, error)
 	// Remove removes the specified template.
 	Remove(retries ...RetryStrategy) error
+	// Export exports the current template to an export storage domain. See TemplateClient.ExportTemplate for details.
+	Export(exportStorageDomainID StorageDomainID, retries ...RetryStrategy) (Template, error)
 }
 
 // TemplateStatus represents the status the template is in.
@@ -138,6 +169,12 @@ func convertSDKTemplate(sdkTemplate *ovirtsdk.Template, client Client) (Template
 	if err != nil {
 		return nil, err
 	}
+	biosType := BIOSTypeClusterDefault
+	if bios, ok := sdkTemplate.Bios(); ok {
+		if sdkBIOSType, ok := bios.Type(); ok {
+			biosType = BIOSType(sdkBIOSType)
+		}
+	}
 	return &template{
 		client:      client,
 		id:          TemplateID(id),
@@ -145,6 +182,7 @@ func convertSDKTemplate(sdkTemplate *ovirtsdk.Template, client Client) (Template
 		status:      TemplateStatus(status),
 		description: description,
 		cpu:         cpu,
+		biosType:    biosType,
 	}, nil
 }
 
@@ -186,16 +224,29 @@ type template struct {
 	description string
 	status      TemplateStatus
 	cpu         *vmCPU
+	biosType    BIOSType
 }
 
 func (t template) ListDiskAttachments(retries ...RetryStrategy) ([]TemplateDiskAttachment, error) {
 	return t.client.ListTemplateDiskAttachments(t.id, retries...)
 }
 
+func (t template) ListDisks(retries ...RetryStrategy) ([]Disk, error) {
+	return t.client.ListTemplateDisks(t.id, retries...)
+}
+
+func (t template) Export(exportStorageDomainID StorageDomainID, retries ...RetryStrategy) (Template, error) {
+	return t.client.ExportTemplate(t.id, exportStorageDomainID, retries...)
+}
+
 func (t template) CPU() VMCPU {
 	return t.cpu
 }
 
+func (t template) BIOSType() BIOSType {
+	return t.biosType
+}
+
 func (t template) Status() TemplateStatus {
 	return t.status
 }
@@ -14,6 +14,9 @@ func (o *oVirtClient) CreateTemplate(
 	retries ...RetryStrategy,
 ) (result Template, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	if err := validateTemplateName(name); err != nil {
+		return nil, err
+	}
 	if params == nil {
 		params = &templateCreateParameters{}
 	}
@@ -60,6 +63,10 @@ func (m *mockClient) CreateTemplate(
 	params OptionalTemplateCreateParameters,
 	_ ...RetryStrategy,
 ) (Template, error) {
+	if err := validateTemplateName(name); err != nil {
+		return nil, err
+	}
+
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -89,6 +96,7 @@ func (m *mockClient) CreateTemplate(
 		description: description,
 		status:      TemplateStatusLocked,
 		cpu:         vm.cpu.clone(),
+		biosType:    vm.biosType,
 	}
 	m.templates[tpl.ID()] = tpl
 	m.templateDiskAttachmentsByTemplate[tpl.ID()] = make(
@@ -101,6 +109,13 @@ func (m *mockClient) CreateTemplate(
 	return tpl, nil
 }
 
+func validateTemplateName(name string) error {
+	if err := ValidateResourceName(name); err != nil {
+		return wrap(err, EBadArgument, "invalid template name")
+	}
+	return nil
+}
+
 func (m *mockClient) handlePostTemplateCreation(tpl *template) {
 	func() {
 		time.Sleep(2 * time.Second)
@@ -113,7 +128,13 @@ func (m *mockClient) handlePostTemplateCreation(tpl *template) {
 			disk := m.disks[attachment.diskID]
 			disk.Unlock()
 		}
-		tpl.status = TemplateStatusOK
+		if _, ok := m.templates[tpl.id]; !ok {
+			return
+		}
+		// Replace the template instead of updating it in place since the caller may be reading the old object.
+		updatedTemplate := *tpl
+		updatedTemplate.status = TemplateStatusOK
+		m.templates[tpl.id] = &updatedTemplate
 	}()
 }
 
@@ -8,6 +8,10 @@ import (
 type TemplateDiskClient interface {
 	// ListTemplateDiskAttachments lists all disk attachments for a template.
 	ListTemplateDiskAttachments(templateID TemplateID, retries ...RetryStrategy) ([]TemplateDiskAttachment, error)
+	// ListTemplateDisks returns the disks of a template, including their sizes and the storage domains they are
+	// currently stored on. This is useful to decide where the disks of VMs created from the template should be
+	// placed. An ENotFound error is returned if the template doesn't exist.
+	ListTemplateDisks(templateID TemplateID, retries ...RetryStrategy) ([]Disk, error)
 }
 
 // TemplateDiskAttachmentData contains the methods to get the details of a disk attached to a template.
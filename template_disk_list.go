@@ -56,3 +56,41 @@ func (m *mockClient) ListTemplateDiskAttachments(
 	}
 	return result, nil
 }
+
+func (o *oVirtClient) ListTemplateDisks(templateID TemplateID, retries ...RetryStrategy) ([]Disk, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	if _, err := o.GetTemplate(templateID, retries...); err != nil {
+		return nil, err
+	}
+	attachments, err := o.ListTemplateDiskAttachments(templateID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Disk, len(attachments))
+	for i, attachment := range attachments {
+		result[i], err = o.GetDisk(attachment.DiskID(), retries...)
+		if err != nil {
+			return nil, wrap(err, EUnidentified, "failed to fetch disk %s of template %s", attachment.DiskID(), templateID)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockClient) ListTemplateDisks(templateID TemplateID, _ ...RetryStrategy) ([]Disk, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.templates[templateID]; !ok {
+		return nil, newError(ENotFound, "template with ID %s not found", templateID)
+	}
+	attachments := m.templateDiskAttachmentsByTemplate[templateID]
+	result := make([]Disk, len(attachments))
+	for i, attachment := range attachments {
+		disk, ok := m.disks[attachment.diskID]
+		if !ok {
+			return nil, newError(ENotFound, "disk with ID %s not found", attachment.diskID)
+		}
+		result[i] = disk
+	}
+	return result, nil
+}
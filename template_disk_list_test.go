@@ -83,3 +83,39 @@ func testTemplateDiskListAttachmentsEmpty(t *testing.T) {
 		)
 	}
 }
+
+func TestListTemplateDisks(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	disk := assertCanCreateDisk(t, helper)
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	assertCanAttachDisk(t, vm, disk)
+	tpl := assertCanCreateTemplate(t, helper, vm)
+
+	disks, err := tpl.ListDisks()
+	if err != nil {
+		t.Fatalf("Failed to list disks of template %s (%v).", tpl.ID(), err)
+	}
+	if len(disks) != 1 {
+		t.Fatalf("Incorrect number of disks on template %s (expected: 1, got: %d).", tpl.ID(), len(disks))
+	}
+	if disks[0].ProvisionedSize() != disk.ProvisionedSize() {
+		t.Fatalf(
+			"Template disk %s has incorrect size (%d instead of %d bytes).",
+			disks[0].ID(),
+			disks[0].ProvisionedSize(),
+			disk.ProvisionedSize(),
+		)
+	}
+	if len(disks[0].StorageDomainIDs()) == 0 {
+		t.Fatalf("Template disk %s has no storage domains.", disks[0].ID())
+	}
+
+	_, err = helper.GetClient().ListTemplateDisks("00000000-0000-0000-0000-000000000001")
+	if err == nil {
+		t.Fatalf("Listing the disks of a nonexistent template did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Listing the disks of a nonexistent template did not result in an ENotFound error (%v).", err)
+	}
+}
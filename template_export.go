@@ -0,0 +1,234 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ExportTemplate(
+	id TemplateID,
+	exportStorageDomainID StorageDomainID,
+	retries ...RetryStrategy,
+) (Template, error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	if err := o.validateActiveStorageDomain(exportStorageDomainID, retries); err != nil {
+		return nil, err
+	}
+	correlationID := fmt.Sprintf("template_export_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf("exporting template %s to storage domain %s", id, exportStorageDomainID)
+	err := retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				TemplatesService().
+				TemplateService(string(id)).
+				Export().
+				StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(string(exportStorageDomainID)).MustBuild()).
+				Query("correlation_id", correlationID).
+				Send()
+			return wrapSDKError(action, err)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	// The template returns to OK after a failed export too, so the job status is the only sign of the failure.
+	if err := o.waitForJobSucceeded(correlationID, retries); err != nil {
+		return nil, wrap(err, EUnidentified, "export of template %s failed", id)
+	}
+	return o.WaitForTemplateStatus(id, TemplateStatusOK, retries...)
+}
+
+func (o *oVirtClient) ImportTemplate(
+	id TemplateID,
+	exportStorageDomainID StorageDomainID,
+	clusterID ClusterID,
+	targetStorageDomainID StorageDomainID,
+	retries ...RetryStrategy,
+) (Template, error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	if err := o.validateActiveStorageDomain(exportStorageDomainID, retries); err != nil {
+		return nil, err
+	}
+	if err := o.validateActiveStorageDomain(targetStorageDomainID, retries); err != nil {
+		return nil, err
+	}
+	correlationID := fmt.Sprintf("template_import_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf(
+		"importing template %s from storage domain %s to storage domain %s",
+		id,
+		exportStorageDomainID,
+		targetStorageDomainID,
+	)
+	err := retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				StorageDomainsService().
+				StorageDomainService(string(exportStorageDomainID)).
+				TemplatesService().
+				TemplateService(string(id)).
+				Import().
+				Cluster(ovirtsdk.NewClusterBuilder().Id(string(clusterID)).MustBuild()).
+				StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(string(targetStorageDomainID)).MustBuild()).
+				Query("correlation_id", correlationID).
+				Send()
+			return wrapSDKError(action, err)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.waitForJobSucceeded(correlationID, retries); err != nil {
+		return nil, wrap(err, EUnidentified, "import of template %s failed", id)
+	}
+	return o.WaitForTemplateStatus(id, TemplateStatusOK, retries...)
+}
+
+func (o *oVirtClient) validateActiveStorageDomain(id StorageDomainID, retries []RetryStrategy) error {
+	storageDomain, err := o.GetStorageDomain(id, retries...)
+	if err != nil {
+		return err
+	}
+	return checkStorageDomainActive(storageDomain)
+}
+
+func checkStorageDomainActive(storageDomain StorageDomain) error {
+	if storageDomain.Status() != StorageDomainStatusActive {
+		return newError(
+			EConflict,
+			"storage domain %s is not active (status: %s)",
+			storageDomain.ID(),
+			storageDomain.Status(),
+		)
+	}
+	return nil
+}
+
+// mockExportedTemplate is the copy of a template stored on an export storage domain in the mock.
+type mockExportedTemplate struct {
+	template    *template
+	attachments []*templateDiskAttachment
+	disks       map[DiskID]*diskWithData
+}
+
+func (m *mockClient) ExportTemplate(
+	id TemplateID,
+	exportStorageDomainID StorageDomainID,
+	_ ...RetryStrategy,
+) (Template, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if err := m.validateActiveStorageDomain(exportStorageDomainID); err != nil {
+		return nil, err
+	}
+	tpl, ok := m.templates[id]
+	if !ok {
+		return nil, newError(ENotFound, "template with ID %s not found", id)
+	}
+	if tpl.status != TemplateStatusOK {
+		return nil, newError(EConflict, "template %s is in status %s, not %s", id, tpl.status, TemplateStatusOK)
+	}
+
+	exported := &mockExportedTemplate{
+		template: &template{
+			client:      m,
+			id:          tpl.id,
+			name:        tpl.name,
+			description: tpl.description,
+			status:      TemplateStatusOK,
+			cpu:         tpl.cpu.clone(),
+			biosType:    tpl.biosType,
+		},
+		disks: map[DiskID]*diskWithData{},
+	}
+	for _, attachment := range m.templateDiskAttachmentsByTemplate[id] {
+		attachmentCopy := *attachment
+		exported.attachments = append(exported.attachments, &attachmentCopy)
+		exported.disks[attachment.diskID] = m.disks[attachment.diskID].clone(nil)
+	}
+	if _, ok := m.exportedTemplates[exportStorageDomainID]; !ok {
+		m.exportedTemplates[exportStorageDomainID] = map[TemplateID]*mockExportedTemplate{}
+	}
+	m.exportedTemplates[exportStorageDomainID][id] = exported
+	return tpl, nil
+}
+
+func (m *mockClient) ImportTemplate(
+	id TemplateID,
+	exportStorageDomainID StorageDomainID,
+	clusterID ClusterID,
+	targetStorageDomainID StorageDomainID,
+	_ ...RetryStrategy,
+) (Template, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if err := m.validateActiveStorageDomain(exportStorageDomainID); err != nil {
+		return nil, err
+	}
+	if err := m.validateActiveStorageDomain(targetStorageDomainID); err != nil {
+		return nil, err
+	}
+	if _, ok := m.clusters[clusterID]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	exported, ok := m.exportedTemplates[exportStorageDomainID][id]
+	if !ok {
+		return nil, newError(
+			ENotFound,
+			"template with ID %s not found on storage domain %s",
+			id,
+			exportStorageDomainID,
+		)
+	}
+	if _, ok := m.templates[id]; ok {
+		return nil, newError(EConflict, "template with ID %s already exists", id)
+	}
+
+	tpl := &template{
+		client:      m,
+		id:          exported.template.id,
+		name:        exported.template.name,
+		description: exported.template.description,
+		status:      TemplateStatusOK,
+		cpu:         exported.template.cpu.clone(),
+		biosType:    exported.template.biosType,
+	}
+	m.templates[id] = tpl
+	m.templateDiskAttachmentsByTemplate[id] = make([]*templateDiskAttachment, len(exported.attachments))
+	for i, attachment := range exported.attachments {
+		disk := exported.disks[attachment.diskID].clone(nil)
+		disk.storageDomainIDs = []StorageDomainID{targetStorageDomainID}
+		m.disks[disk.ID()] = disk
+
+		tplAttachment := &templateDiskAttachment{
+			client:        m,
+			id:            TemplateDiskAttachmentID(m.GenerateUUID()),
+			templateID:    id,
+			diskID:        disk.ID(),
+			diskInterface: attachment.diskInterface,
+			bootable:      attachment.bootable,
+			active:        attachment.active,
+		}
+		m.templateDiskAttachmentsByDisk[disk.ID()] = tplAttachment
+		m.templateDiskAttachmentsByTemplate[id][i] = tplAttachment
+	}
+	return tpl, nil
+}
+
+func (m *mockClient) validateActiveStorageDomain(id StorageDomainID) error {
+	storageDomain, ok := m.storageDomains[id]
+	if !ok {
+		return newError(ENotFound, "storage domain with ID %s not found", id)
+	}
+	return checkStorageDomainActive(storageDomain)
+}
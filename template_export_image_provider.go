@@ -0,0 +1,108 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ExportVMAsTemplate(
+	vmID VMID,
+	name string,
+	providerID ImageProviderID,
+	retries ...RetryStrategy,
+) (Template, error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	if err := o.validateImageProvider(providerID, retries); err != nil {
+		return nil, err
+	}
+	tpl, err := o.CreateTemplate(vmID, name, nil, retries...)
+	if err != nil {
+		return nil, err
+	}
+	tpl, err = o.WaitForTemplateStatus(tpl.ID(), TemplateStatusOK, retries...)
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := o.ListTemplateDiskAttachments(tpl.ID(), retries...)
+	if err != nil {
+		return nil, err
+	}
+	for _, attachment := range attachments {
+		if err := o.exportDiskToImageProvider(attachment.DiskID(), providerID, retries); err != nil {
+			return nil, wrap(
+				err,
+				EUnidentified,
+				"failed to export disk %s of template %s to image provider %s, the template was left in place",
+				attachment.DiskID(),
+				tpl.ID(),
+				providerID,
+			)
+		}
+	}
+	return tpl, nil
+}
+
+// exportDiskToImageProvider exports a disk to an image provider and waits for the export job to succeed. The engine
+// represents each image provider as a storage domain with the ID of the provider, which is the export target.
+func (o *oVirtClient) exportDiskToImageProvider(
+	diskID DiskID,
+	providerID ImageProviderID,
+	retries []RetryStrategy,
+) error {
+	correlationID := fmt.Sprintf("disk_export_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf("exporting disk %s to image provider %s", diskID, providerID)
+	err := retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				DisksService().
+				DiskService(string(diskID)).
+				Export().
+				StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(string(providerID)).MustBuild()).
+				Query("correlation_id", correlationID).
+				Send()
+			return wrapSDKError(action, err)
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return o.waitForJobSucceeded(correlationID, retries)
+}
+
+func (m *mockClient) ExportVMAsTemplate(
+	vmID VMID,
+	name string,
+	providerID ImageProviderID,
+	retries ...RetryStrategy,
+) (Template, error) {
+	m.lock.Lock()
+	_, ok := m.imageProviders[providerID]
+	m.lock.Unlock()
+	if !ok {
+		return nil, newError(ENotFound, "image provider with ID %s not found", providerID)
+	}
+	tpl, err := m.CreateTemplate(vmID, name, nil, retries...)
+	if err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	provider, ok := m.imageProviders[providerID]
+	if !ok {
+		return nil, newError(ENotFound, "image provider with ID %s not found", providerID)
+	}
+	for _, attachment := range m.templateDiskAttachmentsByTemplate[tpl.ID()] {
+		disk := m.disks[attachment.diskID]
+		provider.images[m.GenerateUUID()] = &mockExternalImage{
+			format: disk.format,
+			size:   disk.provisionedSize,
+		}
+	}
+	return tpl, nil
+}
@@ -0,0 +1,61 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestExportVMAsTemplate(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	provider := &mockImageProvider{
+		id:     ImageProviderID(m.GenerateUUID()),
+		images: map[string]*mockExternalImage{},
+	}
+	m.imageProviders[provider.id] = provider
+	var clusterID ClusterID
+	for id := range m.clusters {
+		clusterID = id
+		break
+	}
+	var storageDomainID StorageDomainID
+	for id, storageDomain := range m.storageDomains {
+		if storageDomain.Status() == StorageDomainStatusActive {
+			storageDomainID = id
+			break
+		}
+	}
+	blankTemplate, err := m.GetBlankTemplate()
+	if err != nil {
+		t.Fatalf("Failed to get blank template (%v)", err)
+	}
+	vm, err := m.CreateVM(clusterID, blankTemplate.ID(), "export-source", nil)
+	if err != nil {
+		t.Fatalf("Failed to create VM (%v)", err)
+	}
+	disk, err := m.CreateDisk(storageDomainID, ImageFormatCow, 1024*1024, nil)
+	if err != nil {
+		t.Fatalf("Failed to create disk (%v)", err)
+	}
+	if _, err := m.CreateDiskAttachment(vm.ID(), disk.ID(), DiskInterfaceVirtIO, nil); err != nil {
+		t.Fatalf("Failed to attach disk (%v)", err)
+	}
+
+	if _, err := m.ExportVMAsTemplate(
+		vm.ID(),
+		"exported",
+		ImageProviderID(m.GenerateUUID()),
+	); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Exporting to a nonexistent image provider did not result in an ENotFound error (%v)", err)
+	}
+
+	tpl, err := m.ExportVMAsTemplate(vm.ID(), "exported", provider.id)
+	if err != nil {
+		t.Fatalf("Failed to export VM as template (%v)", err)
+	}
+	if tpl.Name() != "exported" {
+		t.Fatalf("Incorrect template name (expected: exported, got: %s)", tpl.Name())
+	}
+	if len(provider.images) != 1 {
+		t.Fatalf("Incorrect number of images on the image provider (expected: 1, got: %d)", len(provider.images))
+	}
+}
@@ -0,0 +1,52 @@
+package ovirtclient
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExportTemplateFailedJob(t *testing.T) {
+	t.Parallel()
+	client := newFakeEngineClient(t, func(writer http.ResponseWriter, request *http.Request) {
+		switch {
+		case request.URL.Path == "/ovirt-engine/api/storagedomains/00000000-0000-0000-0000-000000000040":
+			writeFakeEngineXML(
+				writer,
+				`<storage_domain id="00000000-0000-0000-0000-000000000040"><name>export</name>`+
+					`<storage><type>nfs</type></storage><status>active</status><type>export</type></storage_domain>`,
+			)
+		case request.URL.Path == "/ovirt-engine/api/templates/00000000-0000-0000-0000-000000000050":
+			writeFakeEngineXML(
+				writer,
+				`<template id="00000000-0000-0000-0000-000000000050"><name>test</name><status>ok</status>`+
+					`<cpu><topology><cores>1</cores><sockets>1</sockets><threads>1</threads></topology></cpu>`+
+					`</template>`,
+			)
+		case strings.HasSuffix(request.URL.Path, "/export"):
+			writeFakeEngineXML(writer, `<action><status>complete</status></action>`)
+		case request.URL.Path == "/ovirt-engine/api/jobs":
+			// The template returns to OK after the failure, only the job shows it.
+			writeFakeEngineXML(
+				writer,
+				`<jobs><job id="1"><description>Exporting Template test</description><status>failed</status></job></jobs>`,
+			)
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	_, err := client.ExportTemplate(
+		"00000000-0000-0000-0000-000000000050",
+		"00000000-0000-0000-0000-000000000040",
+		MaxTries(5),
+		FixedWait(time.Millisecond),
+	)
+	if err == nil {
+		t.Fatalf("A failed export job did not result in an error.")
+	}
+	if HasErrorCode(err, EPending) || !strings.Contains(err.Error(), "Exporting Template test") {
+		t.Fatalf("Incorrect error returned for a failed export job (%v)", err)
+	}
+}
@@ -0,0 +1,74 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestTemplateExportToNonexistentStorageDomain(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	tpl := assertCanCreateTemplate(t, helper, vm)
+	tpl = assertCanGetTemplateOK(t, helper, tpl.ID())
+
+	_, err := tpl.Export("00000000-0000-0000-0000-000000000001")
+	if err == nil {
+		t.Fatalf("Exporting a template to a nonexistent storage domain did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Exporting a template to a nonexistent storage domain did not result in an ENotFound error (%v)", err)
+	}
+}
+
+// TestTemplateExportImport runs against the mock only since the live test environment has no export storage domain.
+func TestTemplateExportImport(t *testing.T) {
+	t.Parallel()
+	helper, err := ovirtclient.NewMockTestHelper(ovirtclientlog.NewTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create mock test helper (%v)", err)
+	}
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+	tpl := assertCanCreateTemplate(t, helper, vm)
+	tpl = assertCanGetTemplateOK(t, helper, tpl.ID())
+
+	if _, err := tpl.Export(helper.GetStorageDomainID()); err != nil {
+		t.Fatalf("Failed to export template %s (%v)", tpl.ID(), err)
+	}
+	if _, err := client.ImportTemplate(
+		tpl.ID(),
+		helper.GetStorageDomainID(),
+		helper.GetClusterID(),
+		helper.GetStorageDomainID(),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Importing a template that still exists did not result in an EConflict error (%v)", err)
+	}
+	assertCanRemoveTemplate(t, helper, tpl.ID())
+
+	importedTemplate, err := client.ImportTemplate(
+		tpl.ID(),
+		helper.GetStorageDomainID(),
+		helper.GetClusterID(),
+		helper.GetStorageDomainID(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to import template %s (%v)", tpl.ID(), err)
+	}
+	if importedTemplate.Name() != tpl.Name() {
+		t.Fatalf("Incorrect name on imported template (expected: %s, got: %s)", tpl.Name(), importedTemplate.Name())
+	}
+	attachments, err := importedTemplate.ListDiskAttachments()
+	if err != nil {
+		t.Fatalf("Failed to list disk attachments of imported template (%v)", err)
+	}
+	if len(attachments) != 1 {
+		t.Fatalf("Incorrect number of disk attachments on imported template (expected: 1, got: %d)", len(attachments))
+	}
+}
@@ -15,7 +15,7 @@ func (o *oVirtClient) GetTemplate(id TemplateID, retries ...RetryStrategy) (resu
 		func() error {
 			response, err := o.conn.SystemService().TemplatesService().TemplateService(string(id)).Get().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting template %s", id), err)
 			}
 			sdkObject, ok := response.Template()
 			if !ok {
@@ -36,7 +36,7 @@ func (o *oVirtClient) GetTemplate(id TemplateID, retries ...RetryStrategy) (resu
 			}
 			return nil
 		})
-	return
+	return result, wrapBlankTemplateNotFound(id, err)
 }
 
 func (m *mockClient) GetTemplate(id TemplateID, _ ...RetryStrategy) (Template, error) {
@@ -45,5 +45,5 @@ func (m *mockClient) GetTemplate(id TemplateID, _ ...RetryStrategy) (Template, e
 	if item, ok := m.templates[id]; ok {
 		return item, nil
 	}
-	return nil, newError(ENotFound, "template with ID %s not found", id)
+	return nil, wrapBlankTemplateNotFound(id, newError(ENotFound, "template with ID %s not found", id))
 }
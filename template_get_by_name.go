@@ -6,14 +6,18 @@ import (
 
 func (o *oVirtClient) GetTemplateByName(templateName string, retries ...RetryStrategy) (result Template, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	query, err := SearchField("name").Eq(templateName).Build()
+	if err != nil {
+		return nil, err
+	}
 	err = retry(
 		fmt.Sprintf("getting template by Name %s", templateName),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().TemplatesService().List().Search("name=" + templateName).Send()
+			response, err := o.conn.SystemService().TemplatesService().List().Search(query).Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting template by Name %s", templateName), err)
 			}
 			for _, sdkObject := range response.MustTemplates().Slice() {
 				if mTemplate, ok := sdkObject.Name(); ok {
@@ -38,6 +42,9 @@ func (o *oVirtClient) GetTemplateByName(templateName string, retries ...RetryStr
 }
 
 func (m *mockClient) GetTemplateByName(templateName string, _ ...RetryStrategy) (result Template, err error) {
+	if _, err := SearchField("name").Eq(templateName).Build(); err != nil {
+		return nil, err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	for _, template := range m.templates {
@@ -8,20 +8,68 @@ func (o *oVirtClient) RemoveTemplate(
 	templateID TemplateID,
 	retries ...RetryStrategy,
 ) (err error) {
+	if err := validateTemplateRemovable(templateID); err != nil {
+		return err
+	}
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	action := fmt.Sprintf("removing template %s", templateID)
 	err = retry(
-		fmt.Sprintf("removing template %s", templateID),
+		action,
 		o.logger,
 		retries,
 		func() error {
 			_, err := o.conn.SystemService().TemplatesService().TemplateService(string(templateID)).Remove().Send()
+			if err == nil {
+				return nil
+			}
+			err = wrapSDKError(action, err)
+			if HasErrorCode(err, ENotFound) {
+				return nil
+			}
 			return err
 		})
-	return
+	if err != nil {
+		return err
+	}
+	return o.waitForTemplateRemoved(templateID, retries)
+}
+
+// waitForTemplateRemoved waits until the engine no longer returns the template, the disks of the template are still
+// being removed in the background when the remove call returns.
+func (o *oVirtClient) waitForTemplateRemoved(id TemplateID, retries []RetryStrategy) error {
+	action := fmt.Sprintf("waiting for template %s to be removed", id)
+	return retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().TemplatesService().TemplateService(string(id)).Get().Send()
+			if err == nil {
+				return newError(EPending, "template %s still exists", id)
+			}
+			err = wrapSDKError(action, err)
+			if HasErrorCode(err, ENotFound) {
+				return nil
+			}
+			return err
+		})
+}
+
+func validateTemplateRemovable(id TemplateID) error {
+	if id == DefaultBlankTemplateID {
+		return newError(EBadArgument, "the Blank template %s cannot be removed", id)
+	}
+	return nil
 }
 
 func (m *mockClient) RemoveTemplate(id TemplateID, retries ...RetryStrategy) (err error) {
-	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	if err := validateTemplateRemovable(id); err != nil {
+		return err
+	}
+	if err := m.checkTemplateNotInUse(id); err != nil {
+		return err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
 	err = retry(
 		fmt.Sprintf("removing template %s", id),
 		m.logger,
@@ -31,18 +79,7 @@ func (m *mockClient) RemoveTemplate(id TemplateID, retries ...RetryStrategy) (er
 			defer m.lock.Unlock()
 			tpl, ok := m.templates[id]
 			if !ok {
-				return newError(ENotFound, "Template with ID %s was not found", id)
-			}
-
-			for _, vm := range m.vms {
-				if vm.templateID == id {
-					return newError(
-						EConflict,
-						"Template %s cannot be removed because it is in use by VM %s.",
-						id,
-						vm.id,
-					)
-				}
+				return nil
 			}
 
 			if tpl.status == TemplateStatusLocked {
@@ -54,3 +91,21 @@ func (m *mockClient) RemoveTemplate(id TemplateID, retries ...RetryStrategy) (er
 		})
 	return err
 }
+
+// checkTemplateNotInUse returns an EConflict error if a VM is still based on the template. This is checked before
+// the retry loop because, unlike a locked template, it doesn't resolve itself.
+func (m *mockClient) checkTemplateNotInUse(id TemplateID) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, vm := range m.vms {
+		if vm.templateID == id {
+			return newError(
+				EConflict,
+				"Template %s cannot be removed because it is in use by VM %s.",
+				id,
+				vm.id,
+			)
+		}
+	}
+	return nil
+}
@@ -274,3 +274,25 @@ func assertCannotRemoveTemplate(t *testing.T, helper ovirtclient.TestHelper, tem
 		t.Fatalf("Successfully removed template %s despite assumption.", templateID)
 	}
 }
+
+func TestRemoveTemplate(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	if err := client.RemoveTemplate(ovirtclient.DefaultBlankTemplateID); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Removing the Blank template did not result in an EBadArgument error (%v)", err)
+	}
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	template := assertCanCreateTemplate(t, helper, vm)
+	assertCanRemoveTemplate(t, helper, template.ID())
+	if _, err := client.GetTemplate(template.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Template %s still exists after removal (%v)", template.ID(), err)
+	}
+	// Removing an already removed template must succeed so cleanups can be repeated safely.
+	assertCanRemoveTemplate(t, helper, template.ID())
+}
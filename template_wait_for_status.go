@@ -10,8 +10,9 @@ func (o *oVirtClient) WaitForTemplateStatus(
 	retries ...RetryStrategy,
 ) (result Template, err error) {
 	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	action := fmt.Sprintf("waiting for template %s to enter status \"%s\"", id, status)
 	err = retry(
-		fmt.Sprintf("waiting for template %s to enter status \"%s\"", id, status),
+		action,
 		o.logger,
 		retries,
 		func() error {
@@ -19,6 +20,7 @@ func (o *oVirtClient) WaitForTemplateStatus(
 			if err != nil {
 				return err
 			}
+			reportStatus(o.ctx, action, string(result.Status()))
 			if result.Status() != status {
 				return newError(EPending, "Template %s status is \"%s\", not \"%s\".", id, result.Status(), status)
 			}
@@ -33,8 +35,9 @@ func (m *mockClient) WaitForTemplateStatus(
 	retries ...RetryStrategy,
 ) (result Template, err error) {
 	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	action := fmt.Sprintf("waiting for template %s to enter status \"%s\"", id, status)
 	err = retry(
-		fmt.Sprintf("waiting for template %s to enter status \"%s\"", id, status),
+		action,
 		nil,
 		retries,
 		func() error {
@@ -42,6 +45,7 @@ func (m *mockClient) WaitForTemplateStatus(
 			if err != nil {
 				return err
 			}
+			reportStatus(m.ctx, action, string(result.Status()))
 			if result.Status() != status {
 				return newError(EPending, "Template %s status is \"%s\", not \"%s\".", id, result.Status(), status)
 			}
@@ -1,11 +1,15 @@
 package ovirtclient
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"sync"
 )
 
@@ -45,6 +49,14 @@ type BuildableTLSProvider interface {
 	// CACertsFromCertPool sets a certificate pool to use as a source for certificates. This is incompatible with  the
 	// CACertsFromSystem call as both create a certificate pool. This function must not be called twice.
 	CACertsFromCertPool(*x509.CertPool) BuildableTLSProvider
+
+	// WithCertFingerprint pins the engine certificate to the SHA-256 fingerprint of its DER encoding. The fingerprint
+	// is given in hexadecimal, optionally separated by colons as printed by openssl x509 -fingerprint -sha256. The
+	// handshake fails if the certificate presented by the engine doesn't match, even if it is signed by a trusted
+	// CA. If no CA certificates are configured, the fingerprint alone is used to verify the engine, which allows
+	// connecting to engines with self-signed certificates. This function can be called multiple times to accept
+	// multiple certificates, for example during a certificate rotation.
+	WithCertFingerprint(fingerprint string) BuildableTLSProvider
 }
 
 // TLS creates a BuildableTLSProvider that can be used to easily add trusted CA certificates and generally follows best
@@ -64,6 +76,9 @@ type standardTLSProvider struct {
 	certPool    *x509.CertPool
 	system      bool
 	configured  bool
+	// fingerprints are the pinned certificate fingerprints as passed by the caller. They are parsed in
+	// CreateTLSConfig so invalid values are reported as an error.
+	fingerprints []string
 }
 
 type standardTLSProviderDirectory struct {
@@ -125,6 +140,14 @@ func (s *standardTLSProvider) CACertsFromSystem() BuildableTLSProvider {
 	return s
 }
 
+func (s *standardTLSProvider) WithCertFingerprint(fingerprint string) BuildableTLSProvider {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.configured = true
+	s.fingerprints = append(s.fingerprints, fingerprint)
+	return s
+}
+
 func (s *standardTLSProvider) CreateTLSConfig() (*tls.Config, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -164,6 +187,20 @@ func (s *standardTLSProvider) CreateTLSConfig() (*tls.Config, error) {
 		KeyLogWriter:                nil,
 	}
 
+	if len(s.fingerprints) > 0 {
+		fingerprints, err := parseCertFingerprints(s.fingerprints)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.VerifyPeerCertificate = verifyCertFingerprint(fingerprints)
+		if !s.hasCASources() {
+			// Without a CA the standard verification would reject every certificate, the pinned fingerprint is
+			// verified in VerifyPeerCertificate instead.
+			tlsConfig.InsecureSkipVerify = true //nolint:gosec
+			return tlsConfig, nil
+		}
+	}
+
 	certPool := s.certPool
 	if certPool == nil {
 		var err error
@@ -282,3 +319,49 @@ func (s *standardTLSProvider) createCertPool() (*x509.CertPool, error) {
 	}
 	return certPool, nil
 }
+
+func (s *standardTLSProvider) hasCASources() bool {
+	return s.certPool != nil || s.system || len(s.caCerts) > 0 || len(s.files) > 0 || len(s.directories) > 0
+}
+
+func parseCertFingerprints(fingerprints []string) ([][]byte, error) {
+	result := make([][]byte, len(fingerprints))
+	for i, fingerprint := range fingerprints {
+		decoded, err := hex.DecodeString(strings.ReplaceAll(strings.TrimSpace(fingerprint), ":", ""))
+		if err != nil {
+			return nil, wrap(err, ETLSError, "invalid certificate fingerprint: %s", fingerprint)
+		}
+		if len(decoded) != sha256.Size {
+			return nil, newError(
+				ETLSError,
+				"invalid certificate fingerprint: %s (expected a SHA-256 fingerprint of %d bytes, got %d bytes)",
+				fingerprint,
+				sha256.Size,
+				len(decoded),
+			)
+		}
+		result[i] = decoded
+	}
+	return result, nil
+}
+
+// verifyCertFingerprint returns a VerifyPeerCertificate function that accepts the connection only if the leaf
+// certificate matches one of the fingerprints.
+func verifyCertFingerprint(fingerprints [][]byte) func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return newError(ETLSError, "the server presented no certificate")
+		}
+		fingerprint := sha256.Sum256(rawCerts[0])
+		for _, pinned := range fingerprints {
+			if bytes.Equal(fingerprint[:], pinned) {
+				return nil
+			}
+		}
+		return newError(
+			ETLSError,
+			"the server certificate fingerprint %s doesn't match any of the pinned fingerprints",
+			hex.EncodeToString(fingerprint[:]),
+		)
+	}
+}
@@ -1,9 +1,15 @@
 package ovirtclient_test
 
 import (
+	"crypto/sha256"
+	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
+	"testing"
 
 	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
 )
@@ -61,3 +67,86 @@ func ExampleBuildableTLSProvider_CACertsFromCertPool() {
 	}
 	// Output: Certificate verification is enabled.
 }
+
+func TestCertFingerprint(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	fingerprint := sha256.Sum256(srv.Certificate().Raw)
+	correctFingerprint := hex.EncodeToString(fingerprint[:])
+	wrongFingerprint := hex.EncodeToString(make([]byte, sha256.Size))
+	certPool := x509.NewCertPool()
+	certPool.AddCert(srv.Certificate())
+
+	for name, testCase := range map[string]struct {
+		provider ovirtclient.TLSProvider
+		success  bool
+	}{
+		"correct fingerprint": {
+			ovirtclient.TLS().WithCertFingerprint(correctFingerprint),
+			true,
+		},
+		"correct fingerprint with colons": {
+			ovirtclient.TLS().WithCertFingerprint(colonSeparated(correctFingerprint)),
+			true,
+		},
+		"wrong fingerprint": {
+			ovirtclient.TLS().WithCertFingerprint(wrongFingerprint),
+			false,
+		},
+		"wrong fingerprint with trusted CA": {
+			ovirtclient.TLS().CACertsFromCertPool(certPool).WithCertFingerprint(wrongFingerprint),
+			false,
+		},
+		"rotated fingerprint": {
+			ovirtclient.TLS().WithCertFingerprint(wrongFingerprint).WithCertFingerprint(correctFingerprint),
+			true,
+		},
+	} {
+		tlsConfig, err := testCase.provider.CreateTLSConfig()
+		if err != nil {
+			t.Fatalf("Failed to create TLS config for %s (%v)", name, err)
+		}
+		err = getWithTLSConfig(srv.URL, tlsConfig)
+		if testCase.success && err != nil {
+			t.Fatalf("Request with %s failed (%v)", name, err)
+		}
+		if !testCase.success && err == nil {
+			t.Fatalf("Request with %s did not fail.", name)
+		}
+	}
+
+	if _, err := ovirtclient.TLS().WithCertFingerprint("abcd").CreateTLSConfig(); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.ETLSError,
+	) {
+		t.Fatalf("Creating a TLS config with a truncated fingerprint did not result in an ETLSError (%v)", err)
+	}
+}
+
+func colonSeparated(hexString string) string {
+	result := ""
+	for i := 0; i < len(hexString); i += 2 {
+		if i > 0 {
+			result += ":"
+		}
+		result += hexString[i : i+2]
+	}
+	return result
+}
+
+func getWithTLSConfig(url string, tlsConfig *tls.Config) error {
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+		},
+	}
+	response, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	return response.Body.Close()
+}
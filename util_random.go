@@ -2,6 +2,8 @@ package ovirtclient
 
 import (
 	"math/rand"
+	"sync"
+	"time"
 )
 
 var letters = []byte("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ") //nolint:gochecknoglobals
@@ -13,3 +15,30 @@ func generateRandomID(length uint, r *rand.Rand) string {
 	}
 	return string(b)
 }
+
+// newNonSecureRandom creates a random number generator for generating non-security-relevant identifiers. Unlike the
+// generators returned by rand.New, it is safe for concurrent use.
+func newNonSecureRandom() *rand.Rand {
+	return rand.New(&lockedSource{ //nolint:gosec
+		lock:   &sync.Mutex{},
+		source: rand.NewSource(time.Now().UnixNano()),
+	})
+}
+
+// lockedSource guards a rand.Source with a mutex.
+type lockedSource struct {
+	lock   *sync.Mutex
+	source rand.Source
+}
+
+func (l *lockedSource) Int63() int64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.source.Int63()
+}
+
+func (l *lockedSource) Seed(seed int64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.source.Seed(seed)
+}
@@ -0,0 +1,44 @@
+package ovirtclient
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxResourceNameLength is the maximum length of a resource name (VM, template, disk alias, etc.) the oVirt Engine
+// accepts.
+const MaxResourceNameLength = 255
+
+var resourceNameRegexp = regexp.MustCompile(`^[\p{L}0-9._-]+$`)
+
+// ValidateResourceName checks if the passed name is acceptable as a resource name in oVirt. Names must not be empty,
+// must not be longer than MaxResourceNameLength, must not have leading or trailing whitespace, and may only contain
+// letters, numbers, underscores, dashes and dots. It returns an EBadArgument error if the name is not valid.
+//
+// This function is called internally by the create functions, but you can use it to validate user input before
+// submitting a request.
+func ValidateResourceName(name string) error {
+	if name == "" {
+		return newError(EBadArgument, "resource name cannot be empty")
+	}
+	if length := utf8.RuneCountInString(name); length > MaxResourceNameLength {
+		return newError(
+			EBadArgument,
+			"resource name is too long (%d characters, maximum is %d)",
+			length,
+			MaxResourceNameLength,
+		)
+	}
+	if strings.TrimSpace(name) != name {
+		return newError(EBadArgument, "resource name cannot have leading or trailing whitespace: \"%s\"", name)
+	}
+	if !resourceNameRegexp.MatchString(name) {
+		return newError(
+			EBadArgument,
+			"resource name may only contain letters, numbers, underscores, dashes and dots: \"%s\"",
+			name,
+		)
+	}
+	return nil
+}
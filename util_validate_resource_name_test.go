@@ -0,0 +1,76 @@
+package ovirtclient_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestValidateResourceName(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input string
+		valid bool
+	}{
+		"simple":                 {"test", true},
+		"dashes and underscores": {"test-vm_1", true},
+		"dots":                   {"test.example.com", true},
+		"maximum length":         {strings.Repeat("a", ovirtclient.MaxResourceNameLength), true},
+		"empty":                  {"", false},
+		"too long":               {strings.Repeat("a", ovirtclient.MaxResourceNameLength+1), false},
+		"leading space":          {" test", false},
+		"trailing space":         {"test ", false},
+		"trailing newline":       {"test\n", false},
+		"inner space":            {"test vm", false},
+		"unicode letters":        {"tést", true},
+		"emoji":                  {"test🚀", false},
+		"slash":                  {"test/vm", false},
+		"quote":                  {"test\"vm", false},
+		"wildcard":               {"test*", false},
+		"semicolon":              {"test;vm", false},
+	}
+
+	for name, tc := range testcases {
+		testcase := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := ovirtclient.ValidateResourceName(testcase.input)
+			if testcase.valid {
+				if err != nil {
+					t.Fatalf("Unexpected error when validating %q (%v)", testcase.input, err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("No error returned when validating invalid name %q", testcase.input)
+			}
+			var e ovirtclient.EngineError
+			if !errors.As(err, &e) {
+				t.Fatalf("Failed to convert returned error to EngineError (%v)", err)
+			}
+			if !e.HasCode(ovirtclient.EBadArgument) {
+				t.Fatalf("Unexpected error code: %s, instead of: %s", e.Code(), ovirtclient.EBadArgument)
+			}
+		})
+	}
+}
+
+func TestCreateTagWithInvalidName(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	_, err := helper.GetClient().CreateTag("invalid tag name", nil)
+	if err == nil {
+		t.Fatalf("Creating a tag with an invalid name did not result in an error.")
+	}
+	var e ovirtclient.EngineError
+	if !errors.As(err, &e) {
+		t.Fatalf("Failed to convert returned error to EngineError (%v)", err)
+	}
+	if !e.HasCode(ovirtclient.EBadArgument) {
+		t.Fatalf("Unexpected error code: %s, instead of: %s", e.Code(), ovirtclient.EBadArgument)
+	}
+}
@@ -22,12 +22,16 @@ import (
 //	    Query("correlation_id", correlationID).
 //	    Send()
 func (o *oVirtClient) waitForJobFinished(correlationID string, retries []RetryStrategy) error {
+	query, err := SearchField("correlation_id").Eq(correlationID).Build()
+	if err != nil {
+		return err
+	}
 	return retry(
 		fmt.Sprintf("waiting for job with correlation ID %s to finish", correlationID),
 		o.logger,
 		retries,
 		func() error {
-			jobResp, err := o.conn.SystemService().JobsService().List().Search(fmt.Sprintf("correlation_id=%s", correlationID)).Send()
+			jobResp, err := o.conn.SystemService().JobsService().List().Search(query).Send()
 			if err != nil {
 				return err
 			}
@@ -46,3 +50,46 @@ func (o *oVirtClient) waitForJobFinished(correlationID string, retries []RetrySt
 		},
 	)
 }
+
+// waitForJobSucceeded is identical to waitForJobFinished, but returns an error with the job description if any of the
+// jobs failed or was aborted. waitForJobFinished treats these as finished, since the following status checks usually
+// reveal the failure.
+func (o *oVirtClient) waitForJobSucceeded(correlationID string, retries []RetryStrategy) error {
+	query, err := SearchField("correlation_id").Eq(correlationID).Build()
+	if err != nil {
+		return err
+	}
+	var jobErr error
+	err = retry(
+		fmt.Sprintf("waiting for job with correlation ID %s to succeed", correlationID),
+		o.logger,
+		retries,
+		func() error {
+			jobResp, err := o.conn.SystemService().JobsService().List().Search(query).Send()
+			if err != nil {
+				return err
+			}
+			jobSlice, ok := jobResp.Jobs()
+			if !ok {
+				return newError(EPending, "job for correlation ID %s still pending", correlationID)
+			}
+			jobErr = nil
+			for _, job := range jobSlice.Slice() {
+				status, _ := job.Status()
+				switch status {
+				case ovirtsdk.JOBSTATUS_STARTED:
+					return newError(EPending, "job for correlation ID %s still pending", correlationID)
+				case ovirtsdk.JOBSTATUS_FAILED, ovirtsdk.JOBSTATUS_ABORTED:
+					description, _ := job.Description()
+					// The failure is returned after the retry loop, since retrying a failed job doesn't help.
+					jobErr = newError(EUnidentified, "job %q (correlation ID %s) %s", description, correlationID, status)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return jobErr
+}
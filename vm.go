@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	ovirtsdk "github.com/ovirt/go-ovirt"
 )
@@ -16,7 +17,22 @@ type VMID string
 
 // VMClient includes the methods required to deal with virtual machines.
 type VMClient interface {
-	// CreateVM creates a virtual machine.
+	// CreateVM creates a virtual machine from a template.
+	//
+	// If templateID is empty, the VM is created from scratch based on the blank template as returned by
+	// GetBlankTemplate. Since the blank template has no disks and no meaningful defaults, the memory must be set in
+	// the optional parameters and no template disk options may be passed. The VM also needs something to boot from,
+	// so at least one new or existing disk or an explicit boot device must be passed. A minimal parameter set for a
+	// VM created from scratch that boots from the network is therefore:
+	//
+	//	vm, err := client.CreateVM(
+	//	    clusterID,
+	//	    "",
+	//	    "my-vm",
+	//	    ovirtclient.NewCreateVMParams().
+	//	        MustWithMemory(2 * 1024 * 1024 * 1024).
+	//	        WithOS(ovirtclient.NewVMOSParameters().MustWithBootDevices(ovirtclient.BootDeviceNetwork)),
+	//	)
 	CreateVM(
 		clusterID ClusterID,
 		templateID TemplateID,
@@ -26,34 +42,213 @@ type VMClient interface {
 	) (VM, error)
 	// GetVM returns a single virtual machine based on an ID.
 	GetVM(id VMID, retries ...RetryStrategy) (VM, error)
+	// GetVMCluster fetches the VM and then the cluster it belongs to. Nothing is cached, both are fetched on every
+	// call.
+	GetVMCluster(id VMID, retries ...RetryStrategy) (Cluster, error)
+	// VMExists returns true if a VM with the specified ID exists. Errors other than the VM not being found, for
+	// example connection errors, are returned.
+	VMExists(id VMID, retries ...RetryStrategy) (bool, error)
+	// ResolveVMID returns the ID of the VM identified by nameOrID. The value is tried as an ID first and as a VM name
+	// if no VM with that ID exists. ENotFound is returned if neither matches, EConflict if the name is ambiguous.
+	ResolveVMID(nameOrID string, retries ...RetryStrategy) (VMID, error)
 	// GetVMByName returns a single virtual machine based on a Name.
 	GetVMByName(name string, retries ...RetryStrategy) (VM, error)
+	// GetVMByMAC returns the VM and its NIC with the specified MAC address. The address may be written in any notation
+	// net.ParseMAC accepts, in upper or lower case. An ENotFound error is returned if no NIC has the address, and an
+	// EConflict error if several NICs have it.
+	GetVMByMAC(mac string, retries ...RetryStrategy) (VM, NIC, error)
+	// ImportVMFromOVA imports a VM from an OVA file on a host into the cluster, placing its disks on the storage
+	// domain. The call follows the import job and returns the imported VM once it is down. The host must be up and
+	// able to read filePath, which must be absolute. Failures of the import job are returned with the job
+	// description.
+	ImportVMFromOVA(
+		hostID HostID,
+		filePath string,
+		clusterID ClusterID,
+		storageDomainID StorageDomainID,
+		params ImportOVAParameters,
+		retries ...RetryStrategy,
+	) (VM, error)
 	// UpdateVM updates the virtual machine with the given parameters.
 	// Use UpdateVMParams to obtain a builder for the params.
 	UpdateVM(id VMID, params UpdateVMParameters, retries ...RetryStrategy) (VM, error)
+	// HotPlugVMMemory adds the specified amount of memory to a running VM. The amount must be a multiple of
+	// MemoryHotPlugBlockSize and the resulting memory must not exceed the maximum memory set in the memory policy of
+	// the VM. If the guest operating system does not support memory hot-plug the engine rejects the request with an
+	// EBadArgument error. To change the memory of a VM that is not running use UpdateVM.
+	HotPlugVMMemory(id VMID, additionalBytes uint64, retries ...RetryStrategy) (VM, error)
+	// HotUnplugVMMemory removes the specified amount of memory from a running VM. Memory hot-unplug is often not
+	// supported: the engine can only remove memory that has previously been hot-plugged, and older engines do not
+	// support it at all, in which case an EUnsupported error is returned.
+	HotUnplugVMMemory(id VMID, removedBytes uint64, retries ...RetryStrategy) (VM, error)
+	// SetVMMemoryPolicy changes the guaranteed memory, maximum memory and ballooning of the VM. Settings left nil in
+	// the parameters are kept. The resulting policy must satisfy guaranteed <= memory <= max, otherwise an
+	// EBadArgument error is returned. On a running VM, the engine stages the changes it can't apply immediately for
+	// the next restart, the returned VM reports this in NextRunConfigurationExists.
+	SetVMMemoryPolicy(id VMID, params MemoryPolicyParameters, retries ...RetryStrategy) (VM, error)
+	// HotPlugVMCPU raises the number of vCPUs of a running VM to targetVCPUs by adding sockets, keeping the cores
+	// per socket and threads per core. The target must be higher than the current vCPU count and a multiple of the
+	// vCPUs per socket, otherwise an EBadArgument error is returned. The target is also checked against the
+	// MaxNumOfVmCpus and MaxNumOfVmSockets engine options if the engine exposes them. An EUnsupported error is
+	// returned if the VM is not running.
+	HotPlugVMCPU(id VMID, targetVCPUs uint, retries ...RetryStrategy) (VM, error)
+	// SetVMSerialNumber changes the serial number the VM reports in its SMBIOS data. The custom serial number must
+	// be set if and only if the policy is SerialNumberPolicyCustom. The change takes effect on the next VM start.
+	SetVMSerialNumber(id VMID, policy SerialNumberPolicy, custom string, retries ...RetryStrategy) (VM, error)
+	// SetVMRNGDevice adds a virtio RNG device to the VM or changes the existing one. The guest may read rateBytes
+	// bytes of entropy per ratePeriod, pass 0 for both to leave the rate unlimited. The change takes effect on the
+	// next VM start.
+	SetVMRNGDevice(
+		id VMID,
+		source RNGSource,
+		rateBytes uint,
+		ratePeriod time.Duration,
+		retries ...RetryStrategy,
+	) (VM, error)
+	// GetVMCompatibilityVersion returns the compatibility version the VM runs with. This is the custom compatibility
+	// version of the VM if it has one, or the compatibility version of its cluster otherwise.
+	GetVMCompatibilityVersion(id VMID, retries ...RetryStrategy) (CompatibilityVersion, error)
+	// SetVMComment replaces the comment of the VM. Unlike UpdateVM it sends only the comment, so concurrent changes
+	// to other fields of the VM are not overwritten.
+	SetVMComment(id VMID, comment string, retries ...RetryStrategy) error
+	// SetVMDescription replaces the description of the VM, sending only the description like SetVMComment. The
+	// description must not be longer than VMDescriptionMaxLength characters.
+	SetVMDescription(id VMID, description string, retries ...RetryStrategy) error
+	// SetVMStateless changes whether the VM discards all changes to its disks when it is shut down. The change takes
+	// effect the next time the VM is started.
+	SetVMStateless(id VMID, stateless bool, retries ...RetryStrategy) error
+	// SetVMDeleteProtected changes whether the VM is protected against removal. RemoveVM returns an EConflict error
+	// for protected VMs unless RemoveVMParameters.AllowDeleteProtected is set.
+	SetVMDeleteProtected(id VMID, deleteProtected bool, retries ...RetryStrategy) error
+	// SetVMLease places the VM lease on the specified storage domain, moving it if the VM already has one. An
+	// EBadArgument error is returned if the storage domain is not a data domain.
+	SetVMLease(id VMID, storageDomainID StorageDomainID, retries ...RetryStrategy) error
+	// ClearVMLease removes the VM lease.
+	ClearVMLease(id VMID, retries ...RetryStrategy) error
+	// SetVMStorageErrorResumeBehaviour changes what the VM does after it was paused because of a storage I/O error
+	// and the storage becomes available again. The change takes effect on the next start of the VM.
+	SetVMStorageErrorResumeBehaviour(
+		id VMID,
+		behaviour StorageErrorResumeBehaviour,
+		retries ...RetryStrategy,
+	) error
+	// SetVMSpiceFileTransfer enables or disables copying files from the SPICE client into the VM. It returns an
+	// EConflict error if the VM doesn't use a SPICE display.
+	SetVMSpiceFileTransfer(id VMID, enabled bool, retries ...RetryStrategy) error
+	// SetVMSpiceCopyPaste enables or disables the shared clipboard between the SPICE client and the VM. It returns
+	// an EConflict error if the VM doesn't use a SPICE display.
+	SetVMSpiceCopyPaste(id VMID, enabled bool, retries ...RetryStrategy) error
+	// SetVMDirectKernel changes the direct kernel boot settings of the VM. Passing empty strings for all three
+	// values makes the VM boot normally again. See BuildableVMOSParameters.WithDirectKernel for details. The change
+	// takes effect on the next start of the VM.
+	SetVMDirectKernel(id VMID, kernelPath string, initrdPath string, cmdline string, retries ...RetryStrategy) error
+	// ListVMHostDevices returns the host devices passed through to the VM.
+	ListVMHostDevices(vmID VMID, retries ...RetryStrategy) ([]HostDevice, error)
+	// AddHostDeviceToVM passes the device with the specified name through to the VM. The device must exist on the
+	// host the VM is pinned to, or runs on if it is not pinned. An ENotFound error is returned if the device doesn't
+	// exist on that host and an EConflict error if it is attached to a different VM.
+	AddHostDeviceToVM(vmID VMID, hostDeviceName string, retries ...RetryStrategy) error
+	// GetVMNextRunConfig returns the configuration the VM will have after its next restart, including changes that
+	// were made while it was running. The returned bool is true if such staged changes exist, meaning a restart is
+	// needed for them to take effect.
+	GetVMNextRunConfig(id VMID, retries ...RetryStrategy) (VM, bool, error)
+	// DiffVMNextRun compares the current configuration of the VM with the one it will have after its next restart and
+	// returns the settings that differ, for example to tell the user which changes need a restart. The diff is empty
+	// if no change is pending.
+	DiffVMNextRun(vmID VMID, retries ...RetryStrategy) (VMConfigDiff, error)
+	// SetVMLabels stores the labels in a reserved block at the end of the VM comment, replacing any labels stored
+	// before. The human-written part of the comment is preserved. An empty map removes the block. See
+	// VMLabelsBlockStart for the encoding.
+	SetVMLabels(id VMID, labels map[string]string, retries ...RetryStrategy) error
+	// GetVMLabels returns the labels stored by SetVMLabels. A VM without labels returns an empty map.
+	GetVMLabels(id VMID, retries ...RetryStrategy) (map[string]string, error)
 	// AutoOptimizeVMCPUPinningSettings sets the CPU settings to optimized.
 	AutoOptimizeVMCPUPinningSettings(id VMID, optimize bool, retries ...RetryStrategy) error
 	// StartVM triggers a VM start. The actual VM startup will take time and should be waited for via the
 	// WaitForVMStatus call.
 	StartVM(id VMID, retries ...RetryStrategy) error
+	// RunVMOnce starts a VM with overrides that only apply to this run, such as a different boot order, an ISO
+	// inserted into the CD-ROM or a cloud-init configuration, and waits until the VM is up. The overrides are not
+	// persisted. See RunOnceParameters for details.
+	RunVMOnce(id VMID, params RunOnceParameters, retries ...RetryStrategy) (VM, error)
 	// StopVM triggers a VM power-off. The actual VM stop will take time and should be waited for via the
 	// WaitForVMStatus call. The force parameter will cause the shutdown to proceed even if a backup is currently
 	// running.
 	StopVM(id VMID, force bool, retries ...RetryStrategy) error
+	// StopVMWithParams triggers a VM power-off with the specified parameters. See StopVM for details.
+	StopVMWithParams(id VMID, params StopVMParameters, retries ...RetryStrategy) error
 	// ShutdownVM triggers a VM shutdown. The actual VM shutdown will take time and should be waited for via the
 	// WaitForVMStatus call. The force parameter will cause the shutdown to proceed even if a backup is currently
 	// running.
 	ShutdownVM(id VMID, force bool, retries ...RetryStrategy) error
+	// StartVMs starts all specified VMs, at most 8 at a time, and waits for each of them to reach the up status. VMs
+	// that are already up are left running. If some VMs fail to start, the others are still started and a MultiError
+	// is returned with the failures keyed by VM ID. Use WithBatchProgress to follow the state of each VM.
+	StartVMs(vmIDs []VMID, retries ...RetryStrategy) error
+	// StopVMs powers off all specified VMs in parallel like StartVMs and waits for each of them to reach the down
+	// status. The force parameter is passed on to StopVM.
+	StopVMs(vmIDs []VMID, force bool, retries ...RetryStrategy) error
+	// ShutdownEnvironment shuts down the VMs tier by tier in the sequence given in order, for example application
+	// servers before their databases. The VMs of a tier are shut down in parallel and the next tier only starts once
+	// all of them are down. A VM that is not down after gracePeriod is powered off. VMs in vmIDs that are not part of
+	// any tier are shut down last. A failing VM doesn't stop the teardown; the failures are returned as a
+	// MultiError keyed by VM ID.
+	ShutdownEnvironment(vmIDs []VMID, order [][]VMID, gracePeriod time.Duration, retries ...RetryStrategy) error
+	// ShutdownVMWithParams triggers a VM shutdown with the specified parameters. See ShutdownVM for details.
+	ShutdownVMWithParams(id VMID, params StopVMParameters, retries ...RetryStrategy) error
+	// MigrateVM live migrates a running VM to another host and waits until the migration is complete. The tuning
+	// options in params, such as the maximum downtime, are stored in the VM configuration before the migration
+	// starts. An EConflict error is returned if the VM is not running or is pinned to its host. The params may be nil.
+	MigrateVM(id VMID, params MigrateVMParameters, retries ...RetryStrategy) (VM, error)
+	// GetVMMigrationStatus returns the phase and the progress of an ongoing migration of the VM, so observers can
+	// follow a migration started by MigrateVM or by the engine. A VM that is not being migrated has the
+	// VMMigrationPhaseNone phase.
+	GetVMMigrationStatus(id VMID, retries ...RetryStrategy) (VMMigrationStatus, error)
+	// GetVMStatistics returns the current CPU, memory, network and disk usage of the VM. Statistics the engine
+	// doesn't report, for example because the VM is down or has no guest agent, are returned as 0.
+	GetVMStatistics(id VMID, retries ...RetryStrategy) (VMStatistics, error)
+	// IsHostedEngineVM returns true if the specified VM is the hosted engine VM. StopVM, ShutdownVM and RemoveVM
+	// refuse to act on the hosted engine VM with an EConflict error unless AllowHostedEngine is set in the
+	// parameters of their WithParams variant.
+	IsHostedEngineVM(id VMID, retries ...RetryStrategy) (bool, error)
+	// GetVMGuestInfo returns the information reported by the guest agent running in the VM. If the guest agent
+	// hasn't reported any data the returned fields are empty, no error is returned in this case.
+	GetVMGuestInfo(id VMID, retries ...RetryStrategy) (GuestInfo, error)
+	// GetVMHealth returns a summary of the VM health, combining the run status, the high availability setting and
+	// the guest agent responsiveness. Unlike the status, the health also flags VMs that are up but degraded.
+	GetVMHealth(id VMID, retries ...RetryStrategy) (VMHealth, error)
+	// RebootVM reboots a running VM and waits for it to return to the up state. An EConflict error is returned if
+	// the VM is not currently up.
+	RebootVM(id VMID, retries ...RetryStrategy) error
 	// WaitForVMStatus waits for the VM to reach the desired status.
 	WaitForVMStatus(id VMID, status VMStatus, retries ...RetryStrategy) (VM, error)
-	// ListVMs returns a list of all virtual machines.
+	// ListVMs returns a list of all virtual machines. It fetches all pages from the engine, use ListVMsPage to fetch
+	// a single page.
 	ListVMs(retries ...RetryStrategy) ([]VM, error)
+	// ListVMsPage returns a single page of virtual machines sorted by name. Use PageParams to get a builder for the
+	// parameters and pass the NextPageToken of the returned page to fetch the next one.
+	ListVMsPage(params PageParameters, retries ...RetryStrategy) (VMPage, error)
 	// SearchVMs lists all virtual machines matching a certain criteria specified in params.
 	SearchVMs(params VMSearchParameters, retries ...RetryStrategy) ([]VM, error)
-	// RemoveVM removes a virtual machine specified by id.
+	// ListVMsInCluster lists the VMs in the specified cluster. The filtering happens on the engine side, so this is
+	// cheaper than ListVMs for large installations. An empty cluster results in an empty list.
+	ListVMsInCluster(clusterID ClusterID, retries ...RetryStrategy) ([]VM, error)
+	// ListVMsOnHost lists the VMs currently running on the specified host. The filtering happens on the engine side.
+	// A host without VMs results in an empty list.
+	ListVMsOnHost(hostID HostID, retries ...RetryStrategy) ([]VM, error)
+	// RemoveVM removes a virtual machine specified by id together with its attached disks. It returns an EConflict
+	// error if the VM is still running and waits until the VM is gone otherwise.
 	RemoveVM(id VMID, retries ...RetryStrategy) error
+	// RemoveVMWithParams removes a virtual machine specified by id with the specified parameters. The parameters
+	// control whether the attached disks are kept and whether a running VM is stopped before removal. Use
+	// RemoveVMParams to get a builder for the parameters.
+	RemoveVMWithParams(id VMID, params RemoveVMParameters, retries ...RetryStrategy) error
 	// AddTagToVM Add tag specified by id to a VM.
 	AddTagToVM(id VMID, tagID TagID, retries ...RetryStrategy) error
+	// AddTagToVMs adds the tag to all specified VMs, making several API calls in parallel. VMs that already have
+	// the tag are left unchanged. If the tag can be added to some VMs only, a MultiError is returned with the
+	// failures keyed by VM ID.
+	AddTagToVMs(tagID TagID, vmIDs []VMID, retries ...RetryStrategy) error
 	// AddTagToVMByName Add tag specified by Name to a VM.
 	AddTagToVMByName(id VMID, tagName string, retries ...RetryStrategy) error
 	// RemoveTagFromVM removes the specified tag from the specified VM.
@@ -238,21 +433,74 @@ type VMData interface {
 
 	// OS returns the operating system structure.
 	OS() VMOS
+
+	// TimeZone returns the time zone of the VM's hardware clock, or an empty string if the engine default is used.
+	TimeZone() string
+	// BIOSType returns the chipset and firmware combination of the VM.
+	BIOSType() BIOSType
+	// SerialNumber returns the SMBIOS serial number configuration of the VM, or nil if the cluster default is used.
+	SerialNumber() VMSerialNumber
+	// RNGDevice returns the virtio RNG device of the VM, or nil if the VM has none.
+	RNGDevice() VMRNGDevice
+	// CustomCompatibilityVersion returns the compatibility version pinned for this VM, or nil if the VM follows the
+	// version of its cluster.
+	CustomCompatibilityVersion() CompatibilityVersion
+	// Stateless returns true if the VM discards all changes to its disks when it is shut down.
+	Stateless() bool
+	// DeleteProtected returns true if the VM is protected against removal.
+	DeleteProtected() bool
+	// LeaseStorageDomainID returns the ID of the storage domain holding the VM lease, or nil if the VM has no lease.
+	LeaseStorageDomainID() *StorageDomainID
+	// StorageErrorResumeBehaviour returns what the VM does once the storage recovers from an I/O error that paused
+	// it. It is empty if the engine didn't report the setting.
+	StorageErrorResumeBehaviour() StorageErrorResumeBehaviour
+	// DisplayType returns the protocol of the graphical display of the VM. It is empty if the VM is headless.
+	DisplayType() DisplayType
+	// SpiceFileTransfer returns true if files can be copied from the SPICE client into the VM.
+	SpiceFileTransfer() bool
+	// SpiceCopyPaste returns true if the SPICE client shares its clipboard with the VM.
+	SpiceCopyPaste() bool
+	// NextRunConfigurationExists returns true if changes to the running VM are staged and only take effect after the
+	// VM is restarted. The VM returned from UpdateVM and the other update calls reports this for the changes just
+	// made. Use GetVMNextRunConfig to see the staged configuration.
+	NextRunConfigurationExists() bool
 }
 
 // VMOS is the structure describing the virtual machine operating system, if set.
 type VMOS interface {
 	Type() string
+	// Kernel returns the path of the kernel the VM boots directly, bypassing the boot loader. It is empty if the VM
+	// boots normally.
+	Kernel() string
+	// Initrd returns the path of the initial ramdisk used with the direct kernel boot.
+	Initrd() string
+	// Cmdline returns the kernel command line used with the direct kernel boot.
+	Cmdline() string
 }
 
 type vmOS struct {
-	t string
+	t       string
+	kernel  string
+	initrd  string
+	cmdline string
 }
 
 func (v vmOS) Type() string {
 	return v.t
 }
 
+func (v vmOS) Kernel() string {
+	return v.kernel
+}
+
+func (v vmOS) Initrd() string {
+	return v.initrd
+}
+
+func (v vmOS) Cmdline() string {
+	return v.cmdline
+}
+
 // VMPlacementPolicy is the structure that holds the rules for VM migration to other hosts.
 type VMPlacementPolicy interface {
 	Affinity() *VMAffinity
@@ -300,17 +548,25 @@ type VMCPU interface {
 	Topo() VMCPUTopo
 	// Mode returns the mode of the CPU.
 	Mode() *CPUMode
+	// CustomModel returns the CPU model the VM uses instead of the cluster CPU type, including the custom CPU flags,
+	// for example "Skylake-Client,+spec-ctrl". It is empty if the VM uses the cluster CPU type.
+	CustomModel() string
 }
 
 type vmCPU struct {
-	topo *vmCPUTopo
-	mode *CPUMode
+	topo        *vmCPUTopo
+	mode        *CPUMode
+	customModel string
 }
 
 func (v vmCPU) Mode() *CPUMode {
 	return v.mode
 }
 
+func (v vmCPU) CustomModel() string {
+	return v.customModel
+}
+
 func (v vmCPU) Topo() VMCPUTopo {
 	return v.topo
 }
@@ -320,7 +576,8 @@ func (v *vmCPU) clone() *vmCPU {
 		return nil
 	}
 	return &vmCPU{
-		topo: v.topo.clone(),
+		topo:        v.topo.clone(),
+		customModel: v.customModel,
 	}
 }
 
@@ -404,8 +661,19 @@ type BuildableInitialization interface {
 	WithNicConfiguration(nic NicConfiguration) BuildableInitialization
 	WithActiveDirectoryOu(activeDirectoryOu string) BuildableInitialization
 	WithAuthorizedSshKeys(authorizedSshKeys string) BuildableInitialization
+	// WithDnsSearch sets the DNS search domains as a space-separated list.
 	WithDnsSearch(dnsSearch string) BuildableInitialization
+	// WithDNSSearchList sets the DNS search domains. An EBadArgument error is returned if an entry is not a valid
+	// domain name.
+	WithDNSSearchList(domains []string) (BuildableInitialization, error)
+	// MustWithDNSSearchList is identical to WithDNSSearchList, but panics instead of returning an error.
+	MustWithDNSSearchList(domains []string) BuildableInitialization
+	// WithDnsServers sets the DNS servers as a space-separated list of IP addresses.
 	WithDnsServers(dnsServers string) BuildableInitialization
+	// WithDNSServerList sets the DNS servers. An EBadArgument error is returned if an entry is not an IP address.
+	WithDNSServerList(servers []string) (BuildableInitialization, error)
+	// MustWithDNSServerList is identical to WithDNSServerList, but panics instead of returning an error.
+	MustWithDNSServerList(servers []string) BuildableInitialization
 	WithDomain(domain string) BuildableInitialization
 	WithInputLocale(inputLocale string) BuildableInitialization
 	WithOrgName(orgName string) BuildableInitialization
@@ -885,17 +1153,61 @@ type VM interface {
 	// Update updates the virtual machine with the given parameters. Use UpdateVMParams to
 	// get a builder for the parameters.
 	Update(params UpdateVMParameters, retries ...RetryStrategy) (VM, error)
+	// HotPlugMemory adds memory to the running VM. See VMClient.HotPlugVMMemory for details.
+	HotPlugMemory(additionalBytes uint64, retries ...RetryStrategy) (VM, error)
+	// HotUnplugMemory removes memory from the running VM. See VMClient.HotUnplugVMMemory for details.
+	HotUnplugMemory(removedBytes uint64, retries ...RetryStrategy) (VM, error)
+	// SetMemoryPolicy changes the memory policy of the VM. See VMClient.SetVMMemoryPolicy for details.
+	SetMemoryPolicy(params MemoryPolicyParameters, retries ...RetryStrategy) (VM, error)
+	// HotPlugCPU raises the number of vCPUs of the running VM. See VMClient.HotPlugVMCPU for details.
+	HotPlugCPU(targetVCPUs uint, retries ...RetryStrategy) (VM, error)
+	// SetSerialNumber changes the SMBIOS serial number of the VM. See VMClient.SetVMSerialNumber for details.
+	SetSerialNumber(policy SerialNumberPolicy, custom string, retries ...RetryStrategy) (VM, error)
+	// SetRNGDevice adds or changes the RNG device of the VM. See VMClient.SetVMRNGDevice for details.
+	SetRNGDevice(source RNGSource, rateBytes uint, ratePeriod time.Duration, retries ...RetryStrategy) (VM, error)
+	// SetComment replaces the comment of the VM. See VMClient.SetVMComment for details.
+	SetComment(comment string, retries ...RetryStrategy) error
+	// SetDescription replaces the description of the VM. See VMClient.SetVMDescription for details.
+	SetDescription(description string, retries ...RetryStrategy) error
+	// CreateSnapshot creates a snapshot of the current VM. See SnapshotClient.CreateSnapshot for details.
+	CreateSnapshot(params OptionalSnapshotParameters, retries ...RetryStrategy) (Snapshot, error)
+	// ListSnapshots lists the snapshots of the current VM.
+	ListSnapshots(retries ...RetryStrategy) ([]Snapshot, error)
 	// Remove removes the current VM. This involves an API call and may be slow.
 	Remove(retries ...RetryStrategy) error
 
 	// Start will cause a VM to start. The actual start process takes some time and should be checked via WaitForStatus.
 	Start(retries ...RetryStrategy) error
+	// RunOnce starts the VM with one-time overrides and waits until it is up. See VMClient.RunVMOnce for details.
+	RunOnce(params RunOnceParameters, retries ...RetryStrategy) (VM, error)
 	// Stop will cause the VM to power-off. The force parameter will cause the VM to stop even if a backup is currently
 	// running.
 	Stop(force bool, retries ...RetryStrategy) error
 	// Shutdown will cause the VM to shut down. The force parameter will cause the VM to shut down even if a backup
 	// is currently running.
 	Shutdown(force bool, retries ...RetryStrategy) error
+	// Reboot reboots the VM and waits for it to come back up.
+	Reboot(retries ...RetryStrategy) error
+	// Migrate live migrates the VM to another host. See VMClient.MigrateVM for details.
+	Migrate(params MigrateVMParameters, retries ...RetryStrategy) (VM, error)
+	// MigrationStatus returns the status of an ongoing migration of the VM. See VMClient.GetVMMigrationStatus for
+	// details.
+	MigrationStatus(retries ...RetryStrategy) (VMMigrationStatus, error)
+	// Statistics returns the current resource usage of the VM. See VMClient.GetVMStatistics for details.
+	Statistics(retries ...RetryStrategy) (VMStatistics, error)
+	// GuestInfo returns the information reported by the guest agent running in the VM.
+	GuestInfo(retries ...RetryStrategy) (GuestInfo, error)
+	// ListPermissions lists the permissions that apply to the VM. See PermissionClient.ListVMPermissions for details.
+	ListPermissions(retries ...RetryStrategy) ([]Permission, error)
+	// AddPermission grants a role to a user or group on the VM. See PermissionClient.AddVMPermission for details.
+	AddPermission(
+		principalType PermissionPrincipalType,
+		principalID string,
+		roleID RoleID,
+		retries ...RetryStrategy,
+	) (Permission, error)
+	// Health returns a summary of the current VM health. See VMClient.GetVMHealth for details.
+	Health(retries ...RetryStrategy) (VMHealth, error)
 	// WaitForStatus will wait until the VM reaches the desired status. If the status is not reached within the
 	// specified amount of retries, an error will be returned. If the VM enters the desired state, an updated VM
 	// object will be returned.
@@ -929,6 +1241,8 @@ type VM interface {
 
 	// GetHost retrieves the host object for the current VM. If the VM is not running, nil will be returned.
 	GetHost(retries ...RetryStrategy) (Host, error)
+	// GetCluster fetches the cluster the VM belongs to.
+	GetCluster(retries ...RetryStrategy) (Cluster, error)
 
 	// GetIPAddresses fetches the IP addresses and returns a map of the interface name and list of IP addresses.
 	//
@@ -970,14 +1284,27 @@ type VM interface {
 	// ListTags lists the tags attached to the current VM.
 	ListTags(retries ...RetryStrategy) (result []Tag, err error)
 
+	// AddAffinityLabel adds the affinity label to the current VM. See AffinityLabelClient.AddAffinityLabelToVM for
+	// details.
+	AddAffinityLabel(labelID AffinityLabelID, retries ...RetryStrategy) error
+	// RemoveAffinityLabel removes the affinity label from the current VM.
+	RemoveAffinityLabel(labelID AffinityLabelID, retries ...RetryStrategy) error
+	// ListAffinityLabels lists the affinity labels of the current VM.
+	ListAffinityLabels(retries ...RetryStrategy) ([]AffinityLabel, error)
+
 	// ListGraphicsConsoles lists the graphics consoles on the VM.
 	ListGraphicsConsoles(retries ...RetryStrategy) ([]VMGraphicsConsole, error)
 
-	// SerialConsole returns true if the VM has a serial console.
+	// SerialConsole returns true if the VM has a serial console. The engine only reports this field if the VM was
+	// fetched with a context created by WithAllContent.
 	SerialConsole() bool
 
-	// SoundcardEnabled returns true if a soundcard for the VM is enabled.
+	// SoundcardEnabled returns true if a soundcard for the VM is enabled. The engine only reports this field if the
+	// VM was fetched with a context created by WithAllContent.
 	SoundcardEnabled() bool
+
+	// HostedEngine returns true if the VM is the hosted engine VM running the oVirt Engine itself.
+	HostedEngine() bool
 }
 
 // VMSearchParameters declares the parameters that can be passed to a VM search. Each parameter
@@ -992,6 +1319,11 @@ type VMSearchParameters interface {
 	Statuses() *VMStatusList
 	// NotStatuses will return a list of not acceptable statuses for this VM search.
 	NotStatuses() *VMStatusList
+	// Max returns the maximum number of VMs the engine should return. If nil, all matching VMs are returned.
+	Max() *uint
+	// CaseSensitive returns if the name and tag criteria are matched taking case into account. If nil, the engine
+	// default applies, which is case-sensitive matching.
+	CaseSensitive() *bool
 }
 
 // BuildableVMSearchParameters is a buildable version of VMSearchParameters.
@@ -1010,6 +1342,10 @@ type BuildableVMSearchParameters interface {
 	WithStatuses(list VMStatusList) BuildableVMSearchParameters
 	// WithNotStatuses will return the statuses the returned VMs should not be in.
 	WithNotStatuses(list VMStatusList) BuildableVMSearchParameters
+	// WithMax limits the number of VMs returned. It must be at least 1.
+	WithMax(max uint) BuildableVMSearchParameters
+	// WithCaseSensitive sets if the name and tag criteria should be matched case-sensitively.
+	WithCaseSensitive(caseSensitive bool) BuildableVMSearchParameters
 }
 
 // VMSearchParams creates a buildable set of search parameters for easier use.
@@ -1024,8 +1360,10 @@ type vmSearchParams struct {
 
 	name        *string
 	tag         *string
-	statuses    *VMStatusList
-	notStatuses *VMStatusList
+	statuses      *VMStatusList
+	notStatuses   *VMStatusList
+	max           *uint
+	caseSensitive *bool
 }
 
 func (v *vmSearchParams) WithStatus(status VMStatus) BuildableVMSearchParameters {
@@ -1098,6 +1436,32 @@ func (v *vmSearchParams) WithNotStatuses(list VMStatusList) BuildableVMSearchPar
 	return v
 }
 
+func (v *vmSearchParams) Max() *uint {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.max
+}
+
+func (v *vmSearchParams) WithMax(max uint) BuildableVMSearchParameters {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.max = &max
+	return v
+}
+
+func (v *vmSearchParams) CaseSensitive() *bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.caseSensitive
+}
+
+func (v *vmSearchParams) WithCaseSensitive(caseSensitive bool) BuildableVMSearchParameters {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.caseSensitive = &caseSensitive
+	return v
+}
+
 // OptionalVMParameters are a list of parameters that can be, but must not necessarily be added on VM creation. This
 // interface is expected to be extended in the future.
 type OptionalVMParameters interface {
@@ -1128,6 +1492,10 @@ type OptionalVMParameters interface {
 
 	// Disks returns a list of disks that are to be changed from the template.
 	Disks() []OptionalVMDiskParameters
+	// NewDisks returns the disks to create along with the VM.
+	NewDisks() []NewDiskSpec
+	// ExistingDisks returns the existing floating disks to attach to the VM on creation.
+	ExistingDisks() []ExistingDiskAttachment
 
 	// PlacementPolicy returns a VM placement policy to apply, if any.
 	PlacementPolicy() *VMPlacementPolicyParameters
@@ -1146,6 +1514,43 @@ type OptionalVMParameters interface {
 
 	// SoundcardEnabled returns if a soundcard should be created or not.
 	SoundcardEnabled() *bool
+
+	// TimeZone returns the time zone of the VM's hardware clock, if set.
+	TimeZone() *string
+
+	// BIOSType returns the chipset and firmware combination of the VM, if set.
+	BIOSType() *BIOSType
+
+	// Watchdog returns the watchdog device to add to the VM, or nil if none should be added.
+	Watchdog() VMWatchdogParameters
+
+	// SerialNumber returns the SMBIOS serial number configuration of the VM, or nil if the cluster default should be
+	// used.
+	SerialNumber() VMSerialNumber
+
+	// RNGDevice returns the virtio RNG device to add to the VM, or nil if the template setting should be used.
+	RNGDevice() VMRNGDevice
+
+	// CustomCompatibilityVersion returns the compatibility version to pin for the VM, or nil if the VM should follow
+	// the version of its cluster.
+	CustomCompatibilityVersion() CompatibilityVersion
+
+	// Stateless returns whether the VM should be stateless, or nil if the template setting should be used.
+	Stateless() *bool
+	// DeleteProtected returns whether the VM should be protected against removal, or nil if the template setting
+	// should be used.
+	DeleteProtected() *bool
+	// LeaseStorageDomainID returns the storage domain holding the VM lease, or nil if the VM should have no lease.
+	LeaseStorageDomainID() *StorageDomainID
+	// StorageErrorResumeBehaviour returns the behaviour of the VM after a storage I/O error, or nil if the engine
+	// default should be used.
+	StorageErrorResumeBehaviour() *StorageErrorResumeBehaviour
+	// SpiceFileTransfer returns whether files can be copied from the SPICE client into the VM, or nil if the
+	// template setting should be used.
+	SpiceFileTransfer() *bool
+	// SpiceCopyPaste returns whether the SPICE client shares its clipboard with the VM, or nil if the template
+	// setting should be used.
+	SpiceCopyPaste() *bool
 }
 
 // BuildableVMParameters is a variant of OptionalVMParameters that can be changed using the supplied
@@ -1204,6 +1609,19 @@ type BuildableVMParameters interface {
 	WithDisks(disks []OptionalVMDiskParameters) (BuildableVMParameters, error)
 	// MustWithDisks is identical to WithDisks, but panics instead of returning an error.
 	MustWithDisks(disks []OptionalVMDiskParameters) BuildableVMParameters
+	// WithNewDisks creates the specified disks as part of the VM creation. The engine provisions them together with
+	// the VM, which is faster than creating and attaching them one by one afterwards. CreateVM returns once all
+	// disks are ready. This also works for VMs created without a template. At most one disk can be bootable.
+	WithNewDisks(disks []NewDiskSpec) (BuildableVMParameters, error)
+	// MustWithNewDisks is identical to WithNewDisks, but panics instead of returning an error.
+	MustWithNewDisks(disks []NewDiskSpec) BuildableVMParameters
+	// WithExistingDisks attaches the specified floating disks in the VM creation request, instead of attaching them
+	// after the VM is created. The disks must exist, be in DiskStatusOK and not be attached to another VM unless they
+	// are shareable. CreateVM returns once all disks are attached. At most one of the new and existing disks can be
+	// bootable.
+	WithExistingDisks(disks []ExistingDiskAttachment) (BuildableVMParameters, error)
+	// MustWithExistingDisks is identical to WithExistingDisks, but panics instead of returning an error.
+	MustWithExistingDisks(disks []ExistingDiskAttachment) BuildableVMParameters
 
 	// WithPlacementPolicy adds a placement policy dictating which hosts the VM can be migrated to.
 	WithPlacementPolicy(placementPolicy VMPlacementPolicyParameters) BuildableVMParameters
@@ -1226,6 +1644,76 @@ type BuildableVMParameters interface {
 
 	// WithSoundcardEnabled enables or disables a soundcard for the VM.
 	WithSoundcardEnabled(soundcardEnabled bool) BuildableVMParameters
+
+	// WithTimeZone sets the time zone of the VM's hardware clock, for example "Etc/GMT" for Linux guests or
+	// "GMT Standard Time" for Windows guests.
+	WithTimeZone(timeZone string) (BuildableVMParameters, error)
+	// MustWithTimeZone is identical to WithTimeZone, but panics instead of returning an error.
+	MustWithTimeZone(timeZone string) BuildableVMParameters
+
+	// WithBIOSType sets the chipset and firmware combination of the VM.
+	WithBIOSType(biosType BIOSType) (BuildableVMParameters, error)
+	// MustWithBIOSType is identical to WithBIOSType, but panics instead of returning an error.
+	MustWithBIOSType(biosType BIOSType) BuildableVMParameters
+
+	// WithWatchdog adds a watchdog device to the VM after creation.
+	WithWatchdog(model WatchdogModel, action WatchdogAction) (BuildableVMParameters, error)
+	// MustWithWatchdog is identical to WithWatchdog, but panics instead of returning an error.
+	MustWithWatchdog(model WatchdogModel, action WatchdogAction) BuildableVMParameters
+
+	// WithSerialNumber sets the serial number the VM reports in its SMBIOS data. The custom serial number must be set
+	// if and only if the policy is SerialNumberPolicyCustom.
+	WithSerialNumber(policy SerialNumberPolicy, custom string) (BuildableVMParameters, error)
+	// MustWithSerialNumber is identical to WithSerialNumber, but panics instead of returning an error.
+	MustWithSerialNumber(policy SerialNumberPolicy, custom string) BuildableVMParameters
+
+	// WithRNGDevice adds a virtio RNG device fed from the specified host entropy source. The guest may read rateBytes
+	// bytes per ratePeriod, pass 0 for both to leave the rate unlimited.
+	WithRNGDevice(source RNGSource, rateBytes uint, ratePeriod time.Duration) (BuildableVMParameters, error)
+	// MustWithRNGDevice is identical to WithRNGDevice, but panics instead of returning an error.
+	MustWithRNGDevice(source RNGSource, rateBytes uint, ratePeriod time.Duration) BuildableVMParameters
+
+	// WithCustomCompatibilityVersion pins the compatibility version of the VM, for example to keep an older machine
+	// type while the cluster is upgraded. The version must not be higher than the version of the cluster.
+	WithCustomCompatibilityVersion(major uint, minor uint) (BuildableVMParameters, error)
+	// MustWithCustomCompatibilityVersion is identical to WithCustomCompatibilityVersion, but panics instead of
+	// returning an error.
+	MustWithCustomCompatibilityVersion(major uint, minor uint) BuildableVMParameters
+
+	// WithStateless sets whether the VM discards all changes to its disks when it is shut down.
+	WithStateless(stateless bool) (BuildableVMParameters, error)
+	// MustWithStateless is identical to WithStateless, but panics instead of returning an error.
+	MustWithStateless(stateless bool) BuildableVMParameters
+
+	// WithDeleteProtected sets whether the VM is protected against removal.
+	WithDeleteProtected(deleteProtected bool) (BuildableVMParameters, error)
+	// MustWithDeleteProtected is identical to WithDeleteProtected, but panics instead of returning an error.
+	MustWithDeleteProtected(deleteProtected bool) BuildableVMParameters
+	// WithLeaseStorageDomainID creates a VM lease on the specified data storage domain. The lease lets the engine
+	// restart a highly available VM on another host without fencing the host it ran on first.
+	WithLeaseStorageDomainID(storageDomainID StorageDomainID) (BuildableVMParameters, error)
+	// MustWithLeaseStorageDomainID is identical to WithLeaseStorageDomainID, but panics instead of returning an
+	// error.
+	MustWithLeaseStorageDomainID(storageDomainID StorageDomainID) BuildableVMParameters
+
+	// WithStorageErrorResumeBehaviour sets what the VM does once the storage recovers from an I/O error that paused
+	// it. See StorageErrorResumeBehaviour for the options.
+	WithStorageErrorResumeBehaviour(behaviour StorageErrorResumeBehaviour) (BuildableVMParameters, error)
+	// MustWithStorageErrorResumeBehaviour is identical to WithStorageErrorResumeBehaviour, but panics instead of
+	// returning an error.
+	MustWithStorageErrorResumeBehaviour(behaviour StorageErrorResumeBehaviour) BuildableVMParameters
+
+	// WithSpiceFileTransfer sets whether files can be copied from the SPICE client into the VM. Secure environments
+	// usually disable it. The engine ignores the setting for VMs without a SPICE display.
+	WithSpiceFileTransfer(enabled bool) (BuildableVMParameters, error)
+	// MustWithSpiceFileTransfer is identical to WithSpiceFileTransfer, but panics instead of returning an error.
+	MustWithSpiceFileTransfer(enabled bool) BuildableVMParameters
+
+	// WithSpiceCopyPaste sets whether the SPICE client shares its clipboard with the VM. The engine ignores the
+	// setting for VMs without a SPICE display.
+	WithSpiceCopyPaste(enabled bool) (BuildableVMParameters, error)
+	// MustWithSpiceCopyPaste is identical to WithSpiceCopyPaste, but panics instead of returning an error.
+	MustWithSpiceCopyPaste(enabled bool) BuildableVMParameters
 }
 
 // VMCPUParams contain the CPU parameters for a VM.
@@ -1234,6 +1722,9 @@ type VMCPUParams interface {
 	Mode() *CPUMode
 	// Topo contains the topology of the CPU.
 	Topo() VMCPUTopoParams
+	// CustomModel returns the CPU model and flags to use instead of the cluster CPU type, in the format the engine
+	// expects, for example "Skylake-Client,+spec-ctrl,-hle".
+	CustomModel() *string
 }
 
 // BuildableVMCPUParams is a buildable version of VMCPUParams.
@@ -1245,6 +1736,15 @@ type BuildableVMCPUParams interface {
 
 	WithTopo(topo VMCPUTopoParams) (BuildableVMCPUParams, error)
 	MustWithTopo(topo VMCPUTopoParams) BuildableVMCPUParams
+
+	// WithCustomModel sets a CPU model, such as "Skylake-Client" or "Haswell-noTSX", overriding the cluster CPU
+	// type. This allows live migration between hosts with different CPUs when the cluster CPU type doesn't fit. Each
+	// flag must start with "+" to enable or "-" to disable a CPU feature, for example "+spec-ctrl". The model is
+	// checked against the CPU models known to the host when the VM starts. An EBadArgument error is returned if the
+	// model or a flag is malformed.
+	WithCustomModel(model string, flags ...string) (BuildableVMCPUParams, error)
+	// MustWithCustomModel is identical to WithCustomModel, but panics instead of returning an error.
+	MustWithCustomModel(model string, flags ...string) BuildableVMCPUParams
 }
 
 // NewVMCPUParams creates a new VMCPUParams object.
@@ -1253,8 +1753,9 @@ func NewVMCPUParams() BuildableVMCPUParams {
 }
 
 type vmCPUParams struct {
-	mode *CPUMode
-	topo VMCPUTopoParams
+	mode        *CPUMode
+	topo        VMCPUTopoParams
+	customModel *string
 }
 
 func (v *vmCPUParams) WithMode(mode CPUMode) (BuildableVMCPUParams, error) {
@@ -1286,10 +1787,51 @@ func (v *vmCPUParams) MustWithTopo(topo VMCPUTopoParams) BuildableVMCPUParams {
 	return b
 }
 
+func (v *vmCPUParams) WithCustomModel(model string, flags ...string) (BuildableVMCPUParams, error) {
+	customModel, err := buildCustomCPUModel(model, flags)
+	if err != nil {
+		return nil, err
+	}
+	v.customModel = &customModel
+	return v, nil
+}
+
+func (v *vmCPUParams) MustWithCustomModel(model string, flags ...string) BuildableVMCPUParams {
+	builder, err := v.WithCustomModel(model, flags...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// buildCustomCPUModel validates the CPU model and flags and joins them into the comma-separated form the engine
+// passes on to libvirt.
+func buildCustomCPUModel(model string, flags []string) (string, error) {
+	if model == "" || strings.ContainsAny(model, ", \t\n") {
+		return "", newError(EBadArgument, "invalid custom CPU model %q", model)
+	}
+	parts := []string{model}
+	for _, flag := range flags {
+		if len(flag) < 2 || (flag[0] != '+' && flag[0] != '-') || strings.ContainsAny(flag, ", \t\n") {
+			return "", newError(
+				EBadArgument,
+				"invalid CPU flag %q, flags must be prefixed with + to enable or - to disable a feature",
+				flag,
+			)
+		}
+		parts = append(parts, flag)
+	}
+	return strings.Join(parts, ","), nil
+}
+
 func (v vmCPUParams) Mode() *CPUMode {
 	return v.mode
 }
 
+func (v vmCPUParams) CustomModel() *string {
+	return v.customModel
+}
+
 func (v vmCPUParams) Topo() VMCPUTopoParams {
 	return v.topo
 }
@@ -1395,6 +1937,14 @@ func (v *vmCPUTopoParams) MustWithThreads(threads uint) BuildableVMCPUTopoParams
 type VMOSParameters interface {
 	// Type returns the type-string for the operating system.
 	Type() *string
+	// Kernel returns the path of the kernel for direct kernel boot, or nil if the VM boots normally.
+	Kernel() *string
+	// Initrd returns the path of the initial ramdisk for direct kernel boot.
+	Initrd() *string
+	// Cmdline returns the kernel command line for direct kernel boot.
+	Cmdline() *string
+	// BootDevices returns the boot order of the VM. If it is empty, the boot order of the template is used.
+	BootDevices() []BootDevice
 }
 
 // BuildableVMOSParameters is a buildable version of VMOSParameters.
@@ -1403,6 +1953,20 @@ type BuildableVMOSParameters interface {
 
 	WithType(t string) (BuildableVMOSParameters, error)
 	MustWithType(t string) BuildableVMOSParameters
+
+	// WithDirectKernel makes the VM boot the specified kernel directly instead of going through the boot loader,
+	// which is useful for appliances and network boot without PXE. The paths refer to files on the host, or to ISO
+	// storage domain files in the iso://filename format. The initrd path and the command line are optional, but
+	// setting either without a kernel path results in an EBadArgument error.
+	WithDirectKernel(kernelPath string, initrdPath string, cmdline string) (BuildableVMOSParameters, error)
+	// MustWithDirectKernel is identical to WithDirectKernel, but panics instead of returning an error.
+	MustWithDirectKernel(kernelPath string, initrdPath string, cmdline string) BuildableVMOSParameters
+
+	// WithBootDevices sets the boot order of the VM. It returns an EBadArgument error if a device is invalid or
+	// listed more than once.
+	WithBootDevices(devices ...BootDevice) (BuildableVMOSParameters, error)
+	// MustWithBootDevices is identical to WithBootDevices, but panics instead of returning an error.
+	MustWithBootDevices(devices ...BootDevice) BuildableVMOSParameters
 }
 
 // NewVMOSParameters creates a new VMOSParameters structure.
@@ -1411,7 +1975,11 @@ func NewVMOSParameters() BuildableVMOSParameters {
 }
 
 type vmOSParameters struct {
-	t *string
+	t           *string
+	kernel      *string
+	initrd      *string
+	cmdline     *string
+	bootDevices []BootDevice
 }
 
 func (v *vmOSParameters) Type() *string {
@@ -1431,6 +1999,67 @@ func (v *vmOSParameters) MustWithType(t string) BuildableVMOSParameters {
 	return builder
 }
 
+func (v *vmOSParameters) Kernel() *string {
+	return v.kernel
+}
+
+func (v *vmOSParameters) Initrd() *string {
+	return v.initrd
+}
+
+func (v *vmOSParameters) Cmdline() *string {
+	return v.cmdline
+}
+
+func (v *vmOSParameters) WithDirectKernel(
+	kernelPath string,
+	initrdPath string,
+	cmdline string,
+) (BuildableVMOSParameters, error) {
+	if kernelPath == "" {
+		return v, newError(EBadArgument, "a kernel path is required for direct kernel boot")
+	}
+	if err := validateDirectKernel(kernelPath, initrdPath, cmdline); err != nil {
+		return v, err
+	}
+	v.kernel = &kernelPath
+	v.initrd = &initrdPath
+	v.cmdline = &cmdline
+	return v, nil
+}
+
+func (v *vmOSParameters) MustWithDirectKernel(
+	kernelPath string,
+	initrdPath string,
+	cmdline string,
+) BuildableVMOSParameters {
+	builder, err := v.WithDirectKernel(kernelPath, initrdPath, cmdline)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmOSParameters) BootDevices() []BootDevice {
+	return v.bootDevices
+}
+
+func (v *vmOSParameters) WithBootDevices(devices ...BootDevice) (BuildableVMOSParameters, error) {
+	if err := validateBootDevices(devices); err != nil {
+		return v, err
+	}
+	v.bootDevices = devices
+	return v, nil
+}
+
+func (v *vmOSParameters) MustWithBootDevices(devices ...BootDevice) BuildableVMOSParameters {
+	builder, err := v.WithBootDevices(devices...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 // CPUMode is the mode of the CPU on a VM.
 type CPUMode string
 
@@ -1508,6 +2137,85 @@ func (v VMType) Validate() error {
 	}
 }
 
+// BIOSType is the chipset and firmware combination of a VM.
+type BIOSType string
+
+const (
+	// BIOSTypeClusterDefault uses the BIOS type configured on the cluster.
+	BIOSTypeClusterDefault BIOSType = "cluster_default"
+	// BIOSTypeI440FXSeaBIOS is the legacy i440FX chipset with the SeaBIOS firmware.
+	BIOSTypeI440FXSeaBIOS BIOSType = "i440fx_sea_bios"
+	// BIOSTypeQ35SeaBIOS is the Q35 chipset with the legacy SeaBIOS firmware.
+	BIOSTypeQ35SeaBIOS BIOSType = "q35_sea_bios"
+	// BIOSTypeQ35UEFI is the Q35 chipset with the OVMF UEFI firmware.
+	BIOSTypeQ35UEFI BIOSType = "q35_ovmf"
+	// BIOSTypeQ35SecureBoot is the Q35 chipset with the OVMF UEFI firmware and secure boot enabled. Secure boot
+	// requires a 64-bit x86 guest operating system.
+	BIOSTypeQ35SecureBoot BIOSType = "q35_secure_boot"
+)
+
+// BIOSTypeList is a list of BIOSType values.
+type BIOSTypeList []BIOSType
+
+// BIOSTypeValues returns all possible BIOSType values.
+func BIOSTypeValues() BIOSTypeList {
+	return []BIOSType{
+		BIOSTypeClusterDefault,
+		BIOSTypeI440FXSeaBIOS,
+		BIOSTypeQ35SeaBIOS,
+		BIOSTypeQ35UEFI,
+		BIOSTypeQ35SecureBoot,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l BIOSTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// Validate returns an error if the BIOS type is not valid.
+func (b BIOSType) Validate() error {
+	for _, value := range BIOSTypeValues() {
+		if value == b {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid BIOS type: %s, must be one of: %s",
+		b,
+		strings.Join(BIOSTypeValues().Strings(), ", "),
+	)
+}
+
+// secureBootOSTypes are the oVirt operating system types that are 64-bit x86-only without carrying the x64 suffix in
+// their name.
+var secureBootOSTypes = []string{"windows_11", "windows_2022"}
+
+// validateSecureBootOSType returns an EBadArgument error if secure boot has been requested for an operating system
+// type that cannot boot with it. Secure boot requires a 64-bit x86 guest whose oVirt operating system type ends in x64,
+// with the exception of a few newer Windows versions that only exist as 64-bit.
+func validateSecureBootOSType(osType string) error {
+	if strings.HasSuffix(osType, "x64") {
+		return nil
+	}
+	for _, t := range secureBootOSTypes {
+		if osType == t {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"the BIOS type %s requires a 64-bit x86 operating system type, %s is not compatible",
+		BIOSTypeQ35SecureBoot,
+		osType,
+	)
+}
+
 // VMTypeValues returns all possible values for VM types.
 func VMTypeValues() []VMType {
 	return []VMType{
@@ -1651,6 +2359,17 @@ func (m *memoryPolicyParameters) Guaranteed() *int64 {
 }
 
 func (m *memoryPolicyParameters) WithGuaranteed(guaranteed int64) (BuildableMemoryPolicyParameters, error) {
+	if guaranteed < 0 {
+		return nil, newError(EBadArgument, "the guaranteed memory cannot be negative (%d given)", guaranteed)
+	}
+	if m.max != nil && guaranteed > *m.max {
+		return nil, newError(
+			EBadArgument,
+			"the guaranteed memory cannot be higher than the maximum memory (%d > %d)",
+			guaranteed,
+			*m.max,
+		)
+	}
 	m.guaranteed = &guaranteed
 	return m, nil
 }
@@ -1668,6 +2387,17 @@ func (m *memoryPolicyParameters) Max() *int64 {
 }
 
 func (m *memoryPolicyParameters) WithMax(max int64) (BuildableMemoryPolicyParameters, error) {
+	if max <= 0 {
+		return nil, newError(EBadArgument, "the maximum memory must be positive (%d given)", max)
+	}
+	if m.guaranteed != nil && *m.guaranteed > max {
+		return nil, newError(
+			EBadArgument,
+			"the maximum memory cannot be lower than the guaranteed memory (%d < %d)",
+			max,
+			*m.guaranteed,
+		)
+	}
 	m.max = &max
 	return m, nil
 }
@@ -2002,11 +2732,17 @@ func (u *updateVMParams) WithComment(comment string) (BuildableUpdateVMParameter
 }
 
 func (u *updateVMParams) WithDescription(description string) (BuildableUpdateVMParameters, error) {
+	if err := validateVMDescription(description); err != nil {
+		return nil, err
+	}
 	u.description = &description
 	return u, nil
 }
 
 func (u *updateVMParams) WithInitialization(initialization Initialization) (BuildableUpdateVMParameters, error) {
+	if err := validateInitializationDNS(initialization); err != nil {
+		return nil, err
+	}
 	u.initialization = initialization
 	return u, nil
 }
@@ -2040,7 +2776,9 @@ type vmParams struct {
 
 	clone *bool
 
-	disks []OptionalVMDiskParameters
+	disks         []OptionalVMDiskParameters
+	newDisks      []NewDiskSpec
+	existingDisks []ExistingDiskAttachment
 
 	placementPolicy *VMPlacementPolicyParameters
 
@@ -2053,6 +2791,267 @@ type vmParams struct {
 
 	serialConsole    *bool
 	soundcardEnabled *bool
+
+	timeZone *string
+	biosType *BIOSType
+
+	watchdog VMWatchdogParameters
+
+	serialNumber VMSerialNumber
+
+	rngDevice VMRNGDevice
+
+	customCompatibilityVersion CompatibilityVersion
+
+	stateless       *bool
+	deleteProtected *bool
+
+	leaseStorageDomainID *StorageDomainID
+
+	storageErrorResumeBehaviour *StorageErrorResumeBehaviour
+
+	spiceFileTransfer *bool
+	spiceCopyPaste    *bool
+}
+
+func (v *vmParams) Stateless() *bool {
+	return v.stateless
+}
+
+func (v *vmParams) WithStateless(stateless bool) (BuildableVMParameters, error) {
+	v.stateless = &stateless
+	return v, nil
+}
+
+func (v *vmParams) MustWithStateless(stateless bool) BuildableVMParameters {
+	builder, err := v.WithStateless(stateless)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) DeleteProtected() *bool {
+	return v.deleteProtected
+}
+
+func (v *vmParams) WithDeleteProtected(deleteProtected bool) (BuildableVMParameters, error) {
+	v.deleteProtected = &deleteProtected
+	return v, nil
+}
+
+func (v *vmParams) MustWithDeleteProtected(deleteProtected bool) BuildableVMParameters {
+	builder, err := v.WithDeleteProtected(deleteProtected)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) LeaseStorageDomainID() *StorageDomainID {
+	return v.leaseStorageDomainID
+}
+
+func (v *vmParams) WithLeaseStorageDomainID(storageDomainID StorageDomainID) (BuildableVMParameters, error) {
+	if storageDomainID == "" {
+		return nil, newError(EBadArgument, "the lease storage domain ID must not be empty")
+	}
+	v.leaseStorageDomainID = &storageDomainID
+	return v, nil
+}
+
+func (v *vmParams) MustWithLeaseStorageDomainID(storageDomainID StorageDomainID) BuildableVMParameters {
+	builder, err := v.WithLeaseStorageDomainID(storageDomainID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) StorageErrorResumeBehaviour() *StorageErrorResumeBehaviour {
+	return v.storageErrorResumeBehaviour
+}
+
+func (v *vmParams) WithStorageErrorResumeBehaviour(
+	behaviour StorageErrorResumeBehaviour,
+) (BuildableVMParameters, error) {
+	if err := behaviour.Validate(); err != nil {
+		return nil, err
+	}
+	v.storageErrorResumeBehaviour = &behaviour
+	return v, nil
+}
+
+func (v *vmParams) MustWithStorageErrorResumeBehaviour(behaviour StorageErrorResumeBehaviour) BuildableVMParameters {
+	builder, err := v.WithStorageErrorResumeBehaviour(behaviour)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) SpiceFileTransfer() *bool {
+	return v.spiceFileTransfer
+}
+
+func (v *vmParams) WithSpiceFileTransfer(enabled bool) (BuildableVMParameters, error) {
+	v.spiceFileTransfer = &enabled
+	return v, nil
+}
+
+func (v *vmParams) MustWithSpiceFileTransfer(enabled bool) BuildableVMParameters {
+	builder, err := v.WithSpiceFileTransfer(enabled)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) SpiceCopyPaste() *bool {
+	return v.spiceCopyPaste
+}
+
+func (v *vmParams) WithSpiceCopyPaste(enabled bool) (BuildableVMParameters, error) {
+	v.spiceCopyPaste = &enabled
+	return v, nil
+}
+
+func (v *vmParams) MustWithSpiceCopyPaste(enabled bool) BuildableVMParameters {
+	builder, err := v.WithSpiceCopyPaste(enabled)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) CustomCompatibilityVersion() CompatibilityVersion {
+	return v.customCompatibilityVersion
+}
+
+func (v *vmParams) WithCustomCompatibilityVersion(major uint, minor uint) (BuildableVMParameters, error) {
+	version, err := newCompatibilityVersion(major, minor)
+	if err != nil {
+		return nil, err
+	}
+	v.customCompatibilityVersion = version
+	return v, nil
+}
+
+func (v *vmParams) MustWithCustomCompatibilityVersion(major uint, minor uint) BuildableVMParameters {
+	builder, err := v.WithCustomCompatibilityVersion(major, minor)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) RNGDevice() VMRNGDevice {
+	return v.rngDevice
+}
+
+func (v *vmParams) WithRNGDevice(
+	source RNGSource,
+	rateBytes uint,
+	ratePeriod time.Duration,
+) (BuildableVMParameters, error) {
+	rngDevice, err := newVMRNGDevice(source, rateBytes, ratePeriod)
+	if err != nil {
+		return nil, err
+	}
+	v.rngDevice = rngDevice
+	return v, nil
+}
+
+func (v *vmParams) MustWithRNGDevice(source RNGSource, rateBytes uint, ratePeriod time.Duration) BuildableVMParameters {
+	builder, err := v.WithRNGDevice(source, rateBytes, ratePeriod)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) SerialNumber() VMSerialNumber {
+	return v.serialNumber
+}
+
+func (v *vmParams) WithSerialNumber(policy SerialNumberPolicy, custom string) (BuildableVMParameters, error) {
+	serialNumber, err := newVMSerialNumber(policy, custom)
+	if err != nil {
+		return nil, err
+	}
+	v.serialNumber = serialNumber
+	return v, nil
+}
+
+func (v *vmParams) MustWithSerialNumber(policy SerialNumberPolicy, custom string) BuildableVMParameters {
+	builder, err := v.WithSerialNumber(policy, custom)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) Watchdog() VMWatchdogParameters {
+	return v.watchdog
+}
+
+func (v *vmParams) WithWatchdog(model WatchdogModel, action WatchdogAction) (BuildableVMParameters, error) {
+	if err := validateWatchdog(model, action); err != nil {
+		return nil, err
+	}
+	v.watchdog = &vmWatchdogParameters{
+		model:  model,
+		action: action,
+	}
+	return v, nil
+}
+
+func (v *vmParams) MustWithWatchdog(model WatchdogModel, action WatchdogAction) BuildableVMParameters {
+	builder, err := v.WithWatchdog(model, action)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) TimeZone() *string {
+	return v.timeZone
+}
+
+func (v *vmParams) WithTimeZone(timeZone string) (BuildableVMParameters, error) {
+	if timeZone == "" {
+		return nil, newError(EBadArgument, "the time zone cannot be empty")
+	}
+	v.timeZone = &timeZone
+	return v, nil
+}
+
+func (v *vmParams) MustWithTimeZone(timeZone string) BuildableVMParameters {
+	builder, err := v.WithTimeZone(timeZone)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) BIOSType() *BIOSType {
+	return v.biosType
+}
+
+func (v *vmParams) WithBIOSType(biosType BIOSType) (BuildableVMParameters, error) {
+	if err := biosType.Validate(); err != nil {
+		return nil, err
+	}
+	v.biosType = &biosType
+	return v, nil
+}
+
+func (v *vmParams) MustWithBIOSType(biosType BIOSType) BuildableVMParameters {
+	builder, err := v.WithBIOSType(biosType)
+	if err != nil {
+		panic(err)
+	}
+	return builder
 }
 
 func (v *vmParams) SerialConsole() *bool {
@@ -2184,6 +3183,49 @@ func (v *vmParams) MustWithDisks(disks []OptionalVMDiskParameters) BuildableVMPa
 	return builder
 }
 
+func (v *vmParams) NewDisks() []NewDiskSpec {
+	return v.newDisks
+}
+
+func (v *vmParams) WithNewDisks(disks []NewDiskSpec) (BuildableVMParameters, error) {
+	if err := validateNewDiskSpecs(disks); err != nil {
+		return nil, err
+	}
+	if err := validateExistingDiskAttachments(v.existingDisks, disks); err != nil {
+		return nil, err
+	}
+	v.newDisks = disks
+	return v, nil
+}
+
+func (v *vmParams) MustWithNewDisks(disks []NewDiskSpec) BuildableVMParameters {
+	builder, err := v.WithNewDisks(disks)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) ExistingDisks() []ExistingDiskAttachment {
+	return v.existingDisks
+}
+
+func (v *vmParams) WithExistingDisks(disks []ExistingDiskAttachment) (BuildableVMParameters, error) {
+	if err := validateExistingDiskAttachments(disks, v.newDisks); err != nil {
+		return nil, err
+	}
+	v.existingDisks = disks
+	return v, nil
+}
+
+func (v *vmParams) MustWithExistingDisks(disks []ExistingDiskAttachment) BuildableVMParameters {
+	builder, err := v.WithExistingDisks(disks)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 func (v *vmParams) HugePages() *VMHugePages {
 	return v.hugePages
 }
@@ -2226,6 +3268,9 @@ func (v *vmParams) Initialization() Initialization {
 }
 
 func (v *vmParams) WithInitialization(initialization Initialization) (BuildableVMParameters, error) {
+	if err := validateInitializationDNS(initialization); err != nil {
+		return nil, err
+	}
 	v.initialization = initialization
 	return v, nil
 }
@@ -2328,6 +3373,9 @@ func (v *vmParams) WithComment(comment string) (BuildableVMParameters, error) {
 }
 
 func (v *vmParams) WithDescription(description string) (BuildableVMParameters, error) {
+	if err := validateVMDescription(description); err != nil {
+		return nil, err
+	}
 	v.description = description
 	return v, nil
 }
@@ -2367,6 +3415,118 @@ type vm struct {
 	os               *vmOS
 	serialConsole    bool
 	soundcardEnabled bool
+	hostedEngine     bool
+	timeZone         string
+	biosType         BIOSType
+	serialNumber     *vmSerialNumber
+	rngDevice        *vmRNGDevice
+
+	customCompatibilityVersion *compatibilityVersion
+
+	stateless       bool
+	deleteProtected bool
+
+	nextRunConfigurationExists bool
+
+	leaseStorageDomainID *StorageDomainID
+
+	storageErrorResumeBehaviour StorageErrorResumeBehaviour
+
+	display vmDisplay
+}
+
+func (v *vm) DisplayType() DisplayType {
+	return v.display.displayType
+}
+
+func (v *vm) SpiceFileTransfer() bool {
+	return v.display.fileTransfer
+}
+
+func (v *vm) SpiceCopyPaste() bool {
+	return v.display.copyPaste
+}
+
+func (v *vm) LeaseStorageDomainID() *StorageDomainID {
+	return v.leaseStorageDomainID
+}
+
+func (v *vm) StorageErrorResumeBehaviour() StorageErrorResumeBehaviour {
+	return v.storageErrorResumeBehaviour
+}
+
+func (v *vm) NextRunConfigurationExists() bool {
+	return v.nextRunConfigurationExists
+}
+
+func (v *vm) Stateless() bool {
+	return v.stateless
+}
+
+func (v *vm) DeleteProtected() bool {
+	return v.deleteProtected
+}
+
+func (v *vm) CustomCompatibilityVersion() CompatibilityVersion {
+	if v.customCompatibilityVersion == nil {
+		return nil
+	}
+	return v.customCompatibilityVersion
+}
+
+func (v *vm) RNGDevice() VMRNGDevice {
+	if v.rngDevice == nil {
+		return nil
+	}
+	return v.rngDevice
+}
+
+func (v *vm) SetRNGDevice(
+	source RNGSource,
+	rateBytes uint,
+	ratePeriod time.Duration,
+	retries ...RetryStrategy,
+) (VM, error) {
+	return v.client.SetVMRNGDevice(v.id, source, rateBytes, ratePeriod, retries...)
+}
+
+func (v *vm) SerialNumber() VMSerialNumber {
+	if v.serialNumber == nil {
+		return nil
+	}
+	return v.serialNumber
+}
+
+func (v *vm) SetSerialNumber(policy SerialNumberPolicy, custom string, retries ...RetryStrategy) (VM, error) {
+	return v.client.SetVMSerialNumber(v.id, policy, custom, retries...)
+}
+
+func (v *vm) SetComment(comment string, retries ...RetryStrategy) error {
+	return v.client.SetVMComment(v.id, comment, retries...)
+}
+
+func (v *vm) SetDescription(description string, retries ...RetryStrategy) error {
+	return v.client.SetVMDescription(v.id, description, retries...)
+}
+
+func (v *vm) CreateSnapshot(params OptionalSnapshotParameters, retries ...RetryStrategy) (Snapshot, error) {
+	return v.client.CreateSnapshot(v.id, params, retries...)
+}
+
+func (v *vm) ListSnapshots(retries ...RetryStrategy) ([]Snapshot, error) {
+	return v.client.ListSnapshots(v.id, retries...)
+}
+
+func (v *vm) TimeZone() string {
+	return v.timeZone
+}
+
+func (v *vm) BIOSType() BIOSType {
+	return v.biosType
+}
+
+func (v *vm) HostedEngine() bool {
+	return v.hostedEngine
 }
 
 func (v *vm) SoundcardEnabled() bool {
@@ -2405,6 +3565,18 @@ func (v *vm) ListTags(retries ...RetryStrategy) (result []Tag, err error) {
 	return v.client.ListVMTags(v.id, retries...)
 }
 
+func (v *vm) AddAffinityLabel(labelID AffinityLabelID, retries ...RetryStrategy) error {
+	return v.client.AddAffinityLabelToVM(v.id, labelID, retries...)
+}
+
+func (v *vm) RemoveAffinityLabel(labelID AffinityLabelID, retries ...RetryStrategy) error {
+	return v.client.RemoveAffinityLabelFromVM(v.id, labelID, retries...)
+}
+
+func (v *vm) ListAffinityLabels(retries ...RetryStrategy) ([]AffinityLabel, error) {
+	return v.client.ListVMAffinityLabels(v.id, retries...)
+}
+
 func (v *vm) PlacementPolicy() (VMPlacementPolicy, bool) {
 	return v.placementPolicy, v.placementPolicy != nil
 }
@@ -2441,6 +3613,10 @@ func (v *vm) GetHost(retries ...RetryStrategy) (Host, error) {
 	return v.client.GetHost(*hostID, retries...)
 }
 
+func (v *vm) GetCluster(retries ...RetryStrategy) (Cluster, error) {
+	return followClusterLink(v.client, "VM", string(v.id), v.clusterID, retries)
+}
+
 func (v *vm) HugePages() *VMHugePages {
 	return v.hugePages
 }
@@ -2449,6 +3625,10 @@ func (v *vm) Start(retries ...RetryStrategy) error {
 	return v.client.StartVM(v.id, retries...)
 }
 
+func (v *vm) RunOnce(params RunOnceParameters, retries ...RetryStrategy) (VM, error) {
+	return v.client.RunVMOnce(v.id, params, retries...)
+}
+
 func (v *vm) Stop(force bool, retries ...RetryStrategy) error {
 	return v.client.StopVM(v.id, force, retries...)
 }
@@ -2457,6 +3637,50 @@ func (v *vm) Shutdown(force bool, retries ...RetryStrategy) error {
 	return v.client.ShutdownVM(v.id, force, retries...)
 }
 
+func (v *vm) Migrate(params MigrateVMParameters, retries ...RetryStrategy) (VM, error) {
+	return v.client.MigrateVM(v.id, params, retries...)
+}
+
+func (v *vm) MigrationStatus(retries ...RetryStrategy) (VMMigrationStatus, error) {
+	return v.client.GetVMMigrationStatus(v.id, retries...)
+}
+
+func (v *vm) Statistics(retries ...RetryStrategy) (VMStatistics, error) {
+	return v.client.GetVMStatistics(v.id, retries...)
+}
+
+// snapshot returns a copy of the VM. The mock client hands out snapshots so that state changes happening in the
+// background, such as a VM starting up, don't race with the caller reading the VM.
+func (v *vm) snapshot() *vm {
+	vmCopy := *v
+	return &vmCopy
+}
+
+func (v *vm) Reboot(retries ...RetryStrategy) error {
+	return v.client.RebootVM(v.id, retries...)
+}
+
+func (v *vm) GuestInfo(retries ...RetryStrategy) (GuestInfo, error) {
+	return v.client.GetVMGuestInfo(v.id, retries...)
+}
+
+func (v *vm) ListPermissions(retries ...RetryStrategy) ([]Permission, error) {
+	return v.client.ListVMPermissions(v.id, retries...)
+}
+
+func (v *vm) AddPermission(
+	principalType PermissionPrincipalType,
+	principalID string,
+	roleID RoleID,
+	retries ...RetryStrategy,
+) (Permission, error) {
+	return v.client.AddVMPermission(v.id, principalType, principalID, roleID, retries...)
+}
+
+func (v *vm) Health(retries ...RetryStrategy) (VMHealth, error) {
+	return v.client.GetVMHealth(v.id, retries...)
+}
+
 func (v *vm) WaitForStatus(status VMStatus, retries ...RetryStrategy) (VM, error) {
 	return v.client.WaitForVMStatus(v.id, status, retries...)
 }
@@ -2498,6 +3722,18 @@ func (v *vm) withName(name string) *vm {
 		v.os,
 		v.serialConsole,
 		v.soundcardEnabled,
+		v.hostedEngine,
+		v.timeZone,
+		v.biosType,
+		v.serialNumber,
+		v.rngDevice,
+		v.customCompatibilityVersion,
+		v.stateless,
+		v.deleteProtected,
+		v.nextRunConfigurationExists,
+		v.leaseStorageDomainID,
+		v.storageErrorResumeBehaviour,
+		v.display,
 	}
 }
 
@@ -2526,6 +3762,18 @@ func (v *vm) withComment(comment string) *vm {
 		v.os,
 		v.serialConsole,
 		v.soundcardEnabled,
+		v.hostedEngine,
+		v.timeZone,
+		v.biosType,
+		v.serialNumber,
+		v.rngDevice,
+		v.customCompatibilityVersion,
+		v.stateless,
+		v.deleteProtected,
+		v.nextRunConfigurationExists,
+		v.leaseStorageDomainID,
+		v.storageErrorResumeBehaviour,
+		v.display,
 	}
 }
 
@@ -2554,6 +3802,18 @@ func (v *vm) withDescription(description string) *vm {
 		v.os,
 		v.serialConsole,
 		v.soundcardEnabled,
+		v.hostedEngine,
+		v.timeZone,
+		v.biosType,
+		v.serialNumber,
+		v.rngDevice,
+		v.customCompatibilityVersion,
+		v.stateless,
+		v.deleteProtected,
+		v.nextRunConfigurationExists,
+		v.leaseStorageDomainID,
+		v.storageErrorResumeBehaviour,
+		v.display,
 	}
 }
 
@@ -2561,6 +3821,22 @@ func (v *vm) Update(params UpdateVMParameters, retries ...RetryStrategy) (VM, er
 	return v.client.UpdateVM(v.id, params, retries...)
 }
 
+func (v *vm) HotPlugMemory(additionalBytes uint64, retries ...RetryStrategy) (VM, error) {
+	return v.client.HotPlugVMMemory(v.id, additionalBytes, retries...)
+}
+
+func (v *vm) HotUnplugMemory(removedBytes uint64, retries ...RetryStrategy) (VM, error) {
+	return v.client.HotUnplugVMMemory(v.id, removedBytes, retries...)
+}
+
+func (v *vm) SetMemoryPolicy(params MemoryPolicyParameters, retries ...RetryStrategy) (VM, error) {
+	return v.client.SetVMMemoryPolicy(v.id, params, retries...)
+}
+
+func (v *vm) HotPlugCPU(targetVCPUs uint, retries ...RetryStrategy) (VM, error) {
+	return v.client.HotPlugVMCPU(v.id, targetVCPUs, retries...)
+}
+
 func (v *vm) Status() VMStatus {
 	return v.status
 }
@@ -2652,11 +3928,9 @@ func (v *vm) AddTagToVMByName(tagName string, retries ...RetryStrategy) error {
 	return v.client.AddTagToVMByName(v.id, tagName, retries...)
 }
 
-var vmNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_\-.]*$`)
-
 func validateVMName(name string) error {
-	if !vmNameRegexp.MatchString(name) {
-		return newError(EBadArgument, "invalid VM name: %s", name)
+	if err := ValidateResourceName(name); err != nil {
+		return wrap(err, EBadArgument, "invalid VM name")
 	}
 	return nil
 }
@@ -2686,6 +3960,17 @@ func convertSDKVM(sdkObject *ovirtsdk.Vm, client Client) (VM, error) {
 		vmOSConverter,
 		vmSoundcardEnabledConverter,
 		vmSerialConsoleConverter,
+		vmHostedEngineConverter,
+		vmTimeZoneConverter,
+		vmBIOSTypeConverter,
+		vmSerialNumberConverter,
+		vmRNGDeviceConverter,
+		vmCustomCompatibilityVersionConverter,
+		vmSafetyFlagsConverter,
+		vmNextRunConverter,
+		vmLeaseConverter,
+		vmStorageErrorResumeBehaviourConverter,
+		vmDisplayConverter,
 	}
 	for _, converter := range vmConverters {
 		if err := converter(sdkObject, vmObject); err != nil {
@@ -2735,6 +4020,26 @@ func vmOSConverter(object *ovirtsdk.Vm, v *vm) error {
 		return newFieldNotFound("os on vm", "type")
 	}
 	v.os.t = osType
+	v.os.kernel, _ = sdkOS.Kernel()
+	v.os.initrd, _ = sdkOS.Initrd()
+	v.os.cmdline, _ = sdkOS.Cmdline()
+	return nil
+}
+
+func vmTimeZoneConverter(object *ovirtsdk.Vm, v *vm) error {
+	if timeZone, ok := object.TimeZone(); ok {
+		v.timeZone, _ = timeZone.Name()
+	}
+	return nil
+}
+
+func vmBIOSTypeConverter(object *ovirtsdk.Vm, v *vm) error {
+	v.biosType = BIOSTypeClusterDefault
+	if bios, ok := object.Bios(); ok {
+		if biosType, ok := bios.Type(); ok {
+			v.biosType = BIOSType(biosType)
+		}
+	}
 	return nil
 }
 
@@ -2966,6 +4271,7 @@ func convertSDKVMCPU(sdkObject *ovirtsdk.Vm) (*vmCPU, error) {
 		},
 		mode: cpuMode,
 	}
+	cpu.customModel, _ = sdkObject.CustomCpuModel()
 	return cpu, nil
 }
 
@@ -0,0 +1,140 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) AddAffinityLabelToVM(vmID VMID, labelID AffinityLabelID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	// The engine reports a missing label as a generic error, so check it explicitly to return ENotFound.
+	if _, err := o.GetAffinityLabel(labelID, retries...); err != nil {
+		return err
+	}
+	labels, err := o.ListVMAffinityLabels(vmID, retries...)
+	if err != nil {
+		return err
+	}
+	for _, label := range labels {
+		if label.ID() == labelID {
+			return nil
+		}
+	}
+	action := fmt.Sprintf("adding affinity label %s to VM %s", labelID, vmID)
+	return retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				AffinityLabelsService().
+				LabelService(string(labelID)).
+				VmsService().
+				Add().
+				Vm(ovirtsdk.NewVmBuilder().Id(string(vmID)).MustBuild()).
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			return nil
+		})
+}
+
+func (o *oVirtClient) RemoveAffinityLabelFromVM(vmID VMID, labelID AffinityLabelID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	action := fmt.Sprintf("removing affinity label %s from VM %s", labelID, vmID)
+	return retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				AffinityLabelsService().
+				LabelService(string(labelID)).
+				VmsService().
+				VmService(string(vmID)).
+				Remove().
+				Send()
+			if err == nil {
+				return nil
+			}
+			err = wrapSDKError(action, err)
+			if HasErrorCode(err, ENotFound) {
+				return nil
+			}
+			return err
+		})
+}
+
+func (o *oVirtClient) ListVMAffinityLabels(vmID VMID, retries ...RetryStrategy) (result []AffinityLabel, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("listing affinity labels of VM %s", vmID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().VmsService().VmService(string(vmID)).AffinityLabelsService().List().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			result = []AffinityLabel{}
+			sdkObjects, ok := response.Label()
+			if !ok {
+				return nil
+			}
+			for i, sdkObject := range sdkObjects.Slice() {
+				label, err := convertSDKAffinityLabel(sdkObject, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert affinity label #%d of VM %s", i, vmID)
+				}
+				result = append(result, label)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) AddAffinityLabelToVM(vmID VMID, labelID AffinityLabelID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	label, ok := m.affinityLabels[labelID]
+	if !ok {
+		return newError(ENotFound, "affinity label with ID %s not found", labelID)
+	}
+	label.vmIDs[vmID] = struct{}{}
+	return nil
+}
+
+func (m *mockClient) RemoveAffinityLabelFromVM(vmID VMID, labelID AffinityLabelID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	if label, ok := m.affinityLabels[labelID]; ok {
+		delete(label.vmIDs, vmID)
+	}
+	return nil
+}
+
+func (m *mockClient) ListVMAffinityLabels(vmID VMID, _ ...RetryStrategy) ([]AffinityLabel, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	result := []AffinityLabel{}
+	for _, label := range m.affinityLabels {
+		if _, ok := label.vmIDs[vmID]; ok {
+			result = append(result, label)
+		}
+	}
+	return result, nil
+}
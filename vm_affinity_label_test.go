@@ -0,0 +1,59 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMAffinityLabelAssignment(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	label := assertCanCreateAffinityLabel(t, helper, helper.GenerateTestResourceName(t))
+
+	if err := vm.AddAffinityLabel("00000000-0000-0000-0000-000000000001"); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.ENotFound,
+	) {
+		t.Fatalf("Adding a nonexistent affinity label did not result in an ENotFound error (%v)", err)
+	}
+	for i := 0; i < 2; i++ {
+		// The second call checks that adding a label twice is a no-op.
+		if err := vm.AddAffinityLabel(label.ID()); err != nil {
+			t.Fatalf("Failed to add affinity label %s to VM %s (%v)", label.ID(), vm.ID(), err)
+		}
+	}
+	labels, err := vm.ListAffinityLabels()
+	if err != nil {
+		t.Fatalf("Failed to list affinity labels of VM %s (%v)", vm.ID(), err)
+	}
+	if len(labels) != 1 || labels[0].ID() != label.ID() {
+		t.Fatalf("Incorrect affinity labels on VM %s (expected: %s, got: %v)", vm.ID(), label.ID(), labels)
+	}
+
+	if err := vm.RemoveAffinityLabel(label.ID()); err != nil {
+		t.Fatalf("Failed to remove affinity label %s from VM %s (%v)", label.ID(), vm.ID(), err)
+	}
+	labels, err = vm.ListAffinityLabels()
+	if err != nil {
+		t.Fatalf("Failed to list affinity labels of VM %s (%v)", vm.ID(), err)
+	}
+	if len(labels) != 0 {
+		t.Fatalf("Affinity labels still present on VM %s after removal (%v)", vm.ID(), labels)
+	}
+}
+
+func assertCanCreateAffinityLabel(t *testing.T, helper ovirtclient.TestHelper, name string) ovirtclient.AffinityLabel {
+	label, err := helper.GetClient().CreateAffinityLabel(name)
+	if err != nil {
+		t.Fatalf("Failed to create affinity label %s (%v)", name, err)
+	}
+	t.Cleanup(func() {
+		if err := label.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to remove affinity label %s (%v)", label.ID(), err)
+		}
+	})
+	return label
+}
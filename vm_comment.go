@@ -0,0 +1,86 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// VMDescriptionMaxLength is the maximum number of characters the oVirt Engine accepts in a VM description. The
+// comment has no such limit.
+const VMDescriptionMaxLength = 255
+
+func validateVMDescription(description string) error {
+	if length := utf8.RuneCountInString(description); length > VMDescriptionMaxLength {
+		return newError(
+			EBadArgument,
+			"the VM description must be at most %d characters long (%d given)",
+			VMDescriptionMaxLength,
+			length,
+		)
+	}
+	return nil
+}
+
+func (o *oVirtClient) SetVMComment(id VMID, comment string, retries ...RetryStrategy) error {
+	sdkVM := &ovirtsdk.Vm{}
+	sdkVM.SetId(string(id))
+	sdkVM.SetComment(comment)
+	return o.updateVMField(id, sdkVM, "comment", retries)
+}
+
+func (o *oVirtClient) SetVMDescription(id VMID, description string, retries ...RetryStrategy) error {
+	if err := validateVMDescription(description); err != nil {
+		return err
+	}
+	sdkVM := &ovirtsdk.Vm{}
+	sdkVM.SetId(string(id))
+	sdkVM.SetDescription(description)
+	return o.updateVMField(id, sdkVM, "description", retries)
+}
+
+// updateVMField sends an update containing only the fields set in sdkVM, leaving every other field of the VM as it
+// is on the engine.
+func (o *oVirtClient) updateVMField(id VMID, sdkVM *ovirtsdk.Vm, field string, retries []RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	action := fmt.Sprintf("setting %s on VM %s", field, id)
+	return retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().VmsService().VmService(string(id)).Update().Vm(sdkVM).Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			return nil
+		})
+}
+
+func (m *mockClient) SetVMComment(id VMID, comment string, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	item, ok := m.vms[id]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", id)
+	}
+	m.vms[id] = item.withComment(comment)
+	return nil
+}
+
+func (m *mockClient) SetVMDescription(id VMID, description string, _ ...RetryStrategy) error {
+	if err := validateVMDescription(description); err != nil {
+		return err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	item, ok := m.vms[id]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", id)
+	}
+	m.vms[id] = item.withDescription(description)
+	return nil
+}
@@ -0,0 +1,147 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// CompatibilityVersion is an oVirt compatibility level, such as 4.7. It determines the machine type and the features
+// available to a VM.
+type CompatibilityVersion interface {
+	// Major returns the major part of the version.
+	Major() uint
+	// Minor returns the minor part of the version.
+	Minor() uint
+	// String returns the version in the major.minor form.
+	String() string
+}
+
+type compatibilityVersion struct {
+	major uint
+	minor uint
+}
+
+func (c *compatibilityVersion) Major() uint {
+	return c.major
+}
+
+func (c *compatibilityVersion) Minor() uint {
+	return c.minor
+}
+
+func (c *compatibilityVersion) String() string {
+	return fmt.Sprintf("%d.%d", c.major, c.minor)
+}
+
+func newCompatibilityVersion(major uint, minor uint) (*compatibilityVersion, error) {
+	if major == 0 {
+		return nil, newError(EBadArgument, "invalid compatibility version %d.%d, the major version must be set", major, minor)
+	}
+	return &compatibilityVersion{
+		major: major,
+		minor: minor,
+	}, nil
+}
+
+// compareCompatibilityVersions returns a negative number if a is older than b, 0 if they are equal, and a positive
+// number if a is newer than b.
+func compareCompatibilityVersions(a CompatibilityVersion, b CompatibilityVersion) int {
+	if a.Major() != b.Major() {
+		if a.Major() > b.Major() {
+			return 1
+		}
+		return -1
+	}
+	if a.Minor() != b.Minor() {
+		if a.Minor() > b.Minor() {
+			return 1
+		}
+		return -1
+	}
+	return 0
+}
+
+// checkCustomCompatibilityVersion verifies that the custom compatibility version requested for a VM is not newer than
+// the compatibility version of the cluster, which the engine would refuse.
+func checkCustomCompatibilityVersion(version CompatibilityVersion, cluster Cluster) error {
+	if version == nil {
+		return nil
+	}
+	clusterVersion := cluster.CompatibilityVersion()
+	if clusterVersion == nil {
+		return nil
+	}
+	if compareCompatibilityVersions(version, clusterVersion) > 0 {
+		return newError(
+			EBadArgument,
+			"the custom compatibility version %s is higher than the version of cluster %s (%s)",
+			version,
+			cluster.ID(),
+			clusterVersion,
+		)
+	}
+	return nil
+}
+
+func convertSDKCompatibilityVersion(version *ovirtsdk.Version) *compatibilityVersion {
+	major, ok := version.Major()
+	if !ok || major <= 0 {
+		return nil
+	}
+	minor, _ := version.Minor()
+	if minor < 0 {
+		minor = 0
+	}
+	return &compatibilityVersion{
+		major: uint(major),
+		minor: uint(minor),
+	}
+}
+
+func vmCustomCompatibilityVersionConverter(object *ovirtsdk.Vm, v *vm) error {
+	if version, ok := object.CustomCompatibilityVersion(); ok {
+		v.customCompatibilityVersion = convertSDKCompatibilityVersion(version)
+	}
+	return nil
+}
+
+func vmCustomCompatibilityVersionCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if version := params.CustomCompatibilityVersion(); version != nil {
+		builder.CustomCompatibilityVersionBuilder(
+			ovirtsdk.NewVersionBuilder().Major(int64(version.Major())).Minor(int64(version.Minor())),
+		)
+	}
+}
+
+func (o *oVirtClient) GetVMCompatibilityVersion(id VMID, retries ...RetryStrategy) (CompatibilityVersion, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	vm, err := o.GetVM(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	if version := vm.CustomCompatibilityVersion(); version != nil {
+		return version, nil
+	}
+	cluster, err := o.GetCluster(vm.ClusterID(), retries...)
+	if err != nil {
+		return nil, err
+	}
+	if version := cluster.CompatibilityVersion(); version != nil {
+		return version, nil
+	}
+	return nil, newFieldNotFound("cluster", "version")
+}
+
+func (m *mockClient) GetVMCompatibilityVersion(id VMID, _ ...RetryStrategy) (CompatibilityVersion, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", id)
+	}
+	if item.customCompatibilityVersion != nil {
+		return item.customCompatibilityVersion, nil
+	}
+	return m.clusters[item.clusterID].CompatibilityVersion(), nil
+}
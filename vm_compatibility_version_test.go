@@ -0,0 +1,68 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMCustomCompatibilityVersion(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	cluster, err := client.GetCluster(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to get cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	clusterVersion := cluster.CompatibilityVersion()
+	if clusterVersion == nil {
+		t.Skipf("Cluster %s has no compatibility version.", cluster.ID())
+	}
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	if vm.CustomCompatibilityVersion() != nil {
+		t.Fatalf("VM created without a custom compatibility version has one (%s)", vm.CustomCompatibilityVersion())
+	}
+	version, err := client.GetVMCompatibilityVersion(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to get compatibility version of VM %s (%v)", vm.ID(), err)
+	}
+	if version.String() != clusterVersion.String() {
+		t.Fatalf("Incorrect compatibility version (expected: %s, got: %s)", clusterVersion, version)
+	}
+
+	pinnedVM := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithCustomCompatibilityVersion(clusterVersion.Major(), clusterVersion.Minor()),
+	)
+	if pinnedVM.CustomCompatibilityVersion() == nil {
+		t.Fatalf("VM created with a custom compatibility version has none.")
+	}
+	if pinnedVM.CustomCompatibilityVersion().String() != clusterVersion.String() {
+		t.Fatalf(
+			"Incorrect custom compatibility version (expected: %s, got: %s)",
+			clusterVersion,
+			pinnedVM.CustomCompatibilityVersion(),
+		)
+	}
+
+	_, err = client.CreateVM(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithCustomCompatibilityVersion(clusterVersion.Major()+1, 0),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a VM with a version higher than the cluster did not result in an EBadArgument error (%v)", err)
+	}
+
+	if _, err := ovirtclient.NewCreateVMParams().WithCustomCompatibilityVersion(0, 1); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting an invalid compatibility version did not result in an EBadArgument error (%v)", err)
+	}
+}
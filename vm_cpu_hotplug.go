@@ -0,0 +1,177 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strconv"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) HotPlugVMCPU(id VMID, targetVCPUs uint, retries ...RetryStrategy) (VM, error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+
+	currentVM, err := o.GetVM(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := o.getVCPULimits(currentVM, retries)
+	if err != nil {
+		return nil, err
+	}
+	sockets, err := calculateHotPluggedCPUSockets(currentVM, targetVCPUs, limits)
+	if err != nil {
+		return nil, err
+	}
+
+	topo := currentVM.CPU().Topo()
+	sdkVM := &ovirtsdk.Vm{}
+	sdkVM.SetId(string(id))
+	sdkVM.SetCpu(
+		ovirtsdk.NewCpuBuilder().
+			TopologyBuilder(
+				ovirtsdk.NewCpuTopologyBuilder().
+					Sockets(int64(sockets)).
+					Cores(int64(topo.Cores())).
+					Threads(int64(topo.Threads())),
+			).
+			MustBuild(),
+	)
+	return o.updateRunningVM(id, sdkVM, fmt.Sprintf("hot-plugging vCPUs on VM %s", id), retries)
+}
+
+// vcpuLimits are the maximum number of vCPUs and sockets of a VM. Zero means the limit is not known.
+type vcpuLimits struct {
+	maxVCPUs   uint
+	maxSockets uint
+}
+
+// getVCPULimits reads the vCPU limits for the compatibility version of the VM from the engine options. Limits the
+// engine doesn't expose are left at zero and the engine checks them when applying the change.
+func (o *oVirtClient) getVCPULimits(vm VM, retries []RetryStrategy) (vcpuLimits, error) {
+	version := ""
+	if customVersion := vm.CustomCompatibilityVersion(); customVersion != nil {
+		version = customVersion.String()
+	} else {
+		cluster, err := o.GetCluster(vm.ClusterID(), retries...)
+		if err != nil {
+			return vcpuLimits{}, err
+		}
+		if clusterVersion := cluster.CompatibilityVersion(); clusterVersion != nil {
+			version = clusterVersion.String()
+		}
+	}
+	limits := vcpuLimits{}
+	for optionName, limit := range map[string]*uint{
+		"MaxNumOfVmCpus":    &limits.maxVCPUs,
+		"MaxNumOfVmSockets": &limits.maxSockets,
+	} {
+		option, err := o.GetEngineOption(optionName, retries...)
+		if err != nil {
+			if HasErrorCode(err, ENotFound) {
+				continue
+			}
+			return vcpuLimits{}, err
+		}
+		*limit = parseVCPULimit(option, version)
+	}
+	return limits, nil
+}
+
+func parseVCPULimit(option EngineOption, version string) uint {
+	value, ok := option.ValueForVersion(version)
+	if !ok {
+		return 0
+	}
+	limit, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return uint(limit)
+}
+
+// calculateHotPluggedCPUSockets returns the number of sockets the VM needs to reach the target vCPU count.
+func calculateHotPluggedCPUSockets(vm VM, targetVCPUs uint, limits vcpuLimits) (uint, error) {
+	if vm.Status() != VMStatusUp {
+		return 0, newError(
+			EUnsupported,
+			"vCPUs can only be hot-plugged on running VMs, VM %s is in status %s, use UpdateVM instead",
+			vm.ID(),
+			vm.Status(),
+		)
+	}
+	topo := vm.CPU().Topo()
+	perSocket := topo.Cores() * topo.Threads()
+	current := topo.Sockets() * perSocket
+	if targetVCPUs <= current {
+		return 0, newError(
+			EBadArgument,
+			"the target of %d vCPUs must be higher than the current %d vCPUs of VM %s",
+			targetVCPUs,
+			current,
+			vm.ID(),
+		)
+	}
+	if targetVCPUs%perSocket != 0 {
+		return 0, newError(
+			EBadArgument,
+			"the target of %d vCPUs must be a multiple of the %d vCPUs per socket of VM %s",
+			targetVCPUs,
+			perSocket,
+			vm.ID(),
+		)
+	}
+	if limits.maxVCPUs != 0 && targetVCPUs > limits.maxVCPUs {
+		return 0, newError(
+			EBadArgument,
+			"the target of %d vCPUs exceeds the engine limit of %d vCPUs per VM",
+			targetVCPUs,
+			limits.maxVCPUs,
+		)
+	}
+	sockets := targetVCPUs / perSocket
+	if limits.maxSockets != 0 && sockets > limits.maxSockets {
+		return 0, newError(
+			EBadArgument,
+			"the target of %d vCPUs requires %d sockets, which exceeds the engine limit of %d sockets per VM",
+			targetVCPUs,
+			sockets,
+			limits.maxSockets,
+		)
+	}
+	return sockets, nil
+}
+
+func (m *mockClient) HotPlugVMCPU(id VMID, targetVCPUs uint, _ ...RetryStrategy) (VM, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	item, ok := m.vms[id]
+	if !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", id)
+	}
+	version := ""
+	if item.customCompatibilityVersion != nil {
+		version = item.customCompatibilityVersion.String()
+	} else if cluster, ok := m.clusters[item.clusterID]; ok && cluster.version != nil {
+		version = cluster.version.String()
+	}
+	limits := vcpuLimits{
+		maxVCPUs:   parseVCPULimit(mockEngineOptions["MaxNumOfVmCpus"], version),
+		maxSockets: parseVCPULimit(mockEngineOptions["MaxNumOfVmSockets"], version),
+	}
+	sockets, err := calculateHotPluggedCPUSockets(item, targetVCPUs, limits)
+	if err != nil {
+		return nil, err
+	}
+	// The topology is shared with snapshots of the VM handed out earlier, so it is replaced instead of modified.
+	item.cpu = &vmCPU{
+		topo: &vmCPUTopo{
+			cores:   item.cpu.topo.cores,
+			threads: item.cpu.topo.threads,
+			sockets: sockets,
+		},
+		mode:        item.cpu.mode,
+		customModel: item.cpu.customModel,
+	}
+	return item.snapshot(), nil
+}
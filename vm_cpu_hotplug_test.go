@@ -0,0 +1,63 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMCPUHotPlug(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithCPUParameters(2, 1, 1),
+	)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanUploadDiskImage(t, helper, disk)
+	assertCanAttachDiskWithParams(
+		t,
+		vm,
+		disk,
+		ovirtclient.CreateDiskAttachmentParams().MustWithBootable(true).MustWithActive(true),
+	)
+	assertCanStartVM(t, helper, vm)
+	vm = assertVMWillStart(t, vm)
+
+	if _, err := vm.HotPlugCPU(3); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Hot-plugging a vCPU count not matching the topology did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := vm.HotPlugCPU(2); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Hot-plugging the current vCPU count did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := vm.HotPlugCPU(1024); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Hot-plugging more vCPUs than the engine allows did not result in an EBadArgument error (%v)", err)
+	}
+
+	updatedVM, err := vm.HotPlugCPU(4)
+	if err != nil {
+		t.Fatalf("Failed to hot-plug vCPUs (%v)", err)
+	}
+	topo := updatedVM.CPU().Topo()
+	if topo.Sockets() != 2 || topo.Cores() != 2 || topo.Threads() != 1 {
+		t.Fatalf(
+			"Incorrect topology after hot-plug (expected: 2 sockets, 2 cores, 1 thread, got: %d, %d, %d)",
+			topo.Sockets(),
+			topo.Cores(),
+			topo.Threads(),
+		)
+	}
+}
+
+func TestVMCPUHotPlugOnStoppedVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	if _, err := vm.HotPlugCPU(2); !ovirtclient.HasErrorCode(err, ovirtclient.EUnsupported) {
+		t.Fatalf("Hot-plugging vCPUs on a stopped VM did not result in an EUnsupported error (%v)", err)
+	}
+}
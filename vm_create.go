@@ -38,6 +38,9 @@ func vmBuilderCPU(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
 			cpuBuilder.Mode(ovirtsdk.CpuMode(*mode))
 		}
 		builder.CpuBuilder(cpuBuilder)
+		if customModel := cpu.CustomModel(); customModel != nil {
+			builder.CustomCpuModel(*customModel)
+		}
 	}
 }
 
@@ -64,12 +67,14 @@ func vmBuilderMemory(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
 	}
 }
 
-func vmBuilderInitialization(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) { //nolint:funlen
+func vmBuilderInitialization(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
 	if params.Initialization() == nil {
 		return
 	}
+	builder.InitializationBuilder(buildSDKInitialization(params.Initialization()))
+}
 
-	init := params.Initialization()
+func buildSDKInitialization(init Initialization) *ovirtsdk.InitializationBuilder { //nolint:funlen
 	initBuilder := ovirtsdk.NewInitializationBuilder()
 
 	if init.CustomScript() != "" {
@@ -153,7 +158,7 @@ func vmBuilderInitialization(params OptionalVMParameters, builder *ovirtsdk.VmBu
 	if init.WindowsLicenseKey() != "" {
 		initBuilder.WindowsLicenseKey(init.WindowsLicenseKey())
 	}
-	builder.InitializationBuilder(initBuilder)
+	return initBuilder
 }
 
 func vmPlacementPolicyParameterConverter(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
@@ -175,6 +180,11 @@ func vmPlacementPolicyParameterConverter(params OptionalVMParameters, builder *o
 func (o *oVirtClient) CreateVM(clusterID ClusterID, templateID TemplateID, name string, params OptionalVMParameters, retries ...RetryStrategy) (result VM, err error) {
 	retries = defaultRetries(retries, defaultLongTimeouts(o))
 
+	if templateID == "" {
+		if templateID, err = resolveBlankTemplateForCreation(o, params, retries); err != nil {
+			return nil, err
+		}
+	}
 	if err := validateVMCreationParameters(clusterID, templateID, name, params); err != nil {
 		return nil, err
 	}
@@ -183,6 +193,30 @@ func (o *oVirtClient) CreateVM(clusterID ClusterID, templateID TemplateID, name
 		params = &vmParams{}
 	}
 
+	if version := params.CustomCompatibilityVersion(); version != nil {
+		cluster, err := o.GetCluster(clusterID, retries...)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkCustomCompatibilityVersion(version, cluster); err != nil {
+			return nil, err
+		}
+	}
+	if storageDomainID := params.LeaseStorageDomainID(); storageDomainID != nil {
+		if err := o.validateLeaseStorageDomain(*storageDomainID, retries); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateNewDiskStorageDomains(o, params.NewDisks(), retries); err != nil {
+		return nil, err
+	}
+	if err := checkExistingDisksAttachable(o, params.ExistingDisks(), retries); err != nil {
+		return nil, err
+	}
+	if err := checkSecureBootSupported(o, clusterID, templateID, params, retries); err != nil {
+		return nil, err
+	}
+
 	message := fmt.Sprintf("creating VM %s", name)
 	vm, err := createSDKVM(clusterID, templateID, name, params)
 	if err != nil {
@@ -217,7 +251,20 @@ func (o *oVirtClient) CreateVM(clusterID ClusterID, templateID TemplateID, name
 			return nil
 		},
 	)
-	return result, err
+	if err != nil {
+		return nil, err
+	}
+	if len(params.NewDisks()) > 0 || len(params.ExistingDisks()) > 0 {
+		if result, err = waitForNewVMDisks(o, result, params.ExistingDisks(), retries); err != nil {
+			return result, err
+		}
+	}
+	if watchdog := params.Watchdog(); watchdog != nil {
+		if err := o.SetVMWatchdog(result.ID(), watchdog.Model(), watchdog.Action(), retries...); err != nil {
+			return result, wrap(err, EUnidentified, "VM %s was created, but adding the watchdog failed", result.ID())
+		}
+	}
+	return result, nil
 }
 
 func createSDKVM(
@@ -244,6 +291,15 @@ func createSDKVM(
 		vmOSCreator,
 		vmSerialConsoleCreator,
 		vmSoundcardEnabledCreator,
+		vmTimeZoneCreator,
+		vmBIOSTypeCreator,
+		vmSerialNumberCreator,
+		vmRNGDeviceCreator,
+		vmCustomCompatibilityVersionCreator,
+		vmSafetyFlagsCreator,
+		vmLeaseCreator,
+		vmStorageErrorResumeBehaviourCreator,
+		vmSpiceCreator,
 	}
 
 	for _, part := range parts {
@@ -272,6 +328,16 @@ func createSDKVM(
 			}
 			diskAttachments = append(diskAttachments, sdkDisk)
 		}
+		newDiskAttachments, err := buildNewDiskAttachments(params.NewDisks())
+		if err != nil {
+			return nil, err
+		}
+		diskAttachments = append(diskAttachments, newDiskAttachments...)
+		existingDiskAttachments, err := buildExistingDiskAttachments(params.ExistingDisks())
+		if err != nil {
+			return nil, err
+		}
+		diskAttachments = append(diskAttachments, existingDiskAttachments...)
 		builder.DiskAttachmentsOfAny(diskAttachments...)
 	}
 
@@ -298,12 +364,40 @@ func vmSoundcardEnabledCreator(params OptionalVMParameters, builder *ovirtsdk.Vm
 	builder.SoundcardEnabled(*soundcardEnabled)
 }
 
+func vmTimeZoneCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if timeZone := params.TimeZone(); timeZone != nil {
+		builder.TimeZoneBuilder(ovirtsdk.NewTimeZoneBuilder().Name(*timeZone))
+	}
+}
+
+func vmBIOSTypeCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if biosType := params.BIOSType(); biosType != nil {
+		builder.BiosBuilder(ovirtsdk.NewBiosBuilder().Type(ovirtsdk.BiosType(*biosType)))
+	}
+}
+
 func vmOSCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
 	if os, ok := params.OS(); ok {
 		osBuilder := ovirtsdk.NewOperatingSystemBuilder()
 		if t := os.Type(); t != nil {
 			osBuilder.Type(*t)
 		}
+		if kernel := os.Kernel(); kernel != nil {
+			osBuilder.Kernel(*kernel)
+		}
+		if initrd := os.Initrd(); initrd != nil && *initrd != "" {
+			osBuilder.Initrd(*initrd)
+		}
+		if cmdline := os.Cmdline(); cmdline != nil && *cmdline != "" {
+			osBuilder.Cmdline(*cmdline)
+		}
+		if devices := os.BootDevices(); len(devices) > 0 {
+			sdkDevices := make([]ovirtsdk.BootDevice, len(devices))
+			for i, device := range devices {
+				sdkDevices[i] = ovirtsdk.BootDevice(device)
+			}
+			osBuilder.BootBuilder(ovirtsdk.NewBootBuilder().Devices(sdkDevices))
+		}
 		builder.OsBuilder(osBuilder)
 	}
 }
@@ -336,13 +430,50 @@ func vmBuilderMemoryPolicy(params OptionalVMParameters, builder *ovirtsdk.VmBuil
 	}
 }
 
+// resolveBlankTemplateForCreation validates the parameters for a VM created without a template and returns the ID of
+// the blank template to create it from. The engine rejects such VMs with unhelpful errors if the memory is missing or
+// template disks are configured, and accepts VMs that have nothing to boot from, so these are checked here.
+func resolveBlankTemplateForCreation(
+	client TemplateClient,
+	params OptionalVMParameters,
+	retries []RetryStrategy,
+) (TemplateID, error) {
+	if params == nil || params.Memory() == nil {
+		return "", newError(EBadArgument, "the memory must be set when creating a VM without a template")
+	}
+	if len(params.Disks()) > 0 {
+		return "", newError(
+			EBadArgument,
+			"disk parameters cannot be passed when creating a VM without a template, attach disks after creation instead",
+		)
+	}
+	hasBootDevices := false
+	if os, ok := params.OS(); ok && os != nil {
+		hasBootDevices = len(os.BootDevices()) > 0
+	}
+	if len(params.NewDisks()) == 0 && len(params.ExistingDisks()) == 0 && !hasBootDevices {
+		return "", newError(
+			EBadArgument,
+			"a VM created without a template needs at least one disk or an explicit boot device to boot from",
+		)
+	}
+	tpl, err := client.GetBlankTemplate(retries...)
+	if err != nil {
+		return "", wrap(err, EUnidentified, "failed to find the blank template to create a VM without a template")
+	}
+	return tpl.ID(), nil
+}
+
 func validateVMCreationParameters(clusterID ClusterID, templateID TemplateID, name string, params OptionalVMParameters) error {
-	if name == "" {
-		return newError(EBadArgument, "name cannot be empty for VM creation")
+	if err := validateVMName(name); err != nil {
+		return err
 	}
 	if clusterID == "" {
 		return newError(EBadArgument, "cluster ID cannot be empty for VM creation")
 	}
+	if err := validateNotBlankUUID("cluster", string(clusterID)); err != nil {
+		return err
+	}
 	if templateID == "" {
 		return newError(EBadArgument, "template ID cannot be empty for VM creation")
 	}
@@ -370,6 +501,25 @@ func validateVMCreationParameters(clusterID ClusterID, templateID TemplateID, na
 			*memory,
 		)
 	}
+	if memPolicy := params.MemoryPolicy(); memPolicy != nil {
+		if max := (*memPolicy).Max(); max != nil && *memory > *max {
+			return newError(
+				EBadArgument,
+				"the VM memory is larger than the maximum memory (%d > %d)",
+				*memory,
+				*max,
+			)
+		}
+	}
+
+	if err := validateExistingDiskAttachments(params.ExistingDisks(), params.NewDisks()); err != nil {
+		return err
+	}
+	if os, ok := params.OS(); ok && os != nil {
+		if err := validateBootDevices(os.BootDevices()); err != nil {
+			return err
+		}
+	}
 
 	disks := params.Disks()
 	diskIDs := map[DiskID]int{}
@@ -391,6 +541,27 @@ func validateVMCreationParameters(clusterID ClusterID, templateID TemplateID, na
 		}
 	}
 
+	return validateVMBIOSType(params)
+}
+
+// validateVMBIOSType checks the BIOS type against the requested operating system. If no operating system type is
+// passed, the one from the template is used and the engine does the validation.
+func validateVMBIOSType(params OptionalVMParameters) error {
+	biosType := params.BIOSType()
+	if biosType == nil {
+		return nil
+	}
+	if err := biosType.Validate(); err != nil {
+		return err
+	}
+	if *biosType != BIOSTypeQ35SecureBoot {
+		return nil
+	}
+	if os, ok := params.OS(); ok && os != nil {
+		if osType := os.Type(); osType != nil {
+			return validateSecureBootOSType(*osType)
+		}
+	}
 	return nil
 }
 
@@ -403,6 +574,11 @@ func (m *mockClient) CreateVM(
 ) (result VM, err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts(m))
 
+	if templateID == "" {
+		if templateID, err = resolveBlankTemplateForCreation(m, params, retries); err != nil {
+			return nil, err
+		}
+	}
 	if err := validateVMCreationParameters(clusterID, templateID, name, params); err != nil {
 		return nil, err
 	}
@@ -412,6 +588,15 @@ func (m *mockClient) CreateVM(
 	if name == "" {
 		return nil, newError(EBadArgument, "The name parameter is required for VM creation.")
 	}
+	if err := validateNewDiskStorageDomains(m, params.NewDisks(), retries); err != nil {
+		return nil, err
+	}
+	if err := checkExistingDisksAttachable(m, params.ExistingDisks(), retries); err != nil {
+		return nil, err
+	}
+	if err := checkSecureBootSupported(m, clusterID, templateID, params, retries); err != nil {
+		return nil, err
+	}
 	err = retry(
 		fmt.Sprintf("creating VM %s", name),
 		m.logger,
@@ -419,9 +604,18 @@ func (m *mockClient) CreateVM(
 		func() error {
 			m.lock.Lock()
 			defer m.lock.Unlock()
-			if _, ok := m.clusters[clusterID]; !ok {
+			cluster, ok := m.clusters[clusterID]
+			if !ok {
 				return newError(ENotFound, "cluster with ID %s not found", clusterID)
 			}
+			if err := checkCustomCompatibilityVersion(params.CustomCompatibilityVersion(), cluster); err != nil {
+				return err
+			}
+			if storageDomainID := params.LeaseStorageDomainID(); storageDomainID != nil {
+				if err := m.validateLeaseStorageDomain(*storageDomainID); err != nil {
+					return err
+				}
+			}
 			tpl, ok := m.templates[templateID]
 			if !ok {
 				return newError(ENotFound, "template with ID %s not found", templateID)
@@ -436,11 +630,19 @@ func (m *mockClient) CreateVM(
 				}
 			}
 
+			if err := m.checkExistingVMDisksFree(params.ExistingDisks()); err != nil {
+				return err
+			}
+
 			cpu := m.createVMCPU(params, tpl)
 
 			vm := m.createVM(name, params, clusterID, templateID, cpu)
 
 			m.attachVMDisksFromTemplate(tpl, vm, params)
+			if err := m.attachNewVMDisks(vm, params.NewDisks()); err != nil {
+				return err
+			}
+			m.attachExistingVMDisks(vm, params.ExistingDisks())
 
 			if clone := params.Clone(); clone != nil && *clone {
 				vm.templateID = DefaultBlankTemplateID
@@ -448,11 +650,17 @@ func (m *mockClient) CreateVM(
 
 			m.vmIPs[vm.id] = map[string][]net.IP{}
 			m.addGraphicsConsoles(vm)
+			if watchdog := params.Watchdog(); watchdog != nil {
+				m.setVMWatchdog(vm.id, watchdog.Model(), watchdog.Action())
+			}
 
 			result = vm
 			return nil
 		},
 	)
+	if err == nil && (len(params.NewDisks()) > 0 || len(params.ExistingDisks()) > 0) {
+		result, err = waitForNewVMDisks(m, result, params.ExistingDisks(), retries)
+	}
 
 	return result, err
 }
@@ -522,6 +730,18 @@ func (m *mockClient) createVM(
 		m.createVMOS(params),
 		console,
 		soundcardEnabled,
+		false,
+		m.createVMTimeZone(params),
+		m.createVMBIOSType(params, m.templates[templateID]),
+		m.createVMSerialNumber(params),
+		m.createVMRNGDevice(params),
+		m.createVMCustomCompatibilityVersion(params),
+		params.Stateless() != nil && *params.Stateless(),
+		params.DeleteProtected() != nil && *params.DeleteProtected(),
+		false,
+		m.createVMLeaseStorageDomainID(params),
+		m.createVMStorageErrorResumeBehaviour(params),
+		m.createVMDisplay(params),
 	}
 	m.vms[VMID(id)] = vm
 	return vm
@@ -563,10 +783,29 @@ func (m *mockClient) createVMOS(params OptionalVMParameters) *vmOS {
 		if osType := osParams.Type(); osType != nil {
 			os.t = *osType
 		}
+		if kernel := osParams.Kernel(); kernel != nil {
+			os.kernel = *kernel
+			os.initrd = *osParams.Initrd()
+			os.cmdline = *osParams.Cmdline()
+		}
 	}
 	return os
 }
 
+func (m *mockClient) createVMTimeZone(params OptionalVMParameters) string {
+	if timeZone := params.TimeZone(); timeZone != nil {
+		return *timeZone
+	}
+	return ""
+}
+
+func (m *mockClient) createVMBIOSType(params OptionalVMParameters, tpl *template) BIOSType {
+	if biosType := params.BIOSType(); biosType != nil {
+		return *biosType
+	}
+	return tpl.biosType
+}
+
 func (m *mockClient) createVMType(params OptionalVMParameters) VMType {
 	vmType := VMTypeServer
 	if paramVMType := params.VMType(); paramVMType != nil {
@@ -608,6 +847,8 @@ func (m *mockClient) attachVMDisksFromTemplate(tpl *template, vm *vm, params Opt
 
 		go func() {
 			time.Sleep(time.Second)
+			m.lock.Lock()
+			defer m.lock.Unlock()
 			newDisk.Unlock()
 		}()
 
@@ -621,7 +862,7 @@ func (m *mockClient) attachVMDisksFromTemplate(tpl *template, vm *vm, params Opt
 			active:        attachment.active,
 		}
 		m.vmDiskAttachmentsByVM[vm.id][diskAttachment.id] = diskAttachment
-		m.vmDiskAttachmentsByDisk[disk.id] = diskAttachment
+		m.addDiskAttachmentByDisk(diskAttachment)
 	}
 }
 
@@ -673,6 +914,9 @@ func (m *mockClient) createVMCPU(params OptionalVMParameters, tpl *template) *vm
 		if mode := cpuParams.Mode(); mode != nil {
 			cpu.mode = mode
 		}
+		if customModel := cpuParams.CustomModel(); customModel != nil {
+			cpu.customModel = *customModel
+		}
 	case tpl.cpu != nil:
 		cpu = tpl.cpu.clone()
 	default:
@@ -686,3 +930,37 @@ func (m *mockClient) createVMCPU(params OptionalVMParameters, tpl *template) *vm
 	}
 	return cpu
 }
+
+func (m *mockClient) createVMCustomCompatibilityVersion(params OptionalVMParameters) *compatibilityVersion {
+	version := params.CustomCompatibilityVersion()
+	if version == nil {
+		return nil
+	}
+	return &compatibilityVersion{
+		major: version.Major(),
+		minor: version.Minor(),
+	}
+}
+
+func (m *mockClient) createVMRNGDevice(params OptionalVMParameters) *vmRNGDevice {
+	rngDevice := params.RNGDevice()
+	if rngDevice == nil {
+		return nil
+	}
+	return &vmRNGDevice{
+		source:     rngDevice.Source(),
+		rateBytes:  rngDevice.RateBytes(),
+		ratePeriod: rngDevice.RatePeriod(),
+	}
+}
+
+func (m *mockClient) createVMSerialNumber(params OptionalVMParameters) *vmSerialNumber {
+	serialNumber := params.SerialNumber()
+	if serialNumber == nil {
+		return nil
+	}
+	return &vmSerialNumber{
+		policy: serialNumber.Policy(),
+		value:  serialNumber.Value(),
+	}
+}
@@ -0,0 +1,234 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// ExistingDiskAttachment describes an existing floating disk to attach to a VM while it is created. Use
+// NewExistingDiskAttachmentParams to create one and pass it to BuildableVMParameters.WithExistingDisks.
+type ExistingDiskAttachment interface {
+	// DiskID returns the ID of the disk to attach.
+	DiskID() DiskID
+	// Interface returns the interface the disk is attached with. Defaults to DiskInterfaceVirtIO.
+	Interface() DiskInterface
+	// Bootable returns true if the VM should boot from the disk.
+	Bootable() bool
+}
+
+// BuildableExistingDiskAttachment is a buildable version of ExistingDiskAttachment.
+type BuildableExistingDiskAttachment interface {
+	ExistingDiskAttachment
+
+	// WithInterface sets the interface the disk is attached with.
+	WithInterface(diskInterface DiskInterface) (BuildableExistingDiskAttachment, error)
+	// MustWithInterface is identical to WithInterface, but panics instead of returning an error.
+	MustWithInterface(diskInterface DiskInterface) BuildableExistingDiskAttachment
+
+	// WithBootable sets if the VM should boot from the disk.
+	WithBootable(bootable bool) (BuildableExistingDiskAttachment, error)
+	// MustWithBootable is identical to WithBootable, but panics instead of returning an error.
+	MustWithBootable(bootable bool) BuildableExistingDiskAttachment
+}
+
+// NewExistingDiskAttachmentParams creates the description of an existing disk to attach to a VM on creation.
+func NewExistingDiskAttachmentParams(diskID DiskID) (BuildableExistingDiskAttachment, error) {
+	if diskID == "" {
+		return nil, newError(EBadArgument, "the ID of an existing disk cannot be empty")
+	}
+	return &existingDiskAttachment{
+		diskID:        diskID,
+		diskInterface: DiskInterfaceVirtIO,
+	}, nil
+}
+
+// MustNewExistingDiskAttachmentParams is identical to NewExistingDiskAttachmentParams, but panics instead of
+// returning an error.
+func MustNewExistingDiskAttachmentParams(diskID DiskID) BuildableExistingDiskAttachment {
+	builder, err := NewExistingDiskAttachmentParams(diskID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+type existingDiskAttachment struct {
+	diskID        DiskID
+	diskInterface DiskInterface
+	bootable      bool
+}
+
+func (e *existingDiskAttachment) DiskID() DiskID {
+	return e.diskID
+}
+
+func (e *existingDiskAttachment) Interface() DiskInterface {
+	return e.diskInterface
+}
+
+func (e *existingDiskAttachment) Bootable() bool {
+	return e.bootable
+}
+
+func (e *existingDiskAttachment) WithInterface(diskInterface DiskInterface) (BuildableExistingDiskAttachment, error) {
+	if err := diskInterface.Validate(); err != nil {
+		return nil, err
+	}
+	e.diskInterface = diskInterface
+	return e, nil
+}
+
+func (e *existingDiskAttachment) MustWithInterface(diskInterface DiskInterface) BuildableExistingDiskAttachment {
+	builder, err := e.WithInterface(diskInterface)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *existingDiskAttachment) WithBootable(bootable bool) (BuildableExistingDiskAttachment, error) {
+	e.bootable = bootable
+	return e, nil
+}
+
+func (e *existingDiskAttachment) MustWithBootable(bootable bool) BuildableExistingDiskAttachment {
+	builder, err := e.WithBootable(bootable)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// validateExistingDiskAttachments checks the existing disks for duplicates and makes sure that at most one disk is
+// bootable, counting the new disks as well.
+func validateExistingDiskAttachments(disks []ExistingDiskAttachment, newDisks []NewDiskSpec) error {
+	bootable := 0
+	for _, disk := range newDisks {
+		if disk != nil && disk.Bootable() {
+			bootable++
+		}
+	}
+	diskIDs := map[DiskID]int{}
+	for i, disk := range disks {
+		if disk == nil {
+			return newError(EBadArgument, "existing disk %d is nil", i)
+		}
+		if previous, ok := diskIDs[disk.DiskID()]; ok {
+			return newError(
+				EBadArgument,
+				"existing disk %s appears twice, in position %d and %d",
+				disk.DiskID(),
+				previous,
+				i,
+			)
+		}
+		diskIDs[disk.DiskID()] = i
+		if disk.Bootable() {
+			bootable++
+		}
+	}
+	if bootable > 1 {
+		return newError(EBadArgument, "%d of the new and existing disks are bootable, only one can be", bootable)
+	}
+	return nil
+}
+
+// checkExistingDisksAttachable verifies that the existing disks exist, are not locked and are not attached to a VM
+// unless they are shareable. The engine would otherwise reject the whole VM creation.
+func checkExistingDisksAttachable(client Client, disks []ExistingDiskAttachment, retries []RetryStrategy) error {
+	for _, attachment := range disks {
+		disk, err := client.GetDisk(attachment.DiskID(), retries...)
+		if err != nil {
+			return wrap(err, EUnidentified, "failed to fetch existing disk %s", attachment.DiskID())
+		}
+		if disk.Status() != DiskStatusOK {
+			return newError(
+				EConflict,
+				"existing disk %s must be in status %s to be attached (status: %s)",
+				disk.ID(),
+				DiskStatusOK,
+				disk.Status(),
+			)
+		}
+		if disk.Shareable() {
+			continue
+		}
+		vmIDs, err := client.GetDiskVMs(disk.ID(), retries...)
+		if err != nil {
+			return err
+		}
+		if len(vmIDs) > 0 {
+			return newError(EConflict, "existing disk %s is already attached to VM %s", disk.ID(), vmIDs[0])
+		}
+	}
+	return nil
+}
+
+func buildExistingDiskAttachments(disks []ExistingDiskAttachment) ([]*ovirtsdk.DiskAttachment, error) {
+	result := make([]*ovirtsdk.DiskAttachment, len(disks))
+	for i, disk := range disks {
+		var err error
+		result[i], err = ovirtsdk.NewDiskAttachmentBuilder().
+			DiskBuilder(ovirtsdk.NewDiskBuilder().Id(string(disk.DiskID()))).
+			Interface(ovirtsdk.DiskInterface(disk.Interface())).
+			Bootable(disk.Bootable()).
+			Active(true).
+			Build()
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to build disk attachment for existing disk %s", disk.DiskID())
+		}
+	}
+	return result, nil
+}
+
+// checkExistingVMDisksAttached returns an error if any of the existing disks is missing from the disks attached to
+// the new VM.
+func checkExistingVMDisksAttached(vmID VMID, disks []ExistingDiskAttachment, attachments []DiskAttachment) error {
+	attached := map[DiskID]bool{}
+	for _, attachment := range attachments {
+		attached[attachment.DiskID()] = true
+	}
+	for _, disk := range disks {
+		if !attached[disk.DiskID()] {
+			return newError(
+				EUnidentified,
+				"VM %s was created, but existing disk %s is not attached to it",
+				vmID,
+				disk.DiskID(),
+			)
+		}
+	}
+	return nil
+}
+
+// checkExistingVMDisksFree repeats the checks of checkExistingDisksAttachable with the lock held, in case a disk was
+// attached elsewhere in the meantime.
+func (m *mockClient) checkExistingVMDisksFree(disks []ExistingDiskAttachment) error {
+	for _, spec := range disks {
+		disk, ok := m.disks[spec.DiskID()]
+		if !ok {
+			return newError(ENotFound, "disk with ID %s not found", spec.DiskID())
+		}
+		if !disk.shareable && len(m.vmDiskAttachmentsByDisk[disk.id]) > 0 {
+			return newError(EConflict, "existing disk %s is already attached to a VM", disk.id)
+		}
+	}
+	return nil
+}
+
+// attachExistingVMDisks attaches the existing disks to a VM in the mock. The disks have already been checked with
+// checkExistingVMDisksFree.
+func (m *mockClient) attachExistingVMDisks(vm *vm, disks []ExistingDiskAttachment) {
+	for _, spec := range disks {
+		attachment := &diskAttachment{
+			client:        m,
+			id:            DiskAttachmentID(m.GenerateUUID()),
+			vmid:          vm.id,
+			diskID:        spec.DiskID(),
+			diskInterface: spec.Interface(),
+			bootable:      spec.Bootable(),
+			active:        true,
+		}
+		m.vmDiskAttachmentsByVM[vm.id][attachment.id] = attachment
+		m.addDiskAttachmentByDisk(attachment)
+	}
+}
@@ -0,0 +1,84 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMCreationWithExistingDisks(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	disk := assertCanCreateDisk(t, helper)
+	params := ovirtclient.NewCreateVMParams().MustWithExistingDisks(
+		[]ovirtclient.ExistingDiskAttachment{
+			ovirtclient.MustNewExistingDiskAttachmentParams(disk.ID()).
+				MustWithInterface(ovirtclient.DiskInterfaceVirtIOSCSI).
+				MustWithBootable(true),
+		},
+	)
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), params)
+
+	attachments, err := vm.ListDiskAttachments()
+	if err != nil {
+		t.Fatalf("Failed to list disk attachments of VM %s (%v)", vm.ID(), err)
+	}
+	if len(attachments) != 1 {
+		t.Fatalf("Incorrect number of disk attachments (expected: 1, got: %d)", len(attachments))
+	}
+	attachment := attachments[0]
+	if attachment.DiskID() != disk.ID() {
+		t.Fatalf("Incorrect disk attached (expected: %s, got: %s)", disk.ID(), attachment.DiskID())
+	}
+	if !attachment.Bootable() || attachment.DiskInterface() != ovirtclient.DiskInterfaceVirtIOSCSI {
+		t.Fatalf("The existing disk was not attached with the requested interface and bootable flag.")
+	}
+
+	_, err = client.CreateVM(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithExistingDisks(
+			[]ovirtclient.ExistingDiskAttachment{ovirtclient.MustNewExistingDiskAttachmentParams(disk.ID())},
+		),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Creating a VM with a disk attached to another VM did not result in an EConflict error (%v)", err)
+	}
+}
+
+func TestVMCreationWithInvalidExistingDisks(t *testing.T) {
+	t.Parallel()
+
+	_, err := ovirtclient.NewExistingDiskAttachmentParams("")
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating an existing disk attachment without a disk ID did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := ovirtclient.NewCreateVMParams().WithExistingDisks(
+		[]ovirtclient.ExistingDiskAttachment{
+			ovirtclient.MustNewExistingDiskAttachmentParams("disk"),
+			ovirtclient.MustNewExistingDiskAttachmentParams("disk"),
+		},
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Attaching the same existing disk twice did not result in an EBadArgument error (%v)", err)
+	}
+
+	params := ovirtclient.NewCreateVMParams().MustWithNewDisks(
+		[]ovirtclient.NewDiskSpec{
+			ovirtclient.MustNewDiskSpecParams(
+				"storage-domain",
+				ovirtclient.ImageFormatRaw,
+				ovirtclient.MinDiskSizeOVirt,
+			).MustWithBootable(true),
+		},
+	)
+	if _, err := params.WithExistingDisks(
+		[]ovirtclient.ExistingDiskAttachment{
+			ovirtclient.MustNewExistingDiskAttachmentParams("disk").MustWithBootable(true),
+		},
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("A bootable existing disk next to a bootable new disk did not result in an EBadArgument error (%v)", err)
+	}
+}
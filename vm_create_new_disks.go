@@ -0,0 +1,270 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// NewDiskSpec describes a disk to create along with a VM. Use NewDiskSpecParams to create one and pass it to
+// BuildableVMParameters.WithNewDisks.
+type NewDiskSpec interface {
+	// StorageDomainID returns the storage domain to create the disk on.
+	StorageDomainID() StorageDomainID
+	// Format returns the image format of the disk.
+	Format() ImageFormat
+	// ProvisionedSize returns the size of the disk in bytes.
+	ProvisionedSize() uint64
+	// DiskParams returns the optional parameters of the disk, such as the alias. May be nil.
+	DiskParams() CreateDiskOptionalParameters
+	// Interface returns the interface the disk is attached with. Defaults to DiskInterfaceVirtIO.
+	Interface() DiskInterface
+	// Bootable returns true if the VM should boot from the disk.
+	Bootable() bool
+}
+
+// BuildableNewDiskSpec is a buildable version of NewDiskSpec.
+type BuildableNewDiskSpec interface {
+	NewDiskSpec
+
+	// WithDiskParams sets the optional parameters of the disk, such as the alias and the allocation policy.
+	WithDiskParams(params CreateDiskOptionalParameters) (BuildableNewDiskSpec, error)
+	// MustWithDiskParams is identical to WithDiskParams, but panics instead of returning an error.
+	MustWithDiskParams(params CreateDiskOptionalParameters) BuildableNewDiskSpec
+
+	// WithInterface sets the interface the disk is attached with.
+	WithInterface(diskInterface DiskInterface) (BuildableNewDiskSpec, error)
+	// MustWithInterface is identical to WithInterface, but panics instead of returning an error.
+	MustWithInterface(diskInterface DiskInterface) BuildableNewDiskSpec
+
+	// WithBootable sets if the VM should boot from the disk.
+	WithBootable(bootable bool) (BuildableNewDiskSpec, error)
+	// MustWithBootable is identical to WithBootable, but panics instead of returning an error.
+	MustWithBootable(bootable bool) BuildableNewDiskSpec
+}
+
+// NewDiskSpecParams creates the specification of a disk to create along with a VM. The size is in bytes and must
+// be at least MinDiskSizeOVirt.
+func NewDiskSpecParams(storageDomainID StorageDomainID, format ImageFormat, size uint64) (BuildableNewDiskSpec, error) {
+	if storageDomainID == "" {
+		return nil, newError(EBadArgument, "the storage domain ID of a new disk cannot be empty")
+	}
+	if err := validateDiskCreationParameters(format, size, nil); err != nil {
+		return nil, err
+	}
+	return &newDiskSpec{
+		storageDomainID: storageDomainID,
+		format:          format,
+		size:            size,
+		diskInterface:   DiskInterfaceVirtIO,
+	}, nil
+}
+
+// MustNewDiskSpecParams is identical to NewDiskSpecParams, but panics instead of returning an error.
+func MustNewDiskSpecParams(storageDomainID StorageDomainID, format ImageFormat, size uint64) BuildableNewDiskSpec {
+	builder, err := NewDiskSpecParams(storageDomainID, format, size)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+type newDiskSpec struct {
+	storageDomainID StorageDomainID
+	format          ImageFormat
+	size            uint64
+	params          CreateDiskOptionalParameters
+	diskInterface   DiskInterface
+	bootable        bool
+}
+
+func (n *newDiskSpec) StorageDomainID() StorageDomainID {
+	return n.storageDomainID
+}
+
+func (n *newDiskSpec) Format() ImageFormat {
+	return n.format
+}
+
+func (n *newDiskSpec) ProvisionedSize() uint64 {
+	return n.size
+}
+
+func (n *newDiskSpec) DiskParams() CreateDiskOptionalParameters {
+	return n.params
+}
+
+func (n *newDiskSpec) Interface() DiskInterface {
+	return n.diskInterface
+}
+
+func (n *newDiskSpec) Bootable() bool {
+	return n.bootable
+}
+
+func (n *newDiskSpec) WithDiskParams(params CreateDiskOptionalParameters) (BuildableNewDiskSpec, error) {
+	if err := validateDiskCreationParameters(n.format, n.size, params); err != nil {
+		return nil, err
+	}
+	n.params = params
+	return n, nil
+}
+
+func (n *newDiskSpec) MustWithDiskParams(params CreateDiskOptionalParameters) BuildableNewDiskSpec {
+	builder, err := n.WithDiskParams(params)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (n *newDiskSpec) WithInterface(diskInterface DiskInterface) (BuildableNewDiskSpec, error) {
+	if err := diskInterface.Validate(); err != nil {
+		return nil, err
+	}
+	n.diskInterface = diskInterface
+	return n, nil
+}
+
+func (n *newDiskSpec) MustWithInterface(diskInterface DiskInterface) BuildableNewDiskSpec {
+	builder, err := n.WithInterface(diskInterface)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (n *newDiskSpec) WithBootable(bootable bool) (BuildableNewDiskSpec, error) {
+	n.bootable = bootable
+	return n, nil
+}
+
+func (n *newDiskSpec) MustWithBootable(bootable bool) BuildableNewDiskSpec {
+	builder, err := n.WithBootable(bootable)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func validateNewDiskSpecs(disks []NewDiskSpec) error {
+	bootableIndex := -1
+	for i, disk := range disks {
+		if disk == nil {
+			return newError(EBadArgument, "new disk %d is nil", i)
+		}
+		if !disk.Bootable() {
+			continue
+		}
+		if bootableIndex != -1 {
+			return newError(EBadArgument, "new disks %d and %d are both bootable, only one can be", bootableIndex, i)
+		}
+		bootableIndex = i
+	}
+	return nil
+}
+
+// validateNewDiskStorageDomains checks that the storage domains of the new disks exist and support the requested
+// allocation policy and content type, so the VM creation doesn't fail halfway.
+func validateNewDiskStorageDomains(client StorageDomainClient, disks []NewDiskSpec, retries []RetryStrategy) error {
+	storageDomains := map[StorageDomainID]StorageDomain{}
+	for i, disk := range disks {
+		storageDomain, ok := storageDomains[disk.StorageDomainID()]
+		if !ok {
+			var err error
+			storageDomain, err = client.GetStorageDomain(disk.StorageDomainID(), retries...)
+			if err != nil {
+				return wrap(err, EUnidentified, "failed to fetch the storage domain of new disk %d", i)
+			}
+			storageDomains[disk.StorageDomainID()] = storageDomain
+		}
+		if params := disk.DiskParams(); params != nil && params.Sparse() != nil {
+			if err := validateDiskAllocation(storageDomain, disk.Format(), *params.Sparse()); err != nil {
+				return wrap(err, EUnidentified, "invalid allocation policy for new disk %d", i)
+			}
+		}
+		if err := validateDiskContentType(storageDomain, disk.DiskParams()); err != nil {
+			return wrap(err, EUnidentified, "invalid content type for new disk %d", i)
+		}
+	}
+	return nil
+}
+
+func buildNewDiskAttachments(disks []NewDiskSpec) ([]*ovirtsdk.DiskAttachment, error) {
+	result := make([]*ovirtsdk.DiskAttachment, len(disks))
+	for i, disk := range disks {
+		sdkDisk, err := buildDiskObjectForCreation(
+			disk.StorageDomainID(),
+			disk.ProvisionedSize(),
+			disk.Format(),
+			disk.DiskParams(),
+		)
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to build new disk %d", i)
+		}
+		result[i], err = ovirtsdk.NewDiskAttachmentBuilder().
+			Disk(sdkDisk).
+			Interface(ovirtsdk.DiskInterface(disk.Interface())).
+			Bootable(disk.Bootable()).
+			Active(true).
+			Build()
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to build disk attachment for new disk %d", i)
+		}
+	}
+	return result, nil
+}
+
+// waitForNewVMDisks waits until all disks of a freshly created VM are ready and returns the VM as it is afterwards.
+// The engine keeps the VM locked while it creates the disks. The existing disks passed are checked to be attached.
+func waitForNewVMDisks(
+	client Client,
+	vm VM,
+	existingDisks []ExistingDiskAttachment,
+	retries []RetryStrategy,
+) (VM, error) {
+	attachments, err := client.ListDiskAttachments(vm.ID(), retries...)
+	if err != nil {
+		return vm, wrap(err, EUnidentified, "VM %s was created, but listing its disks failed", vm.ID())
+	}
+	if err := checkExistingVMDisksAttached(vm.ID(), existingDisks, attachments); err != nil {
+		return vm, err
+	}
+	for _, attachment := range attachments {
+		if _, err := client.WaitForDiskOK(attachment.DiskID(), retries...); err != nil {
+			return vm, wrap(
+				err,
+				EUnidentified,
+				"VM %s was created, but waiting for disk %s failed",
+				vm.ID(),
+				attachment.DiskID(),
+			)
+		}
+	}
+	updatedVM, err := client.WaitForVMStatus(vm.ID(), VMStatusDown, retries...)
+	if err != nil {
+		return vm, wrap(err, EUnidentified, "VM %s was created, but it did not unlock after creating its disks", vm.ID())
+	}
+	return updatedVM, nil
+}
+
+// attachNewVMDisks creates and attaches the new disks of a VM in the mock. The parameters have already been
+// validated, so errors here indicate a bug.
+func (m *mockClient) attachNewVMDisks(vm *vm, disks []NewDiskSpec) error {
+	for i, spec := range disks {
+		disk, err := m.createDisk(spec.StorageDomainID(), spec.Format(), spec.ProvisionedSize(), spec.DiskParams())
+		if err != nil {
+			return wrap(err, EBug, "failed to create new disk %d for VM %s", i, vm.id)
+		}
+		attachment := &diskAttachment{
+			client:        m,
+			id:            DiskAttachmentID(m.GenerateUUID()),
+			vmid:          vm.id,
+			diskID:        disk.ID(),
+			diskInterface: spec.Interface(),
+			bootable:      spec.Bootable(),
+			active:        true,
+		}
+		m.vmDiskAttachmentsByVM[vm.id][attachment.id] = attachment
+		m.addDiskAttachmentByDisk(attachment)
+	}
+	return nil
+}
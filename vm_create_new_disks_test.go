@@ -0,0 +1,74 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMCreationWithNewDisks(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	params := ovirtclient.NewCreateVMParams().MustWithNewDisks(
+		[]ovirtclient.NewDiskSpec{
+			ovirtclient.MustNewDiskSpecParams(
+				helper.GetStorageDomainID(),
+				ovirtclient.ImageFormatCow,
+				ovirtclient.MinDiskSizeOVirt,
+			).MustWithBootable(true),
+			ovirtclient.MustNewDiskSpecParams(
+				helper.GetStorageDomainID(),
+				ovirtclient.ImageFormatRaw,
+				ovirtclient.MinDiskSizeOVirt,
+			).MustWithInterface(ovirtclient.DiskInterfaceVirtIOSCSI),
+		},
+	)
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), params)
+
+	attachments, err := vm.ListDiskAttachments()
+	if err != nil {
+		t.Fatalf("Failed to list disk attachments of VM %s (%v)", vm.ID(), err)
+	}
+	if len(attachments) != 2 {
+		t.Fatalf("Incorrect number of disk attachments (expected: 2, got: %d)", len(attachments))
+	}
+	bootable := 0
+	for _, attachment := range attachments {
+		if attachment.Bootable() {
+			bootable++
+		}
+		disk, err := attachment.Disk()
+		if err != nil {
+			t.Fatalf("Failed to fetch disk of attachment %s (%v)", attachment.ID(), err)
+		}
+		if disk.Status() != ovirtclient.DiskStatusOK {
+			t.Fatalf("Disk %s is not OK after VM creation (status: %s)", disk.ID(), disk.Status())
+		}
+	}
+	if bootable != 1 {
+		t.Fatalf("Incorrect number of bootable disks (expected: 1, got: %d)", bootable)
+	}
+}
+
+func TestVMCreationWithInvalidNewDisks(t *testing.T) {
+	t.Parallel()
+
+	_, err := ovirtclient.NewDiskSpecParams("", ovirtclient.ImageFormatRaw, ovirtclient.MinDiskSizeOVirt)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a disk spec without a storage domain did not result in an EBadArgument error (%v)", err)
+	}
+
+	spec := func() ovirtclient.NewDiskSpec {
+		return ovirtclient.MustNewDiskSpecParams(
+			"storage-domain",
+			ovirtclient.ImageFormatRaw,
+			ovirtclient.MinDiskSizeOVirt,
+		).MustWithBootable(true)
+	}
+	if _, err := ovirtclient.NewCreateVMParams().WithNewDisks(
+		[]ovirtclient.NewDiskSpec{spec(), spec()},
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a VM with two bootable new disks did not result in an EBadArgument error (%v)", err)
+	}
+}
@@ -0,0 +1,65 @@
+package ovirtclient
+
+// minimumSecureBootCompatibilityVersion is the first cluster compatibility version that can run VMs with
+// BIOSTypeQ35SecureBoot.
+var minimumSecureBootCompatibilityVersion = &compatibilityVersion{major: 4, minor: 4}
+
+// checkSecureBootSupported makes sure that the engine and the target cluster can run the VM if it requires secure
+// boot, either because the parameters request BIOSTypeQ35SecureBoot or because the template uses it. The engine
+// would otherwise only reject the VM with a generic error after it has been submitted.
+func checkSecureBootSupported(
+	client Client,
+	clusterID ClusterID,
+	templateID TemplateID,
+	params OptionalVMParameters,
+	retries []RetryStrategy,
+) error {
+	source := "the requested BIOS type"
+	biosType := params.BIOSType()
+	if biosType == nil {
+		tpl, err := client.GetTemplate(templateID, retries...)
+		if err != nil {
+			return err
+		}
+		templateBIOSType := tpl.BIOSType()
+		biosType = &templateBIOSType
+		source = "the BIOS type of template " + string(templateID)
+	}
+	if *biosType != BIOSTypeQ35SecureBoot {
+		return nil
+	}
+
+	capabilities, err := client.GetEngineCapabilities(retries...)
+	if err != nil {
+		return err
+	}
+	if !capabilities.SupportsQ35SecureBoot() {
+		return newError(
+			EBadArgument,
+			"%s is %s, but engine version %s does not support secure boot",
+			source,
+			*biosType,
+			capabilities.Version(),
+		)
+	}
+	cluster, err := client.GetCluster(clusterID, retries...)
+	if err != nil {
+		return err
+	}
+	clusterVersion := cluster.CompatibilityVersion()
+	if clusterVersion == nil {
+		return nil
+	}
+	if compareCompatibilityVersions(clusterVersion, minimumSecureBootCompatibilityVersion) < 0 {
+		return newError(
+			EBadArgument,
+			"%s is %s, but cluster %s has compatibility version %s and secure boot requires at least %s",
+			source,
+			*biosType,
+			clusterID,
+			clusterVersion,
+			minimumSecureBootCompatibilityVersion,
+		)
+	}
+	return nil
+}
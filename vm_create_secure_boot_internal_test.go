@@ -0,0 +1,46 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestCreateVMChecksSecureBootCompatibilityVersion(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	var clusterID ClusterID
+	for id := range m.clusters {
+		clusterID = id
+		break
+	}
+
+	m.lock.Lock()
+	m.templates[DefaultBlankTemplateID].biosType = BIOSTypeQ35SecureBoot
+	m.clusters[clusterID].version = &compatibilityVersion{major: 4, minor: 3}
+	m.lock.Unlock()
+
+	_, err := m.CreateVM(clusterID, DefaultBlankTemplateID, "secure-boot-template", nil)
+	if !HasErrorCode(err, EBadArgument) {
+		t.Fatalf("Creating a VM from a secure boot template on a 4.3 cluster did not fail with EBadArgument (%v)", err)
+	}
+	_, err = m.CreateVM(
+		clusterID,
+		DefaultBlankTemplateID,
+		"secure-boot-override",
+		CreateVMParams().MustWithBIOSType(BIOSTypeQ35UEFI),
+	)
+	if err != nil {
+		t.Fatalf("Overriding the secure boot BIOS type of the template failed (%v)", err)
+	}
+
+	m.lock.Lock()
+	m.clusters[clusterID].version = &compatibilityVersion{major: 4, minor: 4}
+	m.lock.Unlock()
+
+	vm, err := m.CreateVM(clusterID, DefaultBlankTemplateID, "secure-boot-template", nil)
+	if err != nil {
+		t.Fatalf("Creating a VM from a secure boot template on a 4.4 cluster failed (%v)", err)
+	}
+	if vm.BIOSType() != BIOSTypeQ35SecureBoot {
+		t.Fatalf("The VM did not inherit the BIOS type of the template (%s)", vm.BIOSType())
+	}
+}
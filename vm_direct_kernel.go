@@ -0,0 +1,66 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// validateDirectKernel checks that the initrd and the kernel command line are only set together with a kernel, since
+// the engine silently ignores them otherwise.
+func validateDirectKernel(kernelPath string, initrdPath string, cmdline string) error {
+	if kernelPath == "" && (initrdPath != "" || cmdline != "") {
+		return newError(
+			EBadArgument,
+			"the initrd path and the kernel command line require a kernel path for direct kernel boot",
+		)
+	}
+	return nil
+}
+
+func (o *oVirtClient) SetVMDirectKernel(
+	id VMID,
+	kernelPath string,
+	initrdPath string,
+	cmdline string,
+	retries ...RetryStrategy,
+) error {
+	if err := validateDirectKernel(kernelPath, initrdPath, cmdline); err != nil {
+		return err
+	}
+	sdkOS := &ovirtsdk.OperatingSystem{}
+	// Empty values are sent as well, this is how the engine clears the direct kernel boot.
+	sdkOS.SetKernel(kernelPath)
+	sdkOS.SetInitrd(initrdPath)
+	sdkOS.SetCmdline(cmdline)
+	sdkVM := &ovirtsdk.Vm{}
+	sdkVM.SetId(string(id))
+	sdkVM.SetOs(sdkOS)
+	return o.updateVMField(id, sdkVM, "direct kernel boot", retries)
+}
+
+func (m *mockClient) SetVMDirectKernel(
+	id VMID,
+	kernelPath string,
+	initrdPath string,
+	cmdline string,
+	_ ...RetryStrategy,
+) error {
+	if err := validateDirectKernel(kernelPath, initrdPath, cmdline); err != nil {
+		return err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", id)
+	}
+	// The OS structure is shared with VM snapshots handed out earlier, so it is replaced instead of modified.
+	os := &vmOS{t: "other"}
+	if item.os != nil {
+		os.t = item.os.t
+	}
+	os.kernel = kernelPath
+	os.initrd = initrdPath
+	os.cmdline = cmdline
+	item.os = os
+	return nil
+}
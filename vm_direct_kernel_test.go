@@ -0,0 +1,60 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMDirectKernel(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	if _, err := ovirtclient.NewVMOSParameters().WithDirectKernel("", "", "console=ttyS0"); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting a kernel command line without a kernel did not result in an EBadArgument error (%v)", err)
+	}
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().WithOS(
+			ovirtclient.NewVMOSParameters().MustWithDirectKernel(
+				"iso://vmlinuz",
+				"iso://initrd.img",
+				"console=ttyS0",
+			),
+		),
+	)
+	os := vm.OS()
+	if os.Kernel() != "iso://vmlinuz" {
+		t.Fatalf("Incorrect kernel path (expected: %s, got: %s)", "iso://vmlinuz", os.Kernel())
+	}
+	if os.Initrd() != "iso://initrd.img" {
+		t.Fatalf("Incorrect initrd path (expected: %s, got: %s)", "iso://initrd.img", os.Initrd())
+	}
+	if os.Cmdline() != "console=ttyS0" {
+		t.Fatalf("Incorrect kernel command line (expected: %s, got: %s)", "console=ttyS0", os.Cmdline())
+	}
+
+	if err := client.SetVMDirectKernel(vm.ID(), "", "iso://initrd.img", ""); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting an initrd without a kernel did not result in an EBadArgument error (%v)", err)
+	}
+	if err := client.SetVMDirectKernel(vm.ID(), "", "", ""); err != nil {
+		t.Fatalf("Failed to clear the direct kernel boot (%v)", err)
+	}
+	vm, err := client.GetVM(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch VM %s (%v)", vm.ID(), err)
+	}
+	if os := vm.OS(); os.Kernel() != "" || os.Initrd() != "" || os.Cmdline() != "" {
+		t.Fatalf("Direct kernel boot not cleared (kernel: %s, initrd: %s)", os.Kernel(), os.Initrd())
+	}
+}
@@ -5,15 +5,22 @@ import (
 )
 
 func (o *oVirtClient) GetVM(id VMID, retries ...RetryStrategy) (result VM, err error) {
+	if err := validateNotBlankUUID("vm", string(id)); err != nil {
+		return nil, err
+	}
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting vm %s", id),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().VmsService().VmService(string(id)).Get().Send()
+			request := o.conn.SystemService().VmsService().VmService(string(id)).Get()
+			if allContentRequested(o.ctx) {
+				request.Header(allContentHeader, "true")
+			}
+			response, err := request.Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting vm %s", id), err)
 			}
 			sdkObject, ok := response.Vm()
 			if !ok {
@@ -38,10 +45,13 @@ func (o *oVirtClient) GetVM(id VMID, retries ...RetryStrategy) (result VM, err e
 }
 
 func (m *mockClient) GetVM(id VMID, _ ...RetryStrategy) (VM, error) {
+	if err := validateNotBlankUUID("vm", string(id)); err != nil {
+		return nil, err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.vms[id]; ok {
-		return item, nil
+		return item.snapshot(), nil
 	}
 	return nil, newError(ENotFound, "vm with ID %s not found", id)
 }
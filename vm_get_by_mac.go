@@ -0,0 +1,127 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// normalizeMAC converts a MAC address in any of the notations net.ParseMAC accepts into the lower case, colon
+// separated notation the engine uses.
+func normalizeMAC(mac string) (string, error) {
+	hwAddr, err := net.ParseMAC(strings.TrimSpace(mac))
+	if err != nil {
+		return "", wrap(err, EBadArgument, "invalid MAC address: %s", mac)
+	}
+	return hwAddr.String(), nil
+}
+
+// nicReference identifies a NIC found by its MAC address.
+type nicReference struct {
+	vmID  VMID
+	nicID NICID
+}
+
+// checkSingleNICMatch returns the only NIC in matches, or an ENotFound or EConflict error.
+func checkSingleNICMatch(mac string, matches []nicReference) (nicReference, error) {
+	switch len(matches) {
+	case 0:
+		return nicReference{}, newError(ENotFound, "no NIC found with MAC address %s", mac)
+	case 1:
+		return matches[0], nil
+	default:
+		vmIDs := make([]string, len(matches))
+		for i, match := range matches {
+			vmIDs[i] = string(match.vmID)
+		}
+		return nicReference{}, newError(
+			EConflict,
+			"%d NICs have the MAC address %s (VMs: %s)",
+			len(matches),
+			mac,
+			strings.Join(vmIDs, ", "),
+		)
+	}
+}
+
+func (o *oVirtClient) GetVMByMAC(mac string, retries ...RetryStrategy) (resultVM VM, resultNIC NIC, err error) {
+	normalizedMAC, err := normalizeMAC(mac)
+	if err != nil {
+		return nil, nil, err
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	var matches []nicReference
+	action := fmt.Sprintf("searching VM with MAC address %s", normalizedMAC)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			// The engine has no search for the MAC address of a VM NIC, so the NICs of all VMs are compared.
+			response, err := o.conn.SystemService().VmsService().List().Follow("nics").Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			matches = nil
+			sdkVMs, ok := response.Vms()
+			if !ok {
+				return nil
+			}
+			for _, sdkVM := range sdkVMs.Slice() {
+				sdkNICs, ok := sdkVM.Nics()
+				if !ok {
+					continue
+				}
+				vmID, _ := sdkVM.Id()
+				for _, sdkNIC := range sdkNICs.Slice() {
+					sdkMAC, ok := sdkNIC.Mac()
+					if !ok {
+						continue
+					}
+					address, _ := sdkMAC.Address()
+					if nicMAC, err := normalizeMAC(address); err != nil || nicMAC != normalizedMAC {
+						continue
+					}
+					nicID, _ := sdkNIC.Id()
+					matches = append(matches, nicReference{vmID: VMID(vmID), nicID: NICID(nicID)})
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+	match, err := checkSingleNICMatch(normalizedMAC, matches)
+	if err != nil {
+		return nil, nil, err
+	}
+	resultVM, err = o.GetVM(match.vmID, retries...)
+	if err != nil {
+		return nil, nil, err
+	}
+	resultNIC, err = o.GetNIC(match.vmID, match.nicID, retries...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resultVM, resultNIC, nil
+}
+
+func (m *mockClient) GetVMByMAC(mac string, _ ...RetryStrategy) (VM, NIC, error) {
+	normalizedMAC, err := normalizeMAC(mac)
+	if err != nil {
+		return nil, nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var matches []nicReference
+	for _, item := range m.nics {
+		if nicMAC, err := normalizeMAC(item.mac); err == nil && nicMAC == normalizedMAC {
+			matches = append(matches, nicReference{vmID: item.vmid, nicID: item.id})
+		}
+	}
+	match, err := checkSingleNICMatch(normalizedMAC, matches)
+	if err != nil {
+		return nil, nil, err
+	}
+	return m.vms[match.vmID].snapshot(), m.nics[match.nicID], nil
+}
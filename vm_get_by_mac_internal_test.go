@@ -0,0 +1,37 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestGetVMByMACConflict(t *testing.T) {
+	m := NewMock().(*mockClient)
+	var clusterID ClusterID
+	for id := range m.clusters {
+		clusterID = id
+		break
+	}
+	var vnicProfileID VNICProfileID
+	for id := range m.vnicProfiles {
+		vnicProfileID = id
+		break
+	}
+	for _, name := range []string{"first", "second"} {
+		vm, err := m.CreateVM(clusterID, DefaultBlankTemplateID, name, nil)
+		if err != nil {
+			t.Fatalf("Failed to create VM %s (%v)", name, err)
+		}
+		if _, err := m.CreateNIC(
+			vm.ID(),
+			vnicProfileID,
+			"eth-"+name,
+			CreateNICParams().MustWithMac("56:6f:00:00:00:01"),
+		); err != nil {
+			t.Fatalf("Failed to create NIC on VM %s (%v)", name, err)
+		}
+	}
+
+	if _, _, err := m.GetVMByMAC("56:6F:00:00:00:01"); !HasErrorCode(err, EConflict) {
+		t.Fatalf("Looking up a MAC address used by two NICs did not result in an EConflict error (%v)", err)
+	}
+}
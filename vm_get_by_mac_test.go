@@ -0,0 +1,39 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestGetVMByMAC(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	// 56:6f is a locally administered prefix, so the address can't collide with the engine MAC pool.
+	mac := fmt.Sprintf("56:6f:%02x:%02x:%02x:%02x", rand.Intn(256), rand.Intn(256), rand.Intn(256), rand.Intn(256))
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	nic := assertCanCreateNIC(t, helper, vm, "eth0", ovirtclient.CreateNICParams().MustWithMac(mac))
+
+	foundVM, foundNIC, err := client.GetVMByMAC(strings.ToUpper(strings.ReplaceAll(mac, ":", "-")))
+	if err != nil {
+		t.Fatalf("Failed to find VM by MAC address %s (%v)", mac, err)
+	}
+	if foundVM.ID() != vm.ID() {
+		t.Fatalf("Incorrect VM found by MAC address (expected: %s, got: %s)", vm.ID(), foundVM.ID())
+	}
+	if foundNIC.ID() != nic.ID() {
+		t.Fatalf("Incorrect NIC found by MAC address (expected: %s, got: %s)", nic.ID(), foundNIC.ID())
+	}
+
+	if _, _, err := client.GetVMByMAC("56:6f:00:00:00:00"); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Looking up an unused MAC address did not result in an ENotFound error (%v)", err)
+	}
+	if _, _, err := client.GetVMByMAC("not-a-mac"); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Looking up an invalid MAC address did not result in an EBadArgument error (%v)", err)
+	}
+}
@@ -7,14 +7,22 @@ import (
 func (o *oVirtClient) GetVMByName(name string, retries ...RetryStrategy) (result VM, err error) {
 
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	query, err := SearchField("name").Eq(name).Build()
+	if err != nil {
+		return nil, err
+	}
 	err = retry(
 		fmt.Sprintf("getting vm name %s", name),
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().VmsService().List().Search("name=" + name).Send()
+			request := o.conn.SystemService().VmsService().List().Search(query)
+			if allContentRequested(o.ctx) {
+				request.Header(allContentHeader, "true")
+			}
+			response, err := request.Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting vm name %s", name), err)
 			}
 			for _, sdkObject := range response.MustVms().Slice() {
 				if mName, ok := sdkObject.Name(); ok {
@@ -31,11 +39,14 @@ func (o *oVirtClient) GetVMByName(name string, retries ...RetryStrategy) (result
 }
 
 func (m *mockClient) GetVMByName(name string, _ ...RetryStrategy) (result VM, err error) {
+	if _, err := SearchField("name").Eq(name).Build(); err != nil {
+		return nil, err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	for _, vm := range m.vms {
 		if vm.name == name {
-			return vm, nil
+			return vm.snapshot(), nil
 		}
 	}
 	return nil, newError(ENotFound, "No VM found with name %s", name)
@@ -9,6 +9,10 @@ type VMGraphicsConsoleID string
 type GraphicsConsoleClient interface {
 	ListVMGraphicsConsoles(vmID VMID, retries ...RetryStrategy) ([]VMGraphicsConsole, error)
 	RemoveVMGraphicsConsole(vmID VMID, graphicsConsoleID VMGraphicsConsoleID, retries ...RetryStrategy) error
+	// GetVMConsoleVVFile returns the remote-viewer connection file (.vv) for the graphics console, which can be
+	// opened directly with virt-viewer. The engine fills in the host, port and a fresh ticket, so the file is only
+	// valid for a short time. An EConflict error is returned if the VM is not running.
+	GetVMConsoleVVFile(vmID VMID, graphicsConsoleID VMGraphicsConsoleID, retries ...RetryStrategy) ([]byte, error)
 }
 
 // VMGraphicsConsoleData contains the data for VMGraphicsConsole objects.
@@ -23,6 +27,9 @@ type VMGraphicsConsole interface {
 
 	// Remove removes the graphics console.
 	Remove(retries ...RetryStrategy) error
+	// VVFile returns the remote-viewer connection file for the graphics console. See
+	// GraphicsConsoleClient.GetVMConsoleVVFile for details.
+	VVFile(retries ...RetryStrategy) ([]byte, error)
 }
 
 type vmGraphicsConsole struct {
@@ -36,6 +43,10 @@ func (v *vmGraphicsConsole) Remove(retries ...RetryStrategy) error {
 	return v.client.RemoveVMGraphicsConsole(v.vmID, v.id, retries...)
 }
 
+func (v *vmGraphicsConsole) VVFile(retries ...RetryStrategy) ([]byte, error) {
+	return v.client.GetVMConsoleVVFile(v.vmID, v.id, retries...)
+}
+
 func (v *vmGraphicsConsole) ID() VMGraphicsConsoleID {
 	return v.id
 }
@@ -0,0 +1,110 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (o *oVirtClient) GetVMConsoleVVFile(
+	vmID VMID,
+	graphicsConsoleID VMGraphicsConsoleID,
+	retries ...RetryStrategy,
+) (result []byte, err error) {
+	vm, err := o.GetVM(vmID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVMConsoleAvailable(vm); err != nil {
+		return nil, err
+	}
+	// Generating the file sets a new console ticket, so this is treated as a write.
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	action := fmt.Sprintf("generating the remote-viewer file for graphics console %s on VM %s", graphicsConsoleID, vmID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				GraphicsConsolesService().
+				ConsoleService(string(graphicsConsoleID)).
+				RemoteViewerConnectionFile().
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			file, ok := response.RemoteViewerConnectionFile()
+			if !ok || file == "" {
+				return newFieldNotFound("remote viewer connection file response", "file")
+			}
+			result = []byte(file)
+			return nil
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) GetVMConsoleVVFile(
+	vmID VMID,
+	graphicsConsoleID VMGraphicsConsoleID,
+	_ ...RetryStrategy,
+) ([]byte, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	vm, ok := m.vms[vmID]
+	if !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	found := false
+	for _, graphicsConsole := range m.graphicsConsolesByVM[vmID] {
+		if graphicsConsole.ID() == graphicsConsoleID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, newError(ENotFound, "Graphics console with ID %s not found on VM %s", graphicsConsoleID, vmID)
+	}
+	if err := checkVMConsoleAvailable(vm); err != nil {
+		return nil, err
+	}
+	host := "localhost"
+	if vm.hostID != nil {
+		if h, ok := m.hosts[*vm.hostID]; ok {
+			host = h.name
+		}
+	}
+	return buildVVFile(host, 5900, m.GenerateUUID(), vm.name), nil
+}
+
+func checkVMConsoleAvailable(vm VM) error {
+	if vm.Status() != VMStatusUp {
+		return newError(
+			EConflict,
+			"VM %s is in status %s, the graphics console is only available while it is running",
+			vm.ID(),
+			vm.Status(),
+		)
+	}
+	return nil
+}
+
+// buildVVFile creates a remote-viewer connection file in the same layout the engine uses. The delete-this-file option
+// tells remote-viewer to remove the file after reading it since it contains the ticket.
+func buildVVFile(host string, port uint, ticket string, title string) []byte {
+	lines := []string{
+		"[virt-viewer]",
+		"type=spice",
+		fmt.Sprintf("host=%s", host),
+		fmt.Sprintf("port=%d", port),
+		fmt.Sprintf("password=%s", ticket),
+		"delete-this-file=1",
+		fmt.Sprintf("title=%s:%%d - Press SHIFT+F12 to Release Cursor", title),
+		"",
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
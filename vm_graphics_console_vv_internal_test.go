@@ -0,0 +1,42 @@
+package ovirtclient
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetVMConsoleVVFile(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	cluster := generateTestCluster()
+	m.clusters[cluster.ID()] = cluster
+	host := generateTestHost(cluster)
+	m.hosts[host.ID()] = host
+
+	vmID := VMID(m.GenerateUUID())
+	item := &vm{client: m, id: vmID, name: "test", status: VMStatusDown}
+	m.vms[vmID] = item
+	m.addGraphicsConsoles(item)
+	graphicsConsoleID := m.graphicsConsolesByVM[vmID][0].ID()
+
+	if _, err := m.GetVMConsoleVVFile(vmID, graphicsConsoleID); !HasErrorCode(err, EConflict) {
+		t.Fatalf("Fetching the remote-viewer file of a stopped VM did not result in an EConflict error (%v)", err)
+	}
+	if _, err := m.GetVMConsoleVVFile(vmID, "nonexistent"); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Fetching the remote-viewer file of a nonexistent console did not result in an ENotFound error (%v)", err)
+	}
+
+	hostID := host.ID()
+	item.status = VMStatusUp
+	item.hostID = &hostID
+	file, err := m.graphicsConsolesByVM[vmID][0].VVFile()
+	if err != nil {
+		t.Fatalf("Failed to fetch the remote-viewer file of a running VM (%v)", err)
+	}
+	content := string(file)
+	for _, expected := range []string{"[virt-viewer]", "host=" + host.Name(), "port=5900", "password="} {
+		if !strings.Contains(content, expected) {
+			t.Fatalf("The remote-viewer file does not contain %q:\n%s", expected, content)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// GuestInfo contains the information the guest agent running inside a VM reports about the guest operating system.
+// All fields are empty if the guest agent has not reported any data, for example because the VM is not running or
+// the guest agent is not installed.
+type GuestInfo interface {
+	// OSFamily returns the family of the guest operating system, for example "Linux" or "Windows".
+	OSFamily() string
+	// OSDistribution returns the name of the distribution reported by the guest, for example "Fedora".
+	OSDistribution() string
+	// OSVersion returns the full version of the guest operating system.
+	OSVersion() string
+	// OSArchitecture returns the CPU architecture of the guest operating system, for example "x86_64".
+	OSArchitecture() string
+	// KernelVersion returns the full version of the guest kernel.
+	KernelVersion() string
+	// FQDN returns the fully qualified domain name of the guest.
+	FQDN() string
+	// TimeZone returns the name of the time zone configured in the guest.
+	TimeZone() string
+	// TimeZoneUTCOffset returns the UTC offset of the guest time zone, for example "+01:00".
+	TimeZoneUTCOffset() string
+	// AgentResponsive is true if the VM is up and the guest agent has reported data about the guest.
+	AgentResponsive() bool
+}
+
+type guestInfo struct {
+	osFamily          string
+	osDistribution    string
+	osVersion         string
+	osArchitecture    string
+	kernelVersion     string
+	fqdn              string
+	timeZone          string
+	timeZoneUTCOffset string
+	agentResponsive   bool
+}
+
+func (g guestInfo) OSFamily() string {
+	return g.osFamily
+}
+
+func (g guestInfo) OSDistribution() string {
+	return g.osDistribution
+}
+
+func (g guestInfo) OSVersion() string {
+	return g.osVersion
+}
+
+func (g guestInfo) OSArchitecture() string {
+	return g.osArchitecture
+}
+
+func (g guestInfo) KernelVersion() string {
+	return g.kernelVersion
+}
+
+func (g guestInfo) FQDN() string {
+	return g.fqdn
+}
+
+func (g guestInfo) TimeZone() string {
+	return g.timeZone
+}
+
+func (g guestInfo) TimeZoneUTCOffset() string {
+	return g.timeZoneUTCOffset
+}
+
+func (g guestInfo) AgentResponsive() bool {
+	return g.agentResponsive
+}
+
+func (o *oVirtClient) GetVMGuestInfo(id VMID, retries ...RetryStrategy) (result GuestInfo, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting guest information for VM %s", id),
+		o.logger,
+		retries,
+		func() error {
+			request := o.conn.SystemService().VmsService().VmService(string(id)).Get()
+			if allContentRequested(o.ctx) {
+				request.Header(allContentHeader, "true")
+			}
+			response, err := request.Send()
+			if err != nil {
+				return wrapSDKError(fmt.Sprintf("getting VM %s", id), err)
+			}
+			sdkVM, ok := response.Vm()
+			if !ok {
+				return newError(ENotFound, "no VM returned when getting VM %s", id)
+			}
+			result = convertSDKGuestInfo(sdkVM)
+			return nil
+		})
+	return result, err
+}
+
+// convertSDKGuestInfo extracts the guest agent data from a VM. The engine omits these fields entirely when the guest
+// agent hasn't reported, so missing fields are left empty.
+func convertSDKGuestInfo(sdkVM *ovirtsdk.Vm) GuestInfo {
+	info := guestInfo{}
+	if guestOS, ok := sdkVM.GuestOperatingSystem(); ok {
+		info.osFamily, _ = guestOS.Family()
+		info.osDistribution, _ = guestOS.Distribution()
+		info.osArchitecture, _ = guestOS.Architecture()
+		if version, ok := guestOS.Version(); ok {
+			info.osVersion = convertSDKFullVersion(version)
+		}
+		if kernel, ok := guestOS.Kernel(); ok {
+			if version, ok := kernel.Version(); ok {
+				info.kernelVersion = convertSDKFullVersion(version)
+			}
+		}
+	}
+	info.fqdn, _ = sdkVM.Fqdn()
+	if timeZone, ok := sdkVM.GuestTimeZone(); ok {
+		info.timeZone, _ = timeZone.Name()
+		info.timeZoneUTCOffset, _ = timeZone.UtcOffset()
+	}
+	status, _ := sdkVM.Status()
+	info.agentResponsive = VMStatus(status) == VMStatusUp && (info.osFamily != "" || info.fqdn != "")
+	return info
+}
+
+func convertSDKFullVersion(version *ovirtsdk.Version) string {
+	if fullVersion, ok := version.FullVersion(); ok {
+		return fullVersion
+	}
+	major, ok := version.Major()
+	if !ok {
+		return ""
+	}
+	minor, _ := version.Minor()
+	return fmt.Sprintf("%d.%d", major, minor)
+}
+
+func (m *mockClient) GetVMGuestInfo(id VMID, _ ...RetryStrategy) (GuestInfo, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return nil, newError(ENotFound, "vm with ID %s not found", id)
+	}
+	// The mock guest agent reports at the same time as the IP addresses.
+	if item.status != VMStatusUp || len(m.vmIPs[id]) == 0 {
+		return guestInfo{}, nil
+	}
+	return guestInfo{
+		osFamily:          "Linux",
+		osDistribution:    "Mock Linux",
+		osVersion:         "1.0",
+		osArchitecture:    "x86_64",
+		kernelVersion:     "5.14.0",
+		fqdn:              item.name,
+		timeZone:          "Etc/UTC",
+		timeZoneUTCOffset: "+00:00",
+		agentResponsive:   true,
+	}, nil
+}
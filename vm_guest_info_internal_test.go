@@ -0,0 +1,48 @@
+package ovirtclient
+
+import (
+	"testing"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func TestConvertSDKGuestInfo(t *testing.T) {
+	t.Parallel()
+	sdkVM := ovirtsdk.NewVmBuilder().
+		Status(ovirtsdk.VMSTATUS_UP).
+		Fqdn("test.example.com").
+		GuestOperatingSystem(
+			ovirtsdk.NewGuestOperatingSystemBuilder().
+				Family("Linux").
+				Distribution("Fedora").
+				Architecture("x86_64").
+				Version(ovirtsdk.NewVersionBuilder().Major(36).Minor(0).MustBuild()).
+				Kernel(
+					ovirtsdk.NewKernelBuilder().
+						Version(ovirtsdk.NewVersionBuilder().FullVersion("5.14.0-70.el9").MustBuild()).
+						MustBuild(),
+				).
+				MustBuild(),
+		).
+		GuestTimeZone(ovirtsdk.NewTimeZoneBuilder().Name("Europe/Berlin").UtcOffset("+01:00").MustBuild()).
+		MustBuild()
+
+	info := convertSDKGuestInfo(sdkVM)
+	if !info.AgentResponsive() {
+		t.Fatalf("Guest agent not reported as responsive despite reported guest data.")
+	}
+	if info.OSVersion() != "36.0" {
+		t.Fatalf("Incorrect OS version (expected: %s, got: %s)", "36.0", info.OSVersion())
+	}
+	if info.KernelVersion() != "5.14.0-70.el9" {
+		t.Fatalf("Incorrect kernel version (expected: %s, got: %s)", "5.14.0-70.el9", info.KernelVersion())
+	}
+	if info.TimeZone() != "Europe/Berlin" || info.TimeZoneUTCOffset() != "+01:00" {
+		t.Fatalf("Incorrect time zone: %s %s", info.TimeZone(), info.TimeZoneUTCOffset())
+	}
+
+	info = convertSDKGuestInfo(ovirtsdk.NewVmBuilder().Status(ovirtsdk.VMSTATUS_UP).MustBuild())
+	if info.AgentResponsive() || info.OSFamily() != "" {
+		t.Fatalf("Guest info reported for a VM without guest agent data.")
+	}
+}
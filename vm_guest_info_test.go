@@ -0,0 +1,26 @@
+package ovirtclient_test
+
+import (
+	"testing"
+)
+
+func TestVMGuestInfoWithoutAgent(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	guestInfo, err := vm.GuestInfo()
+	if err != nil {
+		t.Fatalf("Failed to get guest info for a stopped VM (%v)", err)
+	}
+	if guestInfo.AgentResponsive() {
+		t.Fatalf("The guest agent of a stopped VM is reported as responsive.")
+	}
+	if guestInfo.OSFamily() != "" || guestInfo.FQDN() != "" {
+		t.Fatalf(
+			"A stopped VM has guest information (OS family: %s, FQDN: %s)",
+			guestInfo.OSFamily(),
+			guestInfo.FQDN(),
+		)
+	}
+}
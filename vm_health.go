@@ -0,0 +1,128 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// VMHealth is a summary of the health of a VM. A VM can be up and still be unhealthy, for example if the guest agent
+// stopped responding or the engine reports a problem in the status detail.
+type VMHealth interface {
+	// Status returns the run status of the VM at the time the health was determined.
+	Status() VMStatus
+	// StatusDetail returns the additional detail the engine reports with the status, for example the reason a VM was
+	// paused. It is empty if the engine reported no detail.
+	StatusDetail() string
+	// HighlyAvailable is true if the engine restarts the VM automatically when it goes down unexpectedly.
+	HighlyAvailable() bool
+	// GuestAgentResponsive is true if the guest agent inside the VM is reporting data.
+	GuestAgentResponsive() bool
+	// Issues returns a human-readable description of each problem found. The list is empty if the VM is healthy.
+	Issues() []string
+	// Healthy is true if the VM is up, the guest agent is responding and the engine reports no problem.
+	Healthy() bool
+}
+
+type vmHealth struct {
+	status               VMStatus
+	statusDetail         string
+	highlyAvailable      bool
+	guestAgentResponsive bool
+	issues               []string
+}
+
+func (v vmHealth) Status() VMStatus {
+	return v.status
+}
+
+func (v vmHealth) StatusDetail() string {
+	return v.statusDetail
+}
+
+func (v vmHealth) HighlyAvailable() bool {
+	return v.highlyAvailable
+}
+
+func (v vmHealth) GuestAgentResponsive() bool {
+	return v.guestAgentResponsive
+}
+
+func (v vmHealth) Issues() []string {
+	return v.issues
+}
+
+func (v vmHealth) Healthy() bool {
+	return len(v.issues) == 0
+}
+
+// newVMHealth builds the health summary from the individual indicators and collects the issues found.
+func newVMHealth(status VMStatus, statusDetail string, highlyAvailable bool, guestAgentResponsive bool) vmHealth {
+	health := vmHealth{
+		status:               status,
+		statusDetail:         statusDetail,
+		highlyAvailable:      highlyAvailable,
+		guestAgentResponsive: guestAgentResponsive,
+		issues:               []string{},
+	}
+	if status != VMStatusUp {
+		health.issues = append(health.issues, fmt.Sprintf("the VM is in the %s status", status))
+		if highlyAvailable && status == VMStatusDown {
+			health.issues = append(health.issues, "the VM is highly available, but not running")
+		}
+	} else if !guestAgentResponsive {
+		health.issues = append(health.issues, "the guest agent is not responding")
+	}
+	if statusDetail != "" {
+		health.issues = append(health.issues, fmt.Sprintf("the engine reports %s", statusDetail))
+	}
+	return health
+}
+
+func convertSDKVMHealth(sdkVM *ovirtsdk.Vm) vmHealth {
+	status, _ := sdkVM.Status()
+	statusDetail, _ := sdkVM.StatusDetail()
+	highlyAvailable := false
+	if ha, ok := sdkVM.HighAvailability(); ok {
+		highlyAvailable, _ = ha.Enabled()
+	}
+	return newVMHealth(
+		VMStatus(status),
+		statusDetail,
+		highlyAvailable,
+		convertSDKGuestInfo(sdkVM).AgentResponsive(),
+	)
+}
+
+func (o *oVirtClient) GetVMHealth(id VMID, retries ...RetryStrategy) (result VMHealth, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting health of VM %s", id),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().VmsService().VmService(string(id)).Get().Send()
+			if err != nil {
+				return wrapSDKError(fmt.Sprintf("getting VM %s", id), err)
+			}
+			sdkVM, ok := response.Vm()
+			if !ok {
+				return newError(ENotFound, "no VM returned when getting VM %s", id)
+			}
+			result = convertSDKVMHealth(sdkVM)
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetVMHealth(id VMID, _ ...RetryStrategy) (VMHealth, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return nil, newError(ENotFound, "vm with ID %s not found", id)
+	}
+	// The mock has no high availability support and its guest agent reports together with the IP addresses, see
+	// GetVMGuestInfo.
+	return newVMHealth(item.status, "", false, item.status == VMStatusUp && len(m.vmIPs[id]) > 0), nil
+}
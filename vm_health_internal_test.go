@@ -0,0 +1,61 @@
+package ovirtclient
+
+import (
+	"testing"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func TestConvertSDKVMHealth(t *testing.T) {
+	t.Parallel()
+	healthy := convertSDKVMHealth(
+		ovirtsdk.NewVmBuilder().
+			Status(ovirtsdk.VMSTATUS_UP).
+			Fqdn("test.example.com").
+			HighAvailability(ovirtsdk.NewHighAvailabilityBuilder().Enabled(true).MustBuild()).
+			MustBuild(),
+	)
+	if !healthy.Healthy() || !healthy.HighlyAvailable() || !healthy.GuestAgentResponsive() {
+		t.Fatalf("Running VM with responsive guest agent not reported as healthy (issues: %v)", healthy.Issues())
+	}
+
+	noAgent := convertSDKVMHealth(ovirtsdk.NewVmBuilder().Status(ovirtsdk.VMSTATUS_UP).MustBuild())
+	if noAgent.Healthy() || len(noAgent.Issues()) != 1 {
+		t.Fatalf("Running VM without guest agent data reported as healthy (issues: %v)", noAgent.Issues())
+	}
+
+	paused := convertSDKVMHealth(
+		ovirtsdk.NewVmBuilder().Status(ovirtsdk.VMSTATUS_PAUSED).StatusDetail("eio").MustBuild(),
+	)
+	if paused.Healthy() || paused.StatusDetail() != "eio" || len(paused.Issues()) != 2 {
+		t.Fatalf("Paused VM reported with incorrect health (issues: %v)", paused.Issues())
+	}
+
+	downHA := convertSDKVMHealth(
+		ovirtsdk.NewVmBuilder().
+			Status(ovirtsdk.VMSTATUS_DOWN).
+			HighAvailability(ovirtsdk.NewHighAvailabilityBuilder().Enabled(true).MustBuild()).
+			MustBuild(),
+	)
+	if downHA.Healthy() || len(downHA.Issues()) != 2 {
+		t.Fatalf("Stopped highly available VM reported with incorrect health (issues: %v)", downHA.Issues())
+	}
+}
+
+func TestMockVMHealth(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	vmID := VMID("test-vm")
+	m.vms[vmID] = &vm{client: m, id: vmID, name: "test", status: VMStatusUp}
+
+	health, err := m.GetVMHealth(vmID)
+	if err != nil {
+		t.Fatalf("Failed to get VM health (%v)", err)
+	}
+	if health.Healthy() || health.GuestAgentResponsive() {
+		t.Fatalf("VM without reported IP addresses has a responsive guest agent in the mock.")
+	}
+	if _, err := m.GetVMHealth("nonexistent"); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Getting the health of a nonexistent VM did not result in an ENotFound error (%v)", err)
+	}
+}
@@ -0,0 +1,172 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// vmDeviceHostID returns the host whose devices can be passed through to the VM. This is the host the VM is pinned
+// to, or the host it runs on if it is not pinned.
+func vmDeviceHostID(vm VM) (HostID, error) {
+	if placementPolicy, ok := vm.PlacementPolicy(); ok && len(placementPolicy.HostIDs()) > 0 {
+		return placementPolicy.HostIDs()[0], nil
+	}
+	if hostID := vm.HostID(); hostID != nil {
+		return *hostID, nil
+	}
+	return "", newError(
+		EConflict,
+		"VM %s is neither pinned to a host nor running, host devices can only be passed through from a specific host",
+		vm.ID(),
+	)
+}
+
+// findHostDeviceForVM returns the device with the specified name from the list. An ENotFound error is returned if
+// the device doesn't exist and an EConflict error if it is attached to a different VM.
+func findHostDeviceForVM(vmID VMID, hostID HostID, name string, devices []*hostDevice) (*hostDevice, error) {
+	for _, device := range devices {
+		if device.name != name {
+			continue
+		}
+		if device.vmID != nil && *device.vmID != vmID {
+			return nil, newError(
+				EConflict,
+				"host device %s on host %s is already attached to VM %s",
+				name,
+				hostID,
+				*device.vmID,
+			)
+		}
+		return device, nil
+	}
+	return nil, newError(ENotFound, "host %s has no device named %s", hostID, name)
+}
+
+func (o *oVirtClient) ListVMHostDevices(vmID VMID, retries ...RetryStrategy) (result []HostDevice, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("listing host devices of VM %s", vmID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().VmsService().VmService(string(vmID)).HostDevicesService().List().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			result = []HostDevice{}
+			sdkDevices, ok := response.Device()
+			if !ok {
+				return nil
+			}
+			for i, sdkDevice := range sdkDevices.Slice() {
+				device, err := convertSDKHostDevice(sdkDevice, "")
+				if err != nil {
+					return wrap(err, EBug, "failed to convert host device #%d of VM %s", i, vmID)
+				}
+				device.vmID = &vmID
+				result = append(result, device)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (o *oVirtClient) AddHostDeviceToVM(vmID VMID, hostDeviceName string, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	vm, err := o.GetVM(vmID, retries...)
+	if err != nil {
+		return err
+	}
+	hostID, err := vmDeviceHostID(vm)
+	if err != nil {
+		return err
+	}
+	devices, err := o.listHostDevices(hostID, retries)
+	if err != nil {
+		return err
+	}
+	device, err := findHostDeviceForVM(vmID, hostID, hostDeviceName, devices)
+	if err != nil {
+		return err
+	}
+	if device.vmID != nil {
+		return nil
+	}
+	action := fmt.Sprintf("attaching host device %s to VM %s", hostDeviceName, vmID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				HostDevicesService().
+				Add().
+				Device(ovirtsdk4.NewHostDeviceBuilder().Id(device.id).MustBuild()).
+				Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return err
+	}
+	return retry(
+		fmt.Sprintf("waiting for host device %s to be attached to VM %s", hostDeviceName, vmID),
+		o.logger,
+		retries,
+		func() error {
+			devices, err := o.ListVMHostDevices(vmID, retries...)
+			if err != nil {
+				return err
+			}
+			for _, device := range devices {
+				if device.Name() == hostDeviceName {
+					return nil
+				}
+			}
+			return newError(EPending, "host device %s is not attached to VM %s yet", hostDeviceName, vmID)
+		})
+}
+
+func (m *mockClient) ListVMHostDevices(vmID VMID, _ ...RetryStrategy) ([]HostDevice, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	result := []HostDevice{}
+	for _, host := range m.hosts {
+		for _, device := range host.devices {
+			if device.vmID != nil && *device.vmID == vmID {
+				result = append(result, device)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (m *mockClient) AddHostDeviceToVM(vmID VMID, hostDeviceName string, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	vm, ok := m.vms[vmID]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	hostID, err := vmDeviceHostID(vm)
+	if err != nil {
+		return err
+	}
+	host, ok := m.hosts[hostID]
+	if !ok {
+		return newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	device, err := findHostDeviceForVM(vmID, hostID, hostDeviceName, host.devices)
+	if err != nil {
+		return err
+	}
+	device.vmID = &vmID
+	return nil
+}
@@ -0,0 +1,62 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestAddHostDeviceToVM(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+
+	var host *host
+	for _, h := range m.hosts {
+		host = h
+		break
+	}
+	devices, err := m.ListHostDevices(host.ID())
+	if err != nil {
+		t.Fatalf("Failed to list host devices (%v)", err)
+	}
+	if len(devices) == 0 {
+		t.Fatalf("The test host has no devices.")
+	}
+	deviceName := devices[0].Name()
+
+	pinnedParams := NewCreateVMParams().WithPlacementPolicy(
+		NewVMPlacementPolicyParameters().
+			MustWithAffinity(VMAffinityPinned).
+			MustWithHostIDs([]HostID{host.ID()}),
+	)
+	vm, err := m.CreateVM(host.clusterID, DefaultBlankTemplateID, "passthrough", pinnedParams)
+	if err != nil {
+		t.Fatalf("Failed to create VM (%v)", err)
+	}
+	unpinnedVM, err := m.CreateVM(host.clusterID, DefaultBlankTemplateID, "unpinned", nil)
+	if err != nil {
+		t.Fatalf("Failed to create VM (%v)", err)
+	}
+	if err := m.AddHostDeviceToVM(unpinnedVM.ID(), deviceName); !HasErrorCode(err, EConflict) {
+		t.Fatalf("Attaching a host device to a VM without a host did not result in an EConflict error (%v)", err)
+	}
+	if err := m.AddHostDeviceToVM(vm.ID(), "pci_nonexistent"); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Attaching a nonexistent host device did not result in an ENotFound error (%v)", err)
+	}
+	if err := m.AddHostDeviceToVM(vm.ID(), deviceName); err != nil {
+		t.Fatalf("Failed to attach host device %s (%v)", deviceName, err)
+	}
+	vmDevices, err := m.ListVMHostDevices(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to list host devices of VM (%v)", err)
+	}
+	if len(vmDevices) != 1 || vmDevices[0].Name() != deviceName {
+		t.Fatalf("Host device %s not listed on the VM.", deviceName)
+	}
+
+	otherVM, err := m.CreateVM(host.clusterID, DefaultBlankTemplateID, "other", pinnedParams)
+	if err != nil {
+		t.Fatalf("Failed to create VM (%v)", err)
+	}
+	if err := m.AddHostDeviceToVM(otherVM.ID(), deviceName); !HasErrorCode(err, EConflict) {
+		t.Fatalf("Attaching a host device used by another VM did not result in an EConflict error (%v)", err)
+	}
+}
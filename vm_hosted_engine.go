@@ -0,0 +1,72 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// hostedEngineOrigins are the VM origins the oVirt Engine reports for the hosted engine VM, depending on whether the
+// hosted engine storage domain has already been imported into the engine or not.
+var hostedEngineOrigins = []string{"managed_hosted_engine", "hosted_engine"}
+
+func (o *oVirtClient) IsHostedEngineVM(id VMID, retries ...RetryStrategy) (bool, error) {
+	vm, err := o.GetVM(id, retries...)
+	if err != nil {
+		return false, err
+	}
+	return vm.HostedEngine(), nil
+}
+
+func (m *mockClient) IsHostedEngineVM(id VMID, _ ...RetryStrategy) (bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return false, newError(ENotFound, "vm with ID %s not found", id)
+	}
+	return item.hostedEngine, nil
+}
+
+// checkHostedEngineAllowed returns an EConflict error if the operation would affect the hosted engine VM and the
+// caller didn't explicitly allow it.
+func checkHostedEngineAllowed(id VMID, hostedEngine bool, allowHostedEngine bool, operation string) error {
+	if hostedEngine && !allowHostedEngine {
+		return newError(
+			EConflict,
+			"refusing to %s VM %s because it is the hosted engine VM, set AllowHostedEngine to override",
+			operation,
+			id,
+		)
+	}
+	return nil
+}
+
+// checkHostedEngineAllowedByID fetches the VM and runs checkHostedEngineAllowed on it. The check is skipped without an
+// API call if the hosted engine is allowed anyway.
+func (o *oVirtClient) checkHostedEngineAllowedByID(
+	id VMID,
+	allowHostedEngine bool,
+	operation string,
+	retries []RetryStrategy,
+) error {
+	if allowHostedEngine {
+		return nil
+	}
+	hostedEngine, err := o.IsHostedEngineVM(id, retries...)
+	if err != nil {
+		return err
+	}
+	return checkHostedEngineAllowed(id, hostedEngine, allowHostedEngine, operation)
+}
+
+func vmHostedEngineConverter(object *ovirtsdk.Vm, v *vm) error {
+	origin, ok := object.Origin()
+	if !ok {
+		return nil
+	}
+	for _, heOrigin := range hostedEngineOrigins {
+		if origin == heOrigin {
+			v.hostedEngine = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package ovirtclient
+
+import (
+	"testing"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func TestVMHostedEngineConverter(t *testing.T) {
+	t.Parallel()
+	for origin, expected := range map[string]bool{
+		"managed_hosted_engine": true,
+		"hosted_engine":         true,
+		"ovirt":                 false,
+	} {
+		v := &vm{}
+		if err := vmHostedEngineConverter(ovirtsdk.NewVmBuilder().Origin(origin).MustBuild(), v); err != nil {
+			t.Fatalf("Failed to convert VM with origin %s (%v)", origin, err)
+		}
+		if v.HostedEngine() != expected {
+			t.Fatalf("Incorrect hosted engine flag for origin %s (expected: %t, got: %t)", origin, expected, v.HostedEngine())
+		}
+	}
+}
+
+func TestHostedEngineVMProtection(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	id := VMID(m.GenerateUUID())
+	m.vms[id] = &vm{client: m, id: id, name: "HostedEngine", status: VMStatusUp, hostedEngine: true}
+
+	isHostedEngine, err := m.IsHostedEngineVM(id)
+	if err != nil {
+		t.Fatalf("Failed to check if VM is the hosted engine (%v)", err)
+	}
+	if !isHostedEngine {
+		t.Fatalf("Hosted engine VM not detected.")
+	}
+
+	if err := m.StopVM(id, true); err == nil || !HasErrorCode(err, EConflict) {
+		t.Fatalf("Stopping the hosted engine VM did not result in an EConflict error (%v)", err)
+	}
+	if err := m.ShutdownVM(id, true); err == nil || !HasErrorCode(err, EConflict) {
+		t.Fatalf("Shutting down the hosted engine VM did not result in an EConflict error (%v)", err)
+	}
+	if err := m.RemoveVM(id); err == nil || !HasErrorCode(err, EConflict) {
+		t.Fatalf("Removing the hosted engine VM did not result in an EConflict error (%v)", err)
+	}
+
+	if err := m.StopVMWithParams(id, StopVMParams().WithForce(true).WithAllowHostedEngine(true)); err != nil {
+		t.Fatalf("Failed to stop the hosted engine VM with the override set (%v)", err)
+	}
+	if err := m.RemoveVMWithParams(id, RemoveVMParams().WithForce(true).WithAllowHostedEngine(true)); err != nil {
+		t.Fatalf("Failed to remove the hosted engine VM with the override set (%v)", err)
+	}
+}
@@ -0,0 +1,22 @@
+package ovirtclient_test
+
+import (
+	"testing"
+)
+
+func TestIsHostedEngineVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	if vm.HostedEngine() {
+		t.Fatalf("Newly created VM reported as the hosted engine VM.")
+	}
+	isHostedEngine, err := helper.GetClient().IsHostedEngineVM(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to check if VM %s is the hosted engine VM (%v)", vm.ID(), err)
+	}
+	if isHostedEngine {
+		t.Fatalf("Newly created VM reported as the hosted engine VM.")
+	}
+}
@@ -0,0 +1,231 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// ImportOVAParameters contains the optional parameters for ImportVMFromOVA.
+type ImportOVAParameters interface {
+	// Name returns the name of the imported VM. If it is nil, the file name without the .ova extension is used.
+	Name() *string
+	// Sparse returns whether the disks of the imported VM are thin provisioned. If it is nil, the engine decides.
+	Sparse() *bool
+}
+
+// BuildableImportOVAParameters is a buildable version of ImportOVAParameters.
+type BuildableImportOVAParameters interface {
+	ImportOVAParameters
+
+	// WithName sets the name of the imported VM.
+	WithName(name string) (BuildableImportOVAParameters, error)
+	// MustWithName is identical to WithName, but panics instead of returning an error.
+	MustWithName(name string) BuildableImportOVAParameters
+
+	// WithSparse sets whether the disks of the imported VM are thin provisioned.
+	WithSparse(sparse bool) (BuildableImportOVAParameters, error)
+	// MustWithSparse is identical to WithSparse, but panics instead of returning an error.
+	MustWithSparse(sparse bool) BuildableImportOVAParameters
+}
+
+// ImportOVAParams creates a new set of parameters for ImportVMFromOVA.
+func ImportOVAParams() BuildableImportOVAParameters {
+	return &importOVAParams{}
+}
+
+type importOVAParams struct {
+	name   *string
+	sparse *bool
+}
+
+func (i *importOVAParams) Name() *string {
+	return i.name
+}
+
+func (i *importOVAParams) WithName(name string) (BuildableImportOVAParameters, error) {
+	if name == "" {
+		return i, newError(EBadArgument, "the name of the imported VM cannot be empty")
+	}
+	i.name = &name
+	return i, nil
+}
+
+func (i *importOVAParams) MustWithName(name string) BuildableImportOVAParameters {
+	builder, err := i.WithName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (i *importOVAParams) Sparse() *bool {
+	return i.sparse
+}
+
+func (i *importOVAParams) WithSparse(sparse bool) (BuildableImportOVAParameters, error) {
+	i.sparse = &sparse
+	return i, nil
+}
+
+func (i *importOVAParams) MustWithSparse(sparse bool) BuildableImportOVAParameters {
+	builder, err := i.WithSparse(sparse)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// validateOVAFilePath returns an EBadArgument error unless filePath is an absolute, clean path. The engine can't
+// report whether the file exists before the import job runs on the host, so that is left to the job.
+func validateOVAFilePath(filePath string) error {
+	if filePath == "" {
+		return newError(EBadArgument, "the OVA file path cannot be empty")
+	}
+	if !path.IsAbs(filePath) || path.Clean(filePath) != filePath {
+		return newError(EBadArgument, "the OVA file path must be an absolute path without relative elements: %s", filePath)
+	}
+	return nil
+}
+
+// ovaImportVMName returns the name of the imported VM as set in params, or the file name without its extension.
+func ovaImportVMName(filePath string, params ImportOVAParameters) string {
+	if name := params.Name(); name != nil {
+		return *name
+	}
+	return strings.TrimSuffix(path.Base(filePath), ".ova")
+}
+
+// checkOVAImportTargets verifies that the host is up and that the cluster and the data storage domain the VM is
+// imported to exist.
+func checkOVAImportTargets(
+	client Client,
+	hostID HostID,
+	clusterID ClusterID,
+	storageDomainID StorageDomainID,
+	retries []RetryStrategy,
+) error {
+	host, err := client.GetHost(hostID, retries...)
+	if err != nil {
+		return err
+	}
+	if host.Status() != HostStatusUp {
+		return newError(
+			EConflict,
+			"host %s must be in status %s to import an OVA file (status: %s)",
+			hostID,
+			HostStatusUp,
+			host.Status(),
+		)
+	}
+	if _, err := client.GetCluster(clusterID, retries...); err != nil {
+		return err
+	}
+	storageDomain, err := client.GetStorageDomain(storageDomainID, retries...)
+	if err != nil {
+		return err
+	}
+	if storageDomain.Function() != StorageDomainFunctionData {
+		return newError(
+			EBadArgument,
+			"storage domain %s is a %s storage domain, VMs can only be imported to a %s storage domain",
+			storageDomainID,
+			storageDomain.Function(),
+			StorageDomainFunctionData,
+		)
+	}
+	return nil
+}
+
+func (o *oVirtClient) ImportVMFromOVA(
+	hostID HostID,
+	filePath string,
+	clusterID ClusterID,
+	storageDomainID StorageDomainID,
+	params ImportOVAParameters,
+	retries ...RetryStrategy,
+) (VM, error) {
+	if params == nil {
+		params = ImportOVAParams()
+	}
+	if err := validateOVAFilePath(filePath); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	if err := checkOVAImportTargets(o, hostID, clusterID, storageDomainID, retries); err != nil {
+		return nil, err
+	}
+	name := ovaImportVMName(filePath, params)
+	importBuilder := ovirtsdk.NewExternalVmImportBuilder().
+		Name(name).
+		// The engine imports OVA files through the KVM provider, reading the file from the host.
+		Provider(ovirtsdk.EXTERNALVMPROVIDERTYPE_KVM).
+		Url(fmt.Sprintf("ova://%s", filePath)).
+		HostBuilder(ovirtsdk.NewHostBuilder().Id(string(hostID))).
+		ClusterBuilder(ovirtsdk.NewClusterBuilder().Id(string(clusterID))).
+		StorageDomainBuilder(ovirtsdk.NewStorageDomainBuilder().Id(string(storageDomainID)))
+	if sparse := params.Sparse(); sparse != nil {
+		importBuilder.Sparse(*sparse)
+	}
+	sdkImport := importBuilder.MustBuild()
+
+	correlationID := fmt.Sprintf("vm_import_ova_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf("importing VM %s from OVA file %s on host %s", name, filePath, hostID)
+	err := retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				ExternalVmImportsService().
+				Add().
+				Import(sdkImport).
+				Query("correlation_id", correlationID).
+				Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return nil, err
+	}
+	if err := o.waitForJobSucceeded(correlationID, retries); err != nil {
+		return nil, wrap(err, EUnidentified, "failed %s", action)
+	}
+	vm, err := o.GetVMByName(name, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return o.WaitForVMStatus(vm.ID(), VMStatusDown, retries...)
+}
+
+func (m *mockClient) ImportVMFromOVA(
+	hostID HostID,
+	filePath string,
+	clusterID ClusterID,
+	storageDomainID StorageDomainID,
+	params ImportOVAParameters,
+	retries ...RetryStrategy,
+) (VM, error) {
+	if params == nil {
+		params = ImportOVAParams()
+	}
+	if err := validateOVAFilePath(filePath); err != nil {
+		return nil, err
+	}
+	if err := checkOVAImportTargets(m, hostID, clusterID, storageDomainID, retries); err != nil {
+		return nil, err
+	}
+	// The mock has no files on its hosts, so the import creates a VM with a single bootable disk.
+	format := ImageFormatRaw
+	if sparse := params.Sparse(); sparse != nil && *sparse {
+		format = ImageFormatCow
+	}
+	disk, err := NewDiskSpecParams(storageDomainID, format, 10*1024*1024*1024)
+	if err != nil {
+		return nil, err
+	}
+	vmParams := CreateVMParams().MustWithNewDisks([]NewDiskSpec{disk.MustWithBootable(true)})
+	return m.CreateVM(clusterID, DefaultBlankTemplateID, ovaImportVMName(filePath, params), vmParams, retries...)
+}
@@ -0,0 +1,72 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestImportVMFromOVA(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	var testHost *host
+	for _, item := range m.hosts {
+		testHost = item
+		break
+	}
+	var storageDomainID StorageDomainID
+	for id, storageDomain := range m.storageDomains {
+		if storageDomain.function == StorageDomainFunctionData {
+			storageDomainID = id
+			break
+		}
+	}
+
+	vm, err := m.ImportVMFromOVA(testHost.ID(), "/var/tmp/exports/web-1.ova", testHost.ClusterID(), storageDomainID, nil)
+	if err != nil {
+		t.Fatalf("Failed to import VM from OVA (%v)", err)
+	}
+	if vm.Name() != "web-1" || vm.ClusterID() != testHost.ClusterID() || vm.Status() != VMStatusDown {
+		t.Fatalf(
+			"Incorrect imported VM (name: %s, cluster: %s, status: %s)",
+			vm.Name(),
+			vm.ClusterID(),
+			vm.Status(),
+		)
+	}
+	attachments, err := m.ListDiskAttachments(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to list disk attachments of the imported VM (%v)", err)
+	}
+	if len(attachments) != 1 || !attachments[0].Bootable() {
+		t.Fatalf("The imported VM doesn't have a single bootable disk (%d disks)", len(attachments))
+	}
+
+	params := ImportOVAParams().MustWithName("web-2")
+	if _, err := m.ImportVMFromOVA(
+		testHost.ID(),
+		"exports/web-1.ova",
+		testHost.ClusterID(),
+		storageDomainID,
+		params,
+	); !HasErrorCode(err, EBadArgument) {
+		t.Fatalf("Importing from a relative path did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := m.ImportVMFromOVA(
+		testHost.ID(),
+		"/var/tmp/exports/web-1.ova",
+		testHost.ClusterID(),
+		"00000000-0000-0000-0000-000000000001",
+		params,
+	); !HasErrorCode(err, ENotFound) {
+		t.Fatalf("Importing to a nonexistent storage domain did not result in an ENotFound error (%v)", err)
+	}
+	testHost.status = HostStatusMaintenance
+	if _, err := m.ImportVMFromOVA(
+		testHost.ID(),
+		"/var/tmp/exports/web-1.ova",
+		testHost.ClusterID(),
+		storageDomainID,
+		params,
+	); !HasErrorCode(err, EConflict) {
+		t.Fatalf("Importing through a host in maintenance did not result in an EConflict error (%v)", err)
+	}
+}
@@ -0,0 +1,72 @@
+package ovirtclient
+
+import (
+	"net"
+	"strings"
+)
+
+// dnsListSeparator separates the entries of the DNS server and search domain lists of an initialization, as
+// cloud-init expects them.
+const dnsListSeparator = " "
+
+func (i *initialization) WithDNSServerList(servers []string) (BuildableInitialization, error) {
+	if err := validateDNSServers(servers); err != nil {
+		return nil, err
+	}
+	i.dnsServers = strings.Join(servers, dnsListSeparator)
+	return i, nil
+}
+
+func (i *initialization) MustWithDNSServerList(servers []string) BuildableInitialization {
+	builder, err := i.WithDNSServerList(servers)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (i *initialization) WithDNSSearchList(domains []string) (BuildableInitialization, error) {
+	if err := validateDNSSearchDomains(domains); err != nil {
+		return nil, err
+	}
+	i.dnsSearch = strings.Join(domains, dnsListSeparator)
+	return i, nil
+}
+
+func (i *initialization) MustWithDNSSearchList(domains []string) BuildableInitialization {
+	builder, err := i.WithDNSSearchList(domains)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func validateDNSServers(servers []string) error {
+	for _, server := range servers {
+		if net.ParseIP(server) == nil {
+			return newError(EBadArgument, "invalid DNS server: %q (must be an IPv4 or IPv6 address)", server)
+		}
+	}
+	return nil
+}
+
+func validateDNSSearchDomains(domains []string) error {
+	for _, domain := range domains {
+		if len(domain) > 253 || !hostnameRegexp.MatchString(domain) {
+			return newError(EBadArgument, "invalid DNS search domain: %q", domain)
+		}
+	}
+	return nil
+}
+
+// validateInitializationDNS checks the DNS settings of an initialization that may have been set with the plain string
+// setters, so invalid entries are rejected before the engine passes them on to cloud-init.
+func validateInitializationDNS(init Initialization) error {
+	if init == nil {
+		return nil
+	}
+	if err := validateDNSServers(strings.Fields(init.DnsServers())); err != nil {
+		return err
+	}
+	return validateDNSSearchDomains(strings.Fields(init.DnsSearch()))
+}
@@ -0,0 +1,57 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMCreationWithInitDNS(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	init := ovirtclient.NewInitialization("", "test").
+		WithNicConfiguration(
+			ovirtclient.NewNicConfiguration("eth0", ovirtclient.IP{
+				Version: ovirtclient.IPVERSION_V4,
+				Address: "192.168.178.15",
+				Gateway: "192.168.178.1",
+				Netmask: "255.255.255.0",
+			}),
+		).
+		MustWithDNSServerList([]string{"192.168.178.1", "2001:db8::53"}).
+		MustWithDNSSearchList([]string{"example.com", "lab.example.com"})
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		ovirtclient.NewCreateVMParams().MustWithInitialization(init),
+	)
+	if servers := vm.Initialization().DnsServers(); servers != "192.168.178.1 2001:db8::53" {
+		t.Fatalf("Incorrect DNS servers on VM (%s)", servers)
+	}
+	if search := vm.Initialization().DnsSearch(); search != "example.com lab.example.com" {
+		t.Fatalf("Incorrect DNS search domains on VM (%s)", search)
+	}
+}
+
+func TestInitDNSValidation(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ovirtclient.NewInitialization("", "test").WithDNSServerList(
+		[]string{"192.168.178.1", "dns.example.com"},
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Setting a host name as DNS server did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := ovirtclient.NewInitialization("", "test").WithDNSSearchList(
+		[]string{"-invalid.example.com"},
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Setting an invalid DNS search domain did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := ovirtclient.NewCreateVMParams().WithInitialization(
+		ovirtclient.NewInitialization("", "test").WithDnsServers("192.168.178.1 192.168.178.300"),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a VM with an invalid DNS server did not result in an EBadArgument error (%v)", err)
+	}
+}
@@ -0,0 +1,116 @@
+package ovirtclient
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// VMLabelsBlockStart and VMLabelsBlockEnd mark the block in the VM comment that holds the labels set with
+// SetVMLabels. The block is placed on its own lines at the end of the comment:
+//
+//	Text written by a human.
+//	[ovirt-client-labels]
+//	{"key":"value"}
+//	[/ovirt-client-labels]
+//
+// The line between the markers is a JSON object mapping the label keys to their values. Everything before the block
+// is left untouched. Text written by hand after the block makes the block unrecognizable, in which case the whole
+// comment is treated as human-written text.
+const (
+	VMLabelsBlockStart = "[ovirt-client-labels]"
+	VMLabelsBlockEnd   = "[/ovirt-client-labels]"
+)
+
+// splitVMLabels separates the human-written part of a VM comment from the labels stored in it.
+func splitVMLabels(comment string) (string, map[string]string) {
+	labels := map[string]string{}
+	suffix := "\n" + VMLabelsBlockEnd
+	if !strings.HasSuffix(comment, suffix) {
+		return comment, labels
+	}
+	start := strings.LastIndex(comment, VMLabelsBlockStart+"\n")
+	if start < 0 || (start > 0 && comment[start-1] != '\n') {
+		return comment, labels
+	}
+	payloadStart := start + len(VMLabelsBlockStart) + 1
+	payloadEnd := len(comment) - len(suffix)
+	if payloadStart > payloadEnd {
+		return comment, labels
+	}
+	if err := json.Unmarshal([]byte(comment[payloadStart:payloadEnd]), &labels); err != nil {
+		return comment, map[string]string{}
+	}
+	text := comment[:start]
+	if start > 0 {
+		text = text[:len(text)-1]
+	}
+	return text, labels
+}
+
+// joinVMLabels appends the labels block to the human-written text of a VM comment. No block is written if there are
+// no labels.
+func joinVMLabels(text string, labels map[string]string) (string, error) {
+	if len(labels) == 0 {
+		return text, nil
+	}
+	for key := range labels {
+		if key == "" {
+			return "", newError(EBadArgument, "VM label keys must not be empty")
+		}
+	}
+	// json.Marshal sorts the keys, so the same labels always produce the same comment.
+	payload, err := json.Marshal(labels)
+	if err != nil {
+		return "", wrap(err, EBug, "failed to encode VM labels")
+	}
+	block := VMLabelsBlockStart + "\n" + string(payload) + "\n" + VMLabelsBlockEnd
+	if text == "" {
+		return block, nil
+	}
+	return text + "\n" + block, nil
+}
+
+// setVMLabels replaces the labels block in the comment of the VM. This is a read-modify-write of the comment, so
+// concurrent comment changes between the two calls are lost.
+func setVMLabels(client Client, id VMID, labels map[string]string, retries []RetryStrategy) error {
+	vm, err := client.GetVM(id, retries...)
+	if err != nil {
+		return err
+	}
+	text, _ := splitVMLabels(vm.Comment())
+	comment, err := joinVMLabels(text, labels)
+	if err != nil {
+		return err
+	}
+	if comment == vm.Comment() {
+		return nil
+	}
+	return client.SetVMComment(id, comment, retries...)
+}
+
+func getVMLabels(client Client, id VMID, retries []RetryStrategy) (map[string]string, error) {
+	vm, err := client.GetVM(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	_, labels := splitVMLabels(vm.Comment())
+	return labels, nil
+}
+
+func (o *oVirtClient) SetVMLabels(id VMID, labels map[string]string, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	return setVMLabels(o, id, labels, retries)
+}
+
+func (o *oVirtClient) GetVMLabels(id VMID, retries ...RetryStrategy) (map[string]string, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	return getVMLabels(o, id, retries)
+}
+
+func (m *mockClient) SetVMLabels(id VMID, labels map[string]string, retries ...RetryStrategy) error {
+	return setVMLabels(m, id, labels, retries)
+}
+
+func (m *mockClient) GetVMLabels(id VMID, retries ...RetryStrategy) (map[string]string, error) {
+	return getVMLabels(m, id, retries)
+}
@@ -0,0 +1,65 @@
+package ovirtclient_test
+
+import (
+	"reflect"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMLabels(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	comment := "Managed by hand.\n"
+	if err := vm.SetComment(comment); err != nil {
+		t.Fatalf("Failed to set comment on VM %s (%v)", vm.ID(), err)
+	}
+
+	labels := map[string]string{
+		"app":       "database",
+		"multiline": "first\n" + ovirtclient.VMLabelsBlockEnd + "\nsecond",
+		"empty":     "",
+	}
+	if err := client.SetVMLabels(vm.ID(), labels); err != nil {
+		t.Fatalf("Failed to set labels on VM %s (%v)", vm.ID(), err)
+	}
+	storedLabels, err := client.GetVMLabels(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to get labels of VM %s (%v)", vm.ID(), err)
+	}
+	if !reflect.DeepEqual(storedLabels, labels) {
+		t.Fatalf("Labels changed on round trip (expected: %v, got: %v)", labels, storedLabels)
+	}
+
+	if err := client.SetVMLabels(vm.ID(), map[string]string{"app": "web"}); err != nil {
+		t.Fatalf("Failed to replace labels on VM %s (%v)", vm.ID(), err)
+	}
+	storedLabels, err = client.GetVMLabels(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to get labels of VM %s (%v)", vm.ID(), err)
+	}
+	if len(storedLabels) != 1 || storedLabels["app"] != "web" {
+		t.Fatalf("Incorrect labels after replacing them (%v)", storedLabels)
+	}
+
+	if err := client.SetVMLabels(vm.ID(), nil); err != nil {
+		t.Fatalf("Failed to remove labels from VM %s (%v)", vm.ID(), err)
+	}
+	updatedVM, err := client.GetVM(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to get VM %s (%v)", vm.ID(), err)
+	}
+	if updatedVM.Comment() != comment {
+		t.Fatalf("The human-written comment was not preserved (expected: %q, got: %q)", comment, updatedVM.Comment())
+	}
+
+	if err := client.SetVMLabels(vm.ID(), map[string]string{"": "value"}); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting a label with an empty key did not result in an EBadArgument error (%v)", err)
+	}
+}
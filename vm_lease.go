@@ -0,0 +1,118 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func vmLeaseConverter(object *ovirtsdk.Vm, v *vm) error {
+	lease, ok := object.Lease()
+	if !ok {
+		return nil
+	}
+	storageDomain, ok := lease.StorageDomain()
+	if !ok {
+		return nil
+	}
+	id, ok := storageDomain.Id()
+	if !ok {
+		return newFieldNotFound("storage domain of VM lease", "ID")
+	}
+	storageDomainID := StorageDomainID(id)
+	v.leaseStorageDomainID = &storageDomainID
+	return nil
+}
+
+func vmLeaseCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if storageDomainID := params.LeaseStorageDomainID(); storageDomainID != nil {
+		builder.Lease(buildSDKVMLease(*storageDomainID))
+	}
+}
+
+func buildSDKVMLease(storageDomainID StorageDomainID) *ovirtsdk.StorageDomainLease {
+	return ovirtsdk.NewStorageDomainLeaseBuilder().
+		StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(string(storageDomainID)).MustBuild()).
+		MustBuild()
+}
+
+// checkLeaseStorageDomain returns an EBadArgument error if the storage domain cannot hold VM leases.
+func checkLeaseStorageDomain(storageDomain StorageDomain) error {
+	if storageDomain.Function() != StorageDomainFunctionData {
+		return newError(
+			EBadArgument,
+			"VM leases can only be placed on %s storage domains, storage domain %s is a %s domain",
+			StorageDomainFunctionData,
+			storageDomain.ID(),
+			storageDomain.Function(),
+		)
+	}
+	return nil
+}
+
+func (o *oVirtClient) validateLeaseStorageDomain(id StorageDomainID, retries []RetryStrategy) error {
+	storageDomain, err := o.GetStorageDomain(id, retries...)
+	if err != nil {
+		return err
+	}
+	return checkLeaseStorageDomain(storageDomain)
+}
+
+func (o *oVirtClient) SetVMLease(id VMID, storageDomainID StorageDomainID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := o.validateLeaseStorageDomain(storageDomainID, retries); err != nil {
+		return err
+	}
+	sdkVM := &ovirtsdk.Vm{}
+	sdkVM.SetId(string(id))
+	sdkVM.SetLease(buildSDKVMLease(storageDomainID))
+	return o.updateVMField(id, sdkVM, "lease", retries)
+}
+
+func (o *oVirtClient) ClearVMLease(id VMID, retries ...RetryStrategy) error {
+	sdkVM := &ovirtsdk.Vm{}
+	sdkVM.SetId(string(id))
+	// The engine removes the lease when it receives an empty lease element.
+	sdkVM.SetLease(&ovirtsdk.StorageDomainLease{})
+	return o.updateVMField(id, sdkVM, "lease", retries)
+}
+
+func (m *mockClient) validateLeaseStorageDomain(id StorageDomainID) error {
+	storageDomain, ok := m.storageDomains[id]
+	if !ok {
+		return newError(ENotFound, "storage domain with ID %s not found", id)
+	}
+	return checkLeaseStorageDomain(storageDomain)
+}
+
+func (m *mockClient) createVMLeaseStorageDomainID(params OptionalVMParameters) *StorageDomainID {
+	storageDomainID := params.LeaseStorageDomainID()
+	if storageDomainID == nil {
+		return nil
+	}
+	result := *storageDomainID
+	return &result
+}
+
+func (m *mockClient) SetVMLease(id VMID, storageDomainID StorageDomainID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", id)
+	}
+	if err := m.validateLeaseStorageDomain(storageDomainID); err != nil {
+		return err
+	}
+	item.leaseStorageDomainID = &storageDomainID
+	return nil
+}
+
+func (m *mockClient) ClearVMLease(id VMID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", id)
+	}
+	item.leaseStorageDomainID = nil
+	return nil
+}
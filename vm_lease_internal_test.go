@@ -0,0 +1,35 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestVMLeaseRequiresDataStorageDomain(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+
+	isoDomain := generateTestStorageDomain()
+	isoDomain.function = StorageDomainFunctionISO
+	m.storageDomains[isoDomain.ID()] = isoDomain
+
+	var clusterID ClusterID
+	for id := range m.clusters {
+		clusterID = id
+		break
+	}
+	vm, err := m.CreateVM(clusterID, DefaultBlankTemplateID, "lease", nil)
+	if err != nil {
+		t.Fatalf("Failed to create VM (%v)", err)
+	}
+	if err := m.SetVMLease(vm.ID(), isoDomain.ID()); !HasErrorCode(err, EBadArgument) {
+		t.Fatalf("Placing a lease on an ISO storage domain did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := m.CreateVM(
+		clusterID,
+		DefaultBlankTemplateID,
+		"lease-iso",
+		NewCreateVMParams().MustWithLeaseStorageDomainID(isoDomain.ID()),
+	); !HasErrorCode(err, EBadArgument) {
+		t.Fatalf("Creating a VM with a lease on an ISO storage domain did not result in an EBadArgument error (%v)", err)
+	}
+}
@@ -0,0 +1,55 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMLease(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	storageDomainID := helper.GetStorageDomainID()
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithLeaseStorageDomainID(storageDomainID),
+	)
+	if leaseStorageDomainID := vm.LeaseStorageDomainID(); leaseStorageDomainID == nil ||
+		*leaseStorageDomainID != storageDomainID {
+		t.Fatalf("The created VM has no lease on storage domain %s.", storageDomainID)
+	}
+
+	if err := client.ClearVMLease(vm.ID()); err != nil {
+		t.Fatalf("Failed to clear lease of VM %s (%v)", vm.ID(), err)
+	}
+	updatedVM, err := client.GetVM(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to get VM %s (%v)", vm.ID(), err)
+	}
+	if updatedVM.LeaseStorageDomainID() != nil {
+		t.Fatalf("The VM still has a lease after clearing it.")
+	}
+
+	if err := client.SetVMLease(vm.ID(), storageDomainID); err != nil {
+		t.Fatalf("Failed to set lease of VM %s (%v)", vm.ID(), err)
+	}
+	updatedVM, err = client.GetVM(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to get VM %s (%v)", vm.ID(), err)
+	}
+	if leaseStorageDomainID := updatedVM.LeaseStorageDomainID(); leaseStorageDomainID == nil ||
+		*leaseStorageDomainID != storageDomainID {
+		t.Fatalf("The VM has no lease on storage domain %s after setting it.", storageDomainID)
+	}
+
+	if _, err := ovirtclient.NewCreateVMParams().WithLeaseStorageDomainID(""); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Passing an empty lease storage domain ID did not result in an EBadArgument error (%v)", err)
+	}
+}
@@ -0,0 +1,238 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// DefaultPageSize is the number of items per page used when no page size is passed in PageParameters.
+const DefaultPageSize uint = 100
+
+// PageParameters describe which page of a list to fetch.
+type PageParameters interface {
+	// PageSize is the maximum number of items returned on a single page.
+	PageSize() uint
+	// PageToken is the token returned in NextPageToken of the previous page. An empty token fetches the first page.
+	PageToken() string
+}
+
+// BuildablePageParameters is a buildable version of PageParameters.
+type BuildablePageParameters interface {
+	PageParameters
+
+	// WithPageSize sets the maximum number of items per page. The page size must be at least 1.
+	WithPageSize(pageSize uint) (BuildablePageParameters, error)
+	// MustWithPageSize is identical to WithPageSize, but panics instead of returning an error.
+	MustWithPageSize(pageSize uint) BuildablePageParameters
+
+	// WithPageToken sets the token of the page to fetch.
+	WithPageToken(pageToken string) (BuildablePageParameters, error)
+	// MustWithPageToken is identical to WithPageToken, but panics instead of returning an error.
+	MustWithPageToken(pageToken string) BuildablePageParameters
+}
+
+// PageParams creates a new set of parameters fetching the first page with DefaultPageSize items.
+func PageParams() BuildablePageParameters {
+	return &pageParams{
+		pageSize: DefaultPageSize,
+	}
+}
+
+type pageParams struct {
+	pageSize  uint
+	pageToken string
+}
+
+func (p *pageParams) PageSize() uint {
+	return p.pageSize
+}
+
+func (p *pageParams) PageToken() string {
+	return p.pageToken
+}
+
+func (p *pageParams) WithPageSize(pageSize uint) (BuildablePageParameters, error) {
+	if pageSize == 0 {
+		return nil, newError(EBadArgument, "the page size must be at least 1")
+	}
+	p.pageSize = pageSize
+	return p, nil
+}
+
+func (p *pageParams) MustWithPageSize(pageSize uint) BuildablePageParameters {
+	builder, err := p.WithPageSize(pageSize)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (p *pageParams) WithPageToken(pageToken string) (BuildablePageParameters, error) {
+	if _, err := parsePageToken(pageToken); err != nil {
+		return nil, err
+	}
+	p.pageToken = pageToken
+	return p, nil
+}
+
+func (p *pageParams) MustWithPageToken(pageToken string) BuildablePageParameters {
+	builder, err := p.WithPageToken(pageToken)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// VMPage is a single page of VMs returned from ListVMsPage.
+type VMPage interface {
+	// Items returns the VMs on the current page.
+	Items() []VM
+	// HasMore returns true if there may be more VMs after the current page. Since the engine does not report the total
+	// number of items, a full last page also reports true and fetching the next page returns no items.
+	HasMore() bool
+	// NextPageToken returns the token to pass to PageParameters to fetch the next page. It is empty if HasMore
+	// returns false.
+	NextPageToken() string
+}
+
+type vmPage struct {
+	items         []VM
+	nextPageToken string
+}
+
+func (v *vmPage) Items() []VM {
+	return v.items
+}
+
+func (v *vmPage) HasMore() bool {
+	return v.nextPageToken != ""
+}
+
+func (v *vmPage) NextPageToken() string {
+	return v.nextPageToken
+}
+
+// parsePageToken returns the 1-based page number encoded in the token. The token is treated as opaque by callers, but
+// it is a plain page number so that the engine's page search clause can be used.
+func parsePageToken(pageToken string) (uint64, error) {
+	if pageToken == "" {
+		return 1, nil
+	}
+	page, err := strconv.ParseUint(pageToken, 10, 64)
+	if err != nil || page < 1 {
+		return 0, newError(EBadArgument, "invalid page token: %q", pageToken)
+	}
+	return page, nil
+}
+
+// newVMPage creates the page result. The next page token is only set if the page is full.
+func newVMPage(items []VM, page uint64, pageSize uint) *vmPage {
+	result := &vmPage{
+		items: items,
+	}
+	if uint(len(items)) >= pageSize {
+		result.nextPageToken = strconv.FormatUint(page+1, 10)
+	}
+	return result
+}
+
+func (o *oVirtClient) ListVMsPage(params PageParameters, retries ...RetryStrategy) (result VMPage, err error) {
+	if params == nil {
+		params = PageParams()
+	}
+	if params.PageSize() == 0 {
+		return nil, newError(EBadArgument, "the page size must be at least 1")
+	}
+	page, err := parsePageToken(params.PageToken())
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("listing vms page %d", page),
+		o.logger,
+		retries,
+		func() error {
+			request := o.conn.
+				SystemService().
+				VmsService().
+				List().
+				Search(fmt.Sprintf("sortby name asc page %d", page)).
+				Max(int64(params.PageSize()))
+			if allContentRequested(o.ctx) {
+				request.Header(allContentHeader, "true")
+			}
+			response, e := request.Send()
+			if e != nil {
+				return wrapSDKError(fmt.Sprintf("listing vms page %d", page), e)
+			}
+			var items []VM
+			if sdkObjects, ok := response.Vms(); ok {
+				items = make([]VM, len(sdkObjects.Slice()))
+				for i, sdkObject := range sdkObjects.Slice() {
+					items[i], e = convertSDKVM(sdkObject, o)
+					if e != nil {
+						return wrap(e, EBug, "failed to convert vm during listing item #%d", i)
+					}
+				}
+			}
+			result = newVMPage(items, page, params.PageSize())
+			return nil
+		})
+	return result, err
+}
+
+// listAllVMPages fetches all pages of VMs using ListVMsPage.
+func listAllVMPages(client VMClient, retries []RetryStrategy) ([]VM, error) {
+	result := []VM{}
+	params := PageParams()
+	for {
+		page, err := client.ListVMsPage(params, retries...)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page.Items()...)
+		if !page.HasMore() {
+			return result, nil
+		}
+		params = params.MustWithPageToken(page.NextPageToken())
+	}
+}
+
+func (m *mockClient) ListVMsPage(params PageParameters, _ ...RetryStrategy) (VMPage, error) {
+	if params == nil {
+		params = PageParams()
+	}
+	if params.PageSize() == 0 {
+		return nil, newError(EBadArgument, "the page size must be at least 1")
+	}
+	page, err := parsePageToken(params.PageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	all := make([]*vm, 0, len(m.vms))
+	for _, item := range m.vms {
+		all = append(all, item)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].name < all[j].name
+	})
+	pageSize := uint64(params.PageSize())
+	start := (page - 1) * pageSize
+	if start > uint64(len(all)) {
+		start = uint64(len(all))
+	}
+	end := start + pageSize
+	if end > uint64(len(all)) {
+		end = uint64(len(all))
+	}
+	items := make([]VM, 0, end-start)
+	for _, item := range all[start:end] {
+		items = append(items, item.snapshot())
+	}
+	return newVMPage(items, page, params.PageSize()), nil
+}
@@ -0,0 +1,59 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+// TestListVMsPage runs against the mock only since other tests running in parallel add and remove VMs on the live
+// engine, which shifts the page boundaries.
+func TestListVMsPage(t *testing.T) {
+	t.Parallel()
+	helper, err := ovirtclient.NewMockTestHelper(ovirtclientlog.NewTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create mock test helper (%v)", err)
+	}
+	client := helper.GetClient()
+
+	vm1 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	vm2 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+
+	seen := map[ovirtclient.VMID]bool{}
+	params := ovirtclient.PageParams().MustWithPageSize(1)
+	for {
+		page, err := client.ListVMsPage(params)
+		if err != nil {
+			t.Fatalf("Failed to list VMs page (%v)", err)
+		}
+		if len(page.Items()) > 1 {
+			t.Fatalf("Too many VMs on a page of size 1 (%d)", len(page.Items()))
+		}
+		for _, vm := range page.Items() {
+			if seen[vm.ID()] {
+				t.Fatalf("VM %s returned on more than one page.", vm.ID())
+			}
+			seen[vm.ID()] = true
+		}
+		if !page.HasMore() {
+			break
+		}
+		params = params.MustWithPageToken(page.NextPageToken())
+	}
+	if !seen[vm1.ID()] || !seen[vm2.ID()] {
+		t.Fatalf("Not all test VMs have been returned across the pages.")
+	}
+}
+
+func TestListVMsPageInvalidToken(t *testing.T) {
+	t.Parallel()
+
+	_, err := ovirtclient.PageParams().WithPageToken("invalid")
+	if err == nil {
+		t.Fatalf("Setting an invalid page token did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Setting an invalid page token did not result in an EBadArgument error (%v)", err)
+	}
+}
@@ -0,0 +1,184 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// MemoryHotPlugBlockSize is the granularity in bytes in which memory can be hot-plugged to or hot-unplugged from a
+// running VM.
+const MemoryHotPlugBlockSize uint64 = 256 * 1024 * 1024
+
+func (o *oVirtClient) HotPlugVMMemory(id VMID, additionalBytes uint64, retries ...RetryStrategy) (VM, error) {
+	if err := validateMemoryHotPlugSize(additionalBytes); err != nil {
+		return nil, err
+	}
+	return o.changeRunningVMMemory(id, int64(additionalBytes), "hot-plugging", retries)
+}
+
+func (o *oVirtClient) HotUnplugVMMemory(id VMID, removedBytes uint64, retries ...RetryStrategy) (VM, error) {
+	if err := validateMemoryHotPlugSize(removedBytes); err != nil {
+		return nil, err
+	}
+	supported, err := o.SupportsFeature(FeatureMemoryHotUnplug, retries...)
+	if err != nil {
+		return nil, err
+	}
+	if !supported {
+		return nil, newError(EUnsupported, "the oVirt Engine does not support memory hot-unplug")
+	}
+	return o.changeRunningVMMemory(id, -int64(removedBytes), "hot-unplugging", retries)
+}
+
+func (o *oVirtClient) changeRunningVMMemory(
+	id VMID,
+	delta int64,
+	operation string,
+	retries []RetryStrategy,
+) (VM, error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+
+	currentVM, err := o.GetVM(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	memory, memPolicy, err := calculateHotPluggedMemory(currentVM, delta)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkVM := &ovirtsdk.Vm{}
+	sdkVM.SetId(string(id))
+	sdkVM.SetMemory(memory)
+	sdkVM.SetMemoryPolicy(memPolicy)
+
+	return o.updateRunningVM(id, sdkVM, fmt.Sprintf("%s memory on VM %s", operation, id), retries)
+}
+
+// updateRunningVM sends the hot-plug changes in sdkVM to the VM and returns the updated VM.
+func (o *oVirtClient) updateRunningVM(
+	id VMID,
+	sdkVM *ovirtsdk.Vm,
+	action string,
+	retries []RetryStrategy,
+) (result VM, err error) {
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().VmsService().VmService(string(id)).Update().Vm(sdkVM).Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			vm, ok := response.Vm()
+			if !ok {
+				return newError(EFieldMissing, "missing VM in VM update response")
+			}
+			result, err = convertSDKVM(vm, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert VM",
+				)
+			}
+			return nil
+		})
+	return result, err
+}
+
+// calculateHotPluggedMemory returns the new memory size and memory policy of a VM after adding delta bytes of memory.
+// The guaranteed memory is lowered if it would be higher than the resulting memory.
+func calculateHotPluggedMemory(vm VM, delta int64) (int64, *ovirtsdk.MemoryPolicy, error) {
+	if vm.Status() != VMStatusUp {
+		return 0, nil, newError(
+			EUnsupported,
+			"memory can only be hot-plugged on running VMs, VM %s is in status %s, use UpdateVM instead",
+			vm.ID(),
+			vm.Status(),
+		)
+	}
+	memory := vm.Memory() + delta
+	if memory <= 0 {
+		return 0, nil, newError(
+			EBadArgument,
+			"cannot remove %d bytes of memory from VM %s with %d bytes of memory",
+			-delta,
+			vm.ID(),
+			vm.Memory(),
+		)
+	}
+	memPolicy := ovirtsdk.NewMemoryPolicyBuilder()
+	if policy := vm.MemoryPolicy(); policy != nil {
+		if max := policy.Max(); max != nil {
+			if memory > *max {
+				return 0, nil, newError(
+					EBadArgument,
+					"the requested memory of %d bytes exceeds the maximum memory of %d bytes for VM %s",
+					memory,
+					*max,
+					vm.ID(),
+				)
+			}
+			memPolicy.Max(*max)
+		}
+		if guaranteed := policy.Guaranteed(); guaranteed != nil {
+			if *guaranteed > memory {
+				memPolicy.Guaranteed(memory)
+			} else {
+				memPolicy.Guaranteed(*guaranteed)
+			}
+		}
+	}
+	return memory, memPolicy.MustBuild(), nil
+}
+
+func validateMemoryHotPlugSize(size uint64) error {
+	if size == 0 {
+		return newError(EBadArgument, "the memory size to hot-plug must be greater than 0")
+	}
+	if size%MemoryHotPlugBlockSize != 0 {
+		return newError(
+			EBadArgument,
+			"the memory size to hot-plug must be a multiple of %d bytes (%d given)",
+			MemoryHotPlugBlockSize,
+			size,
+		)
+	}
+	return nil
+}
+
+func (m *mockClient) HotPlugVMMemory(id VMID, additionalBytes uint64, _ ...RetryStrategy) (VM, error) {
+	if err := validateMemoryHotPlugSize(additionalBytes); err != nil {
+		return nil, err
+	}
+	return m.changeRunningVMMemory(id, int64(additionalBytes))
+}
+
+func (m *mockClient) HotUnplugVMMemory(id VMID, removedBytes uint64, _ ...RetryStrategy) (VM, error) {
+	if err := validateMemoryHotPlugSize(removedBytes); err != nil {
+		return nil, err
+	}
+	return m.changeRunningVMMemory(id, -int64(removedBytes))
+}
+
+func (m *mockClient) changeRunningVMMemory(id VMID, delta int64) (VM, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	item, ok := m.vms[id]
+	if !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", id)
+	}
+	memory, memPolicy, err := calculateHotPluggedMemory(item, delta)
+	if err != nil {
+		return nil, err
+	}
+	item.memory = memory
+	if guaranteed, ok := memPolicy.Guaranteed(); ok && item.memoryPolicy != nil {
+		item.memoryPolicy.guaranteed = &guaranteed
+	}
+	return item, nil
+}
@@ -0,0 +1,59 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMMemoryHotPlug(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	memory := int64(2 * 1024 * 1024 * 1024)
+	maxMemory := 2 * memory
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().
+			MustWithMemory(memory).
+			WithMemoryPolicy(
+				ovirtclient.NewMemoryPolicyParameters().MustWithMax(maxMemory),
+			),
+	)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanUploadDiskImage(t, helper, disk)
+	assertCanAttachDiskWithParams(t, vm, disk, ovirtclient.CreateDiskAttachmentParams().MustWithBootable(true).MustWithActive(true))
+	assertCanStartVM(t, helper, vm)
+	vm = assertVMWillStart(t, vm)
+
+	updatedVM, err := vm.HotPlugMemory(ovirtclient.MemoryHotPlugBlockSize)
+	if err != nil {
+		t.Fatalf("Failed to hot-plug memory (%v)", err)
+	}
+	expectedMemory := memory + int64(ovirtclient.MemoryHotPlugBlockSize)
+	if updatedVM.Memory() != expectedMemory {
+		t.Fatalf("Incorrect memory after hot-plug (expected: %d, got: %d)", expectedMemory, updatedVM.Memory())
+	}
+
+	if _, err := vm.HotPlugMemory(uint64(maxMemory)); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Hot-plugging memory above the maximum memory did not result in an EBadArgument error (%v)", err)
+	}
+}
+
+func TestVMMemoryHotPlugOnStoppedVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	if _, err := vm.HotPlugMemory(ovirtclient.MemoryHotPlugBlockSize); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EUnsupported,
+	) {
+		t.Fatalf("Hot-plugging memory on a stopped VM did not result in an EUnsupported error (%v)", err)
+	}
+	if _, err := vm.HotPlugMemory(1024); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Hot-plugging an invalid amount of memory did not result in an EBadArgument error (%v)", err)
+	}
+}
@@ -0,0 +1,95 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// mergeMemoryPolicy applies the parameters to the current memory policy of the VM and checks that the result
+// satisfies guaranteed <= memory <= max.
+func mergeMemoryPolicy(vm VM, params MemoryPolicyParameters) (memoryPolicy, error) {
+	result := memoryPolicy{ballooning: true}
+	if current := vm.MemoryPolicy(); current != nil {
+		result.guaranteed = current.Guaranteed()
+		result.max = current.Max()
+		result.ballooning = current.Ballooning()
+	}
+	if guaranteed := params.Guaranteed(); guaranteed != nil {
+		value := *guaranteed
+		result.guaranteed = &value
+	}
+	if max := params.Max(); max != nil {
+		value := *max
+		result.max = &value
+	}
+	if ballooning := params.Ballooning(); ballooning != nil {
+		result.ballooning = *ballooning
+	}
+	if result.guaranteed != nil && *result.guaranteed > vm.Memory() {
+		return result, newError(
+			EBadArgument,
+			"the guaranteed memory of %d bytes is larger than the memory of VM %s (%d bytes)",
+			*result.guaranteed,
+			vm.ID(),
+			vm.Memory(),
+		)
+	}
+	if result.max != nil && vm.Memory() > *result.max {
+		return result, newError(
+			EBadArgument,
+			"the maximum memory of %d bytes is lower than the memory of VM %s (%d bytes)",
+			*result.max,
+			vm.ID(),
+			vm.Memory(),
+		)
+	}
+	return result, nil
+}
+
+func (o *oVirtClient) SetVMMemoryPolicy(id VMID, params MemoryPolicyParameters, retries ...RetryStrategy) (VM, error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "the memory policy parameters cannot be nil")
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	currentVM, err := o.GetVM(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := mergeMemoryPolicy(currentVM, params)
+	if err != nil {
+		return nil, err
+	}
+
+	memPolicy := ovirtsdk.NewMemoryPolicyBuilder().Ballooning(policy.ballooning)
+	if policy.guaranteed != nil {
+		memPolicy.Guaranteed(*policy.guaranteed)
+	}
+	if policy.max != nil {
+		memPolicy.Max(*policy.max)
+	}
+	sdkVM := &ovirtsdk.Vm{}
+	sdkVM.SetId(string(id))
+	sdkVM.SetMemoryPolicy(memPolicy.MustBuild())
+
+	return o.updateRunningVM(id, sdkVM, fmt.Sprintf("setting memory policy of VM %s", id), retries)
+}
+
+func (m *mockClient) SetVMMemoryPolicy(id VMID, params MemoryPolicyParameters, _ ...RetryStrategy) (VM, error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "the memory policy parameters cannot be nil")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	item, ok := m.vms[id]
+	if !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", id)
+	}
+	policy, err := mergeMemoryPolicy(item, params)
+	if err != nil {
+		return nil, err
+	}
+	item.memoryPolicy = &policy
+	return item.snapshot(), nil
+}
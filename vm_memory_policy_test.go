@@ -0,0 +1,81 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestSetVMMemoryPolicy(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	const gib = 1024 * 1024 * 1024
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithMemory(gib),
+	)
+	updatedVM, err := client.SetVMMemoryPolicy(
+		vm.ID(),
+		ovirtclient.NewMemoryPolicyParameters().
+			MustWithGuaranteed(gib/2).
+			MustWithMax(2*gib).
+			MustWithBallooning(false),
+	)
+	if err != nil {
+		t.Fatalf("Failed to set memory policy of VM %s (%v)", vm.ID(), err)
+	}
+	policy := updatedVM.MemoryPolicy()
+	if guaranteed := policy.Guaranteed(); guaranteed == nil || *guaranteed != gib/2 {
+		t.Fatalf("The guaranteed memory was not set (%v)", guaranteed)
+	}
+	if max := policy.Max(); max == nil || *max != 2*gib {
+		t.Fatalf("The maximum memory was not set (%v)", max)
+	}
+	if policy.Ballooning() {
+		t.Fatalf("Ballooning was not disabled.")
+	}
+
+	updatedVM, err = client.SetVMMemoryPolicy(vm.ID(), ovirtclient.NewMemoryPolicyParameters().MustWithBallooning(true))
+	if err != nil {
+		t.Fatalf("Failed to enable ballooning on VM %s (%v)", vm.ID(), err)
+	}
+	if max := updatedVM.MemoryPolicy().Max(); max == nil || *max != 2*gib {
+		t.Fatalf("The maximum memory was not kept when only changing ballooning (%v)", max)
+	}
+
+	_, err = client.SetVMMemoryPolicy(vm.ID(), ovirtclient.NewMemoryPolicyParameters().MustWithMax(gib/2))
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Setting a maximum memory below the VM memory did not result in an EBadArgument error (%v)", err)
+	}
+}
+
+func TestMemoryPolicyParametersValidation(t *testing.T) {
+	t.Parallel()
+	if _, err := ovirtclient.NewMemoryPolicyParameters().WithGuaranteed(-1); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("A negative guaranteed memory did not result in an EBadArgument error (%v)", err)
+	}
+	_, err := ovirtclient.NewMemoryPolicyParameters().MustWithMax(1024).WithGuaranteed(2048)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("A guaranteed memory above the maximum did not result in an EBadArgument error (%v)", err)
+	}
+
+	helper := getHelper(t)
+	_, err = helper.GetClient().CreateVM(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().
+			MustWithMemory(2*1024*1024*1024).
+			WithMemoryPolicy(ovirtclient.NewMemoryPolicyParameters().MustWithMax(1024*1024*1024)),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a VM with more memory than its maximum did not result in an EBadArgument error (%v)", err)
+	}
+}
@@ -0,0 +1,519 @@
+package ovirtclient
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// MigrationPolicyID is the identifier of a migration policy of the oVirt Engine, such as "Minimal downtime" or
+// "Suspend workload if needed".
+type MigrationPolicyID string
+
+// The migration policies shipped with the oVirt Engine.
+const (
+	// MigrationPolicyMinimalDowntime migrates the VM with a short pause at the end of the migration. It is the
+	// default policy of new clusters.
+	MigrationPolicyMinimalDowntime MigrationPolicyID = "80554327-0569-496b-bdeb-fcbbf52b827b"
+	// MigrationPolicySuspendWorkload allows long pauses of the VM, so that VMs with a heavy workload can also be
+	// migrated.
+	MigrationPolicySuspendWorkload MigrationPolicyID = "80554327-0569-496b-bdeb-fcbbf52b827c"
+	// MigrationPolicyPostCopy switches to post-copy migration if the migration doesn't converge. The VM then runs on
+	// the target host while its memory is still being copied.
+	MigrationPolicyPostCopy MigrationPolicyID = "a7aeedb2-8d66-4e51-bb22-32595027ce71"
+)
+
+// migrationPoliciesEngineOption is the engine option listing the migration policies for each compatibility version.
+const migrationPoliciesEngineOption = "MigrationPolicies"
+
+const (
+	// MinMigrationDowntime is the lowest maximum downtime in milliseconds accepted for a migration.
+	MinMigrationDowntime uint = 1
+	// MaxMigrationDowntime is the highest maximum downtime in milliseconds accepted for a migration. Longer pauses
+	// are indistinguishable from a VM crash for most workloads.
+	MaxMigrationDowntime uint = 60000
+	// MaxMigrationBandwidth is the highest migration bandwidth limit in Mbps accepted for a migration.
+	MaxMigrationBandwidth uint = 100000
+)
+
+// MigrateVMParameters contains the optional parameters for migrating a VM. The tuning options are stored in the VM
+// configuration before the migration starts, so they also apply to later migrations, for example when a host is put
+// into maintenance.
+type MigrateVMParameters interface {
+	// TargetHostID returns the host the VM should be migrated to. If it returns nil, the engine picks a host.
+	TargetHostID() *HostID
+	// Force returns true if the migration should also be attempted for VMs that are not migratable according to
+	// their placement policy.
+	Force() bool
+	// MaxDowntime returns the maximum time in milliseconds the VM may be paused at the end of the migration.
+	MaxDowntime() *uint
+	// AutoConverge returns whether the hypervisor throttles the VM CPU if the migration does not converge. This
+	// requires oVirt 4.0 or newer.
+	AutoConverge() *bool
+	// Compressed returns whether the memory pages are compressed during the migration. This requires oVirt 4.0 or
+	// newer.
+	Compressed() *bool
+	// BandwidthLimit returns the bandwidth limit of the migration in Mbps. This requires oVirt 4.1 or newer.
+	BandwidthLimit() *uint
+	// PolicyID returns the migration policy that overrides the policy of the cluster for this VM. This requires
+	// oVirt 4.0 or newer.
+	PolicyID() *MigrationPolicyID
+}
+
+// BuildableMigrateVMParameters is a buildable version of MigrateVMParameters.
+type BuildableMigrateVMParameters interface {
+	MigrateVMParameters
+
+	// WithTargetHostID sets the host the VM is migrated to.
+	WithTargetHostID(hostID HostID) (BuildableMigrateVMParameters, error)
+	// MustWithTargetHostID is identical to WithTargetHostID, but panics instead of returning an error.
+	MustWithTargetHostID(hostID HostID) BuildableMigrateVMParameters
+
+	// WithForce sets whether the migration is also attempted for VMs that are not migratable.
+	WithForce(force bool) (BuildableMigrateVMParameters, error)
+	// MustWithForce is identical to WithForce, but panics instead of returning an error.
+	MustWithForce(force bool) BuildableMigrateVMParameters
+
+	// WithMaxDowntime sets the maximum downtime in milliseconds. It returns an EBadArgument error if the value is
+	// outside of MinMigrationDowntime and MaxMigrationDowntime.
+	WithMaxDowntime(milliseconds uint) (BuildableMigrateVMParameters, error)
+	// MustWithMaxDowntime is identical to WithMaxDowntime, but panics instead of returning an error.
+	MustWithMaxDowntime(milliseconds uint) BuildableMigrateVMParameters
+
+	// WithAutoConverge sets whether the VM CPU is throttled if the migration does not converge.
+	WithAutoConverge(autoConverge bool) (BuildableMigrateVMParameters, error)
+	// MustWithAutoConverge is identical to WithAutoConverge, but panics instead of returning an error.
+	MustWithAutoConverge(autoConverge bool) BuildableMigrateVMParameters
+
+	// WithCompressed sets whether memory pages are compressed during the migration.
+	WithCompressed(compressed bool) (BuildableMigrateVMParameters, error)
+	// MustWithCompressed is identical to WithCompressed, but panics instead of returning an error.
+	MustWithCompressed(compressed bool) BuildableMigrateVMParameters
+
+	// WithBandwidthLimit sets the bandwidth limit in Mbps. It returns an EBadArgument error for 0 or values above
+	// MaxMigrationBandwidth.
+	WithBandwidthLimit(mbps uint) (BuildableMigrateVMParameters, error)
+	// MustWithBandwidthLimit is identical to WithBandwidthLimit, but panics instead of returning an error.
+	MustWithBandwidthLimit(mbps uint) BuildableMigrateVMParameters
+
+	// WithPolicyID sets the migration policy overriding the cluster policy, for example
+	// MigrationPolicySuspendWorkload for a migration that must complete even under load. MigrateVM returns an
+	// ENotFound error if the engine has no migration policy with this ID.
+	WithPolicyID(policyID MigrationPolicyID) (BuildableMigrateVMParameters, error)
+	// MustWithPolicyID is identical to WithPolicyID, but panics instead of returning an error.
+	MustWithPolicyID(policyID MigrationPolicyID) BuildableMigrateVMParameters
+}
+
+// MigrateVMParams creates a new set of parameters for MigrateVM.
+func MigrateVMParams() BuildableMigrateVMParameters {
+	return &migrateVMParams{}
+}
+
+type migrateVMParams struct {
+	targetHostID   *HostID
+	force          bool
+	maxDowntime    *uint
+	autoConverge   *bool
+	compressed     *bool
+	bandwidthLimit *uint
+	policyID       *MigrationPolicyID
+}
+
+func (m *migrateVMParams) TargetHostID() *HostID {
+	return m.targetHostID
+}
+
+func (m *migrateVMParams) WithTargetHostID(hostID HostID) (BuildableMigrateVMParameters, error) {
+	if hostID == "" {
+		return m, newError(EBadArgument, "the target host ID of a migration cannot be empty")
+	}
+	m.targetHostID = &hostID
+	return m, nil
+}
+
+func (m *migrateVMParams) MustWithTargetHostID(hostID HostID) BuildableMigrateVMParameters {
+	builder, err := m.WithTargetHostID(hostID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *migrateVMParams) Force() bool {
+	return m.force
+}
+
+func (m *migrateVMParams) WithForce(force bool) (BuildableMigrateVMParameters, error) {
+	m.force = force
+	return m, nil
+}
+
+func (m *migrateVMParams) MustWithForce(force bool) BuildableMigrateVMParameters {
+	builder, err := m.WithForce(force)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *migrateVMParams) MaxDowntime() *uint {
+	return m.maxDowntime
+}
+
+func (m *migrateVMParams) WithMaxDowntime(milliseconds uint) (BuildableMigrateVMParameters, error) {
+	if milliseconds < MinMigrationDowntime || milliseconds > MaxMigrationDowntime {
+		return m, newError(
+			EBadArgument,
+			"the maximum migration downtime must be between %d and %d ms (%d given)",
+			MinMigrationDowntime,
+			MaxMigrationDowntime,
+			milliseconds,
+		)
+	}
+	m.maxDowntime = &milliseconds
+	return m, nil
+}
+
+func (m *migrateVMParams) MustWithMaxDowntime(milliseconds uint) BuildableMigrateVMParameters {
+	builder, err := m.WithMaxDowntime(milliseconds)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *migrateVMParams) AutoConverge() *bool {
+	return m.autoConverge
+}
+
+func (m *migrateVMParams) WithAutoConverge(autoConverge bool) (BuildableMigrateVMParameters, error) {
+	m.autoConverge = &autoConverge
+	return m, nil
+}
+
+func (m *migrateVMParams) MustWithAutoConverge(autoConverge bool) BuildableMigrateVMParameters {
+	builder, err := m.WithAutoConverge(autoConverge)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *migrateVMParams) Compressed() *bool {
+	return m.compressed
+}
+
+func (m *migrateVMParams) WithCompressed(compressed bool) (BuildableMigrateVMParameters, error) {
+	m.compressed = &compressed
+	return m, nil
+}
+
+func (m *migrateVMParams) MustWithCompressed(compressed bool) BuildableMigrateVMParameters {
+	builder, err := m.WithCompressed(compressed)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *migrateVMParams) BandwidthLimit() *uint {
+	return m.bandwidthLimit
+}
+
+func (m *migrateVMParams) WithBandwidthLimit(mbps uint) (BuildableMigrateVMParameters, error) {
+	if mbps == 0 || mbps > MaxMigrationBandwidth {
+		return m, newError(
+			EBadArgument,
+			"the migration bandwidth limit must be between 1 and %d Mbps (%d given)",
+			MaxMigrationBandwidth,
+			mbps,
+		)
+	}
+	m.bandwidthLimit = &mbps
+	return m, nil
+}
+
+func (m *migrateVMParams) MustWithBandwidthLimit(mbps uint) BuildableMigrateVMParameters {
+	builder, err := m.WithBandwidthLimit(mbps)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *migrateVMParams) PolicyID() *MigrationPolicyID {
+	return m.policyID
+}
+
+func (m *migrateVMParams) WithPolicyID(policyID MigrationPolicyID) (BuildableMigrateVMParameters, error) {
+	if policyID == "" {
+		return m, newError(EBadArgument, "the migration policy ID cannot be empty")
+	}
+	m.policyID = &policyID
+	return m, nil
+}
+
+func (m *migrateVMParams) MustWithPolicyID(policyID MigrationPolicyID) BuildableMigrateVMParameters {
+	builder, err := m.WithPolicyID(policyID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// hasMigrationTuning returns true if any of the options stored in the VM configuration are set.
+func hasMigrationTuning(params MigrateVMParameters) bool {
+	return params.MaxDowntime() != nil ||
+		params.AutoConverge() != nil ||
+		params.Compressed() != nil ||
+		params.BandwidthLimit() != nil ||
+		params.PolicyID() != nil
+}
+
+func toInheritableBoolean(value bool) ovirtsdk.InheritableBoolean {
+	if value {
+		return ovirtsdk.INHERITABLEBOOLEAN_TRUE
+	}
+	return ovirtsdk.INHERITABLEBOOLEAN_FALSE
+}
+
+func buildSDKMigrationTuning(id VMID, params MigrateVMParameters) *ovirtsdk.Vm {
+	vmBuilder := ovirtsdk.NewVmBuilder().Id(string(id))
+	if maxDowntime := params.MaxDowntime(); maxDowntime != nil {
+		vmBuilder.MigrationDowntime(int64(*maxDowntime))
+	}
+	options := ovirtsdk.NewMigrationOptionsBuilder()
+	if autoConverge := params.AutoConverge(); autoConverge != nil {
+		options.AutoConverge(toInheritableBoolean(*autoConverge))
+	}
+	if compressed := params.Compressed(); compressed != nil {
+		options.Compressed(toInheritableBoolean(*compressed))
+	}
+	if bandwidthLimit := params.BandwidthLimit(); bandwidthLimit != nil {
+		options.Bandwidth(
+			ovirtsdk.NewMigrationBandwidthBuilder().
+				AssignmentMethod(ovirtsdk.MIGRATIONBANDWIDTHASSIGNMENTMETHOD_CUSTOM).
+				CustomValue(int64(*bandwidthLimit)).
+				MustBuild(),
+		)
+	}
+	if policyID := params.PolicyID(); policyID != nil {
+		options.Policy(ovirtsdk.NewMigrationPolicyBuilder().Id(string(*policyID)).MustBuild())
+	}
+	vmBuilder.Migration(options.MustBuild())
+	return vmBuilder.MustBuild()
+}
+
+// checkMigrationPolicyExists returns an ENotFound error if none of the values of the MigrationPolicies engine option
+// lists the policy. The values are JSON lists of the policies for a compatibility version.
+func checkMigrationPolicyExists(option EngineOption, policyID MigrationPolicyID) error {
+	for _, value := range option.Values() {
+		var policies []struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal([]byte(value.Value()), &policies); err != nil {
+			return wrap(
+				err,
+				EUnsupported,
+				"failed to parse the migration policies for version %s",
+				value.Version(),
+			)
+		}
+		for _, policy := range policies {
+			if MigrationPolicyID(policy.ID) == policyID {
+				return nil
+			}
+		}
+	}
+	return newError(ENotFound, "migration policy with ID %s not found", policyID)
+}
+
+// checkVMMigratable returns an EConflict error if the VM is not running or is pinned to its host and the migration is
+// not forced.
+func checkVMMigratable(vm VM, params MigrateVMParameters) error {
+	if vm.Status() != VMStatusUp {
+		return newError(
+			EConflict,
+			"VM %s must be in status %s to be migrated (status: %s)",
+			vm.ID(),
+			VMStatusUp,
+			vm.Status(),
+		)
+	}
+	if params.Force() {
+		return nil
+	}
+	if placementPolicy, ok := vm.PlacementPolicy(); ok {
+		if affinity := placementPolicy.Affinity(); affinity != nil && *affinity == VMAffinityPinned {
+			return newError(EConflict, "VM %s is pinned to its host, force the migration to move it", vm.ID())
+		}
+	}
+	return nil
+}
+
+// checkVMMigrated returns nil once the VM runs on a host other than the source host, and on the target host if one
+// was requested.
+func checkVMMigrated(vm VM, sourceHostID HostID, params MigrateVMParameters) error {
+	hostID := vm.HostID()
+	if vm.Status() != VMStatusUp || hostID == nil || *hostID == sourceHostID {
+		return newError(EPending, "VM %s is still being migrated (status: %s)", vm.ID(), vm.Status())
+	}
+	if targetHostID := params.TargetHostID(); targetHostID != nil && *hostID != *targetHostID {
+		return newError(
+			EUnidentified,
+			"VM %s was migrated to host %s instead of host %s",
+			vm.ID(),
+			*hostID,
+			*targetHostID,
+		)
+	}
+	return nil
+}
+
+func (o *oVirtClient) MigrateVM(id VMID, params MigrateVMParameters, retries ...RetryStrategy) (result VM, err error) {
+	if params == nil {
+		params = MigrateVMParams()
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	currentVM, err := o.GetVM(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVMMigratable(currentVM, params); err != nil {
+		return nil, err
+	}
+	sourceHostID := currentVM.HostID()
+	if sourceHostID == nil {
+		return nil, newError(EFieldMissing, "running VM %s has no host", id)
+	}
+	if policyID := params.PolicyID(); policyID != nil {
+		option, err := o.GetEngineOption(migrationPoliciesEngineOption, retries...)
+		switch {
+		case HasErrorCode(err, ENotFound):
+			// Engines older than 4.3 don't expose options, the engine rejects an unknown policy on update instead.
+			o.logger.Debugf("Cannot read the migration policies of the engine, not validating policy %s.", *policyID)
+		case err != nil:
+			return nil, err
+		default:
+			if err := checkMigrationPolicyExists(option, *policyID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if hasMigrationTuning(params) {
+		action := fmt.Sprintf("updating migration options of VM %s", id)
+		sdkVM := buildSDKMigrationTuning(id, params)
+		err = retry(
+			action,
+			o.logger,
+			retries,
+			func() error {
+				_, err := o.conn.SystemService().VmsService().VmService(string(id)).Update().Vm(sdkVM).Send()
+				return wrapSDKError(action, err)
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	correlationID := fmt.Sprintf("vm_migrate_%s", generateRandomID(5, o.nonSecureRandom))
+	action := fmt.Sprintf("migrating VM %s", id)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			request := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(id)).
+				Migrate().
+				Force(params.Force()).
+				Query("correlation_id", correlationID)
+			if targetHostID := params.TargetHostID(); targetHostID != nil {
+				request.Host(ovirtsdk.NewHostBuilder().Id(string(*targetHostID)).MustBuild())
+			}
+			_, err := request.Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return nil, err
+	}
+	// A failed migration leaves the VM running on the source host, so the job status is the only sign of it.
+	if err := o.waitForJobSucceeded(correlationID, retries); err != nil {
+		return nil, wrap(err, EUnidentified, "migration of VM %s failed", id)
+	}
+	err = retry(
+		fmt.Sprintf("waiting for VM %s to be migrated", id),
+		o.logger,
+		retries,
+		func() error {
+			result, err = o.GetVM(id, retries...)
+			if err != nil {
+				return err
+			}
+			return checkVMMigrated(result, *sourceHostID, params)
+		})
+	return result, err
+}
+
+func (m *mockClient) MigrateVM(id VMID, params MigrateVMParameters, _ ...RetryStrategy) (VM, error) {
+	if params == nil {
+		params = MigrateVMParams()
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	item, ok := m.vms[id]
+	if !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", id)
+	}
+	if err := checkVMMigratable(item, params); err != nil {
+		return nil, err
+	}
+	if policyID := params.PolicyID(); policyID != nil {
+		if err := checkMigrationPolicyExists(mockEngineOptions[migrationPoliciesEngineOption], *policyID); err != nil {
+			return nil, err
+		}
+	}
+	sourceHost := m.hosts[*item.hostID]
+	var targetHost *host
+	if targetHostID := params.TargetHostID(); targetHostID != nil {
+		targetHost, ok = m.hosts[*targetHostID]
+		if !ok {
+			return nil, newError(ENotFound, "host with ID %s not found", *targetHostID)
+		}
+		if targetHost.id == sourceHost.id {
+			return nil, newError(EConflict, "VM %s is already running on host %s", id, targetHost.id)
+		}
+		if targetHost.status != HostStatusUp || targetHost.clusterID != sourceHost.clusterID {
+			return nil, newError(
+				EConflict,
+				"host %s is not an active host in cluster %s",
+				targetHost.id,
+				sourceHost.clusterID,
+			)
+		}
+	} else {
+		for _, candidate := range m.hosts {
+			if candidate.id != sourceHost.id &&
+				candidate.clusterID == sourceHost.clusterID &&
+				candidate.status == HostStatusUp {
+				targetHost = candidate
+				break
+			}
+		}
+		if targetHost == nil {
+			return nil, newError(
+				EConflict,
+				"cannot migrate VM %s, no other active host in cluster %s",
+				id,
+				sourceHost.clusterID,
+			)
+		}
+	}
+	targetHostID := targetHost.id
+	item.hostID = &targetHostID
+	return item.snapshot(), nil
+}
@@ -0,0 +1,83 @@
+package ovirtclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+)
+
+// migrationTestVM is a running VM as returned by the engine, with the fields convertSDKVM requires.
+const migrationTestVM = `<vm id="00000000-0000-0000-0000-000000000010">
+	<name>test</name>
+	<comment></comment>
+	<description></description>
+	<status>up</status>
+	<memory>1073741824</memory>
+	<memory_policy><ballooning>true</ballooning></memory_policy>
+	<cpu><topology><cores>1</cores><sockets>1</sockets><threads>1</threads></topology></cpu>
+	<os><type>other</type></os>
+	<type>server</type>
+	<console><enabled>false</enabled></console>
+	<soundcard_enabled>false</soundcard_enabled>
+	<cluster id="00000000-0000-0000-0000-000000000020"/>
+	<template id="00000000-0000-0000-0000-000000000000"/>
+	<host id="00000000-0000-0000-0000-000000000030"/>
+</vm>`
+
+func TestMigrateVMFailedJob(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch {
+		case request.URL.Path == "/ovirt-engine/sso/oauth/token":
+			writer.Header().Set("Content-Type", "application/json")
+			_, _ = writer.Write([]byte(`{"access_token":"test"}`))
+		case request.URL.Path == "/ovirt-engine/api/vms/00000000-0000-0000-0000-000000000010":
+			writer.Header().Set("Content-Type", "application/xml")
+			_, _ = writer.Write([]byte(migrationTestVM))
+		case strings.HasSuffix(request.URL.Path, "/migrate"):
+			writer.Header().Set("Content-Type", "application/xml")
+			_, _ = writer.Write([]byte(`<action><status>complete</status></action>`))
+		case request.URL.Path == "/ovirt-engine/api/jobs":
+			// The engine failed the migration, the VM stays on the source host.
+			writer.Header().Set("Content-Type", "application/xml")
+			_, _ = writer.Write([]byte(
+				`<jobs><job id="1"><description>Migrating VM test</description><status>failed</status></job></jobs>`,
+			))
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewWithVerify(
+		srv.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"invalid-password-for-testing-purposes",
+		TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+		func(connection Client) error {
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("failed to set up connection (%v)", err)
+	}
+
+	_, err = client.MigrateVM(
+		"00000000-0000-0000-0000-000000000010",
+		nil,
+		MaxTries(5),
+		FixedWait(time.Millisecond),
+	)
+	if err == nil {
+		t.Fatalf("A failed migration job did not result in an error.")
+	}
+	if HasErrorCode(err, EPending) || !strings.Contains(err.Error(), "Migrating VM test") {
+		t.Fatalf("A failed migration job did not result in the job failure (%v)", err)
+	}
+}
@@ -0,0 +1,161 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+// vmMigrationProgressStatistic is the VM statistic the engine reports the progress of an ongoing migration in.
+const vmMigrationProgressStatistic = "migration.progress"
+
+// VMMigrationPhase describes if a VM is being migrated.
+type VMMigrationPhase string
+
+const (
+	// VMMigrationPhaseNone means the VM is not being migrated.
+	VMMigrationPhaseNone VMMigrationPhase = "none"
+	// VMMigrationPhaseInProgress means the VM is being migrated to another host.
+	VMMigrationPhaseInProgress VMMigrationPhase = "in_progress"
+)
+
+// VMMigrationPhaseList is a list of VMMigrationPhase values.
+type VMMigrationPhaseList []VMMigrationPhase
+
+// VMMigrationPhaseValues returns all possible VMMigrationPhase values.
+func VMMigrationPhaseValues() VMMigrationPhaseList {
+	return []VMMigrationPhase{
+		VMMigrationPhaseNone,
+		VMMigrationPhaseInProgress,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l VMMigrationPhaseList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// VMMigrationStatus is a snapshot of the migration state of a VM, as returned by GetVMMigrationStatus.
+type VMMigrationStatus interface {
+	// VMID returns the ID of the VM the status belongs to.
+	VMID() VMID
+	// Phase returns VMMigrationPhaseInProgress while the VM is being migrated, VMMigrationPhaseNone otherwise.
+	Phase() VMMigrationPhase
+	// Progress returns the completion of the migration in percent. It is 0 if the VM is not being migrated or the
+	// engine hasn't reported any progress yet.
+	Progress() uint
+	// SourceHostID returns the host the VM is migrated away from. It is nil if the VM is not being migrated.
+	SourceHostID() *HostID
+}
+
+type vmMigrationStatus struct {
+	vmID         VMID
+	phase        VMMigrationPhase
+	progress     uint
+	sourceHostID *HostID
+}
+
+func (v vmMigrationStatus) VMID() VMID {
+	return v.vmID
+}
+
+func (v vmMigrationStatus) Phase() VMMigrationPhase {
+	return v.phase
+}
+
+func (v vmMigrationStatus) Progress() uint {
+	return v.progress
+}
+
+func (v vmMigrationStatus) SourceHostID() *HostID {
+	return v.sourceHostID
+}
+
+// newVMMigrationStatus creates the migration status of a VM from its current state. The progress must be filled in
+// by the caller.
+func newVMMigrationStatus(vm VM) vmMigrationStatus {
+	if vm.Status() != VMStatusMigrating {
+		return vmMigrationStatus{vmID: vm.ID(), phase: VMMigrationPhaseNone}
+	}
+	return vmMigrationStatus{
+		vmID:         vm.ID(),
+		phase:        VMMigrationPhaseInProgress,
+		sourceHostID: vm.HostID(),
+	}
+}
+
+func (o *oVirtClient) GetVMMigrationStatus(id VMID, retries ...RetryStrategy) (VMMigrationStatus, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	vm, err := o.GetVM(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	result := newVMMigrationStatus(vm)
+	if result.phase == VMMigrationPhaseNone {
+		return result, nil
+	}
+	result.progress, err = o.getVMMigrationProgress(id, retries)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// getVMMigrationProgress reads the migration progress statistic of the VM. The engine only reports the statistic
+// once the migration has started on the host, until then the progress is 0.
+func (o *oVirtClient) getVMMigrationProgress(id VMID, retries []RetryStrategy) (result uint, err error) {
+	action := fmt.Sprintf("fetching migration progress of VM %s", id)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(id)).
+				StatisticsService().
+				List().
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			result = 0
+			statistics, ok := response.Statistics()
+			if !ok {
+				return nil
+			}
+			for _, statistic := range statistics.Slice() {
+				if name, _ := statistic.Name(); name != vmMigrationProgressStatistic {
+					continue
+				}
+				values, ok := statistic.Values()
+				if !ok || len(values.Slice()) == 0 {
+					return nil
+				}
+				datum, _ := values.Slice()[0].Datum()
+				switch {
+				case datum > 100:
+					result = 100
+				case datum > 0:
+					result = uint(datum)
+				}
+				return nil
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetVMMigrationStatus(id VMID, _ ...RetryStrategy) (VMMigrationStatus, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", id)
+	}
+	// The mock migrates VMs instantly, so a VM put into the migrating state by a test has made no progress.
+	return newVMMigrationStatus(item), nil
+}
@@ -0,0 +1,54 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func vmNextRunConverter(object *ovirtsdk.Vm, v *vm) error {
+	v.nextRunConfigurationExists, _ = object.NextRunConfigurationExists()
+	return nil
+}
+
+func (o *oVirtClient) GetVMNextRunConfig(id VMID, retries ...RetryStrategy) (result VM, pending bool, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("getting next run configuration of vm %s", id)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			request := o.conn.SystemService().VmsService().VmService(string(id)).Get().NextRun(true)
+			if allContentRequested(o.ctx) {
+				request.Header(allContentHeader, "true")
+			}
+			response, err := request.Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkObject, ok := response.Vm()
+			if !ok {
+				return newError(ENotFound, "no vm returned when getting next run configuration of vm ID %s", id)
+			}
+			nextRunVM, err := convertSDKVM(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert vm %s", id)
+			}
+			result = nextRunVM
+			pending = nextRunVM.NextRunConfigurationExists()
+			return nil
+		})
+	return result, pending, err
+}
+
+func (m *mockClient) GetVMNextRunConfig(id VMID, _ ...RetryStrategy) (VM, bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return nil, false, newError(ENotFound, "vm with ID %s not found", id)
+	}
+	// The mock applies all changes immediately, so there is never a staged configuration.
+	return item.snapshot(), item.nextRunConfigurationExists, nil
+}
@@ -0,0 +1,254 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// VMConfigField is a VM setting compared by DiffVMNextRun.
+type VMConfigField string
+
+const (
+	// VMConfigFieldName is the name of the VM.
+	VMConfigFieldName VMConfigField = "name"
+	// VMConfigFieldDescription is the description of the VM.
+	VMConfigFieldDescription VMConfigField = "description"
+	// VMConfigFieldComment is the comment of the VM.
+	VMConfigFieldComment VMConfigField = "comment"
+	// VMConfigFieldMemory is the memory size of the VM.
+	VMConfigFieldMemory VMConfigField = "memory"
+	// VMConfigFieldMemoryGuaranteed is the guaranteed memory from the memory policy of the VM.
+	VMConfigFieldMemoryGuaranteed VMConfigField = "memory_guaranteed"
+	// VMConfigFieldMemoryMax is the maximum memory from the memory policy of the VM.
+	VMConfigFieldMemoryMax VMConfigField = "memory_max"
+	// VMConfigFieldMemoryBallooning is the ballooning setting from the memory policy of the VM.
+	VMConfigFieldMemoryBallooning VMConfigField = "memory_ballooning"
+	// VMConfigFieldCPUSockets is the number of CPU sockets of the VM.
+	VMConfigFieldCPUSockets VMConfigField = "cpu_sockets"
+	// VMConfigFieldCPUCores is the number of cores per CPU socket of the VM.
+	VMConfigFieldCPUCores VMConfigField = "cpu_cores"
+	// VMConfigFieldCPUThreads is the number of threads per CPU core of the VM.
+	VMConfigFieldCPUThreads VMConfigField = "cpu_threads"
+	// VMConfigFieldCPUMode is the CPU mode of the VM.
+	VMConfigFieldCPUMode VMConfigField = "cpu_mode"
+	// VMConfigFieldCPUCustomModel is the custom CPU model of the VM.
+	VMConfigFieldCPUCustomModel VMConfigField = "cpu_custom_model"
+	// VMConfigFieldOSType is the operating system type of the VM.
+	VMConfigFieldOSType VMConfigField = "os_type"
+	// VMConfigFieldBIOSType is the chipset and firmware of the VM.
+	VMConfigFieldBIOSType VMConfigField = "bios_type"
+	// VMConfigFieldTimeZone is the time zone of the hardware clock of the VM.
+	VMConfigFieldTimeZone VMConfigField = "time_zone"
+	// VMConfigFieldCustomCompatibilityVersion is the compatibility version pinned for the VM.
+	VMConfigFieldCustomCompatibilityVersion VMConfigField = "custom_compatibility_version"
+	// VMConfigFieldStateless is the stateless flag of the VM.
+	VMConfigFieldStateless VMConfigField = "stateless"
+	// VMConfigFieldDeleteProtected is the removal protection of the VM.
+	VMConfigFieldDeleteProtected VMConfigField = "delete_protected"
+)
+
+// VMConfigFieldList is a list of VMConfigField values.
+type VMConfigFieldList []VMConfigField
+
+// VMConfigFieldValues returns all possible VMConfigField values in the order DiffVMNextRun reports them.
+func VMConfigFieldValues() VMConfigFieldList {
+	return []VMConfigField{
+		VMConfigFieldName,
+		VMConfigFieldDescription,
+		VMConfigFieldComment,
+		VMConfigFieldMemory,
+		VMConfigFieldMemoryGuaranteed,
+		VMConfigFieldMemoryMax,
+		VMConfigFieldMemoryBallooning,
+		VMConfigFieldCPUSockets,
+		VMConfigFieldCPUCores,
+		VMConfigFieldCPUThreads,
+		VMConfigFieldCPUMode,
+		VMConfigFieldCPUCustomModel,
+		VMConfigFieldOSType,
+		VMConfigFieldBIOSType,
+		VMConfigFieldTimeZone,
+		VMConfigFieldCustomCompatibilityVersion,
+		VMConfigFieldStateless,
+		VMConfigFieldDeleteProtected,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l VMConfigFieldList) Strings() []string {
+	result := make([]string, len(l))
+	for i, field := range l {
+		result[i] = string(field)
+	}
+	return result
+}
+
+// VMConfigChange is a single setting that differs between the current and the next run configuration of a VM.
+type VMConfigChange interface {
+	// Field returns the setting that changed.
+	Field() VMConfigField
+	// Current returns the value the running VM uses, formatted for display. Memory sizes are shown in binary units,
+	// for example "4 GiB".
+	Current() string
+	// NextRun returns the value the VM will use after its next restart, formatted like Current.
+	NextRun() string
+	// String returns the change in the "memory: 4 GiB -> 8 GiB" form.
+	String() string
+}
+
+// VMConfigDiff is the list of settings that only take effect after the next restart of a VM.
+type VMConfigDiff interface {
+	// VMID returns the ID of the VM the diff belongs to.
+	VMID() VMID
+	// Changes returns the changed settings in the order of VMConfigFieldValues.
+	Changes() []VMConfigChange
+	// Empty returns true if no change is pending.
+	Empty() bool
+	// String returns the changes separated by commas, or an empty string if no change is pending.
+	String() string
+}
+
+type vmConfigChange struct {
+	field   VMConfigField
+	current string
+	nextRun string
+}
+
+func (v vmConfigChange) Field() VMConfigField {
+	return v.field
+}
+
+func (v vmConfigChange) Current() string {
+	return v.current
+}
+
+func (v vmConfigChange) NextRun() string {
+	return v.nextRun
+}
+
+func (v vmConfigChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", v.field, v.current, v.nextRun)
+}
+
+type vmConfigDiff struct {
+	vmID    VMID
+	changes []VMConfigChange
+}
+
+func (v vmConfigDiff) VMID() VMID {
+	return v.vmID
+}
+
+func (v vmConfigDiff) Changes() []VMConfigChange {
+	return v.changes
+}
+
+func (v vmConfigDiff) Empty() bool {
+	return len(v.changes) == 0
+}
+
+func (v vmConfigDiff) String() string {
+	parts := make([]string, len(v.changes))
+	for i, change := range v.changes {
+		parts[i] = change.String()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatMemorySize formats a number of bytes in the largest binary unit that represents it without a fraction.
+func formatMemorySize(bytes int64) string {
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB"}
+	unit := 0
+	for unit < len(units)-1 && bytes != 0 && bytes%1024 == 0 {
+		bytes /= 1024
+		unit++
+	}
+	return fmt.Sprintf("%d %s", bytes, units[unit])
+}
+
+func formatOptionalMemorySize(bytes *int64) string {
+	if bytes == nil {
+		return ""
+	}
+	return formatMemorySize(*bytes)
+}
+
+// vmConfigValues returns the formatted value of each VMConfigField of the VM.
+func vmConfigValues(v VM) map[VMConfigField]string {
+	topo := v.CPU().Topo()
+	cpuMode := ""
+	if mode := v.CPU().Mode(); mode != nil {
+		cpuMode = string(*mode)
+	}
+	compatibilityVersion := ""
+	if version := v.CustomCompatibilityVersion(); version != nil {
+		compatibilityVersion = version.String()
+	}
+	return map[VMConfigField]string{
+		VMConfigFieldName:                       v.Name(),
+		VMConfigFieldDescription:                v.Description(),
+		VMConfigFieldComment:                    v.Comment(),
+		VMConfigFieldMemory:                     formatMemorySize(v.Memory()),
+		VMConfigFieldMemoryGuaranteed:           formatOptionalMemorySize(v.MemoryPolicy().Guaranteed()),
+		VMConfigFieldMemoryMax:                  formatOptionalMemorySize(v.MemoryPolicy().Max()),
+		VMConfigFieldMemoryBallooning:           strconv.FormatBool(v.MemoryPolicy().Ballooning()),
+		VMConfigFieldCPUSockets:                 strconv.FormatUint(uint64(topo.Sockets()), 10),
+		VMConfigFieldCPUCores:                   strconv.FormatUint(uint64(topo.Cores()), 10),
+		VMConfigFieldCPUThreads:                 strconv.FormatUint(uint64(topo.Threads()), 10),
+		VMConfigFieldCPUMode:                    cpuMode,
+		VMConfigFieldCPUCustomModel:             v.CPU().CustomModel(),
+		VMConfigFieldOSType:                     v.OS().Type(),
+		VMConfigFieldBIOSType:                   string(v.BIOSType()),
+		VMConfigFieldTimeZone:                   v.TimeZone(),
+		VMConfigFieldCustomCompatibilityVersion: compatibilityVersion,
+		VMConfigFieldStateless:                  strconv.FormatBool(v.Stateless()),
+		VMConfigFieldDeleteProtected:            strconv.FormatBool(v.DeleteProtected()),
+	}
+}
+
+// diffVMConfigs returns the settings that differ between the current and the next run configuration.
+func diffVMConfigs(current VM, nextRun VM) VMConfigDiff {
+	currentValues := vmConfigValues(current)
+	nextRunValues := vmConfigValues(nextRun)
+	result := vmConfigDiff{
+		vmID:    current.ID(),
+		changes: []VMConfigChange{},
+	}
+	for _, field := range VMConfigFieldValues() {
+		if currentValues[field] != nextRunValues[field] {
+			result.changes = append(result.changes, vmConfigChange{
+				field:   field,
+				current: currentValues[field],
+				nextRun: nextRunValues[field],
+			})
+		}
+	}
+	return result
+}
+
+// diffVMNextRun fetches both configurations of the VM. The current configuration is only compared if the engine
+// reports pending changes, since the next run configuration is identical to it otherwise.
+func diffVMNextRun(client Client, vmID VMID, retries []RetryStrategy) (VMConfigDiff, error) {
+	nextRun, pending, err := client.GetVMNextRunConfig(vmID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	if !pending {
+		return vmConfigDiff{vmID: vmID, changes: []VMConfigChange{}}, nil
+	}
+	current, err := client.GetVM(vmID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return diffVMConfigs(current, nextRun), nil
+}
+
+func (o *oVirtClient) DiffVMNextRun(vmID VMID, retries ...RetryStrategy) (VMConfigDiff, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	return diffVMNextRun(o, vmID, retries)
+}
+
+func (m *mockClient) DiffVMNextRun(vmID VMID, retries ...RetryStrategy) (VMConfigDiff, error) {
+	return diffVMNextRun(m, vmID, retries)
+}
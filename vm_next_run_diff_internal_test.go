@@ -0,0 +1,61 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestDiffVMConfigs(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	var clusterID ClusterID
+	for id := range m.clusters {
+		clusterID = id
+		break
+	}
+	createdVM, err := m.CreateVM(
+		clusterID,
+		DefaultBlankTemplateID,
+		"test",
+		CreateVMParams().MustWithMemory(4*1024*1024*1024),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create VM (%v)", err)
+	}
+	m.lock.Lock()
+	current := m.vms[createdVM.ID()].snapshot()
+	nextRun := m.vms[createdVM.ID()].snapshot()
+	m.lock.Unlock()
+
+	if diff := diffVMConfigs(current, nextRun); !diff.Empty() {
+		t.Fatalf("Identical configurations reported changes: %s", diff)
+	}
+
+	nextRun.memory = 8 * 1024 * 1024 * 1024
+	nextRun.deleteProtected = true
+	diff := diffVMConfigs(current, nextRun)
+	changes := diff.Changes()
+	if len(changes) != 2 {
+		t.Fatalf("Incorrect number of changes (expected: %d, got: %d): %s", 2, len(changes), diff)
+	}
+	if changes[0].Field() != VMConfigFieldMemory || changes[1].Field() != VMConfigFieldDeleteProtected {
+		t.Fatalf("The changes were not reported in field order: %s", diff)
+	}
+	if expected := "memory: 4 GiB -> 8 GiB, delete_protected: false -> true"; diff.String() != expected {
+		t.Fatalf("Incorrect diff (expected: %s, got: %s)", expected, diff)
+	}
+}
+
+func TestFormatMemorySize(t *testing.T) {
+	t.Parallel()
+	for bytes, expected := range map[int64]string{
+		0:                             "0 B",
+		1000:                          "1000 B",
+		512 * 1024 * 1024:             "512 MiB",
+		1536 * 1024 * 1024:            "1536 MiB",
+		2 * 1024 * 1024 * 1024 * 1024: "2 TiB",
+	} {
+		if result := formatMemorySize(bytes); result != expected {
+			t.Fatalf("Incorrect formatting of %d bytes (expected: %s, got: %s)", bytes, expected, result)
+		}
+	}
+}
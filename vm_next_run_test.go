@@ -0,0 +1,38 @@
+package ovirtclient_test
+
+import (
+	"testing"
+)
+
+func TestGetVMNextRunConfigOnStoppedVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	nextRunVM, pending, err := client.GetVMNextRunConfig(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to get next run configuration of VM %s (%v)", vm.ID(), err)
+	}
+	if nextRunVM.ID() != vm.ID() {
+		t.Fatalf("Incorrect VM returned (expected: %s, got: %s)", vm.ID(), nextRunVM.ID())
+	}
+	if pending || nextRunVM.NextRunConfigurationExists() {
+		t.Fatalf("A stopped VM reported staged next run changes.")
+	}
+}
+
+func TestDiffVMNextRunWithoutPendingChanges(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	diff, err := client.DiffVMNextRun(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to diff next run configuration of VM %s (%v)", vm.ID(), err)
+	}
+	if !diff.Empty() || len(diff.Changes()) != 0 || diff.String() != "" {
+		t.Fatalf("A stopped VM reported pending changes: %s", diff)
+	}
+}
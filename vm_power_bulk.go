@@ -0,0 +1,53 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) StartVMs(vmIDs []VMID, retries ...RetryStrategy) error {
+	return startVMs(o, batchProgressFromContext(o.ctx), vmIDs, retries)
+}
+
+func (m *mockClient) StartVMs(vmIDs []VMID, retries ...RetryStrategy) error {
+	return startVMs(m, batchProgressFromContext(m.ctx), vmIDs, retries)
+}
+
+func (o *oVirtClient) StopVMs(vmIDs []VMID, force bool, retries ...RetryStrategy) error {
+	return stopVMs(o, batchProgressFromContext(o.ctx), vmIDs, force, retries)
+}
+
+func (m *mockClient) StopVMs(vmIDs []VMID, force bool, retries ...RetryStrategy) error {
+	return stopVMs(m, batchProgressFromContext(m.ctx), vmIDs, force, retries)
+}
+
+func startVMs(client Client, progress BatchProgress, vmIDs []VMID, retries []RetryStrategy) error {
+	return changeVMsPower(client, progress, "starting VMs", vmIDs, VMStatusUp, retries, func(id VMID) error {
+		return client.StartVM(id, retries...)
+	})
+}
+
+func stopVMs(client Client, progress BatchProgress, vmIDs []VMID, force bool, retries []RetryStrategy) error {
+	return changeVMsPower(client, progress, "stopping VMs", vmIDs, VMStatusDown, retries, func(id VMID) error {
+		return client.StopVM(id, force, retries...)
+	})
+}
+
+// changeVMsPower runs the power operation on each VM in parallel and waits for the VM to reach the target status.
+func changeVMsPower(
+	client Client,
+	progress BatchProgress,
+	action string,
+	vmIDs []VMID,
+	status VMStatus,
+	retries []RetryStrategy,
+	operation func(id VMID) error,
+) error {
+	vmErrors := runTrackedBulkOperation(progress, vmIDStrings(vmIDs), func(i int) error {
+		if err := operation(vmIDs[i]); err != nil {
+			return err
+		}
+		_, err := client.WaitForVMStatus(vmIDs[i], status, retries...)
+		return err
+	})
+	return newMultiError(fmt.Sprintf("%s to status %s", action, status), len(vmIDs), vmErrors)
+}
@@ -0,0 +1,65 @@
+package ovirtclient_test
+
+import (
+	"errors"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestStartStopVMs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	var vmIDs []ovirtclient.VMID
+	for i := 0; i < 2; i++ {
+		vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+		disk := assertCanCreateDisk(t, helper)
+		assertCanUploadDiskImage(t, helper, disk)
+		assertCanAttachDiskWithParams(
+			t,
+			vm,
+			disk,
+			ovirtclient.CreateDiskAttachmentParams().MustWithBootable(true).MustWithActive(true),
+		)
+		vmIDs = append(vmIDs, vm.ID())
+	}
+
+	if err := client.StartVMs(vmIDs); err != nil {
+		t.Fatalf("Failed to start VMs (%v)", err)
+	}
+	assertVMsHaveStatus(t, client, vmIDs, ovirtclient.VMStatusUp)
+	if err := client.StopVMs(vmIDs, true); err != nil {
+		t.Fatalf("Failed to stop VMs (%v)", err)
+	}
+	assertVMsHaveStatus(t, client, vmIDs, ovirtclient.VMStatusDown)
+
+	missingVMID := ovirtclient.VMID("00000000-0000-0000-0000-000000000001")
+	err := client.StopVMs([]ovirtclient.VMID{vmIDs[0], missingVMID}, true)
+	var multiErr ovirtclient.MultiError
+	if !errors.As(err, &multiErr) {
+		t.Fatalf("Stopping a nonexistent VM did not result in a MultiError (%v)", err)
+	}
+	vmErrors := multiErr.Errors()
+	if len(vmErrors) != 1 || vmErrors[string(missingVMID)] == nil {
+		t.Fatalf("Incorrect failures in MultiError (%v)", err)
+	}
+}
+
+func assertVMsHaveStatus(
+	t *testing.T,
+	client ovirtclient.Client,
+	vmIDs []ovirtclient.VMID,
+	status ovirtclient.VMStatus,
+) {
+	for _, vmID := range vmIDs {
+		vm, err := client.GetVM(vmID)
+		if err != nil {
+			t.Fatalf("Failed to fetch VM %s (%v)", vmID, err)
+		}
+		if vm.Status() != status {
+			t.Fatalf("Incorrect status on VM %s (expected: %s, got: %s)", vmID, status, vm.Status())
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+)
+
+func (o *oVirtClient) RebootVM(id VMID, retries ...RetryStrategy) error {
+	waitRetries := defaultRetries(retries, defaultLongTimeouts(o))
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	vm, err := o.GetVM(id, retries...)
+	if err != nil {
+		return err
+	}
+	if err := checkVMRebootable(vm); err != nil {
+		return err
+	}
+	err = retry(
+		fmt.Sprintf("rebooting VM %s", id),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().VmsService().VmService(string(id)).Reboot().Send()
+			return err
+		})
+	if err != nil {
+		return err
+	}
+	return waitForVMReboot(o, o.logger, id, waitRetries)
+}
+
+func checkVMRebootable(vm VM) error {
+	if vm.Status() != VMStatusUp {
+		return newError(EConflict, "VM %s cannot be rebooted in status %s, it must be %s", vm.ID(), vm.Status(), VMStatusUp)
+	}
+	return nil
+}
+
+// waitForVMReboot waits for a VM to come back up after a reboot. The VM passes through the reboot_in_progress and
+// the powering_up states while rebooting, any other state means the reboot has gone wrong. Right after the reboot
+// request the engine may still report the VM as up, so up only counts once one of the reboot states has been seen.
+func waitForVMReboot(client Client, logger Logger, id VMID, retries []RetryStrategy) error {
+	rebootSeen := false
+	return retry(
+		fmt.Sprintf("waiting for VM %s to reboot", id),
+		logger,
+		retries,
+		func() error {
+			vm, err := client.GetVM(id, retries...)
+			if err != nil {
+				return err
+			}
+			switch vm.Status() {
+			case VMStatusUp:
+				if !rebootSeen {
+					return newError(EPending, "VM %s has not started rebooting yet", id)
+				}
+				return nil
+			case VMStatusRebooting, VMStatusPoweringUp, VMStatusWaitForLaunch:
+				rebootSeen = true
+				return newError(EPending, "VM %s is still rebooting (status: %s)", id, vm.Status())
+			default:
+				return newError(EUnexpectedVMStatus, "VM %s entered status %s while rebooting", id, vm.Status())
+			}
+		})
+}
+
+func (m *mockClient) RebootVM(id VMID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	if err := m.startVMReboot(id); err != nil {
+		return err
+	}
+	return waitForVMReboot(m, m.logger, id, retries)
+}
+
+func (m *mockClient) startVMReboot(id VMID) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return newError(ENotFound, "vm with ID %s not found", id)
+	}
+	if err := checkVMRebootable(item); err != nil {
+		return err
+	}
+	item.status = VMStatusRebooting
+	go func() {
+		time.Sleep(2 * time.Second)
+		m.lock.Lock()
+		if item.status != VMStatusRebooting {
+			m.lock.Unlock()
+			return
+		}
+		item.status = VMStatusPoweringUp
+		m.lock.Unlock()
+		time.Sleep(2 * time.Second)
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		if item.status != VMStatusPoweringUp {
+			return
+		}
+		item.status = VMStatusUp
+	}()
+	return nil
+}
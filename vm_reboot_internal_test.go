@@ -0,0 +1,50 @@
+package ovirtclient
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWaitForVMRebootIgnoresUpBeforeReboot(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	var clusterID ClusterID
+	for id := range m.clusters {
+		clusterID = id
+		break
+	}
+	vm, err := m.CreateVM(clusterID, DefaultBlankTemplateID, "rebooting", nil)
+	if err != nil {
+		t.Fatalf("Failed to create VM (%v)", err)
+	}
+	setStatus := func(status VMStatus) {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		m.vms[vm.ID()].status = status
+	}
+	// The engine still reports the VM as up when the first poll happens.
+	setStatus(VMStatusUp)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- waitForVMReboot(m, m.logger, vm.ID(), defaultRetries(nil, defaultLongTimeouts(m)))
+	}()
+
+	select {
+	case err := <-done:
+		t.Fatalf("Waiting for the reboot returned before the VM started rebooting (%v)", err)
+	case <-time.After(2 * time.Second):
+	}
+	setStatus(VMStatusRebooting)
+	time.Sleep(3 * time.Second)
+	setStatus(VMStatusUp)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Waiting for the reboot failed (%v)", err)
+		}
+	case <-time.After(30 * time.Second):
+		t.Fatalf("Waiting for the reboot did not return after the VM came back up.")
+	}
+}
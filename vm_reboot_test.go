@@ -0,0 +1,69 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMRebootOnStoppedVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	err := vm.Reboot()
+	if err == nil {
+		t.Fatalf("Rebooting a stopped VM did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Rebooting a stopped VM did not result in an EConflict error (%v)", err)
+	}
+}
+
+// TestVMReboot runs against the mock only so the intermediate states can be observed reliably.
+func TestVMReboot(t *testing.T) {
+	t.Parallel()
+	helper, err := ovirtclient.NewMockTestHelper(ovirtclientlog.NewTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create mock test helper (%v)", err)
+	}
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	assertCanStartVM(t, helper, vm)
+	vm = assertVMWillStart(t, vm)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- vm.Reboot()
+	}()
+
+	seen := map[ovirtclient.VMStatus]bool{}
+	for {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("Failed to reboot VM (%v)", err)
+			}
+			if !seen[ovirtclient.VMStatusRebooting] || !seen[ovirtclient.VMStatusPoweringUp] {
+				t.Fatalf("The VM did not pass through the intermediate reboot states (seen: %v)", seen)
+			}
+			rebootedVM, err := client.GetVM(vm.ID())
+			if err != nil {
+				t.Fatalf("Failed to fetch VM after reboot (%v)", err)
+			}
+			if rebootedVM.Status() != ovirtclient.VMStatusUp {
+				t.Fatalf("Incorrect VM status after reboot (expected: %s, got: %s)", ovirtclient.VMStatusUp, rebootedVM.Status())
+			}
+			return
+		case <-time.After(100 * time.Millisecond):
+			currentVM, err := client.GetVM(vm.ID())
+			if err != nil {
+				t.Fatalf("Failed to fetch VM during reboot (%v)", err)
+			}
+			seen[currentVM.Status()] = true
+		}
+	}
+}
@@ -4,25 +4,200 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) RemoveVM(id VMID, retries ...RetryStrategy) (err error) {
+// RemoveVMParameters contains the optional parameters for removing a VM.
+type RemoveVMParameters interface {
+	// DetachDisks keeps the disks attached to the VM as floating disks instead of removing them together with the
+	// VM.
+	DetachDisks() bool
+	// Force stops the VM before removing it if it is not down. Without this flag removing a VM that is not down fails
+	// with an EConflict error. The flag is not passed on to the engine.
+	Force() bool
+	// AllowHostedEngine permits removing the hosted engine VM. Without this flag removing the hosted engine VM fails
+	// with an EConflict error.
+	AllowHostedEngine() bool
+	// AllowDeleteProtected permits removing a delete protected VM by removing the protection first. Without this flag
+	// removing a protected VM fails with an EConflict error.
+	AllowDeleteProtected() bool
+}
+
+// BuildableRemoveVMParameters is a buildable version of RemoveVMParameters.
+type BuildableRemoveVMParameters interface {
+	RemoveVMParameters
+
+	// WithDetachDisks sets the flag to keep the disks of the VM as floating disks.
+	WithDetachDisks(detachDisks bool) BuildableRemoveVMParameters
+	// WithForce sets the flag to stop a running VM before removing it.
+	WithForce(force bool) BuildableRemoveVMParameters
+	// WithAllowHostedEngine sets the flag that permits removing the hosted engine VM.
+	WithAllowHostedEngine(allowHostedEngine bool) BuildableRemoveVMParameters
+	// WithAllowDeleteProtected sets the flag that permits removing a delete protected VM.
+	WithAllowDeleteProtected(allowDeleteProtected bool) BuildableRemoveVMParameters
+}
+
+// RemoveVMParams creates a new set of parameters for RemoveVMWithParams.
+func RemoveVMParams() BuildableRemoveVMParameters {
+	return &removeVMParams{}
+}
+
+type removeVMParams struct {
+	detachDisks       bool
+	force             bool
+	allowHostedEngine bool
+
+	allowDeleteProtected bool
+}
+
+func (r *removeVMParams) DetachDisks() bool {
+	return r.detachDisks
+}
+
+func (r *removeVMParams) Force() bool {
+	return r.force
+}
+
+func (r *removeVMParams) AllowHostedEngine() bool {
+	return r.allowHostedEngine
+}
+
+func (r *removeVMParams) AllowDeleteProtected() bool {
+	return r.allowDeleteProtected
+}
+
+func (r *removeVMParams) WithDetachDisks(detachDisks bool) BuildableRemoveVMParameters {
+	r.detachDisks = detachDisks
+	return r
+}
+
+func (r *removeVMParams) WithForce(force bool) BuildableRemoveVMParameters {
+	r.force = force
+	return r
+}
+
+func (r *removeVMParams) WithAllowHostedEngine(allowHostedEngine bool) BuildableRemoveVMParameters {
+	r.allowHostedEngine = allowHostedEngine
+	return r
+}
+
+func (r *removeVMParams) WithAllowDeleteProtected(allowDeleteProtected bool) BuildableRemoveVMParameters {
+	r.allowDeleteProtected = allowDeleteProtected
+	return r
+}
+
+// prepareVMForRemoval checks if the VM can be removed with the given parameters and stops it if it is still running
+// and the removal is forced. The delete protection is removed if the parameters allow it, since the engine refuses to
+// remove protected VMs.
+func prepareVMForRemoval(client VMClient, id VMID, params RemoveVMParameters, retries []RetryStrategy) error {
+	vm, err := client.GetVM(id, retries...)
+	if err != nil {
+		return err
+	}
+	if err := checkHostedEngineAllowed(id, vm.HostedEngine(), params.AllowHostedEngine(), "remove"); err != nil {
+		return err
+	}
+	if err := checkDeleteProtection(vm, params.AllowDeleteProtected()); err != nil {
+		return err
+	}
+	stopped := vm.Status() == VMStatusDown
+	if !stopped && !params.Force() {
+		return newError(
+			EConflict,
+			"cannot remove VM %s in status %s, stop it first or set Force to stop it automatically",
+			id,
+			vm.Status(),
+		)
+	}
+	if vm.DeleteProtected() {
+		if err := client.SetVMDeleteProtected(id, false, retries...); err != nil {
+			return err
+		}
+	}
+	if stopped {
+		return nil
+	}
+	stopParams := StopVMParams().WithForce(true).WithAllowHostedEngine(params.AllowHostedEngine())
+	if err := client.StopVMWithParams(id, stopParams, retries...); err != nil {
+		return err
+	}
+	_, err = client.WaitForVMStatus(id, VMStatusDown, retries...)
+	return err
+}
+
+func (o *oVirtClient) RemoveVM(id VMID, retries ...RetryStrategy) error {
+	return o.RemoveVMWithParams(id, RemoveVMParams(), retries...)
+}
+
+func (o *oVirtClient) RemoveVMWithParams(id VMID, params RemoveVMParameters, retries ...RetryStrategy) error {
+	if params == nil {
+		params = RemoveVMParams()
+	}
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
-	err = retry(
-		fmt.Sprintf("removing VM %s", id),
+	if err := prepareVMForRemoval(o, id, params, retries); err != nil {
+		return err
+	}
+	action := fmt.Sprintf("removing VM %s", id)
+	err := retry(
+		action,
 		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().VmsService().VmService(string(id)).Remove().Send()
+			// Force only stops the VM before the removal, it is not passed on to the engine, which would use it to
+			// skip its own checks.
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(id)).
+				Remove().
+				DetachOnly(params.DetachDisks()).
+				Send()
 			if err != nil {
+				err = wrapSDKError(action, err)
+				if HasErrorCode(err, ENotFound) {
+					// A previous attempt removed the VM, but the response was lost.
+					return nil
+				}
 				return err
 			}
 			return nil
 		})
-	return
+	if err != nil {
+		return err
+	}
+	return o.waitForVMRemoved(id, retries)
+}
+
+// waitForVMRemoved waits until the engine no longer returns the VM since the removal continues in the background
+// after the remove call returns.
+func (o *oVirtClient) waitForVMRemoved(id VMID, retries []RetryStrategy) error {
+	action := fmt.Sprintf("waiting for VM %s to be removed", id)
+	return retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().VmsService().VmService(string(id)).Get().Send()
+			if err == nil {
+				return newError(EPending, "VM %s still exists", id)
+			}
+			err = wrapSDKError(action, err)
+			if HasErrorCode(err, ENotFound) {
+				return nil
+			}
+			return err
+		})
 }
 
 func (m *mockClient) RemoveVM(id VMID, retries ...RetryStrategy) error {
+	return m.RemoveVMWithParams(id, RemoveVMParams(), retries...)
+}
 
+func (m *mockClient) RemoveVMWithParams(id VMID, params RemoveVMParameters, retries ...RetryStrategy) error {
+	if params == nil {
+		params = RemoveVMParams()
+	}
 	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	if err := prepareVMForRemoval(m, id, params, retries); err != nil {
+		return err
+	}
 
 	return retry(
 		fmt.Sprintf("removing VM %s", id),
@@ -40,8 +215,13 @@ func (m *mockClient) RemoveVM(id VMID, retries ...RetryStrategy) error {
 				if m.disks[diskAttachment.DiskID()].status == DiskStatusLocked {
 					return newError(EConflict, "Cannot delete VM, disk %s is locked.", diskAttachment.DiskID())
 				}
-				delete(m.disks, diskAttachment.DiskID())
-				delete(m.vmDiskAttachmentsByDisk, diskAttachment.DiskID())
+			}
+			for _, diskAttachment := range m.vmDiskAttachmentsByVM[id] {
+				m.removeDiskAttachmentByDisk(diskAttachment)
+				// Shared disks stay around while they are still attached to other VMs.
+				if _, stillAttached := m.vmDiskAttachmentsByDisk[diskAttachment.diskID]; !params.DetachDisks() && !stillAttached {
+					delete(m.disks, diskAttachment.DiskID())
+				}
 			}
 			for nicID, nic := range m.nics {
 				if nic.VMID() == id {
@@ -51,6 +231,20 @@ func (m *mockClient) RemoveVM(id VMID, retries ...RetryStrategy) error {
 			delete(m.vmIPs, id)
 			delete(m.vmDiskAttachmentsByVM, id)
 			delete(m.graphicsConsolesByVM, id)
+			delete(m.watchdogsByVM, id)
+			for _, label := range m.affinityLabels {
+				delete(label.vmIDs, id)
+			}
+			for snapshotID, item := range m.snapshots {
+				if item.vmID == id {
+					delete(m.snapshots, snapshotID)
+				}
+			}
+			for permissionID, item := range m.permissions {
+				if item.vmID == id {
+					delete(m.permissions, permissionID)
+				}
+			}
 			delete(m.vms, id)
 
 			return nil
@@ -0,0 +1,89 @@
+package ovirtclient
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestImageLockedVMRemoval(t *testing.T) {
+	t.Parallel()
+	m := NewMock().(*mockClient)
+	var clusterID ClusterID
+	for id := range m.clusters {
+		clusterID = id
+		break
+	}
+	vm, err := m.CreateVM(clusterID, DefaultBlankTemplateID, "locked", nil)
+	if err != nil {
+		t.Fatalf("Failed to create VM (%v)", err)
+	}
+	m.lock.Lock()
+	m.vms[vm.ID()].status = VMStatusImageLocked
+	m.lock.Unlock()
+
+	if err := m.RemoveVM(vm.ID()); !HasErrorCode(err, EConflict) {
+		t.Fatalf("Removing an image locked VM without force did not result in an EConflict error (%v)", err)
+	}
+	if _, err := m.GetVM(vm.ID()); err != nil {
+		t.Fatalf("The image locked VM was removed (%v)", err)
+	}
+}
+
+func TestRemoveVMLostResponse(t *testing.T) {
+	t.Parallel()
+	var lock sync.Mutex
+	removed := false
+	removals := 0
+	var removalQueries []string
+	client := newFakeEngineClient(t, func(writer http.ResponseWriter, request *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if request.URL.Path != "/ovirt-engine/api/vms/00000000-0000-0000-0000-000000000010" {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch request.Method {
+		case http.MethodGet:
+			if removed {
+				writer.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeFakeEngineXML(writer, strings.Replace(migrationTestVM, "<status>up</status>", "<status>down</status>", 1))
+		case http.MethodDelete:
+			removals++
+			removalQueries = append(removalQueries, request.URL.RawQuery)
+			removed = true
+			// The first removal succeeds on the engine, but the response is lost on the way.
+			if removals == 1 {
+				writer.WriteHeader(http.StatusServiceUnavailable)
+			} else {
+				writer.WriteHeader(http.StatusNotFound)
+			}
+		default:
+			writer.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	err := client.RemoveVMWithParams(
+		"00000000-0000-0000-0000-000000000010",
+		RemoveVMParams().WithForce(true),
+		MaxTries(3),
+		FixedWait(time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("Removing a VM removed by an earlier attempt failed (%v)", err)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if removals != 2 {
+		t.Fatalf("Incorrect number of removal attempts (expected: 2, got: %d)", removals)
+	}
+	for _, query := range removalQueries {
+		if strings.Contains(query, "force") {
+			t.Fatalf("The force flag was passed on to the engine (%s)", query)
+		}
+	}
+}
@@ -24,3 +24,93 @@ func TestVMRemovalShouldRemoveAttachedDisks(t *testing.T) {
 		t.Fatalf("Getting disk after VM removal did not result in a non found error (%v).", err)
 	}
 }
+
+func TestVMRemovalWithDetachedDisks(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+	if err := helper.GetClient().RemoveVMWithParams(
+		vm.ID(),
+		ovirtclient.RemoveVMParams().WithDetachDisks(true),
+	); err != nil {
+		t.Fatalf("Cannot remove test VM %s (%v)", vm.ID(), err)
+	}
+	if _, err := helper.GetClient().GetVM(vm.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Getting the VM after removal did not result in a not found error (%v)", err)
+	}
+	if _, err := helper.GetClient().GetDisk(disk.ID()); err != nil {
+		t.Fatalf("Disk was not kept after the VM has been removed with detached disks (%v)", err)
+	}
+}
+
+func TestRunningVMRemoval(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanUploadDiskImage(t, helper, disk)
+	assertCanAttachDiskWithParams(t, vm, disk, ovirtclient.CreateDiskAttachmentParams().MustWithBootable(true).MustWithActive(true))
+	assertCanStartVM(t, helper, vm)
+	vm = assertVMWillStart(t, vm)
+
+	if err := vm.Remove(); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Removing a running VM without force did not result in an EConflict error (%v)", err)
+	}
+	if err := helper.GetClient().RemoveVMWithParams(vm.ID(), ovirtclient.RemoveVMParams().WithForce(true)); err != nil {
+		t.Fatalf("Failed to force-remove running VM %s (%v)", vm.ID(), err)
+	}
+	if _, err := helper.GetClient().GetVM(vm.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Getting the VM after removal did not result in a not found error (%v)", err)
+	}
+}
+
+func TestDeleteProtectedVMRemoval(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithDeleteProtected(true).MustWithStateless(true),
+	)
+	if !vm.DeleteProtected() {
+		t.Fatalf("VM created with delete protection is not delete protected.")
+	}
+	if !vm.Stateless() {
+		t.Fatalf("VM created as stateless is not stateless.")
+	}
+
+	if err := vm.Remove(); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Removing a delete protected VM did not result in an EConflict error (%v)", err)
+	}
+	if _, err := client.GetVM(vm.ID()); err != nil {
+		t.Fatalf("The delete protected VM is gone after the refused removal (%v)", err)
+	}
+
+	if err := client.SetVMStateless(vm.ID(), false); err != nil {
+		t.Fatalf("Failed to clear the stateless flag of VM %s (%v)", vm.ID(), err)
+	}
+	updatedVM, err := client.GetVM(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to get VM %s (%v)", vm.ID(), err)
+	}
+	if updatedVM.Stateless() {
+		t.Fatalf("The VM is still stateless after clearing the flag.")
+	}
+
+	if err := client.RemoveVMWithParams(
+		vm.ID(),
+		ovirtclient.RemoveVMParams().WithAllowDeleteProtected(true),
+	); err != nil {
+		t.Fatalf("Failed to remove delete protected VM %s with the override (%v)", vm.ID(), err)
+	}
+	if _, err := client.GetVM(vm.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Getting the VM after removal did not result in a not found error (%v)", err)
+	}
+}
@@ -0,0 +1,216 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// RNGSource is the entropy source on the host that feeds the virtio RNG device of a VM.
+type RNGSource string
+
+const (
+	// RNGSourceURandom reads entropy from /dev/urandom on the host. Every cluster supports this source.
+	RNGSourceURandom RNGSource = "urandom"
+	// RNGSourceHWRNG reads entropy from the hardware random number generator of the host (/dev/hwrng). The cluster
+	// must list this source as required, otherwise the engine rejects the VM.
+	RNGSourceHWRNG RNGSource = "hwrng"
+)
+
+// RNGSourceList is a list of RNGSource values.
+type RNGSourceList []RNGSource
+
+// RNGSourceValues returns all possible RNGSource values.
+func RNGSourceValues() RNGSourceList {
+	return []RNGSource{
+		RNGSourceURandom,
+		RNGSourceHWRNG,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l RNGSourceList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// Validate returns an error if the RNG source is not valid.
+func (r RNGSource) Validate() error {
+	for _, value := range RNGSourceValues() {
+		if value == r {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid RNG source: %s, must be one of: %s",
+		r,
+		strings.Join(RNGSourceValues().Strings(), ", "),
+	)
+}
+
+// VMRNGDevice describes the virtio random number generator device of a VM.
+type VMRNGDevice interface {
+	// Source returns the entropy source on the host.
+	Source() RNGSource
+	// RateBytes returns how many bytes the guest may read from the device per RatePeriod. 0 means unlimited.
+	RateBytes() uint
+	// RatePeriod returns the period RateBytes applies to. It is 0 if the rate is unlimited.
+	RatePeriod() time.Duration
+}
+
+type vmRNGDevice struct {
+	source     RNGSource
+	rateBytes  uint
+	ratePeriod time.Duration
+}
+
+func (v *vmRNGDevice) Source() RNGSource {
+	return v.source
+}
+
+func (v *vmRNGDevice) RateBytes() uint {
+	return v.rateBytes
+}
+
+func (v *vmRNGDevice) RatePeriod() time.Duration {
+	return v.ratePeriod
+}
+
+// newVMRNGDevice validates the RNG device settings. The engine stores the period in milliseconds, and a rate needs
+// both the byte count and the period.
+func newVMRNGDevice(source RNGSource, rateBytes uint, ratePeriod time.Duration) (*vmRNGDevice, error) {
+	if err := source.Validate(); err != nil {
+		return nil, err
+	}
+	if ratePeriod < 0 || ratePeriod%time.Millisecond != 0 {
+		return nil, newError(
+			EBadArgument,
+			"the RNG rate period must be a non-negative whole number of milliseconds (%s given)",
+			ratePeriod,
+		)
+	}
+	if (rateBytes == 0) != (ratePeriod == 0) {
+		return nil, newError(
+			EBadArgument,
+			"the RNG rate bytes and period must either both be set or both be 0 (%d bytes per %s given)",
+			rateBytes,
+			ratePeriod,
+		)
+	}
+	return &vmRNGDevice{
+		source:     source,
+		rateBytes:  rateBytes,
+		ratePeriod: ratePeriod,
+	}, nil
+}
+
+func buildSDKRNGDevice(rngDevice VMRNGDevice) *ovirtsdk.RngDeviceBuilder {
+	builder := ovirtsdk.NewRngDeviceBuilder().Source(ovirtsdk.RngSource(rngDevice.Source()))
+	if rngDevice.RateBytes() != 0 {
+		builder.RateBuilder(
+			ovirtsdk.NewRateBuilder().
+				Bytes(int64(rngDevice.RateBytes())).
+				Period(rngDevice.RatePeriod().Milliseconds()),
+		)
+	}
+	return builder
+}
+
+func vmRNGDeviceConverter(object *ovirtsdk.Vm, v *vm) error {
+	rngDevice, ok := object.RngDevice()
+	if !ok {
+		return nil
+	}
+	source, ok := rngDevice.Source()
+	if !ok {
+		return nil
+	}
+	v.rngDevice = &vmRNGDevice{
+		source: RNGSource(source),
+	}
+	if rate, ok := rngDevice.Rate(); ok {
+		rateBytes, _ := rate.Bytes()
+		ratePeriod, _ := rate.Period()
+		if rateBytes > 0 && ratePeriod > 0 {
+			v.rngDevice.rateBytes = uint(rateBytes)
+			v.rngDevice.ratePeriod = time.Duration(ratePeriod) * time.Millisecond
+		}
+	}
+	return nil
+}
+
+func vmRNGDeviceCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if rngDevice := params.RNGDevice(); rngDevice != nil {
+		builder.RngDeviceBuilder(buildSDKRNGDevice(rngDevice))
+	}
+}
+
+func (o *oVirtClient) SetVMRNGDevice(
+	id VMID,
+	source RNGSource,
+	rateBytes uint,
+	ratePeriod time.Duration,
+	retries ...RetryStrategy,
+) (result VM, err error) {
+	rngDevice, err := newVMRNGDevice(source, rateBytes, ratePeriod)
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	sdkVM, err := ovirtsdk.NewVmBuilder().Id(string(id)).RngDeviceBuilder(buildSDKRNGDevice(rngDevice)).Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build VM object")
+	}
+	action := fmt.Sprintf("setting RNG device with source %s on VM %s", source, id)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().VmsService().VmService(string(id)).Update().Vm(sdkVM).Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			vm, ok := response.Vm()
+			if !ok {
+				return newError(EFieldMissing, "missing VM in VM update response")
+			}
+			result, err = convertSDKVM(vm, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert VM",
+				)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) SetVMRNGDevice(
+	id VMID,
+	source RNGSource,
+	rateBytes uint,
+	ratePeriod time.Duration,
+	_ ...RetryStrategy,
+) (VM, error) {
+	rngDevice, err := newVMRNGDevice(source, rateBytes, ratePeriod)
+	if err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", id)
+	}
+	item.rngDevice = rngDevice
+	return item.snapshot(), nil
+}
@@ -0,0 +1,46 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMRNGDevice(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithRNGDevice(ovirtclient.RNGSourceURandom, 0, 0),
+	)
+	rngDevice := vm.RNGDevice()
+	if rngDevice == nil {
+		t.Fatalf("No RNG device on VM created with an RNG device.")
+	}
+	if rngDevice.Source() != ovirtclient.RNGSourceURandom {
+		t.Fatalf("Incorrect RNG source (expected: %s, got: %s)", ovirtclient.RNGSourceURandom, rngDevice.Source())
+	}
+
+	updatedVM, err := vm.SetRNGDevice(ovirtclient.RNGSourceURandom, 1024, time.Second)
+	if err != nil {
+		t.Fatalf("Failed to set RNG device on VM %s (%v)", vm.ID(), err)
+	}
+	rngDevice = updatedVM.RNGDevice()
+	if rngDevice == nil || rngDevice.RateBytes() != 1024 || rngDevice.RatePeriod() != time.Second {
+		t.Fatalf("Incorrect RNG rate after update (%v)", rngDevice)
+	}
+
+	if _, err := vm.SetRNGDevice("invalid", 0, 0); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Setting an invalid RNG source did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := vm.SetRNGDevice(ovirtclient.RNGSourceURandom, 1024, 0); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting an RNG rate without a period did not result in an EBadArgument error (%v)", err)
+	}
+}
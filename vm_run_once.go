@@ -0,0 +1,336 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// BootDevice is a device a VM can boot from.
+type BootDevice string
+
+const (
+	// BootDeviceHD boots the VM from its bootable disk.
+	BootDeviceHD BootDevice = "hd"
+	// BootDeviceCDROM boots the VM from the attached CD-ROM.
+	BootDeviceCDROM BootDevice = "cdrom"
+	// BootDeviceNetwork boots the VM via PXE.
+	BootDeviceNetwork BootDevice = "network"
+)
+
+// BootDeviceList is a list of BootDevice values.
+type BootDeviceList []BootDevice
+
+// BootDeviceValues returns all possible BootDevice values.
+func BootDeviceValues() BootDeviceList {
+	return []BootDevice{
+		BootDeviceHD,
+		BootDeviceCDROM,
+		BootDeviceNetwork,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l BootDeviceList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// Validate returns an error if the boot device is not a valid value.
+func (b BootDevice) Validate() error {
+	for _, device := range BootDeviceValues() {
+		if device == b {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid boot device: %s must be one of: %s",
+		b,
+		strings.Join(BootDeviceValues().Strings(), ", "),
+	)
+}
+
+// RunOnceParameters contains the overrides for a single run of a VM. None of the overrides are stored in the VM
+// configuration: the boot order, the CD-ROM and the initialization revert to the persisted values once the VM is
+// powered off.
+type RunOnceParameters interface {
+	// BootDevices returns the boot order for this run. If it is empty, the persisted boot order is used.
+	BootDevices() []BootDevice
+	// CDROMFileID returns the ID of the ISO file inserted into the CD-ROM for this run. This is either the name of a
+	// file on an ISO storage domain or the ID of an ISO disk on a data storage domain.
+	CDROMFileID() *string
+	// CloudInit returns the initialization passed to the VM via cloud-init for this run.
+	CloudInit() Initialization
+	// Sysprep returns the initialization passed to the VM via sysprep for this run. Only one of CloudInit and
+	// Sysprep can be set.
+	Sysprep() Initialization
+	// Volatile returns true if the changes made to the disks during this run are discarded when the VM is powered
+	// off.
+	Volatile() bool
+}
+
+// BuildableRunOnceParameters is a buildable version of RunOnceParameters.
+type BuildableRunOnceParameters interface {
+	RunOnceParameters
+
+	// WithBootDevices sets the boot order for this run. It returns an EBadArgument error if a device is invalid or
+	// listed more than once.
+	WithBootDevices(devices ...BootDevice) (BuildableRunOnceParameters, error)
+	// MustWithBootDevices is identical to WithBootDevices, but panics instead of returning an error.
+	MustWithBootDevices(devices ...BootDevice) BuildableRunOnceParameters
+
+	// WithCDROMFileID sets the ISO file inserted into the CD-ROM for this run.
+	WithCDROMFileID(fileID string) (BuildableRunOnceParameters, error)
+	// MustWithCDROMFileID is identical to WithCDROMFileID, but panics instead of returning an error.
+	MustWithCDROMFileID(fileID string) BuildableRunOnceParameters
+
+	// WithCloudInit sets the initialization passed via cloud-init. It returns an EBadArgument error if sysprep is
+	// already set.
+	WithCloudInit(init Initialization) (BuildableRunOnceParameters, error)
+	// MustWithCloudInit is identical to WithCloudInit, but panics instead of returning an error.
+	MustWithCloudInit(init Initialization) BuildableRunOnceParameters
+
+	// WithSysprep sets the initialization passed via sysprep. It returns an EBadArgument error if cloud-init is
+	// already set.
+	WithSysprep(init Initialization) (BuildableRunOnceParameters, error)
+	// MustWithSysprep is identical to WithSysprep, but panics instead of returning an error.
+	MustWithSysprep(init Initialization) BuildableRunOnceParameters
+
+	// WithVolatile sets whether the disk changes of this run are discarded.
+	WithVolatile(volatile bool) (BuildableRunOnceParameters, error)
+	// MustWithVolatile is identical to WithVolatile, but panics instead of returning an error.
+	MustWithVolatile(volatile bool) BuildableRunOnceParameters
+}
+
+// RunOnceParams creates a new set of parameters for RunVMOnce.
+func RunOnceParams() BuildableRunOnceParameters {
+	return &runOnceParams{}
+}
+
+type runOnceParams struct {
+	bootDevices []BootDevice
+	cdromFileID *string
+	cloudInit   Initialization
+	sysprep     Initialization
+	volatile    bool
+}
+
+func (r *runOnceParams) BootDevices() []BootDevice {
+	return r.bootDevices
+}
+
+func (r *runOnceParams) WithBootDevices(devices ...BootDevice) (BuildableRunOnceParameters, error) {
+	if err := validateBootDevices(devices); err != nil {
+		return r, err
+	}
+	r.bootDevices = devices
+	return r, nil
+}
+
+func (r *runOnceParams) MustWithBootDevices(devices ...BootDevice) BuildableRunOnceParameters {
+	builder, err := r.WithBootDevices(devices...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *runOnceParams) CDROMFileID() *string {
+	return r.cdromFileID
+}
+
+func (r *runOnceParams) WithCDROMFileID(fileID string) (BuildableRunOnceParameters, error) {
+	if fileID == "" {
+		return r, newError(EBadArgument, "the CD-ROM file ID cannot be empty")
+	}
+	r.cdromFileID = &fileID
+	return r, nil
+}
+
+func (r *runOnceParams) MustWithCDROMFileID(fileID string) BuildableRunOnceParameters {
+	builder, err := r.WithCDROMFileID(fileID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *runOnceParams) CloudInit() Initialization {
+	return r.cloudInit
+}
+
+func (r *runOnceParams) WithCloudInit(init Initialization) (BuildableRunOnceParameters, error) {
+	if r.sysprep != nil {
+		return r, newError(EBadArgument, "cloud-init and sysprep cannot be used in the same run")
+	}
+	if err := validateInitializationDNS(init); err != nil {
+		return r, err
+	}
+	r.cloudInit = init
+	return r, nil
+}
+
+func (r *runOnceParams) MustWithCloudInit(init Initialization) BuildableRunOnceParameters {
+	builder, err := r.WithCloudInit(init)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *runOnceParams) Sysprep() Initialization {
+	return r.sysprep
+}
+
+func (r *runOnceParams) WithSysprep(init Initialization) (BuildableRunOnceParameters, error) {
+	if r.cloudInit != nil {
+		return r, newError(EBadArgument, "cloud-init and sysprep cannot be used in the same run")
+	}
+	r.sysprep = init
+	return r, nil
+}
+
+func (r *runOnceParams) MustWithSysprep(init Initialization) BuildableRunOnceParameters {
+	builder, err := r.WithSysprep(init)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *runOnceParams) Volatile() bool {
+	return r.volatile
+}
+
+func (r *runOnceParams) WithVolatile(volatile bool) (BuildableRunOnceParameters, error) {
+	r.volatile = volatile
+	return r, nil
+}
+
+func (r *runOnceParams) MustWithVolatile(volatile bool) BuildableRunOnceParameters {
+	builder, err := r.WithVolatile(volatile)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func validateBootDevices(devices []BootDevice) error {
+	seen := map[BootDevice]bool{}
+	for _, device := range devices {
+		if err := device.Validate(); err != nil {
+			return err
+		}
+		if seen[device] {
+			return newError(EBadArgument, "boot device %s is listed more than once", device)
+		}
+		seen[device] = true
+	}
+	return nil
+}
+
+// validateRunOnceParameters repeats the checks of the builder for RunOnceParameters implemented outside this
+// package.
+func validateRunOnceParameters(params RunOnceParameters) error {
+	if err := validateBootDevices(params.BootDevices()); err != nil {
+		return err
+	}
+	if params.CloudInit() != nil && params.Sysprep() != nil {
+		return newError(EBadArgument, "cloud-init and sysprep cannot be used in the same run")
+	}
+	if params.CloudInit() != nil {
+		if err := validateInitializationDNS(params.CloudInit()); err != nil {
+			return err
+		}
+	}
+	if fileID := params.CDROMFileID(); fileID != nil && *fileID == "" {
+		return newError(EBadArgument, "the CD-ROM file ID cannot be empty")
+	}
+	return nil
+}
+
+func buildSDKRunOnceVM(params RunOnceParameters) *ovirtsdk.Vm {
+	builder := ovirtsdk.NewVmBuilder()
+	if devices := params.BootDevices(); len(devices) > 0 {
+		sdkDevices := make([]ovirtsdk.BootDevice, len(devices))
+		for i, device := range devices {
+			sdkDevices[i] = ovirtsdk.BootDevice(device)
+		}
+		builder.OsBuilder(
+			ovirtsdk.NewOperatingSystemBuilder().BootBuilder(ovirtsdk.NewBootBuilder().Devices(sdkDevices)),
+		)
+	}
+	if fileID := params.CDROMFileID(); fileID != nil {
+		builder.CdromsBuilderOfAny(*ovirtsdk.NewCdromBuilder().FileBuilder(ovirtsdk.NewFileBuilder().Id(*fileID)))
+	}
+	if init := params.CloudInit(); init != nil {
+		builder.InitializationBuilder(buildSDKInitialization(init))
+	}
+	if init := params.Sysprep(); init != nil {
+		builder.InitializationBuilder(buildSDKInitialization(init))
+	}
+	return builder.MustBuild()
+}
+
+func (o *oVirtClient) RunVMOnce(id VMID, params RunOnceParameters, retries ...RetryStrategy) (VM, error) {
+	if params == nil {
+		params = RunOnceParams()
+	}
+	if err := validateRunOnceParameters(params); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	action := fmt.Sprintf("running VM %s once", id)
+	sdkVM := buildSDKRunOnceVM(params)
+	err := retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			request := o.conn.SystemService().VmsService().VmService(string(id)).Start().Vm(sdkVM)
+			if params.CloudInit() != nil {
+				request.UseCloudInit(true)
+			}
+			if params.Sysprep() != nil {
+				request.UseSysprep(true)
+			}
+			if params.Volatile() {
+				request.Volatile(true)
+			}
+			_, err := request.Send()
+			return wrapSDKError(action, err)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return o.WaitForVMStatus(id, VMStatusUp, retries...)
+}
+
+func (m *mockClient) RunVMOnce(id VMID, params RunOnceParameters, retries ...RetryStrategy) (VM, error) {
+	if params == nil {
+		params = RunOnceParams()
+	}
+	if err := validateRunOnceParameters(params); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	item, ok := m.vms[id]
+	if !ok {
+		m.lock.Unlock()
+		return nil, newError(ENotFound, "vm with ID %s not found", id)
+	}
+	if item.Status() != VMStatusDown {
+		m.lock.Unlock()
+		return nil, newError(EConflict, "VM %s must be down to be run once (current status: %s)", id, item.Status())
+	}
+	m.lock.Unlock()
+	if err := m.StartVM(id, retries...); err != nil {
+		return nil, err
+	}
+	return m.WaitForVMStatus(id, VMStatusUp, retries...)
+}
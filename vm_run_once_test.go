@@ -0,0 +1,59 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestRunVMOnce(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateBootableVM(t, helper)
+	params := ovirtclient.RunOnceParams().
+		MustWithBootDevices(ovirtclient.BootDeviceHD, ovirtclient.BootDeviceNetwork).
+		MustWithCloudInit(ovirtclient.NewInitialization("", "run-once"))
+	runningVM, err := vm.RunOnce(params)
+	if err != nil {
+		t.Fatalf("Failed to run VM %s once (%v)", vm.ID(), err)
+	}
+	t.Cleanup(func() {
+		if err := runningVM.Stop(true); err != nil {
+			t.Fatalf("Failed to stop VM %s after test (%v)", vm.ID(), err)
+		}
+		if _, err := runningVM.WaitForStatus(ovirtclient.VMStatusDown); err != nil {
+			t.Fatalf("Failed to wait for VM %s to stop (%v)", vm.ID(), err)
+		}
+	})
+	if runningVM.Status() != ovirtclient.VMStatusUp {
+		t.Fatalf("Incorrect VM status after run once (expected: %s, got: %s)", ovirtclient.VMStatusUp, runningVM.Status())
+	}
+	if _, err := runningVM.RunOnce(nil); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Running a VM once that is already up did not result in an EConflict error (%v)", err)
+	}
+}
+
+func TestRunOnceParamsValidation(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ovirtclient.RunOnceParams().WithBootDevices(
+		ovirtclient.BootDeviceCDROM,
+		ovirtclient.BootDeviceCDROM,
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Setting a duplicate boot device did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := ovirtclient.RunOnceParams().WithBootDevices("floppy"); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting an invalid boot device did not result in an EBadArgument error (%v)", err)
+	}
+	params := ovirtclient.RunOnceParams().MustWithCloudInit(ovirtclient.NewInitialization("", "test"))
+	if _, err := params.WithSysprep(ovirtclient.NewInitialization("", "test")); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting sysprep together with cloud-init did not result in an EBadArgument error (%v)", err)
+	}
+}
@@ -0,0 +1,69 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func vmSafetyFlagsConverter(object *ovirtsdk.Vm, v *vm) error {
+	v.stateless, _ = object.Stateless()
+	v.deleteProtected, _ = object.DeleteProtected()
+	return nil
+}
+
+func vmSafetyFlagsCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if stateless := params.Stateless(); stateless != nil {
+		builder.Stateless(*stateless)
+	}
+	if deleteProtected := params.DeleteProtected(); deleteProtected != nil {
+		builder.DeleteProtected(*deleteProtected)
+	}
+}
+
+// checkDeleteProtection returns an EConflict error if the VM is protected against removal and the caller did not
+// explicitly allow removing protected VMs.
+func checkDeleteProtection(vm VMData, allowDeleteProtected bool) error {
+	if vm.DeleteProtected() && !allowDeleteProtected {
+		return newError(
+			EConflict,
+			"VM %s is delete protected, remove the protection with SetVMDeleteProtected or set AllowDeleteProtected",
+			vm.ID(),
+		)
+	}
+	return nil
+}
+
+func (o *oVirtClient) SetVMStateless(id VMID, stateless bool, retries ...RetryStrategy) error {
+	sdkVM := &ovirtsdk.Vm{}
+	sdkVM.SetId(string(id))
+	sdkVM.SetStateless(stateless)
+	return o.updateVMField(id, sdkVM, "stateless flag", retries)
+}
+
+func (o *oVirtClient) SetVMDeleteProtected(id VMID, deleteProtected bool, retries ...RetryStrategy) error {
+	sdkVM := &ovirtsdk.Vm{}
+	sdkVM.SetId(string(id))
+	sdkVM.SetDeleteProtected(deleteProtected)
+	return o.updateVMField(id, sdkVM, "delete protection", retries)
+}
+
+func (m *mockClient) SetVMStateless(id VMID, stateless bool, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", id)
+	}
+	item.stateless = stateless
+	return nil
+}
+
+func (m *mockClient) SetVMDeleteProtected(id VMID, deleteProtected bool, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", id)
+	}
+	item.deleteProtected = deleteProtected
+	return nil
+}
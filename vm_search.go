@@ -1,12 +1,11 @@
 package ovirtclient
 
 import (
-	"fmt"
 	"strings"
 )
 
 func (o *oVirtClient) vmSearchCriteria(params VMSearchParameters) (string, error) {
-	var criteria []string
+	var criteria []SearchQuery
 	var err error
 
 	if criteria, err = o.vmTagCriteria(params, criteria); err != nil {
@@ -25,75 +24,153 @@ func (o *oVirtClient) vmSearchCriteria(params VMSearchParameters) (string, error
 	if len(criteria) == 0 {
 		return "", newError(EBadArgument, "at least one search parameter must be specified")
 	}
-	return strings.Join(criteria, " AND "), nil
+	if err := validateVMSearchMax(params); err != nil {
+		return "", err
+	}
+	return criteria[0].And(criteria[1:]...).Build()
 }
 
-func (o *oVirtClient) vmNotStatusCriteria(params VMSearchParameters, criteria []string) (
-	[]string,
+func (o *oVirtClient) vmNotStatusCriteria(params VMSearchParameters, criteria []SearchQuery) (
+	[]SearchQuery,
 	error,
 ) {
-	if statuses := params.NotStatuses(); statuses != nil {
+	if statuses := params.NotStatuses(); statuses != nil && len(*statuses) > 0 {
 		if err := statuses.Validate(); err != nil {
 			return nil, wrap(err, EBadArgument, "invalid value for search field not statuses")
 		}
-		items := make([]string, len(*statuses))
+		items := make([]SearchQuery, len(*statuses))
 		for i, status := range *statuses {
-			items[i] = fmt.Sprintf("status != %s", status)
+			items[i] = SearchField("status").NotEq(string(status))
 		}
-		criteria = append(criteria, fmt.Sprintf("(%s)", strings.Join(items, " AND ")))
+		criteria = append(criteria, items[0].And(items[1:]...))
 	}
 	return criteria, nil
 }
 
-func (o *oVirtClient) vmStatusCriteria(params VMSearchParameters, criteria []string) ([]string, error) {
-	if statuses := params.Statuses(); statuses != nil {
+func (o *oVirtClient) vmStatusCriteria(params VMSearchParameters, criteria []SearchQuery) ([]SearchQuery, error) {
+	if statuses := params.Statuses(); statuses != nil && len(*statuses) > 0 {
 		if err := statuses.Validate(); err != nil {
 			return nil, wrap(err, EBadArgument, "invalid value for search field statuses")
 		}
-		items := make([]string, len(*statuses))
+		items := make([]SearchQuery, len(*statuses))
 		for i, status := range *statuses {
-			items[i] = fmt.Sprintf("status = %s", status)
+			items[i] = SearchField("status").Eq(string(status))
 		}
-		criteria = append(criteria, fmt.Sprintf("(%s)", strings.Join(items, " OR ")))
+		criteria = append(criteria, items[0].Or(items[1:]...))
 	}
 	return criteria, nil
 }
 
-func (o *oVirtClient) vmNameCriteria(params VMSearchParameters, criteria []string) ([]string, error) {
+func (o *oVirtClient) vmNameCriteria(params VMSearchParameters, criteria []SearchQuery) ([]SearchQuery, error) {
 	if name := params.Name(); name != nil {
-		quotedName, err := quoteSearchString(*name)
-		if err != nil {
-			return nil, newError(EBadArgument, "invalid name search string: %s", *name)
+		query := SearchField("name").Eq(*name)
+		if _, err := query.Build(); err != nil {
+			return nil, wrap(err, EBadArgument, "invalid name search string: %s", *name)
 		}
-		criteria = append(criteria, fmt.Sprintf("name = %s", quotedName))
+		criteria = append(criteria, query)
 	}
 	return criteria, nil
 }
 
-func (o *oVirtClient) vmTagCriteria(params VMSearchParameters, criteria []string) ([]string, error) {
+func (o *oVirtClient) vmTagCriteria(params VMSearchParameters, criteria []SearchQuery) ([]SearchQuery, error) {
 	if tag := params.Tag(); tag != nil {
-		quotedTag, err := quoteSearchString(*tag)
-		if err != nil {
-			return nil, newError(EBadArgument, "invalid tag search string: %s", *tag)
+		query := SearchField("tag").Eq(*tag)
+		if _, err := query.Build(); err != nil {
+			return nil, wrap(err, EBadArgument, "invalid tag search string: %s", *tag)
 		}
-		criteria = append(criteria, fmt.Sprintf("tag = %s", quotedTag))
+		criteria = append(criteria, query)
 	}
 	return criteria, nil
 }
 
-func (o *oVirtClient) SearchVMs(params VMSearchParameters, retries ...RetryStrategy) (result []VM, err error) {
+func (o *oVirtClient) SearchVMs(params VMSearchParameters, retries ...RetryStrategy) ([]VM, error) {
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
-	result = []VM{}
 	qs, err := o.vmSearchCriteria(params)
 	if err != nil {
 		return nil, err
 	}
+	return o.searchVMsByQuery(qs, params, retries)
+}
+
+func validateVMSearchMax(params VMSearchParameters) error {
+	if max := params.Max(); max != nil && *max == 0 {
+		return newError(EBadArgument, "the maximum number of VMs to return must be at least 1")
+	}
+	return nil
+}
+
+func (o *oVirtClient) ListVMsInCluster(clusterID ClusterID, retries ...RetryStrategy) ([]VM, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	cluster, err := o.GetCluster(clusterID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	qs, err := SearchField("cluster").Eq(cluster.Name()).Build()
+	if err != nil {
+		return nil, wrap(err, EBadArgument, "cannot search for VMs in cluster %s", clusterID)
+	}
+	vms, err := o.searchVMsByQuery(qs, nil, retries)
+	if err != nil {
+		return nil, err
+	}
+	// The engine matches the cluster by name, which may contain wildcard characters, so the ID is checked as well.
+	result := []VM{}
+	for _, vm := range vms {
+		if vm.ClusterID() == clusterID {
+			result = append(result, vm)
+		}
+	}
+	return result, nil
+}
+
+func (o *oVirtClient) ListVMsOnHost(hostID HostID, retries ...RetryStrategy) ([]VM, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	host, err := o.GetHost(hostID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	qs, err := SearchField("host").Eq(host.Name()).Build()
+	if err != nil {
+		return nil, wrap(err, EBadArgument, "cannot search for VMs on host %s", hostID)
+	}
+	vms, err := o.searchVMsByQuery(qs, nil, retries)
+	if err != nil {
+		return nil, err
+	}
+	result := []VM{}
+	for _, vm := range vms {
+		if vmHostID := vm.HostID(); vmHostID != nil && *vmHostID == hostID {
+			result = append(result, vm)
+		}
+	}
+	return result, nil
+}
+
+// searchVMsByQuery runs the search query. The max and case sensitivity modifiers are taken from params if not nil.
+func (o *oVirtClient) searchVMsByQuery(
+	qs string,
+	params VMSearchParameters,
+	retries []RetryStrategy,
+) (result []VM, err error) {
+	result = []VM{}
 	err = retry(
 		"searching for VMs",
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().VmsService().List().Search(qs).Send()
+			request := o.conn.SystemService().VmsService().List().Search(qs)
+			if params != nil {
+				if max := params.Max(); max != nil {
+					request.Max(int64(*max))
+				}
+				if caseSensitive := params.CaseSensitive(); caseSensitive != nil {
+					request.CaseSensitive(*caseSensitive)
+				}
+			}
+			if allContentRequested(o.ctx) {
+				request.Header(allContentHeader, "true")
+			}
+			response, e := request.Send()
 			if e != nil {
 				return e
 			}
@@ -114,13 +191,17 @@ func (o *oVirtClient) SearchVMs(params VMSearchParameters, retries ...RetryStrat
 }
 
 func (m *mockClient) SearchVMs(params VMSearchParameters, _ ...RetryStrategy) ([]VM, error) {
+	if err := validateVMSearchMax(params); err != nil {
+		return nil, err
+	}
+	caseSensitive := params.CaseSensitive() == nil || *params.CaseSensitive()
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	// We disable the "prealloc" linter here because it recommends preallocating result, which will lead
 	// to inefficient memory usage.
 	var result []VM //nolint:prealloc
 	for _, vm := range m.vms {
-		if name := params.Name(); name != nil && vm.name != *name {
+		if name := params.Name(); name != nil && !mockSearchMatch(vm.name, *name, caseSensitive) {
 			continue
 		}
 		if statuses := params.Statuses(); statuses != nil {
@@ -147,7 +228,47 @@ func (m *mockClient) SearchVMs(params VMSearchParameters, _ ...RetryStrategy) ([
 				continue
 			}
 		}
-		result = append(result, vm)
+		if max := params.Max(); max != nil && uint(len(result)) >= *max {
+			break
+		}
+		result = append(result, vm.snapshot())
+	}
+	return result, nil
+}
+
+func mockSearchMatch(value string, search string, caseSensitive bool) bool {
+	if caseSensitive {
+		return value == search
+	}
+	return strings.EqualFold(value, search)
+}
+
+func (m *mockClient) ListVMsInCluster(clusterID ClusterID, _ ...RetryStrategy) ([]VM, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.clusters[clusterID]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	result := []VM{}
+	for _, vm := range m.vms {
+		if vm.clusterID == clusterID {
+			result = append(result, vm.snapshot())
+		}
+	}
+	return result, nil
+}
+
+func (m *mockClient) ListVMsOnHost(hostID HostID, _ ...RetryStrategy) ([]VM, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	result := []VM{}
+	for _, vm := range m.vms {
+		if vm.hostID != nil && *vm.hostID == hostID {
+			result = append(result, vm.snapshot())
+		}
 	}
 	return result, nil
 }
@@ -1,6 +1,7 @@
 package ovirtclient_test
 
 import (
+	"strings"
 	"testing"
 
 	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
@@ -26,3 +27,83 @@ func TestVMSearch(t *testing.T) {
 		t.Fatalf("Incorrect VM returned: %s", vms[0].ID())
 	}
 }
+
+func TestVMSearchModifiers(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	name := "Search-" + helper.GenerateRandomID(5)
+	vm := assertCanCreateVM(t, helper, name, nil)
+
+	vms, err := client.SearchVMs(ovirtclient.VMSearchParams().WithName(strings.ToLower(name)).WithCaseSensitive(true))
+	if err != nil {
+		t.Fatalf("Failed to search for VM (%v)", err)
+	}
+	if len(vms) != 0 {
+		t.Fatalf("Case-sensitive search returned %d VMs for a name differing in case.", len(vms))
+	}
+	vms, err = client.SearchVMs(
+		ovirtclient.VMSearchParams().WithName(strings.ToLower(name)).WithCaseSensitive(false).WithMax(1),
+	)
+	if err != nil {
+		t.Fatalf("Failed to search for VM (%v)", err)
+	}
+	if len(vms) != 1 || vms[0].ID() != vm.ID() {
+		t.Fatalf("Case-insensitive search did not return VM %s (got %d VMs)", vm.ID(), len(vms))
+	}
+
+	if _, err := client.SearchVMs(ovirtclient.VMSearchParams().WithName(name).WithMax(0)); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Searching with a max of 0 did not result in an EBadArgument error (%v)", err)
+	}
+}
+
+func TestListVMsInClusterAndOnHost(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	vms, err := client.ListVMsInCluster(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to list VMs in cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	found := false
+	for _, clusterVM := range vms {
+		if clusterVM.ClusterID() != helper.GetClusterID() {
+			t.Fatalf(
+				"VM %s from cluster %s returned for cluster %s",
+				clusterVM.ID(),
+				clusterVM.ClusterID(),
+				helper.GetClusterID(),
+			)
+		}
+		if clusterVM.ID() == vm.ID() {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("VM %s not found in cluster %s", vm.ID(), helper.GetClusterID())
+	}
+
+	host := getTestHost(t, helper)
+	vms, err = client.ListVMsOnHost(host.ID())
+	if err != nil {
+		t.Fatalf("Failed to list VMs on host %s (%v)", host.ID(), err)
+	}
+	for _, hostVM := range vms {
+		if hostVM.ID() == vm.ID() {
+			t.Fatalf("Stopped VM %s returned as running on host %s", vm.ID(), host.ID())
+		}
+	}
+
+	if _, err := client.ListVMsInCluster("00000000-0000-0000-0000-000000000001"); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.ENotFound,
+	) {
+		t.Fatalf("Listing VMs in a nonexistent cluster did not result in an ENotFound error (%v)", err)
+	}
+}
@@ -0,0 +1,191 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// SerialNumberPolicy determines the serial number the VM reports in its SMBIOS data.
+type SerialNumberPolicy string
+
+const (
+	// SerialNumberPolicyHost reports the serial number of the host the VM is running on.
+	SerialNumberPolicyHost SerialNumberPolicy = "host"
+	// SerialNumberPolicyVM reports the ID of the VM as serial number.
+	SerialNumberPolicyVM SerialNumberPolicy = "vm"
+	// SerialNumberPolicyCustom reports a user-provided serial number. This is useful for software licenses bound to
+	// the serial number.
+	SerialNumberPolicyCustom SerialNumberPolicy = "custom"
+)
+
+// SerialNumberPolicyList is a list of SerialNumberPolicy values.
+type SerialNumberPolicyList []SerialNumberPolicy
+
+// SerialNumberPolicyValues returns all possible SerialNumberPolicy values.
+func SerialNumberPolicyValues() SerialNumberPolicyList {
+	return []SerialNumberPolicy{
+		SerialNumberPolicyHost,
+		SerialNumberPolicyVM,
+		SerialNumberPolicyCustom,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l SerialNumberPolicyList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// Validate returns an error if the serial number policy is not valid.
+func (s SerialNumberPolicy) Validate() error {
+	for _, value := range SerialNumberPolicyValues() {
+		if value == s {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid serial number policy: %s, must be one of: %s",
+		s,
+		strings.Join(SerialNumberPolicyValues().Strings(), ", "),
+	)
+}
+
+// VMSerialNumber describes the serial number the VM reports in its SMBIOS data.
+type VMSerialNumber interface {
+	// Policy returns where the serial number comes from.
+	Policy() SerialNumberPolicy
+	// Value returns the custom serial number. It is only set if the policy is SerialNumberPolicyCustom.
+	Value() string
+}
+
+type vmSerialNumber struct {
+	policy SerialNumberPolicy
+	value  string
+}
+
+func (v *vmSerialNumber) Policy() SerialNumberPolicy {
+	return v.policy
+}
+
+func (v *vmSerialNumber) Value() string {
+	return v.value
+}
+
+func newVMSerialNumber(policy SerialNumberPolicy, custom string) (*vmSerialNumber, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	if policy == SerialNumberPolicyCustom && custom == "" {
+		return nil, newError(EBadArgument, "a custom serial number is required for the %s policy", policy)
+	}
+	if policy != SerialNumberPolicyCustom && custom != "" {
+		return nil, newError(
+			EBadArgument,
+			"a custom serial number can only be set with the %s policy, not %s",
+			SerialNumberPolicyCustom,
+			policy,
+		)
+	}
+	return &vmSerialNumber{
+		policy: policy,
+		value:  custom,
+	}, nil
+}
+
+func buildSDKSerialNumber(serialNumber VMSerialNumber) *ovirtsdk.SerialNumberBuilder {
+	builder := ovirtsdk.NewSerialNumberBuilder().Policy(ovirtsdk.SerialNumberPolicy(serialNumber.Policy()))
+	if serialNumber.Policy() == SerialNumberPolicyCustom {
+		builder.Value(serialNumber.Value())
+	}
+	return builder
+}
+
+func vmSerialNumberConverter(object *ovirtsdk.Vm, v *vm) error {
+	serialNumber, ok := object.SerialNumber()
+	if !ok {
+		return nil
+	}
+	policy, ok := serialNumber.Policy()
+	if !ok {
+		return nil
+	}
+	v.serialNumber = &vmSerialNumber{
+		policy: SerialNumberPolicy(policy),
+	}
+	v.serialNumber.value, _ = serialNumber.Value()
+	return nil
+}
+
+func vmSerialNumberCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if serialNumber := params.SerialNumber(); serialNumber != nil {
+		builder.SerialNumberBuilder(buildSDKSerialNumber(serialNumber))
+	}
+}
+
+func (o *oVirtClient) SetVMSerialNumber(
+	id VMID,
+	policy SerialNumberPolicy,
+	custom string,
+	retries ...RetryStrategy,
+) (result VM, err error) {
+	serialNumber, err := newVMSerialNumber(policy, custom)
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	sdkVM, err := ovirtsdk.NewVmBuilder().Id(string(id)).SerialNumberBuilder(buildSDKSerialNumber(serialNumber)).Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build VM object")
+	}
+	action := fmt.Sprintf("setting serial number policy %s on VM %s", policy, id)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().VmsService().VmService(string(id)).Update().Vm(sdkVM).Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			vm, ok := response.Vm()
+			if !ok {
+				return newError(EFieldMissing, "missing VM in VM update response")
+			}
+			result, err = convertSDKVM(vm, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert VM",
+				)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) SetVMSerialNumber(
+	id VMID,
+	policy SerialNumberPolicy,
+	custom string,
+	_ ...RetryStrategy,
+) (VM, error) {
+	serialNumber, err := newVMSerialNumber(policy, custom)
+	if err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", id)
+	}
+	item.serialNumber = serialNumber
+	return item.snapshot(), nil
+}
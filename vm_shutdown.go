@@ -5,24 +5,54 @@ import (
 	"time"
 )
 
-func (o *oVirtClient) ShutdownVM(id VMID, force bool, retries ...RetryStrategy) (err error) {
+func (o *oVirtClient) ShutdownVM(id VMID, force bool, retries ...RetryStrategy) error {
+	return o.ShutdownVMWithParams(id, StopVMParams().WithForce(force), retries...)
+}
+
+func (o *oVirtClient) ShutdownVMWithParams(id VMID, params StopVMParameters, retries ...RetryStrategy) (err error) {
+	if params == nil {
+		params = StopVMParams()
+	}
+	if err := validateActionReason(params.Reason()); err != nil {
+		return err
+	}
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := o.checkHostedEngineAllowedByID(id, params.AllowHostedEngine(), "shut down", retries); err != nil {
+		return err
+	}
 	err = retry(
 		fmt.Sprintf("shutting down VM %s", id),
 		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().VmsService().VmService(string(id)).Shutdown().Force(force).Send()
+			request := o.conn.SystemService().VmsService().VmService(string(id)).Shutdown().Force(params.Force())
+			if reason := params.Reason(); reason != "" {
+				request.Reason(reason)
+			}
+			_, err := request.Send()
 			return err
 		})
 	return
 }
 
-func (m *mockClient) ShutdownVM(id VMID, force bool, _ ...RetryStrategy) error {
+func (m *mockClient) ShutdownVM(id VMID, force bool, retries ...RetryStrategy) error {
+	return m.ShutdownVMWithParams(id, StopVMParams().WithForce(force), retries...)
+}
+
+func (m *mockClient) ShutdownVMWithParams(id VMID, params StopVMParameters, _ ...RetryStrategy) error {
+	if params == nil {
+		params = StopVMParams()
+	}
+	if err := validateActionReason(params.Reason()); err != nil {
+		return err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.vms[id]; ok {
-		if (item.status == VMStatusSavingState || item.status == VMStatusRestoringState) && !force {
+		if err := checkHostedEngineAllowed(id, item.hostedEngine, params.AllowHostedEngine(), "shut down"); err != nil {
+			return err
+		}
+		if (item.status == VMStatusSavingState || item.status == VMStatusRestoringState) && !params.Force() {
 			return newError(EConflict, "VM is currently backing up or restoring.")
 		}
 		if item.status != VMStatusDown {
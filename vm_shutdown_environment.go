@@ -0,0 +1,123 @@
+package ovirtclient
+
+import (
+	"time"
+)
+
+func (o *oVirtClient) ShutdownEnvironment(
+	vmIDs []VMID,
+	order [][]VMID,
+	gracePeriod time.Duration,
+	retries ...RetryStrategy,
+) error {
+	return shutdownEnvironment(o, o.logger, batchProgressFromContext(o.ctx), vmIDs, order, gracePeriod, retries)
+}
+
+func (m *mockClient) ShutdownEnvironment(
+	vmIDs []VMID,
+	order [][]VMID,
+	gracePeriod time.Duration,
+	retries ...RetryStrategy,
+) error {
+	return shutdownEnvironment(m, m.logger, batchProgressFromContext(m.ctx), vmIDs, order, gracePeriod, retries)
+}
+
+// shutdownTiers validates the order against the VM list and returns the tiers to shut down. VMs that are not part
+// of any tier in order form an additional last tier.
+func shutdownTiers(vmIDs []VMID, order [][]VMID) ([][]VMID, error) {
+	remaining := map[VMID]bool{}
+	for _, id := range vmIDs {
+		if remaining[id] {
+			return nil, newError(EBadArgument, "VM %s appears twice in the VM list", id)
+		}
+		remaining[id] = true
+	}
+	tiers := make([][]VMID, 0, len(order)+1)
+	for i, tier := range order {
+		for _, id := range tier {
+			if !remaining[id] {
+				return nil, newError(
+					EBadArgument,
+					"VM %s in shutdown tier %d is not in the VM list or appears in more than one tier",
+					id,
+					i,
+				)
+			}
+			delete(remaining, id)
+		}
+		if len(tier) > 0 {
+			tiers = append(tiers, tier)
+		}
+	}
+	var lastTier []VMID
+	for _, id := range vmIDs {
+		if remaining[id] {
+			lastTier = append(lastTier, id)
+		}
+	}
+	if len(lastTier) > 0 {
+		tiers = append(tiers, lastTier)
+	}
+	return tiers, nil
+}
+
+func shutdownEnvironment(
+	client Client,
+	logger Logger,
+	progress BatchProgress,
+	vmIDs []VMID,
+	order [][]VMID,
+	gracePeriod time.Duration,
+	retries []RetryStrategy,
+) error {
+	if gracePeriod <= 0 {
+		return newError(EBadArgument, "the shutdown grace period must be positive (%s given)", gracePeriod)
+	}
+	tiers, err := shutdownTiers(vmIDs, order)
+	if err != nil {
+		return err
+	}
+	// Register all VMs up front so the progress shows the later tiers as pending while the first ones shut down.
+	registerBatchItems(progress, vmIDStrings(vmIDs))
+	vmErrors := map[string]error{}
+	for i, tier := range tiers {
+		logger.Infof("Shutting down tier %d of %d (%d VMs)...", i+1, len(tiers), len(tier))
+		tierErrors := runTrackedBulkOperation(progress, vmIDStrings(tier), func(j int) error {
+			return shutdownEnvironmentVM(client, logger, tier[j], gracePeriod, retries)
+		})
+		for id, err := range tierErrors {
+			vmErrors[id] = err
+		}
+	}
+	return newMultiError("shutting down environment", len(vmIDs), vmErrors)
+}
+
+// shutdownEnvironmentVM shuts down a single VM and waits the grace period for it to reach the down status. If it
+// doesn't, the VM is powered off.
+func shutdownEnvironmentVM(
+	client Client,
+	logger Logger,
+	id VMID,
+	gracePeriod time.Duration,
+	retries []RetryStrategy,
+) error {
+	vm, err := client.GetVM(id, retries...)
+	if err != nil {
+		return err
+	}
+	if vm.Status() == VMStatusDown {
+		return nil
+	}
+	if err := client.ShutdownVM(id, false, retries...); err == nil {
+		graceRetries := append(append([]RetryStrategy{}, retries...), Timeout(gracePeriod))
+		if _, err := client.WaitForVMStatus(id, VMStatusDown, graceRetries...); err == nil {
+			return nil
+		}
+	}
+	logger.Warningf("VM %s did not shut down within %s, powering it off.", id, gracePeriod)
+	if err := client.StopVM(id, false, retries...); err != nil {
+		return wrap(err, EUnidentified, "failed to power off VM %s after the grace period", id)
+	}
+	_, err = client.WaitForVMStatus(id, VMStatusDown, retries...)
+	return err
+}
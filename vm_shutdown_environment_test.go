@@ -0,0 +1,43 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestShutdownEnvironment(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	var vmIDs []ovirtclient.VMID
+	for i := 0; i < 3; i++ {
+		vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+		disk := assertCanCreateDisk(t, helper)
+		assertCanUploadDiskImage(t, helper, disk)
+		assertCanAttachDiskWithParams(
+			t,
+			vm,
+			disk,
+			ovirtclient.CreateDiskAttachmentParams().MustWithBootable(true).MustWithActive(true),
+		)
+		vmIDs = append(vmIDs, vm.ID())
+	}
+	if err := client.StartVMs(vmIDs); err != nil {
+		t.Fatalf("Failed to start VMs (%v)", err)
+	}
+
+	order := [][]ovirtclient.VMID{{vmIDs[0]}, {vmIDs[1]}}
+	if err := client.ShutdownEnvironment(vmIDs, order, time.Minute); err != nil {
+		t.Fatalf("Failed to shut down environment (%v)", err)
+	}
+	assertVMsHaveStatus(t, client, vmIDs, ovirtclient.VMStatusDown)
+
+	missingVMID := ovirtclient.VMID("00000000-0000-0000-0000-000000000001")
+	err := client.ShutdownEnvironment(vmIDs, [][]ovirtclient.VMID{{missingVMID}}, time.Minute)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("A tier with a VM outside of the VM list did not result in an EBadArgument error (%v)", err)
+	}
+}
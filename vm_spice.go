@@ -0,0 +1,165 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// DisplayType is the protocol of the graphical display of a VM.
+type DisplayType string
+
+const (
+	// DisplayTypeSpice is the SPICE protocol, which supports file transfer and a shared clipboard.
+	DisplayTypeSpice DisplayType = "spice"
+	// DisplayTypeVNC is the VNC protocol.
+	DisplayTypeVNC DisplayType = "vnc"
+)
+
+// DisplayTypeList is a list of DisplayType values.
+type DisplayTypeList []DisplayType
+
+// DisplayTypeValues returns all possible DisplayType values.
+func DisplayTypeValues() DisplayTypeList {
+	return []DisplayType{
+		DisplayTypeSpice,
+		DisplayTypeVNC,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l DisplayTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+type vmDisplay struct {
+	displayType  DisplayType
+	fileTransfer bool
+	copyPaste    bool
+}
+
+func vmDisplayConverter(object *ovirtsdk.Vm, v *vm) error {
+	// The engine enables both SPICE options unless they are turned off explicitly.
+	v.display = vmDisplay{fileTransfer: true, copyPaste: true}
+	display, ok := object.Display()
+	if !ok {
+		return nil
+	}
+	if displayType, ok := display.Type(); ok {
+		v.display.displayType = DisplayType(displayType)
+	}
+	if fileTransfer, ok := display.FileTransferEnabled(); ok {
+		v.display.fileTransfer = fileTransfer
+	}
+	if copyPaste, ok := display.CopyPasteEnabled(); ok {
+		v.display.copyPaste = copyPaste
+	}
+	return nil
+}
+
+func vmSpiceCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	fileTransfer := params.SpiceFileTransfer()
+	copyPaste := params.SpiceCopyPaste()
+	if fileTransfer == nil && copyPaste == nil {
+		return
+	}
+	displayBuilder := ovirtsdk.NewDisplayBuilder()
+	if fileTransfer != nil {
+		displayBuilder.FileTransferEnabled(*fileTransfer)
+	}
+	if copyPaste != nil {
+		displayBuilder.CopyPasteEnabled(*copyPaste)
+	}
+	builder.DisplayBuilder(displayBuilder)
+}
+
+// checkSpiceDisplay returns an EConflict error if the VM has no SPICE display, since the engine would silently
+// accept the SPICE options without them having any effect.
+func checkSpiceDisplay(vm VMData) error {
+	if vm.DisplayType() != DisplayTypeSpice {
+		return newError(
+			EConflict,
+			"VM %s has no SPICE display (display type: %q), the SPICE options don't apply",
+			vm.ID(),
+			vm.DisplayType(),
+		)
+	}
+	return nil
+}
+
+func (o *oVirtClient) SetVMSpiceFileTransfer(id VMID, enabled bool, retries ...RetryStrategy) error {
+	return o.setVMSpiceOption(id, "SPICE file transfer", func(display *ovirtsdk.Display) {
+		display.SetFileTransferEnabled(enabled)
+	}, retries)
+}
+
+func (o *oVirtClient) SetVMSpiceCopyPaste(id VMID, enabled bool, retries ...RetryStrategy) error {
+	return o.setVMSpiceOption(id, "SPICE copy and paste", func(display *ovirtsdk.Display) {
+		display.SetCopyPasteEnabled(enabled)
+	}, retries)
+}
+
+func (o *oVirtClient) setVMSpiceOption(
+	id VMID,
+	field string,
+	setter func(display *ovirtsdk.Display),
+	retries []RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	vm, err := o.GetVM(id, retries...)
+	if err != nil {
+		return err
+	}
+	if err := checkSpiceDisplay(vm); err != nil {
+		return err
+	}
+	display := &ovirtsdk.Display{}
+	setter(display)
+	sdkVM := &ovirtsdk.Vm{}
+	sdkVM.SetId(string(id))
+	sdkVM.SetDisplay(display)
+	return o.updateVMField(id, sdkVM, field, retries)
+}
+
+func (m *mockClient) createVMDisplay(params OptionalVMParameters) vmDisplay {
+	display := vmDisplay{
+		displayType:  DisplayTypeSpice,
+		fileTransfer: true,
+		copyPaste:    true,
+	}
+	if fileTransfer := params.SpiceFileTransfer(); fileTransfer != nil {
+		display.fileTransfer = *fileTransfer
+	}
+	if copyPaste := params.SpiceCopyPaste(); copyPaste != nil {
+		display.copyPaste = *copyPaste
+	}
+	return display
+}
+
+func (m *mockClient) SetVMSpiceFileTransfer(id VMID, enabled bool, _ ...RetryStrategy) error {
+	return m.setVMSpiceOption(id, func(display *vmDisplay) {
+		display.fileTransfer = enabled
+	})
+}
+
+func (m *mockClient) SetVMSpiceCopyPaste(id VMID, enabled bool, _ ...RetryStrategy) error {
+	return m.setVMSpiceOption(id, func(display *vmDisplay) {
+		display.copyPaste = enabled
+	})
+}
+
+func (m *mockClient) setVMSpiceOption(id VMID, setter func(display *vmDisplay)) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", id)
+	}
+	if err := checkSpiceDisplay(item); err != nil {
+		return err
+	}
+	setter(&item.display)
+	return nil
+}
@@ -0,0 +1,25 @@
+package ovirtclient
+
+import (
+	"testing"
+)
+
+func TestSetSpiceOptionsOnVNCDisplay(t *testing.T) {
+	m := NewMock().(*mockClient)
+	var clusterID ClusterID
+	for id := range m.clusters {
+		clusterID = id
+		break
+	}
+	vm, err := m.CreateVM(clusterID, DefaultBlankTemplateID, "vnc", nil)
+	if err != nil {
+		t.Fatalf("Failed to create test VM (%v)", err)
+	}
+	m.lock.Lock()
+	m.vms[vm.ID()].display.displayType = DisplayTypeVNC
+	m.lock.Unlock()
+
+	if err := m.SetVMSpiceFileTransfer(vm.ID(), false); !HasErrorCode(err, EConflict) {
+		t.Fatalf("Setting SPICE file transfer on a VNC VM did not result in an EConflict error (%v)", err)
+	}
+}
@@ -0,0 +1,42 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMSpiceOptions(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().
+			MustWithSpiceFileTransfer(false).
+			MustWithSpiceCopyPaste(false),
+	)
+	if vm.DisplayType() != ovirtclient.DisplayTypeSpice {
+		t.Skipf("The test VM has no SPICE display (display type: %q).", vm.DisplayType())
+	}
+	if vm.SpiceFileTransfer() || vm.SpiceCopyPaste() {
+		t.Fatalf("The SPICE options are still enabled after creating the VM with them disabled.")
+	}
+
+	if err := client.SetVMSpiceCopyPaste(vm.ID(), true); err != nil {
+		t.Fatalf("Failed to enable SPICE copy and paste on VM %s (%v)", vm.ID(), err)
+	}
+	vm, err := client.GetVM(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch VM %s (%v)", vm.ID(), err)
+	}
+	if !vm.SpiceCopyPaste() {
+		t.Fatalf("SPICE copy and paste is not enabled after setting it.")
+	}
+	if vm.SpiceFileTransfer() {
+		t.Fatalf("Enabling SPICE copy and paste also enabled file transfer.")
+	}
+}
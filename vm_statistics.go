@@ -0,0 +1,194 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// The names of the statistics the engine reports for VMs, NICs and disks. Rates are reported in bytes per second.
+const (
+	vmCPUUsageStatistic        = "cpu.current.total"
+	vmMemoryInstalledStatistic = "memory.installed"
+	vmMemoryUsedStatistic      = "memory.used"
+	vmMemoryFreeStatistic      = "memory.free"
+	nicReceiveRateStatistic    = "data.current.rx"
+	nicTransmitRateStatistic   = "data.current.tx"
+	diskReadRateStatistic      = "data.current.read"
+	diskWriteRateStatistic     = "data.current.write"
+)
+
+// VMStatistics is a snapshot of the resource usage of a VM, as returned by GetVMStatistics. The engine only reports
+// statistics for running VMs and leaves out the ones the guest agent or the hypervisor doesn't provide. Missing
+// values are returned as 0.
+type VMStatistics interface {
+	// VMID returns the ID of the VM the statistics belong to.
+	VMID() VMID
+	// CPUUsage returns the CPU usage of the VM in percent, including the time spent in the hypervisor.
+	CPUUsage() float64
+	// MemoryInstalled returns the memory of the VM in bytes.
+	MemoryInstalled() uint64
+	// MemoryUsed returns the memory in bytes the guest operating system uses.
+	MemoryUsed() uint64
+	// MemoryFree returns the memory in bytes the guest operating system reports as free.
+	MemoryFree() uint64
+	// NetworkReceiveRate returns the bytes per second received on all NICs of the VM.
+	NetworkReceiveRate() uint64
+	// NetworkTransmitRate returns the bytes per second transmitted on all NICs of the VM.
+	NetworkTransmitRate() uint64
+	// DiskReadRate returns the bytes per second read from all disks of the VM.
+	DiskReadRate() uint64
+	// DiskWriteRate returns the bytes per second written to all disks of the VM.
+	DiskWriteRate() uint64
+}
+
+type vmStatistics struct {
+	vmID                VMID
+	cpuUsage            float64
+	memoryInstalled     uint64
+	memoryUsed          uint64
+	memoryFree          uint64
+	networkReceiveRate  uint64
+	networkTransmitRate uint64
+	diskReadRate        uint64
+	diskWriteRate       uint64
+}
+
+func (v vmStatistics) VMID() VMID {
+	return v.vmID
+}
+
+func (v vmStatistics) CPUUsage() float64 {
+	return v.cpuUsage
+}
+
+func (v vmStatistics) MemoryInstalled() uint64 {
+	return v.memoryInstalled
+}
+
+func (v vmStatistics) MemoryUsed() uint64 {
+	return v.memoryUsed
+}
+
+func (v vmStatistics) MemoryFree() uint64 {
+	return v.memoryFree
+}
+
+func (v vmStatistics) NetworkReceiveRate() uint64 {
+	return v.networkReceiveRate
+}
+
+func (v vmStatistics) NetworkTransmitRate() uint64 {
+	return v.networkTransmitRate
+}
+
+func (v vmStatistics) DiskReadRate() uint64 {
+	return v.diskReadRate
+}
+
+func (v vmStatistics) DiskWriteRate() uint64 {
+	return v.diskWriteRate
+}
+
+// statisticDatum returns the first value of the named statistic. Statistics without a value and negative values,
+// which the engine uses for unknown counters, are treated as missing.
+func statisticDatum(statistics *ovirtsdk.StatisticSlice, name string) (float64, bool) {
+	if statistics == nil {
+		return 0, false
+	}
+	for _, statistic := range statistics.Slice() {
+		if statisticName, _ := statistic.Name(); statisticName != name {
+			continue
+		}
+		values, ok := statistic.Values()
+		if !ok || len(values.Slice()) == 0 {
+			return 0, false
+		}
+		datum, ok := values.Slice()[0].Datum()
+		if !ok || datum < 0 {
+			return 0, false
+		}
+		return datum, true
+	}
+	return 0, false
+}
+
+func statisticUint(statistics *ovirtsdk.StatisticSlice, name string) uint64 {
+	datum, _ := statisticDatum(statistics, name)
+	return uint64(datum)
+}
+
+func convertSDKVMStatistics(id VMID, object *ovirtsdk.Vm) vmStatistics {
+	vmStats, _ := object.Statistics()
+	cpuUsage, _ := statisticDatum(vmStats, vmCPUUsageStatistic)
+	result := vmStatistics{
+		vmID:            id,
+		cpuUsage:        cpuUsage,
+		memoryInstalled: statisticUint(vmStats, vmMemoryInstalledStatistic),
+		memoryUsed:      statisticUint(vmStats, vmMemoryUsedStatistic),
+		memoryFree:      statisticUint(vmStats, vmMemoryFreeStatistic),
+	}
+	if nics, ok := object.Nics(); ok {
+		for _, nic := range nics.Slice() {
+			nicStats, _ := nic.Statistics()
+			result.networkReceiveRate += statisticUint(nicStats, nicReceiveRateStatistic)
+			result.networkTransmitRate += statisticUint(nicStats, nicTransmitRateStatistic)
+		}
+	}
+	if attachments, ok := object.DiskAttachments(); ok {
+		for _, attachment := range attachments.Slice() {
+			disk, ok := attachment.Disk()
+			if !ok {
+				continue
+			}
+			diskStats, _ := disk.Statistics()
+			result.diskReadRate += statisticUint(diskStats, diskReadRateStatistic)
+			result.diskWriteRate += statisticUint(diskStats, diskWriteRateStatistic)
+		}
+	}
+	return result
+}
+
+func (o *oVirtClient) GetVMStatistics(id VMID, retries ...RetryStrategy) (result VMStatistics, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("getting statistics of VM %s", id)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(id)).
+				Get().
+				Follow("statistics,nics.statistics,disk_attachments.disk.statistics").
+				Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			sdkVM, ok := response.Vm()
+			if !ok {
+				return newError(ENotFound, "no VM returned when getting statistics of VM %s", id)
+			}
+			result = convertSDKVMStatistics(id, sdkVM)
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetVMStatistics(id VMID, _ ...RetryStrategy) (VMStatistics, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", id)
+	}
+	result := vmStatistics{vmID: id}
+	// The mock has no workload, so a running VM reports all of its memory as free and no activity.
+	if item.Status() == VMStatusUp {
+		result.memoryInstalled = uint64(item.memory)
+		result.memoryFree = uint64(item.memory)
+	}
+	return result, nil
+}
@@ -0,0 +1,65 @@
+package ovirtclient
+
+import (
+	"testing"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func newTestStatistic(name string, datum float64) *ovirtsdk.StatisticBuilder {
+	return ovirtsdk.NewStatisticBuilder().
+		Name(name).
+		ValuesBuilderOfAny(*ovirtsdk.NewValueBuilder().Datum(datum))
+}
+
+func TestConvertSDKVMStatistics(t *testing.T) {
+	sdkVM := ovirtsdk.NewVmBuilder().
+		Id("test").
+		StatisticsBuilderOfAny(
+			*newTestStatistic(vmCPUUsageStatistic, 12.5),
+			*newTestStatistic(vmMemoryInstalledStatistic, 1024),
+			*newTestStatistic(vmMemoryUsedStatistic, 256),
+			*newTestStatistic("elapsed.time", 60),
+		).
+		NicsBuilderOfAny(
+			*ovirtsdk.NewNicBuilder().StatisticsBuilderOfAny(
+				*newTestStatistic(nicReceiveRateStatistic, 100),
+				*newTestStatistic(nicTransmitRateStatistic, 10),
+			),
+			*ovirtsdk.NewNicBuilder().StatisticsBuilderOfAny(
+				*newTestStatistic(nicReceiveRateStatistic, 50),
+				*newTestStatistic(nicTransmitRateStatistic, -1),
+			),
+		).
+		DiskAttachmentsBuilderOfAny(
+			*ovirtsdk.NewDiskAttachmentBuilder().DiskBuilder(
+				ovirtsdk.NewDiskBuilder().StatisticsBuilderOfAny(
+					*newTestStatistic(diskReadRateStatistic, 4096),
+					*newTestStatistic(diskWriteRateStatistic, 512),
+				),
+			),
+			*ovirtsdk.NewDiskAttachmentBuilder(),
+		).
+		MustBuild()
+
+	stats := convertSDKVMStatistics("test", sdkVM)
+	if stats.CPUUsage() != 12.5 {
+		t.Fatalf("Incorrect CPU usage (expected: %f, got: %f)", 12.5, stats.CPUUsage())
+	}
+	if stats.MemoryInstalled() != 1024 || stats.MemoryUsed() != 256 {
+		t.Fatalf("Incorrect memory statistics (installed: %d, used: %d)", stats.MemoryInstalled(), stats.MemoryUsed())
+	}
+	if stats.MemoryFree() != 0 {
+		t.Fatalf("Missing free memory statistic not reported as 0 (got: %d)", stats.MemoryFree())
+	}
+	if stats.NetworkReceiveRate() != 150 || stats.NetworkTransmitRate() != 10 {
+		t.Fatalf(
+			"Incorrect network statistics (receive: %d, transmit: %d)",
+			stats.NetworkReceiveRate(),
+			stats.NetworkTransmitRate(),
+		)
+	}
+	if stats.DiskReadRate() != 4096 || stats.DiskWriteRate() != 512 {
+		t.Fatalf("Incorrect disk statistics (read: %d, write: %d)", stats.DiskReadRate(), stats.DiskWriteRate())
+	}
+}
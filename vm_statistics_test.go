@@ -0,0 +1,22 @@
+package ovirtclient_test
+
+import (
+	"testing"
+)
+
+func TestGetVMStatistics(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	stats, err := vm.Statistics()
+	if err != nil {
+		t.Fatalf("Failed to get statistics of VM %s (%v)", vm.ID(), err)
+	}
+	if stats.VMID() != vm.ID() {
+		t.Fatalf("Incorrect VM ID on statistics (expected: %s, got: %s)", vm.ID(), stats.VMID())
+	}
+	if stats.NetworkReceiveRate() != 0 || stats.DiskReadRate() != 0 {
+		t.Fatalf("A VM that is down reports network or disk activity.")
+	}
+}
@@ -4,26 +4,135 @@ import (
 	"fmt"
 	"net"
 	"time"
+	"unicode/utf8"
 )
 
-func (o *oVirtClient) StopVM(id VMID, force bool, retries ...RetryStrategy) (err error) {
+// StopVMParameters contains the optional parameters for stopping or shutting down a VM.
+type StopVMParameters interface {
+	// Force causes the VM to stop even if a backup is currently running.
+	Force() bool
+	// AllowHostedEngine permits stopping the hosted engine VM. Stopping the hosted engine VM takes the oVirt Engine
+	// down with it, so without this flag the operation fails with an EConflict error.
+	AllowHostedEngine() bool
+	// Reason returns the reason recorded in the audit log of the oVirt Engine, or an empty string if none is set.
+	Reason() string
+}
+
+// ActionReasonMaxLength is the maximum number of characters the oVirt Engine stores as the reason of a VM stop,
+// VM shutdown or host maintenance.
+const ActionReasonMaxLength = 4000
+
+// BuildableStopVMParameters is a buildable version of StopVMParameters.
+type BuildableStopVMParameters interface {
+	StopVMParameters
+
+	// WithForce sets the force flag.
+	WithForce(force bool) BuildableStopVMParameters
+	// WithAllowHostedEngine sets the flag that permits stopping the hosted engine VM.
+	WithAllowHostedEngine(allowHostedEngine bool) BuildableStopVMParameters
+	// WithReason sets the reason recorded in the audit log. The length is checked when the VM is stopped, an overly
+	// long reason results in an EBadArgument error.
+	WithReason(reason string) BuildableStopVMParameters
+}
+
+// StopVMParams creates a new set of parameters for StopVMWithParams and ShutdownVMWithParams.
+func StopVMParams() BuildableStopVMParameters {
+	return &stopVMParams{}
+}
+
+type stopVMParams struct {
+	force             bool
+	allowHostedEngine bool
+	reason            string
+}
+
+func (s *stopVMParams) Force() bool {
+	return s.force
+}
+
+func (s *stopVMParams) AllowHostedEngine() bool {
+	return s.allowHostedEngine
+}
+
+func (s *stopVMParams) Reason() string {
+	return s.reason
+}
+
+func (s *stopVMParams) WithForce(force bool) BuildableStopVMParameters {
+	s.force = force
+	return s
+}
+
+func (s *stopVMParams) WithAllowHostedEngine(allowHostedEngine bool) BuildableStopVMParameters {
+	s.allowHostedEngine = allowHostedEngine
+	return s
+}
+
+func (s *stopVMParams) WithReason(reason string) BuildableStopVMParameters {
+	s.reason = reason
+	return s
+}
+
+func validateActionReason(reason string) error {
+	if length := utf8.RuneCountInString(reason); length > ActionReasonMaxLength {
+		return newError(
+			EBadArgument,
+			"the reason must be at most %d characters long (%d given)",
+			ActionReasonMaxLength,
+			length,
+		)
+	}
+	return nil
+}
+
+func (o *oVirtClient) StopVM(id VMID, force bool, retries ...RetryStrategy) error {
+	return o.StopVMWithParams(id, StopVMParams().WithForce(force), retries...)
+}
+
+func (o *oVirtClient) StopVMWithParams(id VMID, params StopVMParameters, retries ...RetryStrategy) (err error) {
+	if params == nil {
+		params = StopVMParams()
+	}
+	if err := validateActionReason(params.Reason()); err != nil {
+		return err
+	}
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := o.checkHostedEngineAllowedByID(id, params.AllowHostedEngine(), "stop", retries); err != nil {
+		return err
+	}
 	err = retry(
 		fmt.Sprintf("stopping VM %s", id),
 		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().VmsService().VmService(string(id)).Stop().Force(force).Send()
+			request := o.conn.SystemService().VmsService().VmService(string(id)).Stop().Force(params.Force())
+			if reason := params.Reason(); reason != "" {
+				request.Reason(reason)
+			}
+			_, err := request.Send()
 			return err
 		})
 	return
 }
 
-func (m *mockClient) StopVM(id VMID, force bool, _ ...RetryStrategy) error {
+func (m *mockClient) StopVM(id VMID, force bool, retries ...RetryStrategy) error {
+	return m.StopVMWithParams(id, StopVMParams().WithForce(force), retries...)
+}
+
+func (m *mockClient) StopVMWithParams(id VMID, params StopVMParameters, _ ...RetryStrategy) error {
+	if params == nil {
+		params = StopVMParams()
+	}
+	if err := validateActionReason(params.Reason()); err != nil {
+		return err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.vms[id]; ok {
-		if (item.status == VMStatusSavingState || item.status == VMStatusRestoringState) && !force {
+		if err := checkHostedEngineAllowed(id, item.hostedEngine, params.AllowHostedEngine(), "stop"); err != nil {
+			return err
+		}
+		if (item.status == VMStatusSavingState || item.status == VMStatusRestoringState) && !params.Force() {
 			return newError(EConflict, "VM is currently backing up or restoring.")
 		}
 		m.vmIPs[id] = map[string][]net.IP{}
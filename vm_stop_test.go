@@ -1,6 +1,11 @@
 package ovirtclient_test
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
 
 func TestStoppingAlreadyStoppedVM(t *testing.T) {
 	helper := getHelper(t)
@@ -10,3 +15,25 @@ func TestStoppingAlreadyStoppedVM(t *testing.T) {
 		t.Fatalf("Failed to issue stop command on already-stopped VM (%v)", err)
 	}
 }
+
+func TestStopVMWithReason(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	if err := client.StopVMWithParams(
+		vm.ID(),
+		ovirtclient.StopVMParams().WithReason("scheduled maintenance window"),
+	); err != nil {
+		t.Fatalf("Failed to stop VM with a reason (%v)", err)
+	}
+
+	tooLong := ovirtclient.StopVMParams().WithReason(strings.Repeat("a", ovirtclient.ActionReasonMaxLength+1))
+	if err := client.StopVMWithParams(vm.ID(), tooLong); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Stopping a VM with an overly long reason did not result in an EBadArgument error (%v)", err)
+	}
+	if err := client.ShutdownVMWithParams(vm.ID(), tooLong); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Shutting down a VM with an overly long reason did not result in an EBadArgument error (%v)", err)
+	}
+}
@@ -0,0 +1,111 @@
+package ovirtclient
+
+import (
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// StorageErrorResumeBehaviour determines what a VM that was paused because of a storage I/O error does once the
+// storage is available again.
+type StorageErrorResumeBehaviour string
+
+const (
+	// StorageErrorResumeBehaviourAutoResume resumes the VM automatically. This is the engine default for VMs that
+	// are not highly available.
+	StorageErrorResumeBehaviourAutoResume StorageErrorResumeBehaviour = "auto_resume"
+	// StorageErrorResumeBehaviourLeavePaused keeps the VM paused until it is resumed or powered off manually.
+	StorageErrorResumeBehaviourLeavePaused StorageErrorResumeBehaviour = "leave_paused"
+	// StorageErrorResumeBehaviourKill powers off the VM if it isn't resumed within a timeout. Highly available VMs
+	// are then restarted, possibly on another host, which avoids resuming a VM whose workload has already failed
+	// over. This is the engine default for highly available VMs with a lease.
+	StorageErrorResumeBehaviourKill StorageErrorResumeBehaviour = "kill"
+)
+
+// StorageErrorResumeBehaviourList is a list of StorageErrorResumeBehaviour values.
+type StorageErrorResumeBehaviourList []StorageErrorResumeBehaviour
+
+// StorageErrorResumeBehaviourValues returns all possible StorageErrorResumeBehaviour values.
+func StorageErrorResumeBehaviourValues() StorageErrorResumeBehaviourList {
+	return []StorageErrorResumeBehaviour{
+		StorageErrorResumeBehaviourAutoResume,
+		StorageErrorResumeBehaviourLeavePaused,
+		StorageErrorResumeBehaviourKill,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l StorageErrorResumeBehaviourList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// Validate returns an error if the storage error resume behaviour is not valid.
+func (s StorageErrorResumeBehaviour) Validate() error {
+	for _, value := range StorageErrorResumeBehaviourValues() {
+		if value == s {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid storage error resume behaviour: %s, must be one of: %s",
+		s,
+		strings.Join(StorageErrorResumeBehaviourValues().Strings(), ", "),
+	)
+}
+
+func vmStorageErrorResumeBehaviourConverter(object *ovirtsdk.Vm, v *vm) error {
+	if behaviour, ok := object.StorageErrorResumeBehaviour(); ok {
+		v.storageErrorResumeBehaviour = StorageErrorResumeBehaviour(behaviour)
+	}
+	return nil
+}
+
+func vmStorageErrorResumeBehaviourCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if behaviour := params.StorageErrorResumeBehaviour(); behaviour != nil {
+		builder.StorageErrorResumeBehaviour(ovirtsdk.VmStorageErrorResumeBehaviour(*behaviour))
+	}
+}
+
+func (o *oVirtClient) SetVMStorageErrorResumeBehaviour(
+	id VMID,
+	behaviour StorageErrorResumeBehaviour,
+	retries ...RetryStrategy,
+) error {
+	if err := behaviour.Validate(); err != nil {
+		return err
+	}
+	sdkVM := &ovirtsdk.Vm{}
+	sdkVM.SetId(string(id))
+	sdkVM.SetStorageErrorResumeBehaviour(ovirtsdk.VmStorageErrorResumeBehaviour(behaviour))
+	return o.updateVMField(id, sdkVM, "storage error resume behaviour", retries)
+}
+
+func (m *mockClient) createVMStorageErrorResumeBehaviour(params OptionalVMParameters) StorageErrorResumeBehaviour {
+	if behaviour := params.StorageErrorResumeBehaviour(); behaviour != nil {
+		return *behaviour
+	}
+	return StorageErrorResumeBehaviourAutoResume
+}
+
+func (m *mockClient) SetVMStorageErrorResumeBehaviour(
+	id VMID,
+	behaviour StorageErrorResumeBehaviour,
+	_ ...RetryStrategy,
+) error {
+	if err := behaviour.Validate(); err != nil {
+		return err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", id)
+	}
+	item.storageErrorResumeBehaviour = behaviour
+	return nil
+}
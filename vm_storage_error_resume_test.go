@@ -0,0 +1,54 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMStorageErrorResumeBehaviour(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	if _, err := ovirtclient.NewCreateVMParams().WithStorageErrorResumeBehaviour("pause"); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting an invalid storage error resume behaviour did not result in an EBadArgument error (%v)", err)
+	}
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithStorageErrorResumeBehaviour(
+			ovirtclient.StorageErrorResumeBehaviourLeavePaused,
+		),
+	)
+	if behaviour := vm.StorageErrorResumeBehaviour(); behaviour != ovirtclient.StorageErrorResumeBehaviourLeavePaused {
+		t.Fatalf(
+			"Incorrect storage error resume behaviour (expected: %s, got: %s)",
+			ovirtclient.StorageErrorResumeBehaviourLeavePaused,
+			behaviour,
+		)
+	}
+
+	if err := client.SetVMStorageErrorResumeBehaviour(
+		vm.ID(),
+		ovirtclient.StorageErrorResumeBehaviourAutoResume,
+	); err != nil {
+		t.Fatalf("Failed to set the storage error resume behaviour (%v)", err)
+	}
+	updatedVM, err := client.GetVM(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch VM %s (%v)", vm.ID(), err)
+	}
+	if behaviour := updatedVM.StorageErrorResumeBehaviour(); behaviour != ovirtclient.StorageErrorResumeBehaviourAutoResume {
+		t.Fatalf(
+			"Incorrect storage error resume behaviour after update (expected: %s, got: %s)",
+			ovirtclient.StorageErrorResumeBehaviourAutoResume,
+			behaviour,
+		)
+	}
+}
@@ -0,0 +1,66 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sync"
+)
+
+// bulkOperationWorkers is the number of concurrent API calls bulk operations make against the oVirt Engine.
+const bulkOperationWorkers = 8
+
+func (o *oVirtClient) AddTagToVMs(tagID TagID, vmIDs []VMID, retries ...RetryStrategy) error {
+	return addTagToVMs(o, batchProgressFromContext(o.ctx), tagID, vmIDs, retries)
+}
+
+func (m *mockClient) AddTagToVMs(tagID TagID, vmIDs []VMID, retries ...RetryStrategy) error {
+	return addTagToVMs(m, batchProgressFromContext(m.ctx), tagID, vmIDs, retries)
+}
+
+func addTagToVMs(client Client, progress BatchProgress, tagID TagID, vmIDs []VMID, retries []RetryStrategy) error {
+	if _, err := client.GetTag(tagID, retries...); err != nil {
+		return err
+	}
+	vmErrors := runTrackedBulkOperation(progress, vmIDStrings(vmIDs), func(i int) error {
+		return addTagToVMIfMissing(client, vmIDs[i], tagID, retries)
+	})
+	return newMultiError(fmt.Sprintf("adding tag %s to VMs", tagID), len(vmIDs), vmErrors)
+}
+
+// addTagToVMIfMissing adds the tag to the VM unless the VM already has it, which the oVirt Engine would reject.
+func addTagToVMIfMissing(client Client, vmID VMID, tagID TagID, retries []RetryStrategy) error {
+	tags, err := client.ListVMTags(vmID, retries...)
+	if err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		if tag.ID() == tagID {
+			return nil
+		}
+	}
+	return client.AddTagToVM(vmID, tagID, retries...)
+}
+
+// runBulkOperation calls fn for each index from 0 to count-1 using at most bulkOperationWorkers goroutines and
+// returns once all calls have finished.
+func runBulkOperation(count int, fn func(i int)) {
+	indexes := make(chan int)
+	wg := &sync.WaitGroup{}
+	workers := bulkOperationWorkers
+	if count < workers {
+		workers = count
+	}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(i)
+			}
+		}()
+	}
+	for i := 0; i < count; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
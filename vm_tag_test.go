@@ -1,6 +1,7 @@
 package ovirtclient_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -56,6 +57,41 @@ func TestVMTagAssignemnt(t *testing.T) {
 	}
 }
 
+func TestAddTagToVMs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm1 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	vm2 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	tag := assertCanCreateTag(t, helper, helper.GenerateTestResourceName(t), "")
+	assertCanAddTagToVM(t, vm1, tag)
+
+	if err := client.AddTagToVMs(tag.ID(), []ovirtclient.VMID{vm1.ID(), vm2.ID()}); err != nil {
+		t.Fatalf("Failed to add tag %s to VMs (%v)", tag.ID(), err)
+	}
+	for _, vm := range []ovirtclient.VM{vm1, vm2} {
+		vmTags, err := vm.ListTags()
+		if err != nil {
+			t.Fatalf("Failed to list VM %s tags (%v).", vm.ID(), err)
+		}
+		if len(vmTags) != 1 || vmTags[0].ID() != tag.ID() {
+			t.Fatalf("Incorrect tags on VM %s after bulk tag assignment (got: %d tags)", vm.ID(), len(vmTags))
+		}
+	}
+
+	missingVMID := ovirtclient.VMID("00000000-0000-0000-0000-000000000001")
+	err := client.AddTagToVMs(tag.ID(), []ovirtclient.VMID{vm1.ID(), missingVMID})
+	var multiErr ovirtclient.MultiError
+	if !errors.As(err, &multiErr) {
+		t.Fatalf("Adding a tag to a nonexistent VM did not result in a MultiError (%v)", err)
+	}
+	vmErrors := multiErr.Errors()
+	if len(vmErrors) != 1 || vmErrors[string(missingVMID)] == nil {
+		t.Fatalf("Incorrect failures in MultiError (%v)", err)
+	}
+}
+
 func assertCanAddTagToVM(t *testing.T, vm ovirtclient.VM, tag ovirtclient.Tag) {
 	if err := vm.AddTag(tag.ID()); err != nil {
 		t.Fatalf("Failed to add tag %s to VM %s.", tag.ID(), vm.ID())
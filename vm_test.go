@@ -2,6 +2,7 @@ package ovirtclient_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
@@ -291,7 +292,7 @@ func TestVMCreationWithInit(t *testing.T) { //nolint:funlen,gocognit,gocyclo
 				"activeDirectoryOu": "ActiveDirectoryOu",
 				"authorizedSshKeys": "AuthorizedSshKeys",
 				"dnsSearch":         "DnsSearch",
-				"dnsServers":        "DnsServers",
+				"dnsServers":        "192.168.19.1 2001:db8::53",
 				"domain":            "Domain",
 				"inputLocale":       "InputLocale",
 				"orgName":           "OrgName",
@@ -1010,6 +1011,27 @@ func TestVMCPUMode(t *testing.T) {
 	}
 }
 
+func TestVMCustomCPUModel(t *testing.T) {
+	helper := getHelper(t)
+
+	if _, err := ovirtclient.NewVMCPUParams().WithCustomModel("Skylake-Client", "spec-ctrl"); err == nil {
+		t.Fatalf("Setting a CPU flag without a + or - prefix did not result in an error.")
+	}
+
+	cpu := ovirtclient.NewVMCPUParams().
+		MustWithTopo(ovirtclient.NewVMCPUTopoParams().MustWithCores(1).MustWithThreads(1).MustWithSockets(1)).
+		MustWithCustomModel("Skylake-Client", "+spec-ctrl", "-hle")
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithCPU(cpu),
+	)
+	if customModel := vm.CPU().CustomModel(); customModel != "Skylake-Client,+spec-ctrl,-hle" {
+		t.Fatalf("Incorrect custom CPU model (expected: %s, got: %s)", "Skylake-Client,+spec-ctrl,-hle", customModel)
+	}
+}
+
 func TestVMDiskStorageDomain(t *testing.T) {
 	helper := getHelper(t)
 
@@ -1321,3 +1343,191 @@ func assertCanCreateBootableVM(t *testing.T, helper ovirtclient.TestHelper) ovir
 	assertCanAttachDiskWithParams(t, vm1, disk1, ovirtclient.CreateDiskAttachmentParams().MustWithBootable(true).MustWithActive(true))
 	return vm1
 }
+
+func TestVMCreationWithTimeZoneAndBIOSType(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().
+			MustWithTimeZone("Etc/GMT").
+			MustWithBIOSType(ovirtclient.BIOSTypeQ35UEFI),
+	)
+	if vm.TimeZone() != "Etc/GMT" {
+		t.Fatalf("Incorrect time zone on VM (expected: %s, got: %s)", "Etc/GMT", vm.TimeZone())
+	}
+	if vm.BIOSType() != ovirtclient.BIOSTypeQ35UEFI {
+		t.Fatalf("Incorrect BIOS type on VM (expected: %s, got: %s)", ovirtclient.BIOSTypeQ35UEFI, vm.BIOSType())
+	}
+}
+
+func TestVMCreationWithSecureBoot(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	_, err := helper.GetClient().CreateVM(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().
+			MustWithBIOSType(ovirtclient.BIOSTypeQ35SecureBoot).
+			WithOS(ovirtclient.NewVMOSParameters().MustWithType("windows_xp")),
+	)
+	if err == nil {
+		t.Fatalf("Creating a VM with secure boot and an incompatible OS type did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a VM with secure boot and an incompatible OS type did not result in an EBadArgument error (%v)", err)
+	}
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().
+			MustWithBIOSType(ovirtclient.BIOSTypeQ35SecureBoot).
+			WithOS(ovirtclient.NewVMOSParameters().MustWithType("rhel_8x64")),
+	)
+	if vm.BIOSType() != ovirtclient.BIOSTypeQ35SecureBoot {
+		t.Fatalf("Incorrect BIOS type on VM (expected: %s, got: %s)", ovirtclient.BIOSTypeQ35SecureBoot, vm.BIOSType())
+	}
+}
+
+func TestVMSerialNumber(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	if _, err := ovirtclient.NewCreateVMParams().WithSerialNumber(
+		ovirtclient.SerialNumberPolicyHost,
+		"ABC-123",
+	); err == nil || !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Setting a custom serial number with the host policy did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := ovirtclient.NewCreateVMParams().WithSerialNumber(
+		ovirtclient.SerialNumberPolicyCustom,
+		"",
+	); err == nil || !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Setting the custom policy without a serial number did not result in an EBadArgument error (%v)", err)
+	}
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithSerialNumber(ovirtclient.SerialNumberPolicyCustom, "ABC-123"),
+	)
+	serialNumber := vm.SerialNumber()
+	if serialNumber == nil {
+		t.Fatalf("No serial number returned on VM created with a custom serial number.")
+	}
+	if serialNumber.Policy() != ovirtclient.SerialNumberPolicyCustom || serialNumber.Value() != "ABC-123" {
+		t.Fatalf(
+			"Incorrect serial number on VM (expected: %s/%s, got: %s/%s)",
+			ovirtclient.SerialNumberPolicyCustom,
+			"ABC-123",
+			serialNumber.Policy(),
+			serialNumber.Value(),
+		)
+	}
+
+	updatedVM, err := vm.SetSerialNumber(ovirtclient.SerialNumberPolicyVM, "")
+	if err != nil {
+		t.Fatalf("Failed to change serial number policy (%v)", err)
+	}
+	serialNumber = updatedVM.SerialNumber()
+	if serialNumber == nil || serialNumber.Policy() != ovirtclient.SerialNumberPolicyVM {
+		t.Fatalf("Serial number policy was not changed to %s.", ovirtclient.SerialNumberPolicyVM)
+	}
+}
+
+func TestVMCreationFromScratch(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	_, err := helper.GetClient().CreateVM(helper.GetClusterID(), "", helper.GenerateTestResourceName(t), nil)
+	if err == nil {
+		t.Fatalf("Creating a VM without a template and without memory did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a VM without a template and without memory did not result in an EBadArgument error (%v)", err)
+	}
+
+	_, err = helper.GetClient().CreateVM(
+		helper.GetClusterID(),
+		"",
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithMemory(1024*1024*1024),
+	)
+	if err == nil {
+		t.Fatalf("Creating a VM without a template and without a disk or boot device did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf(
+			"Creating a VM without a template and without a disk or boot device did not result in an EBadArgument error (%v)",
+			err,
+		)
+	}
+
+	vm, err := helper.GetClient().CreateVM(
+		helper.GetClusterID(),
+		"",
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().
+			MustWithMemory(1024*1024*1024).
+			WithOS(ovirtclient.NewVMOSParameters().MustWithBootDevices(ovirtclient.BootDeviceNetwork)),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create VM without a template (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := vm.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to remove VM %s after test (%v)", vm.ID(), err)
+		}
+	})
+	if vm.TemplateID() != helper.GetBlankTemplateID() {
+		t.Fatalf(
+			"The blank template was not substituted for the empty template ID (expected: %s, got: %s)",
+			helper.GetBlankTemplateID(),
+			vm.TemplateID(),
+		)
+	}
+}
+
+func TestVMCommentAndDescriptionSetters(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithDescription("original description"),
+	)
+	if err := vm.SetComment("managed-by=terraform"); err != nil {
+		t.Fatalf("Failed to set VM comment (%v)", err)
+	}
+	if err := vm.SetDescription(strings.Repeat("a", ovirtclient.VMDescriptionMaxLength+1)); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("Setting a too long VM description did not result in an EBadArgument error (%v)", err)
+	}
+
+	updatedVM, err := helper.GetClient().GetVM(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch VM after setting the comment (%v)", err)
+	}
+	if updatedVM.Comment() != "managed-by=terraform" {
+		t.Fatalf("Incorrect VM comment (expected: %s, got: %s)", "managed-by=terraform", updatedVM.Comment())
+	}
+	if updatedVM.Description() != "original description" {
+		t.Fatalf(
+			"Setting the comment changed the VM description (expected: %s, got: %s)",
+			"original description",
+			updatedVM.Description(),
+		)
+	}
+}
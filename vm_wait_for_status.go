@@ -5,9 +5,10 @@ import (
 )
 
 func (o *oVirtClient) WaitForVMStatus(id VMID, status VMStatus, retries ...RetryStrategy) (vm VM, err error) {
-	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	retries = defaultRetries(o.withPollInterval(retries, pollVMStatus), defaultLongTimeouts(o))
+	action := fmt.Sprintf("waiting for VM %s status %s", id, status)
 	err = retry(
-		fmt.Sprintf("waiting for VM %s status %s", id, status),
+		action,
 		o.logger,
 		retries,
 		func() error {
@@ -15,6 +16,7 @@ func (o *oVirtClient) WaitForVMStatus(id VMID, status VMStatus, retries ...Retry
 			if err != nil {
 				return err
 			}
+			reportStatus(o.ctx, action, string(vm.Status()))
 			if vm.Status() != status {
 				return newError(EPending, "VM status is %s, not %s", vm.Status(), status)
 			}
@@ -25,8 +27,9 @@ func (o *oVirtClient) WaitForVMStatus(id VMID, status VMStatus, retries ...Retry
 
 func (m *mockClient) WaitForVMStatus(id VMID, status VMStatus, retries ...RetryStrategy) (vm VM, err error) {
 	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	action := fmt.Sprintf("waiting for VM %s status %s", id, status)
 	err = retry(
-		fmt.Sprintf("waiting for VM %s status %s", id, status),
+		action,
 		m.logger,
 		retries,
 		func() error {
@@ -34,6 +37,7 @@ func (m *mockClient) WaitForVMStatus(id VMID, status VMStatus, retries ...RetryS
 			if err != nil {
 				return err
 			}
+			reportStatus(m.ctx, action, string(vm.Status()))
 			if vm.Status() != status {
 				return newError(EPending, "VM status is %s, not %s", vm.Status(), status)
 			}
@@ -0,0 +1,347 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// VMWatchdogID is the identifier of a watchdog device on a VM.
+type VMWatchdogID string
+
+// VMWatchdogClient lists the methods to configure the watchdog device of VMs.
+type VMWatchdogClient interface {
+	// SetVMWatchdog adds a watchdog device to the VM, or changes the model and action of the existing one. A VM
+	// can have at most one watchdog device.
+	SetVMWatchdog(vmID VMID, model WatchdogModel, action WatchdogAction, retries ...RetryStrategy) error
+	// GetVMWatchdog returns the watchdog device of the VM. An ENotFound error is returned if the VM has no
+	// watchdog device.
+	GetVMWatchdog(vmID VMID, retries ...RetryStrategy) (VMWatchdog, error)
+	// RemoveVMWatchdog removes the watchdog device from the VM. Removing the watchdog from a VM that doesn't have
+	// one is not an error.
+	RemoveVMWatchdog(vmID VMID, retries ...RetryStrategy) error
+}
+
+// VMWatchdog is the watchdog device of a VM. The guest operating system has to periodically reset the watchdog,
+// otherwise the action is triggered by the hypervisor.
+type VMWatchdog interface {
+	// ID returns the identifier of the watchdog device.
+	ID() VMWatchdogID
+	// VMID returns the ID of the VM the watchdog belongs to.
+	VMID() VMID
+	// Model returns the emulated watchdog hardware.
+	Model() WatchdogModel
+	// Action returns what happens to the VM when the watchdog expires.
+	Action() WatchdogAction
+}
+
+// VMWatchdogParameters contains the watchdog configuration passed on VM creation.
+type VMWatchdogParameters interface {
+	// Model returns the emulated watchdog hardware.
+	Model() WatchdogModel
+	// Action returns what happens to the VM when the watchdog expires.
+	Action() WatchdogAction
+}
+
+// WatchdogModel is the emulated watchdog hardware.
+type WatchdogModel string
+
+const (
+	// WatchdogModelI6300ESB emulates the Intel 6300ESB watchdog. It is the model to use on x86 VMs.
+	WatchdogModelI6300ESB WatchdogModel = "i6300esb"
+	// WatchdogModelDiag288 emulates the diag288 watchdog available on s390x VMs.
+	WatchdogModelDiag288 WatchdogModel = "diag288"
+)
+
+// WatchdogModelList is a list of WatchdogModel values.
+type WatchdogModelList []WatchdogModel
+
+// WatchdogModelValues returns all possible WatchdogModel values.
+func WatchdogModelValues() WatchdogModelList {
+	return []WatchdogModel{
+		WatchdogModelI6300ESB,
+		WatchdogModelDiag288,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l WatchdogModelList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// Validate returns an error if the watchdog model is not valid.
+func (w WatchdogModel) Validate() error {
+	for _, value := range WatchdogModelValues() {
+		if value == w {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid watchdog model: %s, must be one of: %s",
+		w,
+		strings.Join(WatchdogModelValues().Strings(), ", "),
+	)
+}
+
+// WatchdogAction describes what happens to the VM when the watchdog expires.
+type WatchdogAction string
+
+const (
+	// WatchdogActionNone only logs an event when the watchdog expires.
+	WatchdogActionNone WatchdogAction = "none"
+	// WatchdogActionReset resets the VM.
+	WatchdogActionReset WatchdogAction = "reset"
+	// WatchdogActionPoweroff powers off the VM.
+	WatchdogActionPoweroff WatchdogAction = "poweroff"
+	// WatchdogActionPause pauses the VM.
+	WatchdogActionPause WatchdogAction = "pause"
+	// WatchdogActionDump creates a memory dump of the VM and pauses it.
+	WatchdogActionDump WatchdogAction = "dump"
+)
+
+// WatchdogActionList is a list of WatchdogAction values.
+type WatchdogActionList []WatchdogAction
+
+// WatchdogActionValues returns all possible WatchdogAction values.
+func WatchdogActionValues() WatchdogActionList {
+	return []WatchdogAction{
+		WatchdogActionNone,
+		WatchdogActionReset,
+		WatchdogActionPoweroff,
+		WatchdogActionPause,
+		WatchdogActionDump,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l WatchdogActionList) Strings() []string {
+	result := make([]string, len(l))
+	for i, value := range l {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// Validate returns an error if the watchdog action is not valid.
+func (w WatchdogAction) Validate() error {
+	for _, value := range WatchdogActionValues() {
+		if value == w {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid watchdog action: %s, must be one of: %s",
+		w,
+		strings.Join(WatchdogActionValues().Strings(), ", "),
+	)
+}
+
+func validateWatchdog(model WatchdogModel, action WatchdogAction) error {
+	if err := model.Validate(); err != nil {
+		return err
+	}
+	return action.Validate()
+}
+
+type vmWatchdogParameters struct {
+	model  WatchdogModel
+	action WatchdogAction
+}
+
+func (v *vmWatchdogParameters) Model() WatchdogModel {
+	return v.model
+}
+
+func (v *vmWatchdogParameters) Action() WatchdogAction {
+	return v.action
+}
+
+type vmWatchdog struct {
+	id     VMWatchdogID
+	vmID   VMID
+	model  WatchdogModel
+	action WatchdogAction
+}
+
+func (v *vmWatchdog) ID() VMWatchdogID {
+	return v.id
+}
+
+func (v *vmWatchdog) VMID() VMID {
+	return v.vmID
+}
+
+func (v *vmWatchdog) Model() WatchdogModel {
+	return v.model
+}
+
+func (v *vmWatchdog) Action() WatchdogAction {
+	return v.action
+}
+
+func convertSDKWatchdog(sdkObject *ovirtsdk.Watchdog, vmID VMID) (VMWatchdog, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("watchdog", "id")
+	}
+	model, ok := sdkObject.Model()
+	if !ok {
+		return nil, newFieldNotFound("watchdog", "model")
+	}
+	action, ok := sdkObject.Action()
+	if !ok {
+		return nil, newFieldNotFound("watchdog", "action")
+	}
+	return &vmWatchdog{
+		id:     VMWatchdogID(id),
+		vmID:   vmID,
+		model:  WatchdogModel(model),
+		action: WatchdogAction(action),
+	}, nil
+}
+
+func (o *oVirtClient) SetVMWatchdog(
+	vmID VMID,
+	model WatchdogModel,
+	action WatchdogAction,
+	retries ...RetryStrategy,
+) error {
+	if err := validateWatchdog(model, action); err != nil {
+		return err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	existing, err := o.GetVMWatchdog(vmID, retries...)
+	if err != nil && !HasErrorCode(err, ENotFound) {
+		return err
+	}
+	watchdog := ovirtsdk.NewWatchdogBuilder().
+		Model(ovirtsdk.WatchdogModel(model)).
+		Action(ovirtsdk.WatchdogAction(action)).
+		MustBuild()
+	operation := fmt.Sprintf("setting watchdog on VM %s", vmID)
+	return retry(
+		operation,
+		o.logger,
+		retries,
+		func() error {
+			watchdogsService := o.conn.SystemService().VmsService().VmService(string(vmID)).WatchdogsService()
+			if existing != nil {
+				_, err := watchdogsService.WatchdogService(string(existing.ID())).Update().Watchdog(watchdog).Send()
+				return wrapSDKError(operation, err)
+			}
+			_, err := watchdogsService.Add().Watchdog(watchdog).Send()
+			return wrapSDKError(operation, err)
+		})
+}
+
+func (o *oVirtClient) GetVMWatchdog(vmID VMID, retries ...RetryStrategy) (result VMWatchdog, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	action := fmt.Sprintf("getting watchdog of VM %s", vmID)
+	err = retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().VmsService().VmService(string(vmID)).WatchdogsService().List().Send()
+			if err != nil {
+				return wrapSDKError(action, err)
+			}
+			if watchdogs, ok := response.Watchdogs(); ok && len(watchdogs.Slice()) > 0 {
+				result, err = convertSDKWatchdog(watchdogs.Slice()[0], vmID)
+				return err
+			}
+			return newError(ENotFound, "VM %s has no watchdog", vmID)
+		})
+	return result, err
+}
+
+func (o *oVirtClient) RemoveVMWatchdog(vmID VMID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	existing, err := o.GetVMWatchdog(vmID, retries...)
+	if err != nil {
+		if HasErrorCode(err, ENotFound) {
+			return nil
+		}
+		return err
+	}
+	action := fmt.Sprintf("removing watchdog from VM %s", vmID)
+	return retry(
+		action,
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				WatchdogsService().
+				WatchdogService(string(existing.ID())).
+				Remove().
+				Send()
+			return wrapSDKError(action, err)
+		})
+}
+
+func (m *mockClient) SetVMWatchdog(
+	vmID VMID,
+	model WatchdogModel,
+	action WatchdogAction,
+	_ ...RetryStrategy,
+) error {
+	if err := validateWatchdog(model, action); err != nil {
+		return err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	m.setVMWatchdog(vmID, model, action)
+	return nil
+}
+
+// setVMWatchdog stores the watchdog of the VM. The caller must hold the lock.
+func (m *mockClient) setVMWatchdog(vmID VMID, model WatchdogModel, action WatchdogAction) {
+	if existing, ok := m.watchdogsByVM[vmID]; ok {
+		existing.model = model
+		existing.action = action
+		return
+	}
+	m.watchdogsByVM[vmID] = &vmWatchdog{
+		id:     VMWatchdogID(m.GenerateUUID()),
+		vmID:   vmID,
+		model:  model,
+		action: action,
+	}
+}
+
+func (m *mockClient) GetVMWatchdog(vmID VMID, _ ...RetryStrategy) (VMWatchdog, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	watchdog, ok := m.watchdogsByVM[vmID]
+	if !ok {
+		return nil, newError(ENotFound, "VM %s has no watchdog", vmID)
+	}
+	result := *watchdog
+	return &result, nil
+}
+
+func (m *mockClient) RemoveVMWatchdog(vmID VMID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	delete(m.watchdogsByVM, vmID)
+	return nil
+}
@@ -0,0 +1,103 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMWatchdog(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	if _, err := client.GetVMWatchdog(vm.ID()); err == nil || !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Getting the watchdog of a VM without one did not result in an ENotFound error (%v)", err)
+	}
+
+	if err := client.SetVMWatchdog(
+		vm.ID(),
+		ovirtclient.WatchdogModelI6300ESB,
+		ovirtclient.WatchdogActionReset,
+	); err != nil {
+		t.Fatalf("Failed to add watchdog to VM %s (%v)", vm.ID(), err)
+	}
+	assertVMWatchdog(t, client, vm.ID(), ovirtclient.WatchdogModelI6300ESB, ovirtclient.WatchdogActionReset)
+
+	if err := client.SetVMWatchdog(
+		vm.ID(),
+		ovirtclient.WatchdogModelI6300ESB,
+		ovirtclient.WatchdogActionPoweroff,
+	); err != nil {
+		t.Fatalf("Failed to update watchdog on VM %s (%v)", vm.ID(), err)
+	}
+	assertVMWatchdog(t, client, vm.ID(), ovirtclient.WatchdogModelI6300ESB, ovirtclient.WatchdogActionPoweroff)
+
+	if err := client.RemoveVMWatchdog(vm.ID()); err != nil {
+		t.Fatalf("Failed to remove watchdog from VM %s (%v)", vm.ID(), err)
+	}
+	if _, err := client.GetVMWatchdog(vm.ID()); err == nil || !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Getting the watchdog of a VM after removal did not result in an ENotFound error (%v)", err)
+	}
+}
+
+func TestVMCreationWithWatchdog(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithWatchdog(
+			ovirtclient.WatchdogModelI6300ESB,
+			ovirtclient.WatchdogActionPause,
+		),
+	)
+	assertVMWatchdog(t, helper.GetClient(), vm.ID(), ovirtclient.WatchdogModelI6300ESB, ovirtclient.WatchdogActionPause)
+}
+
+func TestVMWatchdogValidation(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	if err := client.SetVMWatchdog(
+		vm.ID(),
+		"invalid",
+		ovirtclient.WatchdogActionReset,
+	); err == nil || !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Setting an invalid watchdog model did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := ovirtclient.NewCreateVMParams().WithWatchdog(
+		ovirtclient.WatchdogModelI6300ESB,
+		"invalid",
+	); err == nil || !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Setting an invalid watchdog action did not result in an EBadArgument error (%v)", err)
+	}
+}
+
+func assertVMWatchdog(
+	t *testing.T,
+	client ovirtclient.Client,
+	vmID ovirtclient.VMID,
+	model ovirtclient.WatchdogModel,
+	action ovirtclient.WatchdogAction,
+) {
+	t.Helper()
+	watchdog, err := client.GetVMWatchdog(vmID)
+	if err != nil {
+		t.Fatalf("Failed to get watchdog of VM %s (%v)", vmID, err)
+	}
+	if watchdog.VMID() != vmID {
+		t.Fatalf("Incorrect VM ID on watchdog (expected: %s, got: %s)", vmID, watchdog.VMID())
+	}
+	if watchdog.Model() != model {
+		t.Fatalf("Incorrect watchdog model (expected: %s, got: %s)", model, watchdog.Model())
+	}
+	if watchdog.Action() != action {
+		t.Fatalf("Incorrect watchdog action (expected: %s, got: %s)", action, watchdog.Action())
+	}
+}
@@ -19,14 +19,27 @@ type VNICProfileClient interface {
 	ListVNICProfiles(retries ...RetryStrategy) ([]VNICProfile, error)
 	// RemoveVNICProfile removes a VNIC profile
 	RemoveVNICProfile(id VNICProfileID, retries ...RetryStrategy) error
+	// GetVNICProfileNetworkFilter returns the network filter applied to the VNIC profile. If the profile has no
+	// network filter, nil is returned without an error.
+	GetVNICProfileNetworkFilter(id VNICProfileID, retries ...RetryStrategy) (NetworkFilter, error)
 }
 
 // OptionalVNICProfileParameters is a set of parameters for creating VNICProfiles that are optional.
-type OptionalVNICProfileParameters interface{}
+type OptionalVNICProfileParameters interface {
+	// NetworkFilterID returns the network filter to apply to the VNIC profile. If nil, the engine default is used,
+	// which is typically vdsm-no-mac-spoofing.
+	NetworkFilterID() *NetworkFilterID
+}
 
 // BuildableVNICProfileParameters is a buildable version of OptionalVNICProfileParameters.
 type BuildableVNICProfileParameters interface {
 	OptionalVNICProfileParameters
+
+	// WithNetworkFilterID sets the network filter to apply to the VNIC profile. Use ListNetworkFilters to find the
+	// available filters.
+	WithNetworkFilterID(id NetworkFilterID) (BuildableVNICProfileParameters, error)
+	// MustWithNetworkFilterID is identical to WithNetworkFilterID, but panics instead of returning an error.
+	MustWithNetworkFilterID(id NetworkFilterID) BuildableVNICProfileParameters
 }
 
 // CreateVNICProfileParams creats a buildable set of optional parameters for VNICProfile creation.
@@ -34,7 +47,29 @@ func CreateVNICProfileParams() BuildableVNICProfileParameters {
 	return &vnicProfileParams{}
 }
 
-type vnicProfileParams struct{}
+type vnicProfileParams struct {
+	networkFilterID *NetworkFilterID
+}
+
+func (v *vnicProfileParams) NetworkFilterID() *NetworkFilterID {
+	return v.networkFilterID
+}
+
+func (v *vnicProfileParams) WithNetworkFilterID(id NetworkFilterID) (BuildableVNICProfileParameters, error) {
+	if id == "" {
+		return nil, newError(EBadArgument, "the network filter ID must not be empty")
+	}
+	v.networkFilterID = &id
+	return v, nil
+}
+
+func (v *vnicProfileParams) MustWithNetworkFilterID(id NetworkFilterID) BuildableVNICProfileParameters {
+	builder, err := v.WithNetworkFilterID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
 
 // VNICProfileData is the core of VNICProfile, providing only data access functions.
 type VNICProfileData interface {
@@ -44,6 +79,9 @@ type VNICProfileData interface {
 	Name() string
 	// NetworkID returns the network ID the VNICProfile is attached to.
 	NetworkID() NetworkID
+	// NetworkFilterID returns the ID of the network filter applied to this VNIC profile, or nil if no filter is
+	// applied.
+	NetworkFilterID() *NetworkFilterID
 }
 
 // VNICProfile is a collection of settings that can be applied to individual virtual network interface cards in the
@@ -55,6 +93,9 @@ type VNICProfile interface {
 	Network(retries ...RetryStrategy) (Network, error)
 	// Remove removes the current VNIC profile.
 	Remove(retries ...RetryStrategy) error
+	// NetworkFilter fetches the network filter applied to this VNIC profile. If no filter is applied, nil is
+	// returned.
+	NetworkFilter(retries ...RetryStrategy) (NetworkFilter, error)
 }
 
 func convertSDKVNICProfile(sdkObject *ovirtsdk.VnicProfile, client Client) (VNICProfile, error) {
@@ -74,22 +115,41 @@ func convertSDKVNICProfile(sdkObject *ovirtsdk.VnicProfile, client Client) (VNIC
 	if !ok {
 		return nil, newFieldNotFound("Network on VNICProfile", "ID")
 	}
+	var networkFilterID *NetworkFilterID
+	if networkFilter, ok := sdkObject.NetworkFilter(); ok {
+		if filterID, ok := networkFilter.Id(); ok {
+			networkFilterID = (*NetworkFilterID)(&filterID)
+		}
+	}
 
 	return &vnicProfile{
 		client: client,
 
-		id:        VNICProfileID(id),
-		name:      name,
-		networkID: NetworkID(networkID),
+		id:              VNICProfileID(id),
+		name:            name,
+		networkID:       NetworkID(networkID),
+		networkFilterID: networkFilterID,
 	}, nil
 }
 
 type vnicProfile struct {
 	client Client
 
-	id        VNICProfileID
-	networkID NetworkID
-	name      string
+	id              VNICProfileID
+	networkID       NetworkID
+	name            string
+	networkFilterID *NetworkFilterID
+}
+
+func (v vnicProfile) NetworkFilterID() *NetworkFilterID {
+	return v.networkFilterID
+}
+
+func (v vnicProfile) NetworkFilter(retries ...RetryStrategy) (NetworkFilter, error) {
+	if v.networkFilterID == nil {
+		return nil, nil
+	}
+	return v.client.GetNetworkFilter(*v.networkFilterID, retries...)
 }
 
 func (v vnicProfile) Remove(retries ...RetryStrategy) error {
@@ -27,6 +27,11 @@ func (o *oVirtClient) CreateVNICProfile(
 			profileBuilder := ovirtsdk.NewVnicProfileBuilder()
 			profileBuilder.Name(name)
 			profileBuilder.Network(ovirtsdk.NewNetworkBuilder().Id(string(networkID)).MustBuild())
+			if params != nil && params.NetworkFilterID() != nil {
+				profileBuilder.NetworkFilter(
+					ovirtsdk.NewNetworkFilterBuilder().Id(string(*params.NetworkFilterID())).MustBuild(),
+				)
+			}
 			req := o.conn.SystemService().VnicProfilesService().Add()
 			response, err := req.Profile(profileBuilder.MustBuild()).Send()
 			if err != nil {
@@ -65,13 +70,22 @@ func (m *mockClient) CreateVNICProfile(
 		}
 	}
 
+	networkFilterID := mockDefaultNetworkFilterID
+	if params != nil && params.NetworkFilterID() != nil {
+		networkFilterID = *params.NetworkFilterID()
+		if _, ok := m.networkFilters[networkFilterID]; !ok {
+			return nil, newError(ENotFound, "network filter with ID %s not found", networkFilterID)
+		}
+	}
+
 	id := VNICProfileID(m.GenerateUUID())
 	m.vnicProfiles[id] = &vnicProfile{
 		client: m,
 
-		id:        id,
-		networkID: networkID,
-		name:      name,
+		id:              id,
+		networkID:       networkID,
+		name:            name,
+		networkFilterID: &networkFilterID,
 	}
 
 	return m.vnicProfiles[id], nil
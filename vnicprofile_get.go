@@ -7,6 +7,9 @@ import (
 )
 
 func (o *oVirtClient) GetVNICProfile(id VNICProfileID, retries ...RetryStrategy) (result VNICProfile, err error) {
+	if err := validateNotBlankUUID("VNIC profile", string(id)); err != nil {
+		return nil, err
+	}
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
 		fmt.Sprintf("getting VNIC profile %s", id),
@@ -15,7 +18,7 @@ func (o *oVirtClient) GetVNICProfile(id VNICProfileID, retries ...RetryStrategy)
 		func() error {
 			response, err := o.conn.SystemService().VnicProfilesService().ProfileService(string(id)).Get().Send()
 			if err != nil {
-				return err
+				return wrapSDKError(fmt.Sprintf("getting VNIC profile %s", id), err)
 			}
 			sdkObject, ok := response.Profile()
 			if !ok {
@@ -40,6 +43,9 @@ func (o *oVirtClient) GetVNICProfile(id VNICProfileID, retries ...RetryStrategy)
 }
 
 func (m *mockClient) GetVNICProfile(id VNICProfileID, _ ...RetryStrategy) (VNICProfile, error) {
+	if err := validateNotBlankUUID("VNIC profile", string(id)); err != nil {
+		return nil, err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.vnicProfiles[id]; ok {
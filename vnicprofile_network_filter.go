@@ -0,0 +1,18 @@
+package ovirtclient
+
+func (o *oVirtClient) GetVNICProfileNetworkFilter(id VNICProfileID, retries ...RetryStrategy) (NetworkFilter, error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	profile, err := o.GetVNICProfile(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return profile.NetworkFilter(retries...)
+}
+
+func (m *mockClient) GetVNICProfileNetworkFilter(id VNICProfileID, retries ...RetryStrategy) (NetworkFilter, error) {
+	profile, err := m.GetVNICProfile(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return profile.NetworkFilter(retries...)
+}
@@ -33,6 +33,60 @@ func TestVNICProfile(t *testing.T) {
 	}
 }
 
+func TestVNICProfileNetworkFilter(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	networkFilters, err := client.ListNetworkFilters()
+	if err != nil {
+		t.Fatalf("failed to list network filters (%v)", err)
+	}
+	var networkFilter ovirtclient.NetworkFilter
+	for _, filter := range networkFilters {
+		if filter.Name() == "clean-traffic" {
+			networkFilter = filter
+		}
+	}
+	if networkFilter == nil {
+		t.Skipf("The clean-traffic network filter is not available.")
+	}
+
+	vnicProfile, err := client.GetVNICProfile(helper.GetVNICProfileID())
+	if err != nil {
+		t.Fatalf("failed to fetch test VNIC profile (%v)", err)
+	}
+	newVNICProfile, err := client.CreateVNICProfile(
+		fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5)),
+		vnicProfile.NetworkID(),
+		ovirtclient.CreateVNICProfileParams().MustWithNetworkFilterID(networkFilter.ID()),
+	)
+	if err != nil {
+		t.Fatalf("failed to create VNIC profile with network filter (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := newVNICProfile.Remove(); err != nil {
+			t.Fatalf("failed to remove VNIC profile %s (%v)", newVNICProfile.ID(), err)
+		}
+	})
+
+	appliedFilter, err := client.GetVNICProfileNetworkFilter(newVNICProfile.ID())
+	if err != nil {
+		t.Fatalf("failed to get network filter of VNIC profile (%v)", err)
+	}
+	if appliedFilter == nil || appliedFilter.ID() != networkFilter.ID() {
+		t.Fatalf("incorrect network filter on VNIC profile (expected: %s, got: %v)", networkFilter.ID(), appliedFilter)
+	}
+
+	_, err = client.GetVNICProfileNetworkFilter("00000000-0000-0000-0000-000000000001")
+	if err == nil {
+		t.Fatalf("getting the network filter of a nonexistent VNIC profile did not result in an error")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("getting the network filter of a nonexistent VNIC profile did not result in an ENotFound error (%v)", err)
+	}
+}
+
 func assertCanCreateVNICProfile(t *testing.T, helper ovirtclient.TestHelper) ovirtclient.VNICProfile {
 	client := helper.GetClient()
 	vnicProfile, err := client.GetVNICProfile(helper.GetVNICProfileID())